@@ -0,0 +1,126 @@
+package bolt12
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/btcsuite/btcd/btcutil/bech32"
+)
+
+const (
+	// charset is the set of characters used in the data section of a
+	// bech32 string.
+	charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+
+	// OfferHRP is the human-readable part of an encoded offer.
+	OfferHRP = "lno"
+
+	// InvoiceRequestHRP is the human-readable part of an encoded
+	// invoice_request.
+	InvoiceRequestHRP = "lnr"
+
+	// InvoiceHRP is the human-readable part of an encoded BOLT 12 invoice.
+	InvoiceHRP = "lni"
+)
+
+// encodeBech32 encodes the given human-readable part and raw TLV stream as a
+// BOLT 12 string. In contrast to BIP-0173, BOLT 12 strings don't carry a
+// checksum since they're never meant to be typed in by hand.
+func encodeBech32(hrp string, data []byte) (string, error) {
+	converted, err := bech32.ConvertBits(data, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	b.Grow(len(hrp) + 1 + len(converted))
+	b.WriteString(hrp)
+	b.WriteByte('1')
+	for _, c := range converted {
+		b.WriteByte(charset[c])
+	}
+
+	return b.String(), nil
+}
+
+// stripContinuations removes all '+' characters and any whitespace following
+// them from the given string. BOLT 12 allows long strings to be split over
+// multiple lines this way. A '+' must always be surrounded by bech32
+// characters.
+func stripContinuations(s string) (string, error) {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '+' {
+			b.WriteByte(s[i])
+			continue
+		}
+
+		if b.Len() == 0 {
+			return "", fmt.Errorf("string must not start with '+'")
+		}
+
+		// Skip all whitespace following the '+'.
+		for i+1 < len(s) && unicode.IsSpace(rune(s[i+1])) {
+			i++
+		}
+
+		if i+1 == len(s) || s[i+1] == '+' {
+			return "", fmt.Errorf("'+' must be followed by a " +
+				"bech32 character")
+		}
+	}
+
+	return b.String(), nil
+}
+
+// decodeBech32 decodes a BOLT 12 string, returning the human-readable part and
+// the raw TLV stream it encodes.
+func decodeBech32(s string) (string, []byte, error) {
+	s, err := stripContinuations(s)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Only ASCII characters between 33 and 126 are allowed.
+	for i := 0; i < len(s); i++ {
+		if s[i] < 33 || s[i] > 126 {
+			return "", nil, fmt.Errorf("invalid character in "+
+				"string: '%c'", s[i])
+		}
+	}
+
+	// The characters must be either all lowercase or all uppercase.
+	lower := strings.ToLower(s)
+	if s != lower && s != strings.ToUpper(s) {
+		return "", nil, fmt.Errorf("string not all lowercase or all " +
+			"uppercase")
+	}
+	s = lower
+
+	// The human-readable part is everything before the last '1'.
+	one := strings.LastIndexByte(s, '1')
+	if one < 1 || one == len(s)-1 {
+		return "", nil, fmt.Errorf("invalid index of 1")
+	}
+	hrp, chars := s[:one], s[one+1:]
+
+	data := make([]byte, len(chars))
+	for i := 0; i < len(chars); i++ {
+		index := strings.IndexByte(charset, chars[i])
+		if index < 0 {
+			return "", nil, fmt.Errorf("invalid character not "+
+				"part of charset: %v", chars[i])
+		}
+		data[i] = byte(index)
+	}
+
+	converted, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return hrp, converted, nil
+}
@@ -0,0 +1,93 @@
+package bolt12
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestBech32RoundTrip makes sure that data survives an encoding round trip
+// and that split strings are joined again.
+func TestBech32RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := []byte("a reusable payment code")
+	encoded, err := encodeBech32(OfferHRP, data)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(encoded, OfferHRP+"1"))
+
+	hrp, decoded, err := decodeBech32(encoded)
+	require.NoError(t, err)
+	require.Equal(t, OfferHRP, hrp)
+	require.Equal(t, data, decoded)
+
+	// Uppercase strings are allowed, mixed case strings aren't.
+	_, decoded, err = decodeBech32(strings.ToUpper(encoded))
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+
+	mixed := strings.ToUpper(encoded[:5]) + encoded[5:]
+	_, _, err = decodeBech32(mixed)
+	require.Error(t, err)
+
+	// The string can be split with '+' followed by optional whitespace.
+	split := encoded[:10] + "+" + encoded[10:20] + "+\n  " + encoded[20:]
+	_, decoded, err = decodeBech32(split)
+	require.NoError(t, err)
+	require.Equal(t, data, decoded)
+}
+
+// TestStripContinuations tests the handling of '+' characters in BOLT 12
+// strings.
+func TestStripContinuations(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input    string
+		expected string
+		valid    bool
+	}{
+		{
+			input:    "lno1qc",
+			expected: "lno1qc",
+			valid:    true,
+		},
+		{
+			input:    "lno1+qc",
+			expected: "lno1qc",
+			valid:    true,
+		},
+		{
+			input:    "lno1+ \t\r\nqc",
+			expected: "lno1qc",
+			valid:    true,
+		},
+		{
+			input: "+lno1qc",
+		},
+		{
+			input: "lno1qc+",
+		},
+		{
+			input: "lno1qc+ ",
+		},
+		{
+			input: "lno1+ +qc",
+		},
+		{
+			input: "lno1++qc",
+		},
+	}
+
+	for _, test := range tests {
+		stripped, err := stripContinuations(test.input)
+		if !test.valid {
+			require.Error(t, err, test.input)
+			continue
+		}
+
+		require.NoError(t, err, test.input)
+		require.Equal(t, test.expected, stripped)
+	}
+}
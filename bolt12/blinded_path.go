@@ -0,0 +1,334 @@
+package bolt12
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// byteOrder defines the endian-ness we use for encoding to and from
+	// buffers.
+	byteOrder = binary.BigEndian
+)
+
+// BlindedPayInfo holds the aggregated routing policy of all hops of a blinded
+// payment path.
+type BlindedPayInfo struct {
+	// FeeBaseMsat is the total base fee for the path in milli-satoshis.
+	FeeBaseMsat uint32
+
+	// FeeRate is the total fee rate for the path in parts per million.
+	FeeRate uint32
+
+	// CltvExpiryDelta is the total CLTV delta to apply to the path.
+	CltvExpiryDelta uint16
+
+	// HTLCMinMsat is the minimum number of milli-satoshis that any hop in
+	// the path will route.
+	HTLCMinMsat uint64
+
+	// HTLCMaxMsat is the maximum number of milli-satoshis that a hop in the
+	// path will route.
+	HTLCMaxMsat uint64
+
+	// Features is the feature bit vector for the path.
+	Features *lnwire.FeatureVector
+}
+
+// BlindedPaymentPath is a blinded path to the recipient of a BOLT 12 invoice
+// together with the aggregated policy a payer needs to route through it.
+type BlindedPaymentPath struct {
+	// Path is the blinded path. The introduction point of the path is the
+	// real node ID of the first hop.
+	Path *sphinx.BlindedPath
+
+	// PayInfo is the aggregated policy of the path.
+	PayInfo BlindedPayInfo
+}
+
+// EncodeBlindedPath writes a blinded path in the BOLT 12 format:
+//
+//  1. Introduction node ID: 33 bytes.
+//  2. First path key: 33 bytes.
+//  3. Number of hops: 1 byte.
+//  4. For each hop: the blinded node ID (33 bytes), the length of the
+//     encrypted recipient data (2 bytes) and the data itself.
+func EncodeBlindedPath(w io.Writer, path *sphinx.BlindedPath) error {
+	numHops := len(path.BlindedHops)
+	if numHops == 0 || numHops > math.MaxUint8 {
+		return fmt.Errorf("invalid number of blinded hops: %d",
+			numHops)
+	}
+
+	_, err := w.Write(path.IntroductionPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(path.BlindingPoint.SerializeCompressed())
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write([]byte{byte(numHops)}); err != nil {
+		return err
+	}
+
+	for _, hop := range path.BlindedHops {
+		_, err := w.Write(hop.BlindedNodePub.SerializeCompressed())
+		if err != nil {
+			return err
+		}
+
+		if len(hop.CipherText) > math.MaxUint16 {
+			return fmt.Errorf("encrypted recipient data too "+
+				"large: %d bytes", len(hop.CipherText))
+		}
+
+		dataLen := uint16(len(hop.CipherText))
+		if err := binary.Write(w, byteOrder, dataLen); err != nil {
+			return err
+		}
+
+		if _, err := w.Write(hop.CipherText); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readPubKey reads a compressed public key from the given reader.
+func readPubKey(r io.Reader) (*btcec.PublicKey, error) {
+	var keyBytes [btcec.PubKeyBytesLenCompressed]byte
+	if _, err := io.ReadFull(r, keyBytes[:]); err != nil {
+		return nil, err
+	}
+
+	return btcec.ParsePubKey(keyBytes[:])
+}
+
+// DecodeBlindedPath reads a blinded path in the BOLT 12 format.
+//
+// NOTE: Introduction nodes that are identified by a short channel ID and
+// direction aren't supported.
+func DecodeBlindedPath(r io.Reader) (*sphinx.BlindedPath, error) {
+	var first [1]byte
+	if _, err := io.ReadFull(r, first[:]); err != nil {
+		return nil, err
+	}
+	if first[0] == 0 || first[0] == 1 {
+		return nil, fmt.Errorf("blinded paths with a short channel " +
+			"ID as introduction node are not supported")
+	}
+
+	// The first byte was the prefix of the introduction node ID.
+	intro, err := readPubKey(io.MultiReader(bytes.NewReader(first[:]), r))
+	if err != nil {
+		return nil, fmt.Errorf("invalid introduction node: %w", err)
+	}
+
+	blinding, err := readPubKey(r)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path key: %w", err)
+	}
+
+	var numHops [1]byte
+	if _, err := io.ReadFull(r, numHops[:]); err != nil {
+		return nil, err
+	}
+	if numHops[0] == 0 {
+		return nil, fmt.Errorf("blinded path has no hops")
+	}
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: intro,
+		BlindingPoint:     blinding,
+		BlindedHops: make(
+			[]*sphinx.BlindedHopInfo, 0, int(numHops[0]),
+		),
+	}
+	for i := 0; i < int(numHops[0]); i++ {
+		nodeID, err := readPubKey(r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid blinded node ID: %w",
+				err)
+		}
+
+		var dataLen uint16
+		if err := binary.Read(r, byteOrder, &dataLen); err != nil {
+			return nil, err
+		}
+
+		data := make([]byte, dataLen)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, err
+		}
+
+		path.BlindedHops = append(
+			path.BlindedHops, &sphinx.BlindedHopInfo{
+				BlindedNodePub: nodeID,
+				CipherText:     data,
+			},
+		)
+	}
+
+	return path, nil
+}
+
+// encodePayInfo writes the aggregated policy of a blinded payment path.
+func encodePayInfo(w io.Writer, info *BlindedPayInfo) error {
+	if err := binary.Write(w, byteOrder, info.FeeBaseMsat); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, info.FeeRate); err != nil {
+		return err
+	}
+
+	err := binary.Write(w, byteOrder, info.CltvExpiryDelta)
+	if err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, info.HTLCMinMsat); err != nil {
+		return err
+	}
+
+	if err := binary.Write(w, byteOrder, info.HTLCMaxMsat); err != nil {
+		return err
+	}
+
+	features := info.Features
+	if features == nil {
+		features = lnwire.EmptyFeatureVector()
+	}
+
+	return features.Encode(w)
+}
+
+// decodePayInfo reads the aggregated policy of a blinded payment path.
+func decodePayInfo(r io.Reader) (*BlindedPayInfo, error) {
+	var info BlindedPayInfo
+
+	if err := binary.Read(r, byteOrder, &info.FeeBaseMsat); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &info.FeeRate); err != nil {
+		return nil, err
+	}
+
+	err := binary.Read(r, byteOrder, &info.CltvExpiryDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &info.HTLCMinMsat); err != nil {
+		return nil, err
+	}
+
+	if err := binary.Read(r, byteOrder, &info.HTLCMaxMsat); err != nil {
+		return nil, err
+	}
+
+	features := lnwire.EmptyFeatureVector()
+	if err := features.Decode(r); err != nil {
+		return nil, err
+	}
+	info.Features = features
+
+	return &info, nil
+}
+
+// serializeBlindedPaths serializes a list of blinded paths as the value of a
+// TLV record.
+func serializeBlindedPaths(paths []*sphinx.BlindedPath) ([]byte, error) {
+	var b bytes.Buffer
+	for _, path := range paths {
+		if err := EncodeBlindedPath(&b, path); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// blindedPathsDecoder is a tlv.Decoder for a list of blinded paths.
+func blindedPathsDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*[]*sphinx.BlindedPath); ok {
+		b := make([]byte, l)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+
+		var (
+			paths  []*sphinx.BlindedPath
+			reader = bytes.NewReader(b)
+		)
+		for reader.Len() > 0 {
+			path, err := DecodeBlindedPath(reader)
+			if err != nil {
+				return err
+			}
+			paths = append(paths, path)
+		}
+		*v = paths
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "[]*sphinx.BlindedPath", l, l)
+}
+
+// serializePayInfos serializes a list of blinded pay infos as the value of a
+// TLV record.
+func serializePayInfos(infos []*BlindedPayInfo) ([]byte, error) {
+	var b bytes.Buffer
+	for _, info := range infos {
+		if err := encodePayInfo(&b, info); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// payInfosDecoder is a tlv.Decoder for a list of blinded pay infos.
+func payInfosDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*[]*BlindedPayInfo); ok {
+		b := make([]byte, l)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return err
+		}
+
+		var (
+			infos  []*BlindedPayInfo
+			reader = bytes.NewReader(b)
+		)
+		for reader.Len() > 0 {
+			info, err := decodePayInfo(reader)
+			if err != nil {
+				return err
+			}
+			infos = append(infos, info)
+		}
+		*v = infos
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "[]*BlindedPayInfo", l, l)
+}
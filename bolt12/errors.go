@@ -0,0 +1,51 @@
+package bolt12
+
+import "errors"
+
+var (
+	// ErrEmptyStream is returned when a message doesn't contain any
+	// records.
+	ErrEmptyStream = errors.New("empty TLV stream")
+
+	// ErrInvalidRange is returned when a message contains a record whose
+	// type isn't allowed for the message.
+	ErrInvalidRange = errors.New("record type not allowed in message")
+
+	// ErrUnknownRequiredField is returned when a message contains an
+	// unknown record with an even type.
+	ErrUnknownRequiredField = errors.New("unknown required field")
+
+	// ErrNonCanonical is returned when a message isn't encoded in its
+	// canonical form.
+	ErrNonCanonical = errors.New("message not canonically encoded")
+
+	// ErrInvalidUTF8 is returned when a string field isn't valid UTF-8.
+	ErrInvalidUTF8 = errors.New("string is not valid UTF-8")
+
+	// ErrUnknownRequiredFeature is returned when a message requires a
+	// feature we don't understand.
+	ErrUnknownRequiredFeature = errors.New("unknown required feature")
+
+	// ErrWrongHRP is returned when a string is decoded with the wrong
+	// human-readable part for the expected message.
+	ErrWrongHRP = errors.New("unexpected human-readable part")
+
+	// ErrMissingField is returned when a mandatory field of a message is
+	// missing.
+	ErrMissingField = errors.New("mandatory field missing")
+
+	// ErrInvalidField is returned when a field of a message has an invalid
+	// value.
+	ErrInvalidField = errors.New("invalid field value")
+
+	// ErrMissingSignature is returned when a message that must be signed
+	// doesn't carry a signature.
+	ErrMissingSignature = errors.New("signature missing")
+
+	// ErrInvalidSignature is returned when the signature of a message is
+	// invalid.
+	ErrInvalidSignature = errors.New("invalid signature")
+
+	// ErrExpired is returned when an offer or invoice has expired.
+	ErrExpired = errors.New("expired")
+)
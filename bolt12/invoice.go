@@ -0,0 +1,459 @@
+package bolt12
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// invoiceMessageName is the name of the invoice message that is used
+	// in its signature tag.
+	invoiceMessageName = "invoice"
+
+	// DefaultRelativeExpiry is the expiry of an invoice that doesn't
+	// specify a relative expiry.
+	DefaultRelativeExpiry = 2 * time.Hour
+)
+
+// Invoice is a BOLT 12 invoice that the issuer of an offer sends in response
+// to an invoice request. It mirrors all fields of the invoice request, except
+// for its signature, and is signed by the issuer.
+type Invoice struct {
+	// Request is the invoice request this invoice was created for.
+	Request *InvoiceRequest
+
+	// Paths is the list of blinded paths that can be used to pay the
+	// invoice.
+	Paths []*BlindedPaymentPath
+
+	// CreatedAt is the time the invoice was created.
+	CreatedAt time.Time
+
+	// RelativeExpiry is the optional time after creation at which the
+	// invoice expires. If not set, DefaultRelativeExpiry applies.
+	RelativeExpiry fn.Option[time.Duration]
+
+	// PaymentHash is the hash of the payment preimage.
+	PaymentHash lntypes.Hash
+
+	// Amount is the amount to pay.
+	Amount lnwire.MilliSatoshi
+
+	// Features is the optional feature vector of the invoice.
+	Features *lnwire.RawFeatureVector
+
+	// NodeID is the public key the invoice is signed with.
+	NodeID *btcec.PublicKey
+
+	// Signature is the signature of the issuer over the invoice.
+	Signature *schnorr.Signature
+
+	// ExtraRecords holds all unknown odd records within the invoice and
+	// signature ranges.
+	ExtraRecords map[uint64][]byte
+
+	// fallbacks holds the raw on-chain fallback addresses of a decoded
+	// invoice. We never pay to them, but need to retain them to verify
+	// the signature.
+	fallbacks []byte
+}
+
+// NewInvoice creates a new, unsigned invoice in response to the given invoice
+// request.
+func NewInvoice(req *InvoiceRequest, paths []*BlindedPaymentPath,
+	createdAt time.Time, paymentHash lntypes.Hash, amt lnwire.MilliSatoshi,
+	nodeID *btcec.PublicKey) *Invoice {
+
+	return &Invoice{
+		Request:     req,
+		Paths:       paths,
+		CreatedAt:   createdAt,
+		PaymentHash: paymentHash,
+		Amount:      amt,
+		NodeID:      nodeID,
+	}
+}
+
+// records returns the TLV records of all fields of the invoice that are set.
+func (i *Invoice) records() ([]tlv.Record, error) {
+	records, err := i.Request.records(false)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(i.Paths) > 0 {
+		paths := make([]*sphinx.BlindedPath, 0, len(i.Paths))
+		infos := make([]*BlindedPayInfo, 0, len(i.Paths))
+		for _, path := range i.Paths {
+			paths = append(paths, path.Path)
+			infos = append(infos, &path.PayInfo)
+		}
+
+		pathBytes, err := serializeBlindedPaths(paths)
+		if err != nil {
+			return nil, err
+		}
+
+		infoBytes, err := serializePayInfos(infos)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(
+			records, bytesRecord(typeInvoicePaths, pathBytes),
+			bytesRecord(typeInvoiceBlindedPay, infoBytes),
+		)
+	}
+
+	createdAt := uint64(i.CreatedAt.Unix())
+	records = append(records, tu64Record(typeInvoiceCreatedAt, &createdAt))
+
+	i.RelativeExpiry.WhenSome(func(expiry time.Duration) {
+		seconds := uint32(expiry.Seconds())
+		records = append(records, tu32Record(
+			typeInvoiceRelativeExpiry, &seconds,
+		))
+	})
+
+	paymentHash := [32]byte(i.PaymentHash)
+	amt := uint64(i.Amount)
+	records = append(
+		records,
+		tlv.MakePrimitiveRecord(typeInvoicePaymentHash, &paymentHash),
+		tu64Record(typeInvoiceAmount, &amt),
+	)
+
+	if i.fallbacks != nil {
+		records = append(records, bytesRecord(
+			typeInvoiceFallbacks, i.fallbacks,
+		))
+	}
+
+	if i.Features != nil {
+		features, err := featuresBytes(i.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, bytesRecord(
+			typeInvoiceFeatures, features,
+		))
+	}
+
+	if i.NodeID != nil {
+		nodeID := i.NodeID
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeInvoiceNodeID, &nodeID,
+		))
+	}
+
+	if i.Signature != nil {
+		var sig [schnorr.SignatureSize]byte
+		copy(sig[:], i.Signature.Serialize())
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeSignature, &sig,
+		))
+	}
+
+	records = append(records, tlv.MapToRecords(
+		filterRecords(i.ExtraRecords, func(typ uint64) bool {
+			return inInvoiceRange(typ) || isSignatureType(typ)
+		}),
+	)...)
+
+	return records, nil
+}
+
+// Serialize returns the invoice as a raw TLV stream.
+func (i *Invoice) Serialize() ([]byte, error) {
+	records, err := i.records()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeStream(records)
+}
+
+// Encode returns the bech32 string encoding of the invoice.
+func (i *Invoice) Encode() (string, error) {
+	data, err := i.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceHRP, data)
+}
+
+// MerkleRoot returns the merkle root of the invoice that is signed by the
+// issuer.
+func (i *Invoice) MerkleRoot() (chainhash.Hash, error) {
+	data, err := i.Serialize()
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return merkleRoot(data)
+}
+
+// Sign signs the invoice with the given signer, which must use the private key
+// that belongs to the node ID of the invoice.
+func (i *Invoice) Sign(signer SignFunc) error {
+	root, err := i.MerkleRoot()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer(signatureTag(invoiceMessageName), root)
+	if err != nil {
+		return err
+	}
+	i.Signature = sig
+
+	return i.VerifySignature()
+}
+
+// VerifySignature verifies that the invoice is signed by its node ID.
+func (i *Invoice) VerifySignature() error {
+	if i.NodeID == nil {
+		return fmt.Errorf("%w: node ID", ErrMissingField)
+	}
+
+	data, err := i.Serialize()
+	if err != nil {
+		return err
+	}
+
+	return verifySignature(invoiceMessageName, data, i.Signature, i.NodeID)
+}
+
+// Expiry returns the time after creation at which the invoice expires.
+func (i *Invoice) Expiry() time.Duration {
+	return i.RelativeExpiry.UnwrapOr(DefaultRelativeExpiry)
+}
+
+// IsExpired returns true if the invoice has expired at the given time.
+func (i *Invoice) IsExpired(now time.Time) bool {
+	return now.After(i.CreatedAt.Add(i.Expiry()))
+}
+
+// Validate checks that the invoice fulfills all requirements that a payer must
+// check before paying it. This doesn't include the verification of the
+// signature.
+func (i *Invoice) Validate() error {
+	if i.Request == nil || i.Request.Offer == nil {
+		return fmt.Errorf("%w: invoice request", ErrMissingField)
+	}
+
+	if i.Request.PayerID == nil {
+		return fmt.Errorf("%w: payer ID", ErrMissingField)
+	}
+
+	if len(i.Paths) == 0 {
+		return fmt.Errorf("%w: paths", ErrMissingField)
+	}
+
+	if i.NodeID == nil {
+		return fmt.Errorf("%w: node ID", ErrMissingField)
+	}
+
+	// If the offer can only be reached directly, the invoice must be
+	// signed by the issuer of the offer.
+	offer := i.Request.Offer
+	if len(offer.Paths) == 0 && (offer.IssuerID == nil ||
+		!offer.IssuerID.IsEqual(i.NodeID)) {
+
+		return fmt.Errorf("%w: invoice not signed by offer issuer",
+			ErrInvalidField)
+	}
+
+	if i.Amount == 0 {
+		return fmt.Errorf("%w: zero amount", ErrInvalidField)
+	}
+
+	// If the payer explicitly requested an amount, the invoice must be for
+	// exactly that amount.
+	var mismatch bool
+	i.Request.Amount.WhenSome(func(amt uint64) {
+		mismatch = uint64(i.Amount) != amt
+	})
+	if mismatch {
+		return fmt.Errorf("%w: amount %v differs from requested amount",
+			ErrInvalidField, i.Amount)
+	}
+
+	return checkRequiredFeatures(i.Features, lnwire.MPPRequired)
+}
+
+// invoiceFields holds the values of the invoice records while decoding.
+type invoiceFields struct {
+	invoiceRequestFields
+
+	paths          []*sphinx.BlindedPath
+	payInfos       []*BlindedPayInfo
+	createdAt      uint64
+	relativeExpiry uint32
+	paymentHash    [32]byte
+	amount         uint64
+	fallbacks      []byte
+	features       []byte
+	nodeID         *btcec.PublicKey
+	signature      [schnorr.SignatureSize]byte
+}
+
+// records returns the records that are used to decode the invoice fields,
+// including the mirrored invoice request and offer fields.
+func (f *invoiceFields) records() []tlv.Record {
+	return append(
+		f.invoiceRequestFields.records(),
+		decodeOnlyRecord(
+			typeInvoicePaths, &f.paths, blindedPathsDecoder,
+		),
+		decodeOnlyRecord(
+			typeInvoiceBlindedPay, &f.payInfos, payInfosDecoder,
+		),
+		tu64Record(typeInvoiceCreatedAt, &f.createdAt),
+		tu32Record(typeInvoiceRelativeExpiry, &f.relativeExpiry),
+		tlv.MakePrimitiveRecord(typeInvoicePaymentHash, &f.paymentHash),
+		tu64Record(typeInvoiceAmount, &f.amount),
+		tlv.MakePrimitiveRecord(typeInvoiceFallbacks, &f.fallbacks),
+		tlv.MakePrimitiveRecord(typeInvoiceFeatures, &f.features),
+		tlv.MakePrimitiveRecord(typeInvoiceNodeID, &f.nodeID),
+		tlv.MakePrimitiveRecord(typeSignature, &f.signature),
+	)
+}
+
+// ParseInvoice parses an invoice from the given raw TLV stream.
+func ParseInvoice(data []byte) (*Invoice, error) {
+	var fields invoiceFields
+	parsedTypes, extra, err := decodeStream(
+		data, fields.records(), func(typ uint64) bool {
+			return inOfferRange(typ) ||
+				inInvoiceRequestRange(typ) ||
+				inInvoiceRange(typ) || isSignatureType(typ)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	has := func(typ tlv.Type) bool {
+		_, ok := parsedTypes[typ]
+		return ok
+	}
+
+	// All records in the signature range belong to the invoice, so we
+	// remove them before assembling the invoice request.
+	reqExtra := filterRecords(extra, func(typ uint64) bool {
+		return !isSignatureType(typ)
+	})
+	req, err := fields.invoiceRequestFields.toInvoiceRequest(
+		parsedTypes, reqExtra,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields.paths) != len(fields.payInfos) {
+		return nil, fmt.Errorf("%w: %d paths but %d pay infos",
+			ErrInvalidField, len(fields.paths),
+			len(fields.payInfos))
+	}
+
+	for _, typ := range []tlv.Type{
+		typeInvoiceCreatedAt, typeInvoicePaymentHash,
+		typeInvoiceAmount,
+	} {
+		if !has(typ) {
+			return nil, fmt.Errorf("%w: type %d", ErrMissingField,
+				typ)
+		}
+	}
+
+	invoice := &Invoice{
+		Request:     req,
+		CreatedAt:   time.Unix(int64(fields.createdAt), 0),
+		PaymentHash: fields.paymentHash,
+		Amount:      lnwire.MilliSatoshi(fields.amount),
+		NodeID:      fields.nodeID,
+		ExtraRecords: filterRecords(extra, func(typ uint64) bool {
+			return inInvoiceRange(typ) || isSignatureType(typ)
+		}),
+		fallbacks: fields.fallbacks,
+	}
+
+	for idx, path := range fields.paths {
+		invoice.Paths = append(invoice.Paths, &BlindedPaymentPath{
+			Path:    path,
+			PayInfo: *fields.payInfos[idx],
+		})
+	}
+
+	if has(typeInvoiceRelativeExpiry) {
+		invoice.RelativeExpiry = fn.Some(
+			time.Duration(fields.relativeExpiry) * time.Second,
+		)
+	}
+
+	if has(typeInvoiceFallbacks) && invoice.fallbacks == nil {
+		invoice.fallbacks = []byte{}
+	}
+
+	if has(typeInvoiceFeatures) {
+		features, err := parseFeatures(fields.features)
+		if err != nil {
+			return nil, err
+		}
+		invoice.Features = features
+	}
+
+	if has(typeSignature) {
+		invoice.Signature, err = schnorr.ParseSignature(
+			fields.signature[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkCanonical(data, invoice.Serialize); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
+
+// DecodeInvoice decodes a bech32 encoded invoice. The invoice is validated and
+// its signature is verified.
+func DecodeInvoice(s string) (*Invoice, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != InvoiceHRP {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrWrongHRP,
+			InvoiceHRP, hrp)
+	}
+
+	invoice, err := ParseInvoice(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := invoice.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := invoice.VerifySignature(); err != nil {
+		return nil, err
+	}
+
+	return invoice, nil
+}
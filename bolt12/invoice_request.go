@@ -0,0 +1,501 @@
+package bolt12
+
+import (
+	"fmt"
+	"math/bits"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// invoiceRequestMessageName is the name of the invoice request message
+	// that is used in its signature tag.
+	invoiceRequestMessageName = "invoice_request"
+)
+
+// InvoiceRequest is a request for an invoice that a payer sends to the issuer
+// of an offer. It mirrors all fields of the offer and is signed by a transient
+// key of the payer.
+type InvoiceRequest struct {
+	// Metadata is opaque data that the payer attaches to the request. It
+	// makes every request unique.
+	Metadata []byte
+
+	// Offer is the offer the invoice is requested for.
+	Offer *Offer
+
+	// Chain is the optional chain the payer wants to pay on. If not set,
+	// bitcoin mainnet is assumed.
+	Chain fn.Option[chainhash.Hash]
+
+	// Amount is the optional amount in milli-satoshis the payer wants to
+	// pay. It must be set if the offer doesn't specify an amount.
+	Amount fn.Option[uint64]
+
+	// Features is the optional feature vector of the request.
+	Features *lnwire.RawFeatureVector
+
+	// Quantity is the number of items the payer wants to pay for. It must
+	// be set if and only if the offer specifies a maximum quantity.
+	Quantity fn.Option[uint64]
+
+	// PayerID is the transient public key of the payer that the request
+	// is signed with.
+	PayerID *btcec.PublicKey
+
+	// PayerNote is an optional note the payer attaches to the request.
+	PayerNote fn.Option[string]
+
+	// Paths is the list of blinded paths that can be used to reach the
+	// payer with an onion message.
+	Paths []*sphinx.BlindedPath
+
+	// Signature is the signature of the payer over the request.
+	Signature *schnorr.Signature
+
+	// ExtraRecords holds all unknown odd records within the invoice
+	// request and signature ranges.
+	ExtraRecords map[uint64][]byte
+}
+
+// NewInvoiceRequest creates a new, unsigned invoice request for the given
+// offer.
+func NewInvoiceRequest(offer *Offer, metadata []byte,
+	payerID *btcec.PublicKey) *InvoiceRequest {
+
+	return &InvoiceRequest{
+		Metadata: metadata,
+		Offer:    offer,
+		PayerID:  payerID,
+	}
+}
+
+// records returns the TLV records of all fields of the invoice request that
+// are set. If withSignature is false, all records within the signature range
+// are omitted.
+func (r *InvoiceRequest) records(withSignature bool) ([]tlv.Record, error) {
+	records, err := r.Offer.records()
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Metadata != nil {
+		records = append(records, bytesRecord(
+			typeInvReqMetadata, r.Metadata,
+		))
+	}
+
+	r.Chain.WhenSome(func(chain chainhash.Hash) {
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeInvReqChain, (*[32]byte)(&chain),
+		))
+	})
+
+	r.Amount.WhenSome(func(amt uint64) {
+		records = append(records, tu64Record(typeInvReqAmount, &amt))
+	})
+
+	if r.Features != nil {
+		features, err := featuresBytes(r.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, bytesRecord(
+			typeInvReqFeatures, features,
+		))
+	}
+
+	r.Quantity.WhenSome(func(quantity uint64) {
+		records = append(records, tu64Record(
+			typeInvReqQuantity, &quantity,
+		))
+	})
+
+	if r.PayerID != nil {
+		payerID := r.PayerID
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeInvReqPayerID, &payerID,
+		))
+	}
+
+	r.PayerNote.WhenSome(func(note string) {
+		records = append(records, bytesRecord(
+			typeInvReqPayerNote, []byte(note),
+		))
+	})
+
+	if len(r.Paths) > 0 {
+		paths, err := serializeBlindedPaths(r.Paths)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, bytesRecord(typeInvReqPaths, paths))
+	}
+
+	records = append(records, tlv.MapToRecords(
+		filterRecords(r.ExtraRecords, inInvoiceRequestRange),
+	)...)
+
+	if !withSignature {
+		return records, nil
+	}
+
+	if r.Signature != nil {
+		var sig [schnorr.SignatureSize]byte
+		copy(sig[:], r.Signature.Serialize())
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeSignature, &sig,
+		))
+	}
+
+	records = append(records, tlv.MapToRecords(
+		filterRecords(r.ExtraRecords, isSignatureType),
+	)...)
+
+	return records, nil
+}
+
+// Serialize returns the invoice request as a raw TLV stream.
+func (r *InvoiceRequest) Serialize() ([]byte, error) {
+	records, err := r.records(true)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeStream(records)
+}
+
+// Encode returns the bech32 string encoding of the invoice request.
+func (r *InvoiceRequest) Encode() (string, error) {
+	data, err := r.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(InvoiceRequestHRP, data)
+}
+
+// MerkleRoot returns the merkle root of the invoice request that is signed by
+// the payer.
+func (r *InvoiceRequest) MerkleRoot() (chainhash.Hash, error) {
+	data, err := r.Serialize()
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return merkleRoot(data)
+}
+
+// Sign signs the invoice request with the given signer, which must use the
+// private key that belongs to the payer ID.
+func (r *InvoiceRequest) Sign(signer SignFunc) error {
+	root, err := r.MerkleRoot()
+	if err != nil {
+		return err
+	}
+
+	sig, err := signer(signatureTag(invoiceRequestMessageName), root)
+	if err != nil {
+		return err
+	}
+	r.Signature = sig
+
+	return r.VerifySignature()
+}
+
+// VerifySignature verifies that the invoice request is signed by the payer.
+func (r *InvoiceRequest) VerifySignature() error {
+	if r.PayerID == nil {
+		return fmt.Errorf("%w: payer ID", ErrMissingField)
+	}
+
+	data, err := r.Serialize()
+	if err != nil {
+		return err
+	}
+
+	return verifySignature(
+		invoiceRequestMessageName, data, r.Signature, r.PayerID,
+	)
+}
+
+// ChainHash returns the chain the payer wants to pay on.
+func (r *InvoiceRequest) ChainHash() chainhash.Hash {
+	return r.Chain.UnwrapOr(*chaincfg.MainNetParams.GenesisHash)
+}
+
+// offerAmountMsat returns the offer amount multiplied by the requested
+// quantity. None is returned if the offer doesn't specify an amount in
+// milli-satoshis.
+func (r *InvoiceRequest) offerAmountMsat() (fn.Option[uint64], error) {
+	if r.Offer.Amount.IsNone() || r.Offer.Currency.IsSome() {
+		return fn.None[uint64](), nil
+	}
+
+	offerAmt := r.Offer.Amount.UnsafeFromSome()
+	hi, amt := bits.Mul64(offerAmt, r.Quantity.UnwrapOr(1))
+	if hi != 0 {
+		return fn.None[uint64](), fmt.Errorf("%w: amount overflow",
+			ErrInvalidField)
+	}
+
+	return fn.Some(amt), nil
+}
+
+// AmountMsat returns the amount in milli-satoshis that the payer wants to pay.
+// This is either the explicitly requested amount or the offer amount
+// multiplied by the requested quantity.
+func (r *InvoiceRequest) AmountMsat() (uint64, error) {
+	if r.Amount.IsSome() {
+		return r.Amount.UnsafeFromSome(), nil
+	}
+
+	offerAmt, err := r.offerAmountMsat()
+	if err != nil {
+		return 0, err
+	}
+
+	return offerAmt.UnwrapOrErr(fmt.Errorf("%w: amount is required "+
+		"for offers without an amount in milli-satoshis",
+		ErrMissingField))
+}
+
+// Validate checks that the invoice request fulfills all requirements that the
+// issuer of the offer must check before responding with an invoice. This
+// doesn't include the verification of the signature.
+func (r *InvoiceRequest) Validate() error {
+	if r.Metadata == nil {
+		return fmt.Errorf("%w: metadata", ErrMissingField)
+	}
+
+	if r.PayerID == nil {
+		return fmt.Errorf("%w: payer ID", ErrMissingField)
+	}
+
+	if err := r.Offer.Validate(); err != nil {
+		return fmt.Errorf("invalid offer: %w", err)
+	}
+
+	if !r.Offer.SupportsChain(r.ChainHash()) {
+		return fmt.Errorf("%w: unsupported chain %v", ErrInvalidField,
+			r.ChainHash())
+	}
+
+	var (
+		quantityMax = r.Offer.QuantityMax.UnwrapOr(0)
+		hasMax      = r.Offer.QuantityMax.IsSome()
+		quantity    = r.Quantity.UnwrapOr(0)
+		hasQuantity = r.Quantity.IsSome()
+	)
+	switch {
+	case hasMax && !hasQuantity:
+		return fmt.Errorf("%w: quantity", ErrMissingField)
+
+	case !hasMax && hasQuantity:
+		return fmt.Errorf("%w: quantity not allowed", ErrInvalidField)
+
+	case hasQuantity && quantity == 0:
+		return fmt.Errorf("%w: zero quantity", ErrInvalidField)
+
+	case hasMax && quantityMax != 0 && quantity > quantityMax:
+		return fmt.Errorf("%w: quantity %d exceeds maximum %d",
+			ErrInvalidField, quantity, quantityMax)
+	}
+
+	amt, err := r.AmountMsat()
+	if err != nil {
+		return err
+	}
+	if amt == 0 {
+		return fmt.Errorf("%w: zero amount", ErrInvalidField)
+	}
+
+	// If the amount is set explicitly, it must not be lower than the
+	// amount the offer asks for.
+	offerAmt, err := r.offerAmountMsat()
+	if err != nil {
+		return err
+	}
+	if minAmt := offerAmt.UnwrapOr(0); amt < minAmt {
+		return fmt.Errorf("%w: amount %d below offer amount %d",
+			ErrInvalidField, amt, minAmt)
+	}
+
+	// None of the currently defined features apply to invoice requests,
+	// so any required bit is unknown to us.
+	return checkRequiredFeatures(r.Features)
+}
+
+// invoiceRequestFields holds the values of the invoice request records while
+// decoding.
+type invoiceRequestFields struct {
+	offerFields
+
+	metadata  []byte
+	chain     [32]byte
+	amount    uint64
+	features  []byte
+	quantity  uint64
+	payerID   *btcec.PublicKey
+	payerNote []byte
+	paths     []*sphinx.BlindedPath
+	signature [schnorr.SignatureSize]byte
+}
+
+// records returns the records that are used to decode the invoice request
+// fields, including the mirrored offer fields.
+func (f *invoiceRequestFields) records() []tlv.Record {
+	return append(
+		f.offerFields.records(),
+		tlv.MakePrimitiveRecord(typeInvReqMetadata, &f.metadata),
+		tlv.MakePrimitiveRecord(typeInvReqChain, &f.chain),
+		tu64Record(typeInvReqAmount, &f.amount),
+		tlv.MakePrimitiveRecord(typeInvReqFeatures, &f.features),
+		tu64Record(typeInvReqQuantity, &f.quantity),
+		tlv.MakePrimitiveRecord(typeInvReqPayerID, &f.payerID),
+		tlv.MakePrimitiveRecord(typeInvReqPayerNote, &f.payerNote),
+		decodeOnlyRecord(
+			typeInvReqPaths, &f.paths, blindedPathsDecoder,
+		),
+	)
+}
+
+// toInvoiceRequest assembles an invoice request from the decoded fields. Only
+// the fields whose types are contained in the given type map are set.
+func (f *invoiceRequestFields) toInvoiceRequest(parsedTypes tlv.TypeMap,
+	extra map[uint64][]byte) (*InvoiceRequest, error) {
+
+	has := func(typ tlv.Type) bool {
+		_, ok := parsedTypes[typ]
+		return ok
+	}
+
+	offer, err := f.offerFields.toOffer(parsedTypes, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &InvoiceRequest{
+		Metadata: f.metadata,
+		Offer:    offer,
+		PayerID:  f.payerID,
+		Paths:    f.paths,
+		ExtraRecords: filterRecords(extra, func(typ uint64) bool {
+			return inInvoiceRequestRange(typ) ||
+				isSignatureType(typ)
+		}),
+	}
+
+	if has(typeInvReqMetadata) && req.Metadata == nil {
+		req.Metadata = []byte{}
+	}
+
+	if has(typeInvReqChain) {
+		req.Chain = fn.Some(chainhash.Hash(f.chain))
+	}
+
+	if has(typeInvReqAmount) {
+		req.Amount = fn.Some(f.amount)
+	}
+
+	if has(typeInvReqFeatures) {
+		features, err := parseFeatures(f.features)
+		if err != nil {
+			return nil, err
+		}
+		req.Features = features
+	}
+
+	if has(typeInvReqQuantity) {
+		req.Quantity = fn.Some(f.quantity)
+	}
+
+	if has(typeInvReqPayerNote) {
+		note, err := parseString(typeInvReqPayerNote, f.payerNote)
+		if err != nil {
+			return nil, err
+		}
+		req.PayerNote = fn.Some(note)
+	}
+
+	if has(typeInvReqPaths) && len(f.paths) == 0 {
+		return nil, fmt.Errorf("%w: empty paths", ErrInvalidField)
+	}
+
+	return req, nil
+}
+
+// ParseInvoiceRequest parses an invoice request from the given raw TLV stream.
+func ParseInvoiceRequest(data []byte) (*InvoiceRequest, error) {
+	var fields invoiceRequestFields
+	records := append(
+		fields.records(),
+		tlv.MakePrimitiveRecord(typeSignature, &fields.signature),
+	)
+
+	parsedTypes, extra, err := decodeStream(
+		data, records, func(typ uint64) bool {
+			return inOfferRange(typ) ||
+				inInvoiceRequestRange(typ) ||
+				isSignatureType(typ)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := fields.toInvoiceRequest(parsedTypes, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[typeSignature]; ok {
+		req.Signature, err = schnorr.ParseSignature(
+			fields.signature[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkCanonical(data, req.Serialize); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// DecodeInvoiceRequest decodes a bech32 encoded invoice request. The request
+// is validated and its signature is verified.
+func DecodeInvoiceRequest(s string) (*InvoiceRequest, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != InvoiceRequestHRP {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrWrongHRP,
+			InvoiceRequestHRP, hrp)
+	}
+
+	req, err := ParseInvoiceRequest(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := req.VerifySignature(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
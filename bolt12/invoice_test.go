@@ -0,0 +1,266 @@
+package bolt12
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// testPaymentPath returns a random blinded payment path.
+func testPaymentPath(t *testing.T) *BlindedPaymentPath {
+	t.Helper()
+
+	return &BlindedPaymentPath{
+		Path: testBlindedPath(t, 2),
+		PayInfo: BlindedPayInfo{
+			FeeBaseMsat:     1000,
+			FeeRate:         100,
+			CltvExpiryDelta: 144,
+			HTLCMinMsat:     1,
+			HTLCMaxMsat:     100_000_000,
+			Features: lnwire.NewFeatureVector(
+				lnwire.NewRawFeatureVector(), lnwire.Features,
+			),
+		},
+	}
+}
+
+// TestInvoiceFlow tests the complete flow of creating an offer, requesting an
+// invoice for it and answering the request with an invoice.
+func TestInvoiceFlow(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	payerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	offer := testOffer(t, issuerKey.PubKey())
+	offerStr, err := offer.Encode()
+	require.NoError(t, err)
+
+	// The payer decodes the offer and requests an invoice for two items.
+	decodedOffer, err := DecodeOffer(offerStr)
+	require.NoError(t, err)
+
+	req := NewInvoiceRequest(
+		decodedOffer, []byte{9, 9, 9}, payerKey.PubKey(),
+	)
+	req.Quantity = fn.Some[uint64](2)
+	req.PayerNote = fn.Some("thanks!")
+	req.ExtraRecords = map[uint64][]byte{
+		2_000_000_001: {0x01},
+	}
+	require.NoError(t, req.Sign(PrivKeySigner(payerKey)))
+
+	reqStr, err := req.Encode()
+	require.NoError(t, err)
+
+	// The issuer decodes the request, which verifies its signature.
+	decodedReq, err := DecodeInvoiceRequest(reqStr)
+	require.NoError(t, err)
+	require.Equal(t, req, decodedReq)
+
+	amt, err := decodedReq.AmountMsat()
+	require.NoError(t, err)
+	require.EqualValues(t, 20_000, amt)
+
+	// The issuer responds with a signed invoice.
+	invoice := NewInvoice(
+		decodedReq, []*BlindedPaymentPath{testPaymentPath(t)},
+		time.Unix(1_800_000_000, 0), lntypes.Hash{1, 2, 3},
+		lnwire.MilliSatoshi(amt), issuerKey.PubKey(),
+	)
+	invoice.RelativeExpiry = fn.Some(time.Hour)
+	require.NoError(t, invoice.Sign(PrivKeySigner(issuerKey)))
+
+	invoiceStr, err := invoice.Encode()
+	require.NoError(t, err)
+
+	decodedInvoice, err := DecodeInvoice(invoiceStr)
+	require.NoError(t, err)
+
+	// The invoice doesn't mirror the signature of the request.
+	expected := *invoice
+	expectedReq := *decodedReq
+	expectedReq.Signature = nil
+	expected.Request = &expectedReq
+	require.Equal(t, &expected, decodedInvoice)
+
+	require.False(t, decodedInvoice.IsExpired(invoice.CreatedAt))
+	require.True(t, decodedInvoice.IsExpired(
+		invoice.CreatedAt.Add(time.Hour+time.Second),
+	))
+
+	// An invoice signed by someone else than the issuer of an offer
+	// without paths is rejected.
+	decodedInvoice.Request.Offer.Paths = nil
+	decodedInvoice.NodeID = payerKey.PubKey()
+	require.ErrorIs(t, decodedInvoice.Validate(), ErrInvalidField)
+}
+
+// TestInvoiceTampering makes sure that modified messages fail the signature
+// verification.
+func TestInvoiceTampering(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	payerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	req := NewInvoiceRequest(
+		testOffer(t, issuerKey.PubKey()), []byte{1}, payerKey.PubKey(),
+	)
+	req.Quantity = fn.Some[uint64](1)
+	require.NoError(t, req.Sign(PrivKeySigner(payerKey)))
+
+	req.PayerNote = fn.Some("tampered")
+	require.ErrorIs(t, req.VerifySignature(), ErrInvalidSignature)
+	req.PayerNote = fn.None[string]()
+	require.NoError(t, req.VerifySignature())
+
+	invoice := NewInvoice(
+		req, []*BlindedPaymentPath{testPaymentPath(t)},
+		time.Now(), lntypes.Hash{1}, 10_000, issuerKey.PubKey(),
+	)
+	require.ErrorIs(t, invoice.VerifySignature(), ErrMissingSignature)
+	require.NoError(t, invoice.Sign(PrivKeySigner(issuerKey)))
+
+	invoice.Amount = 1
+	require.ErrorIs(t, invoice.VerifySignature(), ErrInvalidSignature)
+
+	// Signing with a key that doesn't match the node ID fails.
+	invoice.Amount = 10_000
+	require.ErrorIs(
+		t, invoice.Sign(PrivKeySigner(payerKey)), ErrInvalidSignature,
+	)
+}
+
+// TestInvoiceRequestValidate tests the validation of invoice requests.
+func TestInvoiceRequestValidate(t *testing.T) {
+	t.Parallel()
+
+	issuerID := randPubKey(t)
+	payerID := randPubKey(t)
+
+	tests := []struct {
+		name   string
+		modify func(r *InvoiceRequest)
+		err    error
+	}{
+		{
+			name:   "valid",
+			modify: func(*InvoiceRequest) {},
+		},
+		{
+			name: "missing metadata",
+			modify: func(r *InvoiceRequest) {
+				r.Metadata = nil
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "missing payer ID",
+			modify: func(r *InvoiceRequest) {
+				r.PayerID = nil
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "missing quantity",
+			modify: func(r *InvoiceRequest) {
+				r.Quantity = fn.None[uint64]()
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "quantity not allowed",
+			modify: func(r *InvoiceRequest) {
+				r.Offer.QuantityMax = fn.None[uint64]()
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "zero quantity",
+			modify: func(r *InvoiceRequest) {
+				r.Quantity = fn.Some[uint64](0)
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "quantity above maximum",
+			modify: func(r *InvoiceRequest) {
+				r.Quantity = fn.Some[uint64](6)
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "unlimited quantity",
+			modify: func(r *InvoiceRequest) {
+				r.Offer.QuantityMax = fn.Some[uint64](0)
+				r.Quantity = fn.Some[uint64](1000)
+			},
+		},
+		{
+			name: "amount above offer amount",
+			modify: func(r *InvoiceRequest) {
+				r.Amount = fn.Some[uint64](50_000)
+			},
+		},
+		{
+			name: "amount below offer amount",
+			modify: func(r *InvoiceRequest) {
+				r.Amount = fn.Some[uint64](19_999)
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "amount overflow",
+			modify: func(r *InvoiceRequest) {
+				r.Quantity = fn.Some[uint64](1 << 60)
+				r.Offer.QuantityMax = fn.Some[uint64](0)
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "missing amount",
+			modify: func(r *InvoiceRequest) {
+				r.Offer.Amount = fn.None[uint64]()
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "unsupported chain",
+			modify: func(r *InvoiceRequest) {
+				r.Chain = fn.Some(
+					*chaincfg.TestNet3Params.GenesisHash,
+				)
+			},
+			err: ErrInvalidField,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			req := NewInvoiceRequest(
+				testOffer(t, issuerID), []byte{1}, payerID,
+			)
+			req.Quantity = fn.Some[uint64](2)
+			test.modify(req)
+
+			err := req.Validate()
+			if test.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
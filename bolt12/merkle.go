@@ -0,0 +1,181 @@
+package bolt12
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// leafTag is the tag used to hash a single TLV record into a leaf of
+	// the merkle tree.
+	leafTag = []byte("LnLeaf")
+
+	// nonceTag is the prefix of the tag used to hash the type of a TLV
+	// record into the nonce leaf that is paired with each record's leaf.
+	nonceTag = []byte("LnNonce")
+
+	// branchTag is the tag used to hash two nodes of the merkle tree into
+	// their parent.
+	branchTag = []byte("LnBranch")
+)
+
+// SignFunc is a function that creates a BIP-340 signature over the tagged hash
+// of the given merkle root, using the given tag.
+type SignFunc func(tag []byte,
+	merkleRoot chainhash.Hash) (*schnorr.Signature, error)
+
+// PrivKeySigner returns a SignFunc that signs with the given private key.
+func PrivKeySigner(privKey *btcec.PrivateKey) SignFunc {
+	return func(tag []byte, merkleRoot chainhash.Hash) (*schnorr.Signature,
+		error) {
+
+		digest := chainhash.TaggedHash(tag, merkleRoot[:])
+
+		return schnorr.Sign(privKey, digest[:])
+	}
+}
+
+// signatureTag returns the tag that is used to sign the merkle root of the
+// message with the given name.
+func signatureTag(messageName string) []byte {
+	return []byte("lightning" + messageName + "signature")
+}
+
+// isSignatureType returns true if the given TLV type is within the range that
+// is reserved for signatures. These records aren't part of the merkle tree.
+func isSignatureType(typ uint64) bool {
+	return typ >= 240 && typ <= 1000
+}
+
+// rawRecord is a single TLV record in its serialized form.
+type rawRecord struct {
+	typ   uint64
+	bytes []byte
+}
+
+// splitStream splits a serialized TLV stream into its individual records.
+func splitStream(stream []byte) ([]rawRecord, error) {
+	var (
+		records []rawRecord
+		buf     [8]byte
+		r       = bytes.NewReader(stream)
+	)
+	for r.Len() > 0 {
+		start := len(stream) - r.Len()
+
+		typ, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := tlv.ReadVarInt(r, &buf)
+		if err != nil {
+			return nil, err
+		}
+		if length > uint64(r.Len()) {
+			return nil, fmt.Errorf("record %d exceeds stream "+
+				"length", typ)
+		}
+		if _, err := r.Seek(int64(length), 1); err != nil {
+			return nil, err
+		}
+
+		end := len(stream) - r.Len()
+		records = append(records, rawRecord{
+			typ:   typ,
+			bytes: stream[start:end],
+		})
+	}
+
+	return records, nil
+}
+
+// branchHash returns the parent node of the two given nodes. The lesser of
+// both hashes is always hashed first.
+func branchHash(a, b chainhash.Hash) chainhash.Hash {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+
+	return *chainhash.TaggedHash(branchTag, a[:], b[:])
+}
+
+// merkleRoot computes the merkle root of the given serialized TLV stream as
+// defined by BOLT 12. Records within the signature range are skipped.
+func merkleRoot(stream []byte) (chainhash.Hash, error) {
+	records, err := splitStream(stream)
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+	if len(records) == 0 {
+		return chainhash.Hash{}, ErrEmptyStream
+	}
+
+	// The nonce tag commits to the first record of the stream, which
+	// prevents an attacker from revealing a subset of the tree without
+	// knowing its first record.
+	nonceTagFull := append(
+		append([]byte{}, nonceTag...), records[0].bytes...,
+	)
+
+	var (
+		nodes []chainhash.Hash
+		buf   bytes.Buffer
+		b     [8]byte
+	)
+	for _, record := range records {
+		if isSignatureType(record.typ) {
+			continue
+		}
+
+		buf.Reset()
+		if err := tlv.WriteVarInt(&buf, record.typ, &b); err != nil {
+			return chainhash.Hash{}, err
+		}
+
+		leaf := chainhash.TaggedHash(leafTag, record.bytes)
+		nonce := chainhash.TaggedHash(nonceTagFull, buf.Bytes())
+		nodes = append(nodes, branchHash(*leaf, *nonce))
+	}
+	if len(nodes) == 0 {
+		return chainhash.Hash{}, fmt.Errorf("no records to sign")
+	}
+
+	// Combine the nodes level by level. If the number of nodes isn't a
+	// power of two, the tree ends up deeper on the lower order nodes.
+	for step := 1; step < len(nodes); step *= 2 {
+		for i := 0; i+step < len(nodes); i += 2 * step {
+			nodes[i] = branchHash(nodes[i], nodes[i+step])
+		}
+	}
+
+	return nodes[0], nil
+}
+
+// verifySignature verifies that sig is a valid signature of the given public
+// key over the merkle root of the stream, using the signature tag of the
+// message with the given name.
+func verifySignature(messageName string, stream []byte,
+	sig *schnorr.Signature, pubKey *btcec.PublicKey) error {
+
+	if sig == nil {
+		return ErrMissingSignature
+	}
+
+	root, err := merkleRoot(stream)
+	if err != nil {
+		return err
+	}
+
+	digest := chainhash.TaggedHash(signatureTag(messageName), root[:])
+	if !sig.Verify(digest[:], pubKey) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
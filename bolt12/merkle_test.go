@@ -0,0 +1,127 @@
+package bolt12
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/stretchr/testify/require"
+)
+
+// TestMerkleRoot checks the merkle root computation against the test vectors
+// of the BOLT 12 specification.
+func TestMerkleRoot(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		stream string
+		root   string
+	}{
+		{
+			name:   "single record",
+			stream: "010203e8",
+			root: "b013756c8fee86503a0b4abdab4cddeb1af5d344ca6fc2" +
+				"fa8b6c08938caa6f93",
+		},
+		{
+			name:   "two records",
+			stream: "010203e802080000010000020003",
+			root: "c3774abbf4815aa54ccaa026bff6581f01f3be5fe814c6" +
+				"20a252534f434bc0d1",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			stream, err := hex.DecodeString(test.stream)
+			require.NoError(t, err)
+
+			root, err := merkleRoot(stream)
+			require.NoError(t, err)
+			require.Equal(t, test.root, hex.EncodeToString(root[:]))
+		})
+	}
+}
+
+// TestMerkleRootSkipsSignatures makes sure that records within the signature
+// range don't change the merkle root.
+func TestMerkleRootSkipsSignatures(t *testing.T) {
+	t.Parallel()
+
+	stream, err := hex.DecodeString("010203e802080000010000020003")
+	require.NoError(t, err)
+
+	root, err := merkleRoot(stream)
+	require.NoError(t, err)
+
+	// Append a record of type 240 with a dummy value.
+	withSig := append(append([]byte{}, stream...), 0xf0, 0x01, 0x00)
+	rootWithSig, err := merkleRoot(withSig)
+	require.NoError(t, err)
+	require.Equal(t, root, rootWithSig)
+
+	// A stream that only consists of signature records can't be signed.
+	_, err = merkleRoot([]byte{0xf0, 0x01, 0x00})
+	require.Error(t, err)
+
+	_, err = merkleRoot(nil)
+	require.ErrorIs(t, err, ErrEmptyStream)
+}
+
+// TestSignatureVerification makes sure that a signature created with the
+// signer of a private key is only valid for the corresponding message and
+// public key.
+func TestSignatureVerification(t *testing.T) {
+	t.Parallel()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	stream, err := hex.DecodeString("010203e802080000010000020003")
+	require.NoError(t, err)
+
+	root, err := merkleRoot(stream)
+	require.NoError(t, err)
+
+	signer := PrivKeySigner(privKey)
+	sig, err := signer(signatureTag(invoiceMessageName), root)
+	require.NoError(t, err)
+
+	err = verifySignature(
+		invoiceMessageName, stream, sig, privKey.PubKey(),
+	)
+	require.NoError(t, err)
+
+	// The signature must not be valid for a different message name.
+	err = verifySignature(
+		invoiceRequestMessageName, stream, sig, privKey.PubKey(),
+	)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// Nor for a different key.
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	err = verifySignature(
+		invoiceMessageName, stream, sig, otherKey.PubKey(),
+	)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	// Nor for a different stream.
+	err = verifySignature(
+		invoiceMessageName, stream[:4], sig, privKey.PubKey(),
+	)
+	require.ErrorIs(t, err, ErrInvalidSignature)
+
+	err = verifySignature(invoiceMessageName, stream, nil, nil)
+	require.ErrorIs(t, err, ErrMissingSignature)
+
+	// Make sure the signature tag is what the specification mandates.
+	require.Equal(
+		t, chainhash.TaggedHash(
+			[]byte("lightninginvoicesignature"), root[:],
+		),
+		chainhash.TaggedHash(signatureTag(invoiceMessageName), root[:]),
+	)
+}
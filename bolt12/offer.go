@@ -0,0 +1,390 @@
+package bolt12
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// Offer is a BOLT 12 offer. In contrast to a BOLT 11 invoice, an offer can be
+// paid many times: a payer uses it to request a fresh invoice from the issuer
+// for every payment.
+type Offer struct {
+	// Chains is the list of chains the offer is valid for. If empty, the
+	// offer is only valid for bitcoin mainnet.
+	Chains []chainhash.Hash
+
+	// Metadata is opaque data the issuer attaches to the offer. It's
+	// mirrored in every invoice request for the offer.
+	Metadata []byte
+
+	// Currency is the optional ISO 4217 code of the currency the amount is
+	// denominated in. If not set, the amount is in milli-satoshis.
+	Currency fn.Option[string]
+
+	// Amount is the optional minimum amount per item. If not set, the
+	// payer chooses the amount.
+	Amount fn.Option[uint64]
+
+	// Description is the optional human-readable description of the
+	// offer. It must be set if the amount is set.
+	Description fn.Option[string]
+
+	// Features is the optional feature vector of the offer.
+	Features *lnwire.RawFeatureVector
+
+	// AbsoluteExpiry is the optional time after which the offer must no
+	// longer be paid.
+	AbsoluteExpiry fn.Option[time.Time]
+
+	// Paths is the list of blinded paths that can be used to reach the
+	// issuer with an onion message.
+	Paths []*sphinx.BlindedPath
+
+	// Issuer is the optional human-readable name of the issuer.
+	Issuer fn.Option[string]
+
+	// QuantityMax is the optional maximum number of items that can be
+	// requested. A value of zero means there is no limit. If not set, only
+	// a single item can be requested.
+	QuantityMax fn.Option[uint64]
+
+	// IssuerID is the public key of the issuer. It must be set if the
+	// offer doesn't contain any paths.
+	IssuerID *btcec.PublicKey
+
+	// ExtraRecords holds all unknown odd records within the offer ranges.
+	ExtraRecords map[uint64][]byte
+}
+
+// records returns the TLV records of all fields of the offer that are set.
+func (o *Offer) records() ([]tlv.Record, error) {
+	var records []tlv.Record
+
+	if len(o.Chains) > 0 {
+		records = append(records, bytesRecord(
+			typeOfferChains, serializeChains(o.Chains),
+		))
+	}
+
+	if o.Metadata != nil {
+		records = append(records, bytesRecord(
+			typeOfferMetadata, o.Metadata,
+		))
+	}
+
+	o.Currency.WhenSome(func(currency string) {
+		records = append(records, bytesRecord(
+			typeOfferCurrency, []byte(currency),
+		))
+	})
+
+	o.Amount.WhenSome(func(amt uint64) {
+		records = append(records, tu64Record(typeOfferAmount, &amt))
+	})
+
+	o.Description.WhenSome(func(description string) {
+		records = append(records, bytesRecord(
+			typeOfferDescription, []byte(description),
+		))
+	})
+
+	if o.Features != nil {
+		features, err := featuresBytes(o.Features)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, bytesRecord(
+			typeOfferFeatures, features,
+		))
+	}
+
+	o.AbsoluteExpiry.WhenSome(func(expiry time.Time) {
+		seconds := uint64(expiry.Unix())
+		records = append(records, tu64Record(
+			typeOfferAbsoluteExpiry, &seconds,
+		))
+	})
+
+	if len(o.Paths) > 0 {
+		paths, err := serializeBlindedPaths(o.Paths)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, bytesRecord(typeOfferPaths, paths))
+	}
+
+	o.Issuer.WhenSome(func(issuer string) {
+		records = append(records, bytesRecord(
+			typeOfferIssuer, []byte(issuer),
+		))
+	})
+
+	o.QuantityMax.WhenSome(func(quantityMax uint64) {
+		records = append(records, tu64Record(
+			typeOfferQuantityMax, &quantityMax,
+		))
+	})
+
+	if o.IssuerID != nil {
+		issuerID := o.IssuerID
+		records = append(records, tlv.MakePrimitiveRecord(
+			typeOfferIssuerID, &issuerID,
+		))
+	}
+
+	records = append(records, tlv.MapToRecords(
+		filterRecords(o.ExtraRecords, inOfferRange),
+	)...)
+
+	return records, nil
+}
+
+// Serialize returns the offer as a raw TLV stream.
+func (o *Offer) Serialize() ([]byte, error) {
+	records, err := o.records()
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeStream(records)
+}
+
+// Encode returns the bech32 string encoding of the offer.
+func (o *Offer) Encode() (string, error) {
+	data, err := o.Serialize()
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBech32(OfferHRP, data)
+}
+
+// ID returns the offer ID, which is the merkle root of the offer's TLV stream.
+// It can be used to match invoice requests to the offer they were created
+// for.
+func (o *Offer) ID() (chainhash.Hash, error) {
+	data, err := o.Serialize()
+	if err != nil {
+		return chainhash.Hash{}, err
+	}
+
+	return merkleRoot(data)
+}
+
+// IsExpired returns true if the offer has an absolute expiry that lies before
+// the given time.
+func (o *Offer) IsExpired(now time.Time) bool {
+	return fn.MapOptionZ(o.AbsoluteExpiry, func(expiry time.Time) bool {
+		return now.After(expiry)
+	})
+}
+
+// SupportsChain returns true if the offer can be paid on the given chain.
+func (o *Offer) SupportsChain(chain chainhash.Hash) bool {
+	if len(o.Chains) == 0 {
+		return chain == *chaincfg.MainNetParams.GenesisHash
+	}
+
+	for _, offerChain := range o.Chains {
+		if offerChain == chain {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate checks that the offer fulfills all requirements that a reader of
+// an offer must check before requesting an invoice for it.
+func (o *Offer) Validate() error {
+	if o.Currency.IsSome() && o.Amount.IsNone() {
+		return fmt.Errorf("%w: currency set without amount",
+			ErrInvalidField)
+	}
+
+	if o.Amount.UnwrapOr(1) == 0 {
+		return fmt.Errorf("%w: zero amount", ErrInvalidField)
+	}
+
+	if o.Amount.IsSome() && o.Description.IsNone() {
+		return fmt.Errorf("%w: description is required when an "+
+			"amount is set", ErrMissingField)
+	}
+
+	if o.IssuerID == nil && len(o.Paths) == 0 {
+		return fmt.Errorf("%w: either issuer ID or paths must be set",
+			ErrMissingField)
+	}
+
+	// None of the currently defined features apply to offers, so any
+	// required bit is unknown to us.
+	return checkRequiredFeatures(o.Features)
+}
+
+// offerFields holds the values of the offer records while decoding.
+type offerFields struct {
+	chains         []chainhash.Hash
+	metadata       []byte
+	currency       []byte
+	amount         uint64
+	description    []byte
+	features       []byte
+	absoluteExpiry uint64
+	paths          []*sphinx.BlindedPath
+	issuer         []byte
+	quantityMax    uint64
+	issuerID       *btcec.PublicKey
+}
+
+// records returns the records that are used to decode the offer fields.
+func (f *offerFields) records() []tlv.Record {
+	return []tlv.Record{
+		decodeOnlyRecord(typeOfferChains, &f.chains, chainsDecoder),
+		tlv.MakePrimitiveRecord(typeOfferMetadata, &f.metadata),
+		tlv.MakePrimitiveRecord(typeOfferCurrency, &f.currency),
+		tu64Record(typeOfferAmount, &f.amount),
+		tlv.MakePrimitiveRecord(typeOfferDescription, &f.description),
+		tlv.MakePrimitiveRecord(typeOfferFeatures, &f.features),
+		tu64Record(typeOfferAbsoluteExpiry, &f.absoluteExpiry),
+		decodeOnlyRecord(
+			typeOfferPaths, &f.paths, blindedPathsDecoder,
+		),
+		tlv.MakePrimitiveRecord(typeOfferIssuer, &f.issuer),
+		tu64Record(typeOfferQuantityMax, &f.quantityMax),
+		tlv.MakePrimitiveRecord(typeOfferIssuerID, &f.issuerID),
+	}
+}
+
+// toOffer assembles an offer from the decoded fields. Only the fields whose
+// types are contained in the given type map are set.
+func (f *offerFields) toOffer(parsedTypes tlv.TypeMap,
+	extra map[uint64][]byte) (*Offer, error) {
+
+	has := func(typ tlv.Type) bool {
+		_, ok := parsedTypes[typ]
+		return ok
+	}
+
+	offer := &Offer{
+		Chains:       f.chains,
+		Metadata:     f.metadata,
+		Paths:        f.paths,
+		IssuerID:     f.issuerID,
+		ExtraRecords: filterRecords(extra, inOfferRange),
+	}
+
+	if has(typeOfferChains) && len(f.chains) == 0 {
+		return nil, fmt.Errorf("%w: empty chains", ErrInvalidField)
+	}
+
+	if has(typeOfferMetadata) && offer.Metadata == nil {
+		offer.Metadata = []byte{}
+	}
+
+	if has(typeOfferCurrency) {
+		currency, err := parseString(typeOfferCurrency, f.currency)
+		if err != nil {
+			return nil, err
+		}
+		offer.Currency = fn.Some(currency)
+	}
+
+	if has(typeOfferAmount) {
+		offer.Amount = fn.Some(f.amount)
+	}
+
+	if has(typeOfferDescription) {
+		description, err := parseString(
+			typeOfferDescription, f.description,
+		)
+		if err != nil {
+			return nil, err
+		}
+		offer.Description = fn.Some(description)
+	}
+
+	if has(typeOfferFeatures) {
+		features, err := parseFeatures(f.features)
+		if err != nil {
+			return nil, err
+		}
+		offer.Features = features
+	}
+
+	if has(typeOfferAbsoluteExpiry) {
+		offer.AbsoluteExpiry = fn.Some(
+			time.Unix(int64(f.absoluteExpiry), 0),
+		)
+	}
+
+	if has(typeOfferPaths) && len(f.paths) == 0 {
+		return nil, fmt.Errorf("%w: empty paths", ErrInvalidField)
+	}
+
+	if has(typeOfferIssuer) {
+		issuer, err := parseString(typeOfferIssuer, f.issuer)
+		if err != nil {
+			return nil, err
+		}
+		offer.Issuer = fn.Some(issuer)
+	}
+
+	if has(typeOfferQuantityMax) {
+		offer.QuantityMax = fn.Some(f.quantityMax)
+	}
+
+	return offer, nil
+}
+
+// ParseOffer parses an offer from the given raw TLV stream.
+func ParseOffer(data []byte) (*Offer, error) {
+	var fields offerFields
+	parsedTypes, extra, err := decodeStream(
+		data, fields.records(), inOfferRange,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	offer, err := fields.toOffer(parsedTypes, extra)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCanonical(data, offer.Serialize); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
+
+// DecodeOffer decodes and validates a bech32 encoded offer.
+func DecodeOffer(s string) (*Offer, error) {
+	hrp, data, err := decodeBech32(s)
+	if err != nil {
+		return nil, err
+	}
+	if hrp != OfferHRP {
+		return nil, fmt.Errorf("%w: expected %s, got %s", ErrWrongHRP,
+			OfferHRP, hrp)
+	}
+
+	offer, err := ParseOffer(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, err
+	}
+
+	return offer, nil
+}
@@ -0,0 +1,270 @@
+package bolt12
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// randPubKey returns a random public key.
+func randPubKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return privKey.PubKey()
+}
+
+// testBlindedPath returns a random blinded path with the given number of hops.
+func testBlindedPath(t *testing.T, numHops int) *sphinx.BlindedPath {
+	t.Helper()
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: randPubKey(t),
+		BlindingPoint:     randPubKey(t),
+	}
+	for i := 0; i < numHops; i++ {
+		path.BlindedHops = append(
+			path.BlindedHops, &sphinx.BlindedHopInfo{
+				BlindedNodePub: randPubKey(t),
+				CipherText:     []byte{byte(i), 1, 2, 3},
+			},
+		)
+	}
+
+	return path
+}
+
+// testOffer returns an offer with all fields set.
+func testOffer(t *testing.T, issuerID *btcec.PublicKey) *Offer {
+	t.Helper()
+
+	return &Offer{
+		Chains: []chainhash.Hash{
+			*chaincfg.MainNetParams.GenesisHash,
+			*chaincfg.RegressionNetParams.GenesisHash,
+		},
+		Metadata:       []byte{1, 2, 3, 4},
+		Amount:         fn.Some[uint64](10_000),
+		Description:    fn.Some("coffee"),
+		Features:       lnwire.NewRawFeatureVector(),
+		AbsoluteExpiry: fn.Some(time.Unix(2_000_000_000, 0)),
+		Paths: []*sphinx.BlindedPath{
+			testBlindedPath(t, 1), testBlindedPath(t, 3),
+		},
+		Issuer:      fn.Some("lnd coffee shop"),
+		QuantityMax: fn.Some[uint64](5),
+		IssuerID:    issuerID,
+		ExtraRecords: map[uint64][]byte{
+			77: {0xaa, 0xbb},
+		},
+	}
+}
+
+// TestOfferEncodeDecode makes sure that an offer with all fields set survives
+// an encoding round trip.
+func TestOfferEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	offer := testOffer(t, randPubKey(t))
+
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, offer, decoded)
+
+	// The offer ID must be stable across the round trip.
+	id, err := offer.ID()
+	require.NoError(t, err)
+	decodedID, err := decoded.ID()
+	require.NoError(t, err)
+	require.Equal(t, id, decodedID)
+
+	// A minimal offer only needs an issuer ID.
+	minimal := &Offer{
+		IssuerID: randPubKey(t),
+	}
+	encoded, err = minimal.Encode()
+	require.NoError(t, err)
+
+	decoded, err = DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.Equal(t, minimal, decoded)
+
+	// Offers can't be decoded as invoice requests.
+	_, err = DecodeInvoiceRequest(encoded)
+	require.ErrorIs(t, err, ErrWrongHRP)
+}
+
+// TestOfferDecodeInvalidRecords makes sure that unknown even records and
+// records outside of the offer ranges are rejected.
+func TestOfferDecodeInvalidRecords(t *testing.T) {
+	t.Parallel()
+
+	issuerID := randPubKey(t)
+
+	encode := func(typ uint64) []byte {
+		records := []tlv.Record{
+			tlv.MakePrimitiveRecord(typeOfferIssuerID, &issuerID),
+		}
+		records = append(records, tlv.MapToRecords(
+			map[uint64][]byte{typ: {0x01}},
+		)...)
+
+		data, err := encodeStream(records)
+		require.NoError(t, err)
+
+		return data
+	}
+
+	// An unknown odd record within the offer range is retained.
+	offer, err := ParseOffer(encode(1_000_000_001))
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x01}, offer.ExtraRecords[1_000_000_001])
+
+	_, err = ParseOffer(encode(76))
+	require.ErrorIs(t, err, ErrUnknownRequiredField)
+
+	_, err = ParseOffer(encode(81))
+	require.ErrorIs(t, err, ErrInvalidRange)
+
+	_, err = ParseOffer(nil)
+	require.ErrorIs(t, err, ErrEmptyStream)
+}
+
+// TestOfferValidate tests the validation of offers.
+func TestOfferValidate(t *testing.T) {
+	t.Parallel()
+
+	issuerID := randPubKey(t)
+
+	tests := []struct {
+		name   string
+		modify func(o *Offer)
+		err    error
+	}{
+		{
+			name:   "valid",
+			modify: func(*Offer) {},
+		},
+		{
+			name: "no amount",
+			modify: func(o *Offer) {
+				o.Amount = fn.None[uint64]()
+				o.Description = fn.None[string]()
+			},
+		},
+		{
+			name: "zero amount",
+			modify: func(o *Offer) {
+				o.Amount = fn.Some[uint64](0)
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "amount without description",
+			modify: func(o *Offer) {
+				o.Description = fn.None[string]()
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "currency without amount",
+			modify: func(o *Offer) {
+				o.Amount = fn.None[uint64]()
+				o.Currency = fn.Some("USD")
+			},
+			err: ErrInvalidField,
+		},
+		{
+			name: "no issuer ID and no paths",
+			modify: func(o *Offer) {
+				o.IssuerID = nil
+				o.Paths = nil
+			},
+			err: ErrMissingField,
+		},
+		{
+			name: "paths without issuer ID",
+			modify: func(o *Offer) {
+				o.IssuerID = nil
+			},
+		},
+		{
+			name: "unknown required feature",
+			modify: func(o *Offer) {
+				o.Features = lnwire.NewRawFeatureVector(
+					lnwire.FeatureBit(20),
+				)
+			},
+			err: ErrUnknownRequiredFeature,
+		},
+		{
+			name: "unknown optional feature",
+			modify: func(o *Offer) {
+				o.Features = lnwire.NewRawFeatureVector(
+					lnwire.FeatureBit(21),
+				)
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			offer := testOffer(t, issuerID)
+			test.modify(offer)
+
+			err := offer.Validate()
+			if test.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+// TestOfferExpiryAndChains tests the expiry and chain helpers of offers.
+func TestOfferExpiryAndChains(t *testing.T) {
+	t.Parallel()
+
+	offer := &Offer{
+		IssuerID: randPubKey(t),
+	}
+
+	// Offers without an absolute expiry never expire, and are only valid
+	// on mainnet.
+	require.False(t, offer.IsExpired(time.Now()))
+	require.True(t, offer.SupportsChain(
+		*chaincfg.MainNetParams.GenesisHash,
+	))
+	require.False(t, offer.SupportsChain(
+		*chaincfg.RegressionNetParams.GenesisHash,
+	))
+
+	expiry := time.Unix(1_700_000_000, 0)
+	offer.AbsoluteExpiry = fn.Some(expiry)
+	offer.Chains = []chainhash.Hash{
+		*chaincfg.RegressionNetParams.GenesisHash,
+	}
+
+	require.False(t, offer.IsExpired(expiry))
+	require.True(t, offer.IsExpired(expiry.Add(time.Second)))
+	require.False(t, offer.SupportsChain(
+		*chaincfg.MainNetParams.GenesisHash,
+	))
+	require.True(t, offer.SupportsChain(
+		*chaincfg.RegressionNetParams.GenesisHash,
+	))
+}
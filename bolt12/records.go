@@ -0,0 +1,363 @@
+package bolt12
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// typeOfferChains is the record type of the chains an offer is valid
+	// for.
+	typeOfferChains tlv.Type = 2
+
+	// typeOfferMetadata is the record type of the opaque metadata that
+	// the issuer attaches to an offer.
+	typeOfferMetadata tlv.Type = 4
+
+	// typeOfferCurrency is the record type of the ISO 4217 currency code
+	// of the offer amount.
+	typeOfferCurrency tlv.Type = 6
+
+	// typeOfferAmount is the record type of the offer amount.
+	typeOfferAmount tlv.Type = 8
+
+	// typeOfferDescription is the record type of the offer description.
+	typeOfferDescription tlv.Type = 10
+
+	// typeOfferFeatures is the record type of the offer features.
+	typeOfferFeatures tlv.Type = 12
+
+	// typeOfferAbsoluteExpiry is the record type of the unix timestamp
+	// after which the offer expires.
+	typeOfferAbsoluteExpiry tlv.Type = 14
+
+	// typeOfferPaths is the record type of the blinded paths that can be
+	// used to reach the issuer with an onion message.
+	typeOfferPaths tlv.Type = 16
+
+	// typeOfferIssuer is the record type of the human-readable issuer.
+	typeOfferIssuer tlv.Type = 18
+
+	// typeOfferQuantityMax is the record type of the maximum quantity of
+	// items that can be requested.
+	typeOfferQuantityMax tlv.Type = 20
+
+	// typeOfferIssuerID is the record type of the public key of the
+	// issuer.
+	typeOfferIssuerID tlv.Type = 22
+
+	// typeInvReqMetadata is the record type of the opaque metadata that
+	// the payer attaches to an invoice request.
+	typeInvReqMetadata tlv.Type = 0
+
+	// typeInvReqChain is the record type of the chain the payer wants to
+	// pay on.
+	typeInvReqChain tlv.Type = 80
+
+	// typeInvReqAmount is the record type of the amount the payer wants to
+	// pay.
+	typeInvReqAmount tlv.Type = 82
+
+	// typeInvReqFeatures is the record type of the invoice request
+	// features.
+	typeInvReqFeatures tlv.Type = 84
+
+	// typeInvReqQuantity is the record type of the number of items the
+	// payer wants to pay for.
+	typeInvReqQuantity tlv.Type = 86
+
+	// typeInvReqPayerID is the record type of the transient public key of
+	// the payer.
+	typeInvReqPayerID tlv.Type = 88
+
+	// typeInvReqPayerNote is the record type of the note the payer
+	// attaches to the request.
+	typeInvReqPayerNote tlv.Type = 89
+
+	// typeInvReqPaths is the record type of the blinded paths that can be
+	// used to reach the payer with an onion message.
+	typeInvReqPaths tlv.Type = 90
+
+	// typeInvoicePaths is the record type of the blinded payment paths to
+	// the recipient.
+	typeInvoicePaths tlv.Type = 160
+
+	// typeInvoiceBlindedPay is the record type of the aggregated policies
+	// of the blinded payment paths.
+	typeInvoiceBlindedPay tlv.Type = 162
+
+	// typeInvoiceCreatedAt is the record type of the unix timestamp the
+	// invoice was created at.
+	typeInvoiceCreatedAt tlv.Type = 164
+
+	// typeInvoiceRelativeExpiry is the record type of the number of
+	// seconds after the creation time at which the invoice expires.
+	typeInvoiceRelativeExpiry tlv.Type = 166
+
+	// typeInvoicePaymentHash is the record type of the payment hash.
+	typeInvoicePaymentHash tlv.Type = 168
+
+	// typeInvoiceAmount is the record type of the invoice amount.
+	typeInvoiceAmount tlv.Type = 170
+
+	// typeInvoiceFallbacks is the record type of the on-chain fallback
+	// addresses.
+	typeInvoiceFallbacks tlv.Type = 172
+
+	// typeInvoiceFeatures is the record type of the invoice features.
+	typeInvoiceFeatures tlv.Type = 174
+
+	// typeInvoiceNodeID is the record type of the public key the invoice
+	// is signed with.
+	typeInvoiceNodeID tlv.Type = 176
+
+	// typeSignature is the record type of the BIP-340 signature of both
+	// invoice requests and invoices.
+	typeSignature tlv.Type = 240
+)
+
+// inOfferRange returns true if the given type is reserved for offer fields.
+func inOfferRange(typ uint64) bool {
+	return (typ >= 1 && typ <= 79) ||
+		(typ >= 1_000_000_000 && typ <= 1_999_999_999)
+}
+
+// inInvoiceRequestRange returns true if the given type is reserved for fields
+// that are specific to invoice requests.
+func inInvoiceRequestRange(typ uint64) bool {
+	return typ == 0 || (typ >= 80 && typ <= 159) ||
+		(typ >= 2_000_000_000 && typ <= 2_999_999_999)
+}
+
+// inInvoiceRange returns true if the given type is reserved for fields that
+// are specific to invoices.
+func inInvoiceRange(typ uint64) bool {
+	return (typ >= 160 && typ <= 239) ||
+		(typ >= 3_000_000_000 && typ <= 3_999_999_999)
+}
+
+// tu64Record returns a record for a truncated uint64.
+func tu64Record(typ tlv.Type, val *uint64) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		typ, val, func() uint64 {
+			return tlv.SizeTUint64(*val)
+		}, tlv.ETUint64, tlv.DTUint64,
+	)
+}
+
+// tu32Record returns a record for a truncated uint32.
+func tu32Record(typ tlv.Type, val *uint32) tlv.Record {
+	return tlv.MakeDynamicRecord(
+		typ, val, func() uint64 {
+			return tlv.SizeTUint32(*val)
+		}, tlv.ETUint32, tlv.DTUint32,
+	)
+}
+
+// bytesRecord returns a record for a variable length byte slice. A copy of
+// the slice is used so that the record can be created from a temporary value.
+func bytesRecord(typ tlv.Type, val []byte) tlv.Record {
+	return tlv.MakePrimitiveRecord(typ, &val)
+}
+
+// featuresBytes serializes the given feature vector without a length prefix.
+func featuresBytes(features *lnwire.RawFeatureVector) ([]byte, error) {
+	var b bytes.Buffer
+	if err := features.EncodeBase256(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// parseFeatures parses a feature vector that was serialized without a length
+// prefix.
+func parseFeatures(b []byte) (*lnwire.RawFeatureVector, error) {
+	features := lnwire.NewRawFeatureVector()
+	err := features.DecodeBase256(bytes.NewReader(b), len(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return features, nil
+}
+
+// checkRequiredFeatures makes sure that all required bits of the given feature
+// vector are contained in the set of known features.
+func checkRequiredFeatures(features *lnwire.RawFeatureVector,
+	known ...lnwire.FeatureBit) error {
+
+	if features == nil {
+		return nil
+	}
+
+	knownSet := fn.NewSet(known...)
+	fv := lnwire.NewFeatureVector(features, lnwire.Features)
+	for bit := range fv.Features() {
+		if bit.IsRequired() && !knownSet.Contains(bit) {
+			return fmt.Errorf("%w: %d", ErrUnknownRequiredFeature,
+				bit)
+		}
+	}
+
+	return nil
+}
+
+// parseString converts the given bytes to a string, making sure that they are
+// valid UTF-8.
+func parseString(typ tlv.Type, b []byte) (string, error) {
+	if !utf8.Valid(b) {
+		return "", fmt.Errorf("%w: type %d", ErrInvalidUTF8, typ)
+	}
+
+	return string(b), nil
+}
+
+// serializeChains serializes a list of chain hashes.
+func serializeChains(chains []chainhash.Hash) []byte {
+	b := make([]byte, 0, len(chains)*chainhash.HashSize)
+	for _, chain := range chains {
+		b = append(b, chain[:]...)
+	}
+
+	return b
+}
+
+// chainsDecoder is a tlv.Decoder for a list of chain hashes.
+func chainsDecoder(r io.Reader, val interface{}, _ *[8]byte, l uint64) error {
+	if v, ok := val.(*[]chainhash.Hash); ok {
+		if l%chainhash.HashSize != 0 {
+			return fmt.Errorf("invalid chains length: %d", l)
+		}
+
+		chains := make([]chainhash.Hash, l/chainhash.HashSize)
+		for i := range chains {
+			_, err := io.ReadFull(r, chains[i][:])
+			if err != nil {
+				return err
+			}
+		}
+		*v = chains
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "[]chainhash.Hash", l, l)
+}
+
+// decodeOnlyRecord returns a record that can only be used to decode a value.
+func decodeOnlyRecord(typ tlv.Type, val interface{},
+	decoder tlv.Decoder) tlv.Record {
+
+	return tlv.MakeDynamicRecord(typ, val, nil, nil, decoder)
+}
+
+// encodeStream serializes the given records as a TLV stream. The records don't
+// need to be sorted.
+func encodeStream(records []tlv.Record) ([]byte, error) {
+	tlv.SortRecords(records)
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeStream decodes the given serialized TLV stream into the given records.
+// Every record of the stream that isn't known must be odd and within the
+// ranges that are allowed for the message. These unknown records are returned
+// so that they can be preserved. The returned type map contains the types of
+// all records that were present in the stream.
+func decodeStream(data []byte, records []tlv.Record,
+	allowed func(uint64) bool) (tlv.TypeMap, map[uint64][]byte, error) {
+
+	if len(data) == 0 {
+		return nil, nil, ErrEmptyStream
+	}
+
+	tlv.SortRecords(records)
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	extra := make(map[uint64][]byte)
+	for typ, value := range parsedTypes {
+		// Known records don't have their value set.
+		if value == nil {
+			continue
+		}
+
+		switch {
+		case !allowed(uint64(typ)):
+			return nil, nil, fmt.Errorf("%w: %d", ErrInvalidRange,
+				typ)
+
+		case typ%2 == 0:
+			return nil, nil, fmt.Errorf("%w: %d",
+				ErrUnknownRequiredField, typ)
+		}
+
+		extra[uint64(typ)] = value
+	}
+
+	return parsedTypes, extra, nil
+}
+
+// filterRecords returns the subset of the given raw records whose type
+// satisfies the given predicate, or nil if there are none.
+func filterRecords(records map[uint64][]byte,
+	include func(uint64) bool) map[uint64][]byte {
+
+	var filtered map[uint64][]byte
+	for typ, value := range records {
+		if !include(typ) {
+			continue
+		}
+
+		if filtered == nil {
+			filtered = make(map[uint64][]byte)
+		}
+		filtered[typ] = value
+	}
+
+	return filtered
+}
+
+// checkCanonical makes sure that serializing a parsed message again results in
+// exactly the data it was parsed from. Signatures are computed over the
+// serialized message, so we only accept messages that we can reproduce byte
+// by byte.
+func checkCanonical(data []byte, serialize func() ([]byte, error)) error {
+	reserialized, err := serialize()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(data, reserialized) {
+		return ErrNonCanonical
+	}
+
+	return nil
+}
@@ -24,8 +24,13 @@ type ChannelAcceptRequest struct {
 	Node *btcec.PublicKey
 
 	// OpenChanMsg is the actual OpenChannel protocol message that the peer
-	// sent to us.
+	// sent to us. For dual-funded channels, the fields of the OpenChannel2
+	// message are mapped onto it.
 	OpenChanMsg *lnwire.OpenChannel
+
+	// DualFunded indicates that the peer proposed a dual-funded channel, so
+	// we may contribute funds to it.
+	DualFunded bool
 }
 
 // ChannelAcceptResponse is a struct containing the response to a request to
@@ -66,6 +71,11 @@ type ChannelAcceptResponse struct {
 	// ZeroConf indicates that the fundee wishes to send min_depth = 0 and
 	// request a zero-conf channel with the counter-party.
 	ZeroConf bool
+
+	// FundingContribution is the amount we contribute to the funding
+	// output of a dual-funded channel. It must be zero for channels that
+	// are not dual-funded.
+	FundingContribution btcutil.Amount
 }
 
 // NewChannelAcceptResponse is a constructor for a channel accept response,
@@ -58,6 +58,16 @@ func TestMergeResponse(t *testing.T) {
 			},
 			err: fieldMismatchError(fieldCSV, 1, 2),
 		},
+		{
+			name: "different contribution",
+			current: ChannelAcceptResponse{
+				FundingContribution: 1,
+			},
+			new: ChannelAcceptResponse{
+				FundingContribution: 2,
+			},
+			err: fieldMismatchError(fieldContribution, 1, 2),
+		},
 		{
 			name: "different reserve",
 			current: ChannelAcceptResponse{
@@ -43,6 +43,11 @@ var (
 	errMaxHtlcTooHigh = fmt.Errorf("htlc limit exceeds spec limit of: %v",
 		input.MaxHTLCNumber/2)
 
+	// errContributionNotDualFunded is returned when we get a response that
+	// contributes funds to a channel that is not dual-funded.
+	errContributionNotDualFunded = errors.New("funding contribution set " +
+		"for channel that is not dual-funded")
+
 	// maxErrorLength is the maximum error length we allow the error we
 	// send to our peer to be.
 	maxErrorLength = 500
@@ -217,6 +222,8 @@ func (r *RPCAcceptor) receiveResponses(errChan chan error,
 			MinHtlcIn:       resp.MinHtlcIn,
 			MinAcceptDepth:  resp.MinAcceptDepth,
 			ZeroConf:        resp.ZeroConf,
+
+			FundingContributionSat: resp.FundingContributionSat,
 		}
 
 		// We have received a decision for one of our channel
@@ -429,6 +436,7 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				CommitmentType:   commitmentType,
 				WantsZeroConf:    wantsZeroConf,
 				WantsScidAlias:   wantsScidAlias,
+				DualFunded:       req.DualFunded,
 			}
 
 			if err := r.send(chanAcceptReq); err != nil {
@@ -453,13 +461,14 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 			// valid, we log our error and proceed to deliver the
 			// rejection.
 			accept, acceptErr, shutdown, err := r.validateAcceptorResponse(
-				requestInfo.request.OpenChanMsg.DustLimit, resp,
+				requestInfo.request.OpenChanMsg.DustLimit,
+				requestInfo.request.DualFunded, resp,
 			)
 			if err != nil {
 				log.Errorf("Invalid acceptor response: %v", err)
 			}
 
-			requestInfo.response <- NewChannelAcceptResponse(
+			acceptResp := NewChannelAcceptResponse(
 				accept, acceptErr, shutdown,
 				uint16(resp.CsvDelay),
 				uint16(resp.MaxHtlcCount),
@@ -469,6 +478,12 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 				lnwire.MilliSatoshi(resp.MinHtlcIn),
 				resp.ZeroConf,
 			)
+			if accept {
+				acceptResp.FundingContribution = btcutil.Amount(
+					resp.FundingContributionSat,
+				)
+			}
+			requestInfo.response <- acceptResp
 
 			// Delete the channel from the acceptRequests map.
 			delete(acceptRequests, pendingID)
@@ -489,8 +504,8 @@ func (r *RPCAcceptor) sendAcceptRequests(errChan chan error,
 // acceptor, returning a boolean indicating whether to accept the channel, an
 // error to send to the peer, and any validation errors that occurred.
 func (r *RPCAcceptor) validateAcceptorResponse(dustLimit btcutil.Amount,
-	req *lnrpc.ChannelAcceptResponse) (bool, error, lnwire.DeliveryAddress,
-	error) {
+	dualFunded bool, req *lnrpc.ChannelAcceptResponse) (bool, error,
+	lnwire.DeliveryAddress, error) {
 
 	channelStr := hex.EncodeToString(req.PendingChanId)
 
@@ -517,6 +532,17 @@ func (r *RPCAcceptor) validateAcceptorResponse(dustLimit btcutil.Amount,
 		return false, errChannelRejected, nil, errInsufficientReserve
 	}
 
+	// Only dual-funded channels allow us to contribute funds, so we fail
+	// the channel if the acceptor wants to contribute to any other one.
+	if req.FundingContributionSat != 0 && !dualFunded {
+		log.Errorf("Funding contribution: %v sat for channel: %v "+
+			"that is not dual-funded", req.FundingContributionSat,
+			channelStr)
+
+		return false, errChannelRejected, nil,
+			errContributionNotDualFunded
+	}
+
 	// Attempt to parse the upfront shutdown address provided.
 	upfront, err := chancloser.ParseUpfrontShutdownAddress(
 		req.UpfrontShutdown, r.params,
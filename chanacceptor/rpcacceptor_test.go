@@ -27,6 +27,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 	tests := []struct {
 		name        string
 		dustLimit   btcutil.Amount
+		dualFunded  bool
 		response    *lnrpc.ChannelAcceptResponse
 		accept      bool
 		acceptorErr error
@@ -115,6 +116,27 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			acceptorErr: errChannelRejected,
 			error:       errMaxHtlcTooHigh,
 		},
+		{
+			name: "contribution not dual-funded",
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:                 true,
+				FundingContributionSat: 10_000,
+			},
+			accept:      false,
+			acceptorErr: errChannelRejected,
+			error:       errContributionNotDualFunded,
+		},
+		{
+			name:       "contribution dual-funded",
+			dualFunded: true,
+			response: &lnrpc.ChannelAcceptResponse{
+				Accept:                 true,
+				FundingContributionSat: 10_000,
+			},
+			accept:      true,
+			acceptorErr: nil,
+			error:       nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -128,7 +150,7 @@ func TestValidateAcceptorResponse(t *testing.T) {
 			)
 
 			accept, acceptErr, shutdown, err := acceptor.validateAcceptorResponse(
-				test.dustLimit, test.response,
+				test.dustLimit, test.dualFunded, test.response,
 			)
 			require.Equal(t, test.accept, accept)
 			require.Equal(t, test.acceptorErr, acceptErr)
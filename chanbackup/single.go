@@ -59,6 +59,13 @@ const (
 	// channel with a top level tapscript commitment.
 	TapscriptRootVersion = 6
 
+	// AcceptorLeaseVersion is a version that denotes this channel is using
+	// the zero-fee second-level anchor commitment format along with an
+	// additional CLTV requirement of the channel lease maturity on any
+	// commitment and HTLC outputs that pay directly to the channel
+	// acceptor, who sold the lease.
+	AcceptorLeaseVersion = 7
+
 	// closeTxVersionMask is the byte mask used that is ORed to version byte
 	// on wire indicating that the backup has CloseTxInputs.
 	closeTxVersionMask = 1 << 7
@@ -103,6 +110,12 @@ func (v SingleBackupVersion) HasTapscriptRoot() bool {
 	return v == TapscriptRootVersion
 }
 
+// HasLeaseExpiry returns true if the backup is for a script enforced leased
+// channel.
+func (v SingleBackupVersion) HasLeaseExpiry() bool {
+	return v == ScriptEnforcedLeaseVersion || v == AcceptorLeaseVersion
+}
+
 // Single is a static description of an existing channel that can be used for
 // the purposes of backing up. The fields in this struct allow a node to
 // recover the settled funds within a channel in the case of partial or
@@ -186,6 +199,7 @@ type Single struct {
 	// NOTE: This field will only be present for the following versions:
 	//
 	// - ScriptEnforcedLeaseVersion
+	// - AcceptorLeaseVersion
 	LeaseExpiry uint32
 
 	// CloseTxInputs contains data needed to produce a force close tx
@@ -309,6 +323,10 @@ func NewSingle(channel *channeldb.OpenChannel,
 			single.Version = SimpleTaprootVersion
 		}
 
+	case channel.ChanType.HasAcceptorLease():
+		single.Version = AcceptorLeaseVersion
+		single.LeaseExpiry = channel.ThawHeight
+
 	case channel.ChanType.HasLeaseExpiration():
 		single.Version = ScriptEnforcedLeaseVersion
 		single.LeaseExpiry = channel.ThawHeight
@@ -351,6 +369,7 @@ func (s *Single) Serialize(w io.Writer) error {
 	case ScriptEnforcedLeaseVersion:
 	case SimpleTaprootVersion:
 	case TapscriptRootVersion:
+	case AcceptorLeaseVersion:
 	default:
 		return fmt.Errorf("unable to serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -412,7 +431,7 @@ func (s *Single) Serialize(w io.Writer) error {
 	); err != nil {
 		return err
 	}
-	if s.Version == ScriptEnforcedLeaseVersion {
+	if s.Version.HasLeaseExpiry() {
 		err := lnwire.WriteElements(&singleBytes, s.LeaseExpiry)
 		if err != nil {
 			return err
@@ -585,6 +604,7 @@ func (s *Single) Deserialize(r io.Reader) error {
 	case ScriptEnforcedLeaseVersion:
 	case SimpleTaprootVersion:
 	case TapscriptRootVersion:
+	case AcceptorLeaseVersion:
 	default:
 		return fmt.Errorf("unable to de-serialize w/ unknown "+
 			"version: %v", s.Version)
@@ -683,7 +703,7 @@ func (s *Single) Deserialize(r io.Reader) error {
 		return err
 	}
 
-	if s.Version == ScriptEnforcedLeaseVersion {
+	if s.Version.HasLeaseExpiry() {
 		if err := lnwire.ReadElement(r, &s.LeaseExpiry); err != nil {
 			return err
 		}
@@ -363,6 +363,13 @@ func TestSinglePackUnpack(t *testing.T) {
 			valid:   true,
 		},
 
+		// The acceptor lease version should pack/unpack with no
+		// problem.
+		{
+			version: AcceptorLeaseVersion,
+			valid:   true,
+		},
+
 		// The new taproot channel version should
 		// pack/unpack with no problem.
 		{
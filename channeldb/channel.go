@@ -261,6 +261,11 @@ type openChannelTlvData struct {
 	// customBlob is an optional TLV encoded blob of data representing
 	// custom channel funding information.
 	customBlob tlv.OptionalRecordT[tlv.TlvType7, tlv.Blob]
+
+	// splicedFundingOutpoint is the optional outpoint of the funding
+	// output that replaced the original one after the channel was
+	// spliced.
+	splicedFundingOutpoint tlv.OptionalRecordT[tlv.TlvType8, outPointRecord]
 }
 
 // encode serializes the openChannelTlvData to the given io.Writer.
@@ -282,6 +287,11 @@ func (c *openChannelTlvData) encode(w io.Writer) error {
 	c.customBlob.WhenSome(func(blob tlv.RecordT[tlv.TlvType7, tlv.Blob]) {
 		tlvRecords = append(tlvRecords, blob.Record())
 	})
+	c.splicedFundingOutpoint.WhenSome(
+		func(op tlv.RecordT[tlv.TlvType8, outPointRecord]) {
+			tlvRecords = append(tlvRecords, op.Record())
+		},
+	)
 
 	// Create the tlv stream.
 	tlvStream, err := tlv.NewStream(tlvRecords...)
@@ -297,6 +307,7 @@ func (c *openChannelTlvData) decode(r io.Reader) error {
 	memo := c.memo.Zero()
 	tapscriptRoot := c.tapscriptRoot.Zero()
 	blob := c.customBlob.Zero()
+	splicedOutpoint := c.splicedFundingOutpoint.Zero()
 
 	// Create the tlv stream.
 	tlvStream, err := tlv.NewStream(
@@ -307,6 +318,7 @@ func (c *openChannelTlvData) decode(r io.Reader) error {
 		memo.Record(),
 		tapscriptRoot.Record(),
 		blob.Record(),
+		splicedOutpoint.Record(),
 	)
 	if err != nil {
 		return err
@@ -326,6 +338,9 @@ func (c *openChannelTlvData) decode(r io.Reader) error {
 	if _, ok := tlvs[c.customBlob.TlvType()]; ok {
 		c.customBlob = tlv.SomeRecordT(blob)
 	}
+	if _, ok := tlvs[splicedOutpoint.TlvType()]; ok {
+		c.splicedFundingOutpoint = tlv.SomeRecordT(splicedOutpoint)
+	}
 
 	return nil
 }
@@ -412,6 +427,13 @@ const (
 	// level tapscript commitment. This MUST be set along with the
 	// SimpleTaprootFeatureBit.
 	TapscriptRootBit ChannelType = 1 << 11
+
+	// AcceptorLeaseBit indicates that the acceptor of a leased channel
+	// sold the lease to the initiator, as negotiated with liquidity ads.
+	// The additional CLTV of the lease maturity then constrains every
+	// output that pays to the acceptor instead of the initiator. This
+	// MUST be set along with the LeaseExpirationBit.
+	AcceptorLeaseBit ChannelType = 1 << 12
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -461,6 +483,18 @@ func (c ChannelType) HasLeaseExpiration() bool {
 	return c&LeaseExpirationBit == LeaseExpirationBit
 }
 
+// HasAcceptorLease returns true if the channel was leased from its acceptor.
+func (c ChannelType) HasAcceptorLease() bool {
+	return c&AcceptorLeaseBit == AcceptorLeaseBit
+}
+
+// IsLeaseSeller returns true if the channel is leased and the party that
+// either is or isn't the initiator of the channel sold the lease. The outputs
+// paying to the seller of a lease are constrained by the lease maturity.
+func (c ChannelType) IsLeaseSeller(initiator bool) bool {
+	return c.HasLeaseExpiration() && initiator != c.HasAcceptorLease()
+}
+
 // HasZeroConf returns true if the channel is a zero-conf channel.
 func (c ChannelType) HasZeroConf() bool {
 	return c&ZeroConfBit == ZeroConfBit
@@ -1039,6 +1073,11 @@ type OpenChannel struct {
 	// immutable.
 	CustomBlob fn.Option[tlv.Blob]
 
+	// SplicedFundingOutpoint is the outpoint of the funding output that
+	// currently backs the channel if the channel was spliced. The original
+	// FundingOutpoint is still used to identify the channel.
+	SplicedFundingOutpoint fn.Option[wire.OutPoint]
+
 	// TODO(roasbeef): eww
 	Db *ChannelStateDB
 
@@ -1217,6 +1256,9 @@ func (c *OpenChannel) amendTlvData(auxData openChannelTlvData) {
 	auxData.customBlob.WhenSomeV(func(blob tlv.Blob) {
 		c.CustomBlob = fn.Some(blob)
 	})
+	auxData.splicedFundingOutpoint.WhenSomeV(func(op outPointRecord) {
+		c.SplicedFundingOutpoint = fn.Some(op.OutPoint)
+	})
 }
 
 // extractTlvData creates a new openChannelTlvData from the given channel.
@@ -1251,6 +1293,11 @@ func (c *OpenChannel) extractTlvData() openChannelTlvData {
 			tlv.NewPrimitiveRecord[tlv.TlvType7](blob),
 		)
 	})
+	c.SplicedFundingOutpoint.WhenSome(func(op wire.OutPoint) {
+		auxData.splicedFundingOutpoint = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType8](outPointRecord{op}),
+		)
+	})
 
 	return auxData
 }
@@ -1600,6 +1647,51 @@ func (c *OpenChannel) MarkScidAliasNegotiated() error {
 	return nil
 }
 
+// UpdateFundingTxn replaces the funding transaction of a pending dual-funded
+// channel. The funding transaction is constructed interactively, and only
+// carries the witnesses of both parties once they exchanged their signatures
+// after the channel was persisted.
+func (c *OpenChannel) UpdateFundingTxn(fundingTx *wire.MsgTx) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.ChanType.IsDualFunder() {
+		return fmt.Errorf("funding transaction can only be updated " +
+			"for dual-funded channels")
+	}
+
+	if fundingTx.TxHash() != c.FundingOutpoint.Hash {
+		return fmt.Errorf("funding transaction %v doesn't match "+
+			"ChannelPoint(%v)", fundingTx.TxHash(),
+			c.FundingOutpoint)
+	}
+
+	if err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(
+			chanBucket, &c.FundingOutpoint,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel.FundingTxn = fundingTx
+		return putOpenChannel(chanBucket, channel)
+	}, func() {}); err != nil {
+		return err
+	}
+
+	c.FundingTxn = fundingTx
+
+	return nil
+}
+
 // MarkDataLoss marks sets the channel status to LocalDataLoss and stores the
 // passed commitPoint for use to retrieve funds in case the remote force closes
 // the channel.
@@ -4302,12 +4394,13 @@ func writeChanConfig(b io.Writer, c *ChannelConfig) error {
 }
 
 // fundingTxPresent returns true if expect the funding transcation to be found
-// on disk or already populated within the passed open channel struct.
+// on disk or already populated within the passed open channel struct. Both
+// parties of a dual-funded channel know the funding transaction.
 func fundingTxPresent(channel *OpenChannel) bool {
 	chanType := channel.ChanType
 
-	return chanType.IsSingleFunder() && chanType.HasFundingTx() &&
-		channel.IsInitiator &&
+	return (chanType.IsDualFunder() || channel.IsInitiator) &&
+		chanType.HasFundingTx() &&
 		!channel.hasChanStatus(ChanStatusRestored)
 }
 
@@ -4766,6 +4859,49 @@ func DKeyLocator(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
 	return tlv.NewTypeForDecodingErr(val, "keychain.KeyLocator", l, 8)
 }
 
+// outPointRecord is a wrapper struct around wire.OutPoint to implement the
+// tlv.RecordProducer interface.
+type outPointRecord struct {
+	wire.OutPoint
+}
+
+// Record creates a Record out of an OutPoint using the EOutPoint and DOutPoint
+// functions. The size will always be 36 as the hash is 32 bytes and the index
+// is a uint32.
+//
+// NOTE: This is part of the tlv.RecordProducer interface.
+func (o *outPointRecord) Record() tlv.Record {
+	// Note that we set the type here as zero, as when used with a
+	// tlv.RecordT, the type param will be used as the type.
+	return tlv.MakeStaticRecord(
+		0, &o.OutPoint, 36, EOutPoint, DOutPoint,
+	)
+}
+
+// EOutPoint is an encoder for wire.OutPoint.
+func EOutPoint(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*wire.OutPoint); ok {
+		if _, err := w.Write(v.Hash[:]); err != nil {
+			return err
+		}
+
+		return tlv.EUint32T(w, v.Index, buf)
+	}
+	return tlv.NewTypeForEncodingErr(val, "wire.OutPoint")
+}
+
+// DOutPoint is a decoder for wire.OutPoint.
+func DOutPoint(r io.Reader, val interface{}, buf *[8]byte, l uint64) error {
+	if v, ok := val.(*wire.OutPoint); ok && l == 36 {
+		if _, err := io.ReadFull(r, v.Hash[:]); err != nil {
+			return err
+		}
+
+		return tlv.DUint32(r, &v.Index, buf, 4)
+	}
+	return tlv.NewTypeForDecodingErr(val, "wire.OutPoint", l, 36)
+}
+
 // ShutdownInfo contains various info about the shutdown initiation of a
 // channel.
 type ShutdownInfo struct {
@@ -0,0 +1,143 @@
+package channeldb
+
+import (
+	"io"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// ChannelParams are the parameters of a channel that can be changed with a
+// dynamic commitment after the channel was opened.
+type ChannelParams struct {
+	// ChanType is the type of the channel.
+	ChanType ChannelType
+
+	// LocalBounds are the bounds the remote party imposes on the updates
+	// we offer, along with the reserve we must maintain.
+	LocalBounds ChannelStateBounds
+
+	// RemoteBounds are the bounds we impose on the updates offered by the
+	// remote party, along with the reserve it must maintain.
+	RemoteBounds ChannelStateBounds
+
+	// LocalCommitParams are the parameters of our commitment transaction.
+	LocalCommitParams CommitmentParams
+
+	// RemoteCommitParams are the parameters of the remote party's
+	// commitment transaction.
+	RemoteCommitParams CommitmentParams
+}
+
+// RequiresReanchor returns true if the channel must be moved to a new funding
+// output to switch from the given parameters to these ones. This is the case
+// if the channel type or the parameters of a commitment change, as the outputs
+// of all prior commitments must remain resolvable with the parameters they
+// were created with.
+func (p *ChannelParams) RequiresReanchor(prev ChannelParams) bool {
+	return p.ChanType != prev.ChanType ||
+		p.LocalCommitParams != prev.LocalCommitParams ||
+		p.RemoteCommitParams != prev.RemoteCommitParams
+}
+
+// Swapped returns the parameters from the point of view of the remote party.
+func (p *ChannelParams) Swapped() ChannelParams {
+	return ChannelParams{
+		ChanType:           p.ChanType,
+		LocalBounds:        p.RemoteBounds,
+		RemoteBounds:       p.LocalBounds,
+		LocalCommitParams:  p.RemoteCommitParams,
+		RemoteCommitParams: p.LocalCommitParams,
+	}
+}
+
+// encode serializes the ChannelParams to the given io.Writer.
+func (p *ChannelParams) encode(w io.Writer) error {
+	return WriteElements(
+		w, p.ChanType,
+		p.LocalBounds.ChanReserve, p.LocalBounds.MaxPendingAmount,
+		p.LocalBounds.MinHTLC, p.LocalBounds.MaxAcceptedHtlcs,
+		p.RemoteBounds.ChanReserve, p.RemoteBounds.MaxPendingAmount,
+		p.RemoteBounds.MinHTLC, p.RemoteBounds.MaxAcceptedHtlcs,
+		p.LocalCommitParams.DustLimit, p.LocalCommitParams.CsvDelay,
+		p.RemoteCommitParams.DustLimit, p.RemoteCommitParams.CsvDelay,
+	)
+}
+
+// decodeChannelParams deserializes ChannelParams from the given io.Reader.
+func decodeChannelParams(r io.Reader) (ChannelParams, error) {
+	var p ChannelParams
+	err := ReadElements(
+		r, &p.ChanType,
+		&p.LocalBounds.ChanReserve, &p.LocalBounds.MaxPendingAmount,
+		&p.LocalBounds.MinHTLC, &p.LocalBounds.MaxAcceptedHtlcs,
+		&p.RemoteBounds.ChanReserve, &p.RemoteBounds.MaxPendingAmount,
+		&p.RemoteBounds.MinHTLC, &p.RemoteBounds.MaxAcceptedHtlcs,
+		&p.LocalCommitParams.DustLimit, &p.LocalCommitParams.CsvDelay,
+		&p.RemoteCommitParams.DustLimit,
+		&p.RemoteCommitParams.CsvDelay,
+	)
+
+	return p, err
+}
+
+// Params returns the parameters of the channel that can be changed with a
+// dynamic commitment.
+func (c *OpenChannel) Params() ChannelParams {
+	c.RLock()
+	defer c.RUnlock()
+
+	return ChannelParams{
+		ChanType:           c.ChanType,
+		LocalBounds:        c.LocalChanCfg.ChannelStateBounds,
+		RemoteBounds:       c.RemoteChanCfg.ChannelStateBounds,
+		LocalCommitParams:  c.LocalChanCfg.CommitmentParams,
+		RemoteCommitParams: c.RemoteChanCfg.CommitmentParams,
+	}
+}
+
+// UpdateChanStateBounds persists new bounds on the updates both parties may
+// offer. Unlike the commitment parameters, the bounds don't influence how
+// prior commitments are resolved, so they can be changed in place once both
+// parties agreed on them.
+func (c *OpenChannel) UpdateChanStateBounds(local,
+	remote ChannelStateBounds) error {
+
+	c.Lock()
+	defer c.Unlock()
+
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		channel.LocalChanCfg.ChannelStateBounds = local
+		channel.RemoteChanCfg.ChannelStateBounds = remote
+
+		return putChanInfo(chanBucket, channel)
+	}, func() {})
+	if err != nil {
+		return err
+	}
+
+	c.LocalChanCfg.ChannelStateBounds = local
+	c.RemoteChanCfg.ChannelStateBounds = remote
+
+	return nil
+}
+
+// applyParams updates the channel with the given parameters.
+func applyParams(c *OpenChannel, params ChannelParams) {
+	c.ChanType = params.ChanType
+	c.LocalChanCfg.ChannelStateBounds = params.LocalBounds
+	c.RemoteChanCfg.ChannelStateBounds = params.RemoteBounds
+	c.LocalChanCfg.CommitmentParams = params.LocalCommitParams
+	c.RemoteChanCfg.CommitmentParams = params.RemoteCommitParams
+}
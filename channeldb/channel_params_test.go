@@ -0,0 +1,118 @@
+package channeldb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestUpdateChanStateBounds tests that the bounds of a channel are updated in
+// place, both in memory and on disk.
+func TestUpdateChanStateBounds(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+	params := channel.Params()
+
+	local := ChannelStateBounds{
+		ChanReserve:      5_000,
+		MaxPendingAmount: lnwire.MilliSatoshi(50_000_000),
+		MinHTLC:          lnwire.MilliSatoshi(2_000),
+		MaxAcceptedHtlcs: 30,
+	}
+	remote := ChannelStateBounds{
+		ChanReserve:      6_000,
+		MaxPendingAmount: lnwire.MilliSatoshi(60_000_000),
+		MinHTLC:          lnwire.MilliSatoshi(3_000),
+		MaxAcceptedHtlcs: 40,
+	}
+	require.NoError(t, channel.UpdateChanStateBounds(local, remote))
+
+	dbChannel, err := cdb.FetchChannel(channel.FundingOutpoint)
+	require.NoError(t, err)
+
+	for _, c := range []*OpenChannel{channel, dbChannel} {
+		newParams := c.Params()
+		require.Equal(t, local, newParams.LocalBounds)
+		require.Equal(t, remote, newParams.RemoteBounds)
+
+		// The commitment parameters are left untouched, so the channel
+		// doesn't need to be reanchored.
+		require.False(t, newParams.RequiresReanchor(params))
+		require.Equal(
+			t, params.LocalCommitParams,
+			newParams.LocalCommitParams,
+		)
+		require.Equal(
+			t, params.RemoteCommitParams,
+			newParams.RemoteCommitParams,
+		)
+	}
+}
+
+// TestPendingSpliceParams tests that the new parameters of a reanchored
+// channel are persisted along with the pending splice, and take effect once
+// the splice is applied.
+func TestPendingSpliceParams(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+	chanPoint := channel.FundingOutpoint
+
+	params := channel.Params()
+	params.ChanType |= SimpleTaprootFeatureBit
+	params.LocalCommitParams.CsvDelay++
+	params.RemoteCommitParams.DustLimit++
+	require.True(t, params.RequiresReanchor(channel.Params()))
+
+	kickoffTx := wire.NewMsgTx(2)
+	kickoffTx.AddTxIn(wire.NewTxIn(&chanPoint, []byte{}, nil))
+	kickoffTx.AddTxOut(wire.NewTxOut(9_000, bytes.Repeat([]byte{1}, 34)))
+
+	localCommit := channel.LocalCommitment
+	localCommit.CustomBlob = fn.None[[]byte]()
+
+	splice := &PendingSplice{
+		SpliceTx: kickoffTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash: kickoffTx.TxHash(),
+		},
+		Capacity:         btcutil.Amount(9_000),
+		BroadcastHeight:  200,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: channel.RemoteCommitment,
+		Params:           fn.Some(params),
+	}
+	require.NoError(t, channel.StorePendingSplice(splice))
+
+	dbSplice, err := channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, splice, dbSplice)
+
+	require.NoError(t, channel.ApplySplice())
+
+	dbChannel, err := cdb.FetchChannel(chanPoint)
+	require.NoError(t, err)
+
+	for _, c := range []*OpenChannel{channel, dbChannel} {
+		require.Equal(t, params, c.Params())
+		require.Equal(
+			t, splice.FundingOutpoint, c.CurrentFundingOutpoint(),
+		)
+	}
+}
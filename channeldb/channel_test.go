@@ -1807,3 +1807,21 @@ func TestOnionBlobIncorrectLength(t *testing.T) {
 	_, err := DeserializeHtlcs(&b)
 	require.ErrorIs(t, err, ErrOnionBlobLength)
 }
+
+// TestIsLeaseSeller asserts that the seller of a leased channel is its
+// initiator, unless the lease was sold by its acceptor.
+func TestIsLeaseSeller(t *testing.T) {
+	t.Parallel()
+
+	var chanType ChannelType
+	require.False(t, chanType.IsLeaseSeller(true))
+	require.False(t, chanType.IsLeaseSeller(false))
+
+	chanType |= LeaseExpirationBit
+	require.True(t, chanType.IsLeaseSeller(true))
+	require.False(t, chanType.IsLeaseSeller(false))
+
+	chanType |= AcceptorLeaseBit
+	require.False(t, chanType.IsLeaseSeller(true))
+	require.True(t, chanType.IsLeaseSeller(false))
+}
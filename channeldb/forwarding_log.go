@@ -42,6 +42,29 @@ const (
 	MaxResponseEvents = 50000
 )
 
+// ForwardingLogDB is the interface of a time series database that logs the
+// fulfillment of payment circuits by a lightning network daemon.
+type ForwardingLogDB interface {
+	// AddForwardingEvents adds a series of forwarding events to the
+	// database.
+	AddForwardingEvents(events []ForwardingEvent) error
+
+	// Query allows a caller to query the forwarding event time series for
+	// a particular time slice.
+	Query(q ForwardingEventQuery) (ForwardingLogTimeSlice, error)
+
+	// QueryFeeAggregates returns the forwarding volume and the fees earned
+	// per outgoing channel and day for all events that match the time
+	// slice and channel filters of the given query. The index offset and
+	// max number of events of the query are ignored.
+	QueryFeeAggregates(q ForwardingEventQuery) ([]ChannelFeeAggregate,
+		error)
+}
+
+// A compile-time assertion to ensure that ForwardingLog implements the
+// ForwardingLogDB interface.
+var _ ForwardingLogDB = (*ForwardingLog)(nil)
+
 // ForwardingLog returns an instance of the ForwardingLog object backed by the
 // target database instance.
 func (d *DB) ForwardingLog() *ForwardingLog {
@@ -268,6 +291,22 @@ type ForwardingEventQuery struct {
 	OutgoingChanIDs fn.Set[uint64]
 }
 
+// matchesChanIDs returns true if the given event matches the incoming and
+// outgoing channel filters of the query.
+func (q *ForwardingEventQuery) matchesChanIDs(event *ForwardingEvent) bool {
+	// Check if the incoming channel ID matches the filter criteria. Either
+	// no filtering is applied (IsEmpty), or the ID is explicitly included.
+	incomingMatch := q.IncomingChanIDs.IsEmpty() ||
+		q.IncomingChanIDs.Contains(event.IncomingChanID.ToUint64())
+
+	// Check if the outgoing channel ID matches the filter criteria. Either
+	// no filtering is applied (IsEmpty), or the ID is explicitly included.
+	outgoingMatch := q.OutgoingChanIDs.IsEmpty() ||
+		q.OutgoingChanIDs.Contains(event.OutgoingChanID.ToUint64())
+
+	return incomingMatch && outgoingMatch
+}
+
 // ForwardingLogTimeSlice is the response to a forwarding query. It includes
 // the original query, the set  events that match the query, and an integer
 // which represents the offset index of the last item in the set of returned
@@ -363,27 +402,9 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice,
 				return err
 			}
 
-			// Check if the incoming channel ID matches the
-			// filter criteria. Either no filtering is
-			// applied (IsEmpty), or the ID is explicitly
-			// included.
-			incomingMatch := q.IncomingChanIDs.IsEmpty() ||
-				q.IncomingChanIDs.Contains(
-					event.IncomingChanID.ToUint64(),
-				)
-
-			// Check if the outgoing channel ID matches the
-			// filter criteria. Either no filtering is
-			// applied (IsEmpty), or  the ID is explicitly
-			// included.
-			outgoingMatch := q.OutgoingChanIDs.IsEmpty() ||
-				q.OutgoingChanIDs.Contains(
-					event.OutgoingChanID.ToUint64(),
-				)
-
 			// Skip this event if it doesn't match the
 			// filters.
-			if !incomingMatch || !outgoingMatch {
+			if !q.matchesChanIDs(&event) {
 				continue
 			}
 			// If we're not yet past the user defined offset
@@ -416,6 +437,137 @@ func (f *ForwardingLog) Query(q ForwardingEventQuery) (ForwardingLogTimeSlice,
 	return resp, nil
 }
 
+// ChannelFeeAggregate is the aggregated forwarding volume and the fees earned
+// on a single outgoing channel during a single day.
+type ChannelFeeAggregate struct {
+	// ChanID is the outgoing channel the HTLCs were forwarded on.
+	ChanID lnwire.ShortChannelID
+
+	// Day is the start of the day (UTC) this aggregate covers.
+	Day time.Time
+
+	// NumForwards is the number of forwarding events in this aggregate.
+	NumForwards uint64
+
+	// TotalAmtIn is the sum of the incoming HTLC amounts.
+	TotalAmtIn lnwire.MilliSatoshi
+
+	// TotalAmtOut is the sum of the outgoing HTLC amounts.
+	TotalAmtOut lnwire.MilliSatoshi
+
+	// TotalFees is the sum of the fees earned.
+	TotalFees lnwire.MilliSatoshi
+}
+
+// dayIndex returns the number of full days that have passed between the unix
+// epoch and the given time. It is used to group forwarding events by day.
+func dayIndex(t time.Time) int64 {
+	return t.UnixNano() / int64(24*time.Hour)
+}
+
+// dayStart returns the start of the day (UTC) with the given day index.
+func dayStart(dayIdx int64) time.Time {
+	return time.Unix(0, dayIdx*int64(24*time.Hour)).UTC()
+}
+
+// QueryFeeAggregates returns the forwarding volume and the fees earned per
+// outgoing channel and day for all events that match the time slice and
+// channel filters of the given query. The aggregates are sorted by day and
+// then by channel ID. The index offset and max number of events of the query
+// are ignored.
+//
+// NOTE: part of the ForwardingLogDB interface.
+func (f *ForwardingLog) QueryFeeAggregates(q ForwardingEventQuery) (
+	[]ChannelFeeAggregate, error) {
+
+	type aggregateKey struct {
+		chanID lnwire.ShortChannelID
+		day    int64
+	}
+
+	var aggregates map[aggregateKey]*ChannelFeeAggregate
+	err := kvdb.View(f.db, func(tx kvdb.RTx) error {
+		logBucket := tx.ReadBucket(forwardingLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		var startTime, endTime [8]byte
+		byteOrder.PutUint64(startTime[:], uint64(q.StartTime.UnixNano()))
+		byteOrder.PutUint64(endTime[:], uint64(q.EndTime.UnixNano()))
+
+		logCursor := logBucket.ReadCursor()
+		timestamp, eventBytes := logCursor.Seek(startTime[:])
+		//nolint:ll
+		for ; timestamp != nil && bytes.Compare(timestamp, endTime[:]) <= 0; timestamp, eventBytes = logCursor.Next() {
+			readBuf := bytes.NewReader(eventBytes)
+			if readBuf.Len() == 0 {
+				continue
+			}
+
+			var event ForwardingEvent
+			err := decodeForwardingEvent(readBuf, &event)
+			if err != nil {
+				return err
+			}
+
+			if !q.matchesChanIDs(&event) {
+				continue
+			}
+
+			event.Timestamp = time.Unix(
+				0, int64(byteOrder.Uint64(timestamp)),
+			)
+
+			key := aggregateKey{
+				chanID: event.OutgoingChanID,
+				day:    dayIndex(event.Timestamp),
+			}
+			aggregate, ok := aggregates[key]
+			if !ok {
+				aggregate = &ChannelFeeAggregate{
+					ChanID: key.chanID,
+					Day:    dayStart(key.day),
+				}
+				aggregates[key] = aggregate
+			}
+
+			aggregate.NumForwards++
+			aggregate.TotalAmtIn += event.AmtIn
+			aggregate.TotalAmtOut += event.AmtOut
+			aggregate.TotalFees += event.AmtIn - event.AmtOut
+		}
+
+		return nil
+	}, func() {
+		aggregates = make(map[aggregateKey]*ChannelFeeAggregate)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]ChannelFeeAggregate, 0, len(aggregates))
+	for _, aggregate := range aggregates {
+		result = append(result, *aggregate)
+	}
+	sortChannelFeeAggregates(result)
+
+	return result, nil
+}
+
+// sortChannelFeeAggregates sorts the given aggregates by day and then by
+// channel ID.
+func sortChannelFeeAggregates(aggregates []ChannelFeeAggregate) {
+	sort.Slice(aggregates, func(i, j int) bool {
+		if !aggregates[i].Day.Equal(aggregates[j].Day) {
+			return aggregates[i].Day.Before(aggregates[j].Day)
+		}
+
+		return aggregates[i].ChanID.ToUint64() <
+			aggregates[j].ChanID.ToUint64()
+	})
+}
+
 // makeUniqueTimestamps takes a slice of forwarding events, sorts it by the
 // event timestamps and then makes sure there are no duplicates in the
 // timestamps. If duplicates are found, some of the timestamps are increased on
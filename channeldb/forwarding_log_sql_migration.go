@@ -0,0 +1,238 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// forwardingLogTombstoneKey is the key of the marker that is set in
+	// the KV database once all forwarding events have been migrated to the
+	// native SQL schema. From then on, the KV forwarding log must no
+	// longer be used as it would silently diverge from the SQL store.
+	forwardingLogTombstoneKey = []byte("forwarding-log-tombstone")
+)
+
+// MigrateForwardingLogToSQL migrates all forwarding events from the KV
+// forwarding log to the native SQL forwarding events table. Events are read
+// from the KV store and verified against the SQL store in batches of the given
+// size to bound memory usage.
+func MigrateForwardingLogToSQL(ctx context.Context, kvBackend kvdb.Backend,
+	sqlDB SQLForwardingLogQueries, batchSize int) error {
+
+	log.Infof("Starting migration of forwarding events from KV to SQL")
+
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size: %d", batchSize)
+	}
+
+	s := rate.Sometimes{
+		Interval: 30 * time.Second,
+	}
+
+	var (
+		t0      = time.Now()
+		tChunk  = t0
+		chunk   int
+		total   uint64
+		lastKey []byte
+	)
+	for {
+		batch, nextKey, err := fetchKVForwardingEventBatch(
+			kvBackend, lastKey, batchSize,
+		)
+		if err != nil {
+			return fmt.Errorf("could not read forwarding events: "+
+				"%w", err)
+		}
+		if len(batch) == 0 {
+			break
+		}
+
+		err = migrateForwardingEventBatch(ctx, sqlDB, batch)
+		if err != nil {
+			return err
+		}
+
+		lastKey = nextKey
+		total += uint64(len(batch))
+		chunk += len(batch)
+
+		s.Do(func() {
+			elapsed := time.Since(tChunk).Seconds()
+			ratePerSec := float64(chunk) / elapsed
+			log.Infof("Migrated %d forwarding events (%.2f "+
+				"events/sec)", total, ratePerSec)
+
+			tChunk = time.Now()
+			chunk = 0
+		})
+	}
+
+	log.Infof("Migrated %d forwarding events from KV to SQL in %v", total,
+		time.Since(t0))
+
+	return nil
+}
+
+// fetchKVForwardingEventBatch reads up to batchSize forwarding events from the
+// KV forwarding log. Reading starts at the first timestamp key that is
+// strictly greater than lastKey, or at the very first event if lastKey is nil.
+// The key of the last event that was read is returned as well so that the
+// next batch can be resumed from there.
+func fetchKVForwardingEventBatch(kvBackend kvdb.Backend, lastKey []byte,
+	batchSize int) ([]ForwardingEvent, []byte, error) {
+
+	var (
+		batch   []ForwardingEvent
+		nextKey []byte
+	)
+	err := kvdb.View(kvBackend, func(tx kvdb.RTx) error {
+		logBucket := tx.ReadBucket(forwardingLogBucket)
+		if logBucket == nil {
+			return nil
+		}
+
+		cursor := logBucket.ReadCursor()
+
+		k, v := cursor.First()
+		if lastKey != nil {
+			k, v = cursor.Seek(lastKey)
+			if bytes.Equal(k, lastKey) {
+				k, v = cursor.Next()
+			}
+		}
+
+		for ; k != nil && len(batch) < batchSize; k, v = cursor.Next() {
+			nextKey = append(nextKey[:0], k...)
+
+			// Empty values were never returned by the KV
+			// forwarding log, so we don't migrate them either.
+			readBuf := bytes.NewReader(v)
+			if readBuf.Len() == 0 {
+				continue
+			}
+
+			var event ForwardingEvent
+			err := decodeForwardingEvent(readBuf, &event)
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"forwarding event %x: %w", k, err)
+			}
+			event.Timestamp = time.Unix(
+				0, int64(byteOrder.Uint64(k)),
+			)
+
+			batch = append(batch, event)
+		}
+
+		return nil
+	}, func() {
+		batch = nil
+		nextKey = nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return batch, nextKey, nil
+}
+
+// migrateForwardingEventBatch inserts the given KV forwarding events into the
+// SQL database. Afterwards, the whole batch is read back with a single query
+// and compared against the original events to make sure the migration was
+// successful.
+func migrateForwardingEventBatch(ctx context.Context,
+	sqlDB SQLForwardingLogQueries, batch []ForwardingEvent) error {
+
+	for _, event := range batch {
+		err := insertForwardingEvent(ctx, sqlDB, event)
+		if err != nil {
+			return fmt.Errorf("could not persist forwarding "+
+				"event(timestamp=%d): %w",
+				event.Timestamp.UnixNano(), err)
+		}
+	}
+
+	// The events of a batch are sorted by their unique timestamp, so we
+	// can fetch all of them with a single range query.
+	dbEvents, err := sqlDB.FilterForwardingEvents(
+		ctx, sqlc.FilterForwardingEventsParams{
+			StartTime: batch[0].Timestamp.UnixNano(),
+			EndTime:   batch[len(batch)-1].Timestamp.UnixNano(),
+			NumLimit:  int32(len(batch)),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("could not get forwarding events after "+
+			"migration: %w", err)
+	}
+	if len(dbEvents) != len(batch) {
+		return fmt.Errorf("expected %d migrated forwarding events, "+
+			"got %d", len(batch), len(dbEvents))
+	}
+
+	for i, dbEvent := range dbEvents {
+		err := sqldb.CompareRecords(
+			batch[i], unmarshalForwardingEvent(dbEvent),
+			fmt.Sprintf("forwarding event(timestamp=%d)",
+				batch[i].Timestamp.UnixNano()),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetForwardingLogTombstone sets the forwarding log tombstone marker to mark
+// the KV forwarding log as permanently closed after its content was migrated
+// to the native SQL store. This prevents it from being used again in the
+// future.
+func (d *DB) SetForwardingLogTombstone() error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		err := AddMarker(tx, forwardingLogTombstoneKey, []byte("1"))
+		if err != nil {
+			return fmt.Errorf("failed to set tombstone: %w", err)
+		}
+
+		return nil
+	}, func() {})
+}
+
+// GetForwardingLogTombstone checks if the forwarding log tombstone marker
+// exists. It returns true if the tombstone is present and false otherwise.
+func (d *DB) GetForwardingLogTombstone() (bool, error) {
+	var tombstoneExists bool
+
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		_, err := CheckMarkerPresent(tx, forwardingLogTombstoneKey)
+		switch {
+		case err == ErrMarkerNotPresent:
+			tombstoneExists = false
+
+		case err != nil:
+			return err
+
+		default:
+			tombstoneExists = true
+		}
+
+		return nil
+	}, func() {
+		tombstoneExists = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return tombstoneExists, nil
+}
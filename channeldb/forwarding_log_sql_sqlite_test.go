@@ -0,0 +1,25 @@
+//go:build !test_db_postgres && test_db_sqlite
+
+package channeldb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/sqldb"
+)
+
+// newTestForwardingLogSQLStore creates a ForwardingLogSQLStore backed by a
+// fresh sqlite database for testing.
+func newTestForwardingLogSQLStore(t *testing.T) (*ForwardingLogSQLStore,
+	*sqldb.BaseDB) {
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLForwardingLogQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewForwardingLogSQLStore(executor), db
+}
@@ -0,0 +1,303 @@
+package channeldb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+)
+
+// SQLForwardingLogQueries is an interface that defines the set of operations
+// that can be executed against the forwarding log SQL database.
+//
+//nolint:ll
+type SQLForwardingLogQueries interface {
+	InsertForwardingEvent(ctx context.Context, arg sqlc.InsertForwardingEventParams) (sql.Result, error)
+	FilterForwardingEvents(ctx context.Context, arg sqlc.FilterForwardingEventsParams) ([]sqlc.ForwardingEvent, error)
+	GetForwardingFeesPerChannelDay(ctx context.Context, arg sqlc.GetForwardingFeesPerChannelDayParams) ([]sqlc.GetForwardingFeesPerChannelDayRow, error)
+}
+
+// BatchedSQLForwardingLogQueries is a version of the SQLForwardingLogQueries
+// that's capable of batched database operations.
+type BatchedSQLForwardingLogQueries interface {
+	SQLForwardingLogQueries
+	sqldb.BatchedTx[SQLForwardingLogQueries]
+}
+
+// ForwardingLogSQLStore is an implementation of the ForwardingLogDB interface
+// that uses a native SQL database as the backend. In contrast to the KV
+// forwarding log, the channel filters of a query are evaluated by the database
+// using indexes, and fee aggregates are computed by the database as well.
+type ForwardingLogSQLStore struct {
+	db BatchedSQLForwardingLogQueries
+}
+
+// A compile-time assertion to ensure that ForwardingLogSQLStore implements the
+// ForwardingLogDB interface.
+var _ ForwardingLogDB = (*ForwardingLogSQLStore)(nil)
+
+// NewForwardingLogSQLStore creates a new ForwardingLogSQLStore instance given
+// an open BatchedSQLForwardingLogQueries storage backend.
+func NewForwardingLogSQLStore(
+	db BatchedSQLForwardingLogQueries) *ForwardingLogSQLStore {
+
+	return &ForwardingLogSQLStore{
+		db: db,
+	}
+}
+
+// AddForwardingEvents adds a series of forwarding events to the database.
+// Just like the KV forwarding log, the events are sorted by their timestamp
+// and the timestamps are made unique before they are inserted.
+//
+// NOTE: part of the ForwardingLogDB interface.
+func (s *ForwardingLogSQLStore) AddForwardingEvents(
+	events []ForwardingEvent) error {
+
+	makeUniqueTimestamps(events)
+
+	ctx := context.TODO()
+
+	return s.db.ExecTx(ctx, sqldb.WriteTxOpt(),
+		func(db SQLForwardingLogQueries) error {
+			for _, event := range events {
+				err := insertForwardingEvent(ctx, db, event)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		}, sqldb.NoOpReset,
+	)
+}
+
+// insertForwardingEvent inserts the given forwarding event. If an event with
+// the same timestamp already exists, the timestamp is incremented in
+// nanosecond intervals until a free slot is found, mirroring the behavior of
+// the KV forwarding log.
+func insertForwardingEvent(ctx context.Context, db SQLForwardingLogQueries,
+	event ForwardingEvent) error {
+
+	params := sqlc.InsertForwardingEventParams{
+		TimestampNs:    event.Timestamp.UnixNano(),
+		IncomingChanID: int64(event.IncomingChanID.ToUint64()),
+		OutgoingChanID: int64(event.OutgoingChanID.ToUint64()),
+		AmtInMsat:      int64(event.AmtIn),
+		AmtOutMsat:     int64(event.AmtOut),
+		FeeMsat:        int64(event.AmtIn) - int64(event.AmtOut),
+	}
+	event.IncomingHtlcID.WhenSome(func(id uint64) {
+		params.IncomingHtlcID = sqldb.SQLInt64(id)
+	})
+	event.OutgoingHtlcID.WhenSome(func(id uint64) {
+		params.OutgoingHtlcID = sqldb.SQLInt64(id)
+	})
+
+	// We try up to 100 times to find a free slot, see storeEvent for the
+	// rationale behind this limit.
+	const maxTries = 100
+	for tries := 0; tries < maxTries; tries++ {
+		result, err := db.InsertForwardingEvent(ctx, params)
+		if err != nil {
+			return fmt.Errorf("unable to insert forwarding event: "+
+				"%w", err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected > 0 {
+			return nil
+		}
+
+		// Collision, try the next nanosecond timestamp.
+		params.TimestampNs++
+	}
+
+	log.Warnf("Unable to find a free timestamp slot for forwarding "+
+		"event at %v, dropping it", event.Timestamp)
+
+	return nil
+}
+
+// chanIDFilter converts the given set of channel IDs into a slice that can be
+// used as a query parameter. The returned boolean indicates whether the filter
+// should be applied at all.
+func chanIDFilter(chanIDs fn.Set[uint64]) ([]int64, bool) {
+	if chanIDs.IsEmpty() {
+		return nil, false
+	}
+
+	ids := make([]int64, 0, chanIDs.Size())
+	for _, chanID := range chanIDs.ToSlice() {
+		ids = append(ids, int64(chanID))
+	}
+
+	return ids, true
+}
+
+// Query allows a caller to query the forwarding event time series for a
+// particular time slice. The caller can control the precise time as well as
+// the number of events to be returned.
+//
+// NOTE: part of the ForwardingLogDB interface.
+func (s *ForwardingLogSQLStore) Query(q ForwardingEventQuery) (
+	ForwardingLogTimeSlice, error) {
+
+	ctx := context.TODO()
+
+	incomingChanIDs, filterIncoming := chanIDFilter(q.IncomingChanIDs)
+	outgoingChanIDs, filterOutgoing := chanIDFilter(q.OutgoingChanIDs)
+
+	params := sqlc.FilterForwardingEventsParams{
+		StartTime:           q.StartTime.UnixNano(),
+		EndTime:             q.EndTime.UnixNano(),
+		FilterIncomingChans: filterIncoming,
+		FilterOutgoingChans: filterOutgoing,
+		NumLimit:            int32(min(q.NumMaxEvents, math.MaxInt32)),
+		NumOffset:           int32(min(q.IndexOffset, math.MaxInt32)),
+		IncomingChanIds:     incomingChanIDs,
+		OutgoingChanIds:     outgoingChanIDs,
+	}
+
+	resp := ForwardingLogTimeSlice{
+		ForwardingEventQuery: q,
+	}
+	err := s.db.ExecTx(ctx, sqldb.ReadTxOpt(),
+		func(db SQLForwardingLogQueries) error {
+			dbEvents, err := db.FilterForwardingEvents(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to query forwarding "+
+					"events: %w", err)
+			}
+
+			for _, dbEvent := range dbEvents {
+				resp.ForwardingEvents = append(
+					resp.ForwardingEvents,
+					unmarshalForwardingEvent(dbEvent),
+				)
+			}
+
+			return nil
+		}, func() {
+			resp.ForwardingEvents = nil
+		},
+	)
+	if err != nil {
+		return ForwardingLogTimeSlice{}, err
+	}
+
+	resp.LastIndexOffset = q.IndexOffset +
+		uint32(len(resp.ForwardingEvents))
+
+	return resp, nil
+}
+
+// QueryFeeAggregates returns the forwarding volume and the fees earned per
+// outgoing channel and day for all events that match the time slice and
+// channel filters of the given query. The aggregates are sorted by day and
+// then by channel ID. The index offset and max number of events of the query
+// are ignored.
+//
+// NOTE: part of the ForwardingLogDB interface.
+func (s *ForwardingLogSQLStore) QueryFeeAggregates(q ForwardingEventQuery) (
+	[]ChannelFeeAggregate, error) {
+
+	ctx := context.TODO()
+
+	incomingChanIDs, filterIncoming := chanIDFilter(q.IncomingChanIDs)
+	outgoingChanIDs, filterOutgoing := chanIDFilter(q.OutgoingChanIDs)
+
+	params := sqlc.GetForwardingFeesPerChannelDayParams{
+		StartTime:           q.StartTime.UnixNano(),
+		EndTime:             q.EndTime.UnixNano(),
+		FilterIncomingChans: filterIncoming,
+		FilterOutgoingChans: filterOutgoing,
+		IncomingChanIds:     incomingChanIDs,
+		OutgoingChanIds:     outgoingChanIDs,
+	}
+
+	var aggregates []ChannelFeeAggregate
+	err := s.db.ExecTx(ctx, sqldb.ReadTxOpt(),
+		func(db SQLForwardingLogQueries) error {
+			rows, err := db.GetForwardingFeesPerChannelDay(
+				ctx, params,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to query forwarding "+
+					"fees: %w", err)
+			}
+
+			aggregates = make([]ChannelFeeAggregate, 0, len(rows))
+			for _, row := range rows {
+				aggregates = append(
+					aggregates,
+					unmarshalChannelFeeAggregate(row),
+				)
+			}
+
+			return nil
+		}, func() {
+			aggregates = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregates, nil
+}
+
+// unmarshalForwardingEvent converts a forwarding event row of the SQL database
+// into a ForwardingEvent.
+func unmarshalForwardingEvent(dbEvent sqlc.ForwardingEvent) ForwardingEvent {
+	event := ForwardingEvent{
+		Timestamp: time.Unix(0, dbEvent.TimestampNs),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(
+			uint64(dbEvent.IncomingChanID),
+		),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(
+			uint64(dbEvent.OutgoingChanID),
+		),
+		AmtIn:  lnwire.MilliSatoshi(dbEvent.AmtInMsat),
+		AmtOut: lnwire.MilliSatoshi(dbEvent.AmtOutMsat),
+	}
+
+	if dbEvent.IncomingHtlcID.Valid {
+		event.IncomingHtlcID = fn.Some(
+			uint64(dbEvent.IncomingHtlcID.Int64),
+		)
+	}
+	if dbEvent.OutgoingHtlcID.Valid {
+		event.OutgoingHtlcID = fn.Some(
+			uint64(dbEvent.OutgoingHtlcID.Int64),
+		)
+	}
+
+	return event
+}
+
+// unmarshalChannelFeeAggregate converts an aggregated row of the SQL database
+// into a ChannelFeeAggregate.
+func unmarshalChannelFeeAggregate(
+	row sqlc.GetForwardingFeesPerChannelDayRow) ChannelFeeAggregate {
+
+	return ChannelFeeAggregate{
+		ChanID: lnwire.NewShortChanIDFromInt(
+			uint64(row.OutgoingChanID),
+		),
+		Day:         dayStart(row.DayIndex),
+		NumForwards: uint64(row.NumForwards),
+		TotalAmtIn:  lnwire.MilliSatoshi(row.TotalAmtInMsat),
+		TotalAmtOut: lnwire.MilliSatoshi(row.TotalAmtOutMsat),
+		TotalFees:   lnwire.MilliSatoshi(row.TotalFeeMsat),
+	}
+}
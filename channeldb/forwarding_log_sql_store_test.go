@@ -0,0 +1,214 @@
+//go:build test_db_postgres || test_db_sqlite
+
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// genForwardingEvents creates numEvents forwarding events that are spread
+// over multiple days and a small set of channels. Some of the events share
+// the same timestamp to exercise the timestamp collision handling.
+func genForwardingEvents(numEvents int,
+	chanIDs []lnwire.ShortChannelID) []ForwardingEvent {
+
+	timestamp := time.Unix(1700000000, 0)
+	events := make([]ForwardingEvent, numEvents)
+	for i := 0; i < numEvents; i++ {
+		amtOut := lnwire.MilliSatoshi(1000 * (i + 1))
+		events[i] = ForwardingEvent{
+			Timestamp:      timestamp,
+			IncomingChanID: chanIDs[i%len(chanIDs)],
+			OutgoingChanID: chanIDs[(i+1)%len(chanIDs)],
+			AmtIn:          amtOut + lnwire.MilliSatoshi(i),
+			AmtOut:         amtOut,
+			IncomingHtlcID: fn.Some(uint64(i)),
+			OutgoingHtlcID: fn.Some(uint64(i + 1)),
+		}
+
+		if i%5 != 0 {
+			timestamp = timestamp.Add(3 * time.Hour)
+		}
+	}
+
+	return events
+}
+
+// TestForwardingLogSQLStoreParity asserts that the KV forwarding log and the
+// native SQL forwarding log return exactly the same results for the same set
+// of queries.
+func TestForwardingLogSQLStoreParity(t *testing.T) {
+	t.Parallel()
+
+	kvDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	kvStore := kvDB.ForwardingLog()
+	sqlStore, _ := newTestForwardingLogSQLStore(t)
+
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(1001),
+		lnwire.NewShortChanIDFromInt(1002),
+		lnwire.NewShortChanIDFromInt(1003),
+	}
+
+	// Add the events in two batches to both stores. The second batch
+	// re-uses the timestamps of the first one, so both stores need to
+	// shift them to free slots.
+	events := genForwardingEvents(50, chanIDs)
+	for i := 0; i < 2; i++ {
+		for _, store := range []ForwardingLogDB{kvStore, sqlStore} {
+			batch := make([]ForwardingEvent, len(events))
+			copy(batch, events)
+
+			require.NoError(t, store.AddForwardingEvents(batch))
+		}
+	}
+
+	startTime := time.Unix(1700000000, 0)
+	endTime := startTime.Add(30 * 24 * time.Hour)
+
+	queries := []ForwardingEventQuery{{
+		StartTime:    startTime,
+		EndTime:      endTime,
+		NumMaxEvents: 1000,
+	}, {
+		StartTime:    startTime,
+		EndTime:      endTime,
+		IndexOffset:  13,
+		NumMaxEvents: 20,
+	}, {
+		StartTime:    startTime.Add(24 * time.Hour),
+		EndTime:      startTime.Add(3 * 24 * time.Hour),
+		NumMaxEvents: 1000,
+	}, {
+		StartTime:       startTime,
+		EndTime:         endTime,
+		IndexOffset:     5,
+		NumMaxEvents:    10,
+		IncomingChanIDs: fn.NewSet(chanIDs[0].ToUint64()),
+	}, {
+		StartTime:    startTime,
+		EndTime:      endTime,
+		NumMaxEvents: 1000,
+		IncomingChanIDs: fn.NewSet(
+			chanIDs[0].ToUint64(), chanIDs[2].ToUint64(),
+		),
+		OutgoingChanIDs: fn.NewSet(chanIDs[1].ToUint64()),
+	}, {
+		StartTime:       startTime,
+		EndTime:         endTime,
+		NumMaxEvents:    1000,
+		OutgoingChanIDs: fn.NewSet[uint64](4242),
+	}}
+
+	for _, query := range queries {
+		kvResp, err := kvStore.Query(query)
+		require.NoError(t, err)
+
+		sqlResp, err := sqlStore.Query(query)
+		require.NoError(t, err)
+
+		require.Equal(t, kvResp, sqlResp)
+
+		kvAggregates, err := kvStore.QueryFeeAggregates(query)
+		require.NoError(t, err)
+
+		sqlAggregates, err := sqlStore.QueryFeeAggregates(query)
+		require.NoError(t, err)
+
+		require.Equal(t, kvAggregates, sqlAggregates)
+	}
+}
+
+// TestMigrateForwardingLogToSQL tests that forwarding events, including
+// legacy events without HTLC IDs, are migrated from the KV forwarding log to
+// the SQL store unchanged.
+func TestMigrateForwardingLogToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	kvDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	kvStore := kvDB.ForwardingLog()
+
+	chanIDs := []lnwire.ShortChannelID{
+		lnwire.NewShortChanIDFromInt(2001),
+		lnwire.NewShortChanIDFromInt(2002),
+	}
+	events := genForwardingEvents(10, chanIDs)
+	require.NoError(t, kvStore.AddForwardingEvents(events))
+
+	// Add a legacy event that was stored before the HTLC IDs were
+	// recorded.
+	legacyTime := time.Unix(1600000000, 0)
+	err = kvdb.Update(kvDB, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(forwardingLogBucket)
+		if err != nil {
+			return err
+		}
+
+		var key [8]byte
+		byteOrder.PutUint64(key[:], uint64(legacyTime.UnixNano()))
+
+		var b bytes.Buffer
+		err = WriteElements(
+			&b, chanIDs[0], chanIDs[1], lnwire.MilliSatoshi(2000),
+			lnwire.MilliSatoshi(1000),
+		)
+		if err != nil {
+			return err
+		}
+
+		return bucket.Put(key[:], b.Bytes())
+	}, func() {})
+	require.NoError(t, err)
+
+	// Use a small batch size to make sure the migration correctly resumes
+	// from the previous batch.
+	sqlStore, sqlDB := newTestForwardingLogSQLStore(t)
+	require.NoError(t, MigrateForwardingLogToSQL(ctx, kvDB, sqlDB, 3))
+
+	query := ForwardingEventQuery{
+		StartTime:    time.Unix(0, 0),
+		EndTime:      time.Unix(1800000000, 0),
+		NumMaxEvents: 1000,
+	}
+	kvResp, err := kvStore.Query(query)
+	require.NoError(t, err)
+
+	sqlResp, err := sqlStore.Query(query)
+	require.NoError(t, err)
+
+	require.Len(t, sqlResp.ForwardingEvents, 11)
+	require.Equal(t, kvResp, sqlResp)
+
+	legacyEvent := sqlResp.ForwardingEvents[0]
+	require.Equal(
+		t, legacyTime.UnixNano(), legacyEvent.Timestamp.UnixNano(),
+	)
+	require.True(t, legacyEvent.IncomingHtlcID.IsNone())
+	require.True(t, legacyEvent.OutgoingHtlcID.IsNone())
+
+	// Finally, the KV forwarding log can be tombstoned once the migration
+	// is done.
+	tombstoned, err := kvDB.GetForwardingLogTombstone()
+	require.NoError(t, err)
+	require.False(t, tombstoned)
+
+	require.NoError(t, kvDB.SetForwardingLogTombstone())
+
+	tombstoned, err = kvDB.GetForwardingLogTombstone()
+	require.NoError(t, err)
+	require.True(t, tombstoned)
+}
@@ -606,3 +606,111 @@ func TestForwardingLogQueryChanIDs(t *testing.T) {
 		})
 	}
 }
+
+// TestForwardingLogQueryFeeAggregates tests that the forwarding volume and
+// fees are correctly aggregated per outgoing channel and day.
+func TestForwardingLogQueryFeeAggregates(t *testing.T) {
+	t.Parallel()
+
+	db, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test db")
+
+	log := ForwardingLog{db: db}
+
+	chanA := lnwire.NewShortChanIDFromInt(1001)
+	chanB := lnwire.NewShortChanIDFromInt(1002)
+	chanC := lnwire.NewShortChanIDFromInt(1003)
+
+	day1 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+
+	newEvent := func(ts time.Time, in, out lnwire.ShortChannelID,
+		amtIn, amtOut lnwire.MilliSatoshi) ForwardingEvent {
+
+		return ForwardingEvent{
+			Timestamp:      ts,
+			IncomingChanID: in,
+			OutgoingChanID: out,
+			AmtIn:          amtIn,
+			AmtOut:         amtOut,
+			IncomingHtlcID: fn.Some(uint64(0)),
+			OutgoingHtlcID: fn.Some(uint64(0)),
+		}
+	}
+
+	events := []ForwardingEvent{
+		newEvent(day1.Add(time.Hour), chanC, chanA, 1010, 1000),
+		newEvent(day1.Add(2*time.Hour), chanC, chanA, 2020, 2000),
+		newEvent(day1.Add(3*time.Hour), chanA, chanB, 3030, 3000),
+		newEvent(day2.Add(time.Hour), chanC, chanA, 4040, 4000),
+		newEvent(day2.Add(23*time.Hour), chanB, chanA, 5050, 5000),
+	}
+	require.NoError(t, log.AddForwardingEvents(events))
+
+	// Without any filters, we expect one aggregate per outgoing channel
+	// and day, sorted by day and channel ID.
+	query := ForwardingEventQuery{
+		StartTime: day1,
+		EndTime:   day2.Add(24 * time.Hour),
+	}
+	aggregates, err := log.QueryFeeAggregates(query)
+	require.NoError(t, err)
+	require.Equal(t, []ChannelFeeAggregate{{
+		ChanID:      chanA,
+		Day:         day1,
+		NumForwards: 2,
+		TotalAmtIn:  3030,
+		TotalAmtOut: 3000,
+		TotalFees:   30,
+	}, {
+		ChanID:      chanB,
+		Day:         day1,
+		NumForwards: 1,
+		TotalAmtIn:  3030,
+		TotalAmtOut: 3000,
+		TotalFees:   30,
+	}, {
+		ChanID:      chanA,
+		Day:         day2,
+		NumForwards: 2,
+		TotalAmtIn:  9090,
+		TotalAmtOut: 9000,
+		TotalFees:   90,
+	}}, aggregates)
+
+	// The channel filters of the query apply to the aggregates as well.
+	query.IncomingChanIDs = fn.NewSet(chanC.ToUint64())
+	aggregates, err = log.QueryFeeAggregates(query)
+	require.NoError(t, err)
+	require.Equal(t, []ChannelFeeAggregate{{
+		ChanID:      chanA,
+		Day:         day1,
+		NumForwards: 2,
+		TotalAmtIn:  3030,
+		TotalAmtOut: 3000,
+		TotalFees:   30,
+	}, {
+		ChanID:      chanA,
+		Day:         day2,
+		NumForwards: 1,
+		TotalAmtIn:  4040,
+		TotalAmtOut: 4000,
+		TotalFees:   40,
+	}}, aggregates)
+
+	// Finally, events outside of the time range must be ignored.
+	query = ForwardingEventQuery{
+		StartTime: day2,
+		EndTime:   day2.Add(2 * time.Hour),
+	}
+	aggregates, err = log.QueryFeeAggregates(query)
+	require.NoError(t, err)
+	require.Equal(t, []ChannelFeeAggregate{{
+		ChanID:      chanA,
+		Day:         day2,
+		NumForwards: 1,
+		TotalAmtIn:  4040,
+		TotalAmtOut: 4000,
+		TotalFees:   40,
+	}}, aggregates)
+}
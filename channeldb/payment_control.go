@@ -130,6 +130,52 @@ var (
 		"does not exist")
 )
 
+// PaymentDB is the interface of a persistent store for outgoing payments and
+// their HTLC attempts. It is implemented by the KV backed PaymentControl and by
+// the native SQL PaymentSQLStore.
+type PaymentDB interface {
+	// InitPayment checks or records the given PaymentCreationInfo with the
+	// DB, making sure it does not already exist as an in-flight payment.
+	InitPayment(lntypes.Hash, *PaymentCreationInfo) error
+
+	// DeleteFailedAttempts removes all failed HTLCs of a payment from the
+	// DB, unless failed attempts are configured to be kept.
+	DeleteFailedAttempts(lntypes.Hash) error
+
+	// RegisterAttempt atomically records the provided HTLCAttemptInfo.
+	RegisterAttempt(lntypes.Hash, *HTLCAttemptInfo) (*MPPayment, error)
+
+	// SettleAttempt marks the given attempt settled with the preimage.
+	SettleAttempt(lntypes.Hash, uint64, *HTLCSettleInfo) (*MPPayment,
+		error)
+
+	// FailAttempt marks the given payment attempt failed.
+	FailAttempt(lntypes.Hash, uint64, *HTLCFailInfo) (*MPPayment, error)
+
+	// Fail transitions a payment into the Failed state and records the
+	// reason the payment failed.
+	Fail(lntypes.Hash, FailureReason) (*MPPayment, error)
+
+	// FetchPayment returns information about a payment from the database.
+	FetchPayment(lntypes.Hash) (*MPPayment, error)
+
+	// FetchInFlightPayments returns all payments with status InFlight.
+	FetchInFlightPayments() ([]*MPPayment, error)
+
+	// QueryPayments is a query to the payments database which is
+	// restricted to a subset of payments by the payments query.
+	QueryPayments(PaymentsQuery) (PaymentsResponse, error)
+
+	// DeletePayment deletes a payment from the DB given its payment hash.
+	// If failedHtlcsOnly is set, only failed HTLC attempts of the payment
+	// will be deleted.
+	DeletePayment(paymentHash lntypes.Hash, failedHtlcsOnly bool) error
+
+	// DeletePayments deletes all completed and failed payments from the
+	// DB and returns the number of deleted payments.
+	DeletePayments(failedOnly, failedHtlcsOnly bool) (int, error)
+}
+
 // PaymentControl implements persistence for payments and payment attempts.
 type PaymentControl struct {
 	paymentSeqMx     sync.Mutex
@@ -138,6 +184,10 @@ type PaymentControl struct {
 	db               *DB
 }
 
+// A compile-time assertion to ensure that PaymentControl implements the
+// PaymentDB interface.
+var _ PaymentDB = (*PaymentControl)(nil)
+
 // NewPaymentControl creates a new instance of the PaymentControl.
 func NewPaymentControl(db *DB) *PaymentControl {
 	return &PaymentControl{
@@ -350,88 +400,10 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 			return err
 		}
 
-		// If the final hop has encrypted data, then we know this is a
-		// blinded payment. In blinded payments, MPP records are not set
-		// for split payments and the recipient is responsible for using
-		// a consistent PathID across the various encrypted data
-		// payloads that we received from them for this payment. All we
-		// need to check is that the total amount field for each HTLC
-		// in the split payment is correct.
-		isBlinded := len(attempt.Route.FinalHop().EncryptedData) != 0
-
-		// Make sure any existing shards match the new one with regards
-		// to MPP options.
-		mpp := attempt.Route.FinalHop().MPP
-
-		// MPP records should not be set for attempts to blinded paths.
-		if isBlinded && mpp != nil {
-			return ErrMPPRecordInBlindedPayment
-		}
-
-		for _, h := range payment.InFlightHTLCs() {
-			hMpp := h.Route.FinalHop().MPP
-
-			// If this is a blinded payment, then no existing HTLCs
-			// should have MPP records.
-			if isBlinded && hMpp != nil {
-				return ErrMPPRecordInBlindedPayment
-			}
-
-			// If this is a blinded payment, then we just need to
-			// check that the TotalAmtMsat field for this shard
-			// is equal to that of any other shard in the same
-			// payment.
-			if isBlinded {
-				if attempt.Route.FinalHop().TotalAmtMsat !=
-					h.Route.FinalHop().TotalAmtMsat {
-
-					//nolint:ll
-					return ErrBlindedPaymentTotalAmountMismatch
-				}
-
-				continue
-			}
-
-			switch {
-			// We tried to register a non-MPP attempt for a MPP
-			// payment.
-			case mpp == nil && hMpp != nil:
-				return ErrMPPayment
-
-			// We tried to register a MPP shard for a non-MPP
-			// payment.
-			case mpp != nil && hMpp == nil:
-				return ErrNonMPPayment
-
-			// Non-MPP payment, nothing more to validate.
-			case mpp == nil:
-				continue
-			}
-
-			// Check that MPP options match.
-			if mpp.PaymentAddr() != hMpp.PaymentAddr() {
-				return ErrMPPPaymentAddrMismatch
-			}
-
-			if mpp.TotalMsat() != hMpp.TotalMsat() {
-				return ErrMPPTotalAmountMismatch
-			}
-		}
-
-		// If this is a non-MPP attempt, it must match the total amount
-		// exactly. Note that a blinded payment is considered an MPP
-		// attempt.
-		amt := attempt.Route.ReceiverAmt()
-		if !isBlinded && mpp == nil && amt != payment.Info.Value {
-			return ErrValueMismatch
-		}
-
-		// Ensure we aren't sending more than the total payment amount.
-		sentAmt, _ := payment.SentAmt()
-		if sentAmt+amt > payment.Info.Value {
-			return fmt.Errorf("%w: attempted=%v, payment amount="+
-				"%v", ErrValueExceedsAmt, sentAmt+amt,
-				payment.Info.Value)
+		// Make sure the new attempt is compatible with the attempts
+		// that are already registered for this payment.
+		if err := verifyAttempt(payment, attempt); err != nil {
+			return err
 		}
 
 		htlcsBucket, err := bucket.CreateBucketIfNotExists(
@@ -460,6 +432,95 @@ func (p *PaymentControl) RegisterAttempt(paymentHash lntypes.Hash,
 	return payment, err
 }
 
+// verifyAttempt validates that the given attempt can be registered for the
+// payment with regards to its MPP options and the amounts already in flight.
+func verifyAttempt(payment *MPPayment, attempt *HTLCAttemptInfo) error {
+	// If the final hop has encrypted data, then we know this is a
+	// blinded payment. In blinded payments, MPP records are not set
+	// for split payments and the recipient is responsible for using
+	// a consistent PathID across the various encrypted data
+	// payloads that we received from them for this payment. All we
+	// need to check is that the total amount field for each HTLC
+	// in the split payment is correct.
+	isBlinded := len(attempt.Route.FinalHop().EncryptedData) != 0
+
+	// Make sure any existing shards match the new one with regards
+	// to MPP options.
+	mpp := attempt.Route.FinalHop().MPP
+
+	// MPP records should not be set for attempts to blinded paths.
+	if isBlinded && mpp != nil {
+		return ErrMPPRecordInBlindedPayment
+	}
+
+	for _, h := range payment.InFlightHTLCs() {
+		hMpp := h.Route.FinalHop().MPP
+
+		// If this is a blinded payment, then no existing HTLCs
+		// should have MPP records.
+		if isBlinded && hMpp != nil {
+			return ErrMPPRecordInBlindedPayment
+		}
+
+		// If this is a blinded payment, then we just need to
+		// check that the TotalAmtMsat field for this shard
+		// is equal to that of any other shard in the same
+		// payment.
+		if isBlinded {
+			if attempt.Route.FinalHop().TotalAmtMsat !=
+				h.Route.FinalHop().TotalAmtMsat {
+
+				return ErrBlindedPaymentTotalAmountMismatch
+			}
+
+			continue
+		}
+
+		switch {
+		// We tried to register a non-MPP attempt for a MPP
+		// payment.
+		case mpp == nil && hMpp != nil:
+			return ErrMPPayment
+
+		// We tried to register a MPP shard for a non-MPP
+		// payment.
+		case mpp != nil && hMpp == nil:
+			return ErrNonMPPayment
+
+		// Non-MPP payment, nothing more to validate.
+		case mpp == nil:
+			continue
+		}
+
+		// Check that MPP options match.
+		if mpp.PaymentAddr() != hMpp.PaymentAddr() {
+			return ErrMPPPaymentAddrMismatch
+		}
+
+		if mpp.TotalMsat() != hMpp.TotalMsat() {
+			return ErrMPPTotalAmountMismatch
+		}
+	}
+
+	// If this is a non-MPP attempt, it must match the total amount
+	// exactly. Note that a blinded payment is considered an MPP
+	// attempt.
+	amt := attempt.Route.ReceiverAmt()
+	if !isBlinded && mpp == nil && amt != payment.Info.Value {
+		return ErrValueMismatch
+	}
+
+	// Ensure we aren't sending more than the total payment amount.
+	sentAmt, _ := payment.SentAmt()
+	if sentAmt+amt > payment.Info.Value {
+		return fmt.Errorf("%w: attempted=%v, payment amount="+
+			"%v", ErrValueExceedsAmt, sentAmt+amt,
+			payment.Info.Value)
+	}
+
+	return nil
+}
+
 // SettleAttempt marks the given attempt settled with the preimage. If this is
 // a multi shard payment, this might implicitly mean that the full payment
 // succeeded.
@@ -834,3 +895,31 @@ func (p *PaymentControl) FetchInFlightPayments() ([]*MPPayment, error) {
 
 	return inFlights, nil
 }
+
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query, containing an offset index and a
+// maximum number of returned payments.
+func (p *PaymentControl) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	error) {
+
+	return p.db.QueryPayments(query)
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash. If
+// failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
+// deleted.
+func (p *PaymentControl) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	return p.db.DeletePayment(paymentHash, failedHtlcsOnly)
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlcsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts.
+func (p *PaymentControl) DeletePayments(failedOnly, failedHtlcsOnly bool) (int,
+	error) {
+
+	return p.db.DeletePayments(failedOnly, failedHtlcsOnly)
+}
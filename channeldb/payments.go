@@ -200,6 +200,69 @@ type PaymentCreationInfo struct {
 	// first hop of this payment. These records will be transmitted via the
 	// wire message only and therefore do not affect the onion payload size.
 	FirstHopCustomRecords lnwire.CustomRecords
+
+	// Rebalance is set if the payment is a circular payment that moves
+	// liquidity between two of our own channels.
+	Rebalance *RebalanceInfo
+}
+
+// rebalanceInfoType is the type of the rebalance info in the TLV stream of the
+// payment creation info. It is below the custom range, so it can't clash with
+// the first hop custom records that share the stream.
+const rebalanceInfoType tlv.Type = 1
+
+// RebalanceInfo describes the channels a circular payment moves liquidity
+// between.
+type RebalanceInfo struct {
+	// OutgoingChanID is the channel the payment leaves through.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel the payment returns through.
+	IncomingChanID lnwire.ShortChannelID
+}
+
+// record returns the TLV record of the rebalance info.
+func (r *RebalanceInfo) record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		rebalanceInfoType, r, 16, encodeRebalanceInfo,
+		decodeRebalanceInfo,
+	)
+}
+
+// encodeRebalanceInfo encodes the rebalance info as two short channel IDs.
+func encodeRebalanceInfo(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*RebalanceInfo); ok {
+		err := tlv.EUint64T(w, v.OutgoingChanID.ToUint64(), buf)
+		if err != nil {
+			return err
+		}
+
+		return tlv.EUint64T(w, v.IncomingChanID.ToUint64(), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "*RebalanceInfo")
+}
+
+// decodeRebalanceInfo decodes the rebalance info from two short channel IDs.
+func decodeRebalanceInfo(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*RebalanceInfo); ok && l == 16 {
+		var outgoing, incoming uint64
+		if err := tlv.DUint64(r, &outgoing, buf, 8); err != nil {
+			return err
+		}
+		if err := tlv.DUint64(r, &incoming, buf, 8); err != nil {
+			return err
+		}
+
+		v.OutgoingChanID = lnwire.NewShortChanIDFromInt(outgoing)
+		v.IncomingChanID = lnwire.NewShortChanIDFromInt(incoming)
+
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "*RebalanceInfo", l, 16)
 }
 
 // String returns a human-readable description of the payment creation info.
@@ -1031,16 +1094,16 @@ func serializePaymentCreationInfo(w io.Writer, c *PaymentCreationInfo) error {
 		return err
 	}
 
-	// Any remaining bytes are TLV encoded records. Currently, these are
-	// only the custom records provided by the user to be sent to the first
-	// hop. But this can easily be extended with further records by merging
-	// the records into a single TLV stream.
-	err := c.FirstHopCustomRecords.SerializeTo(w)
-	if err != nil {
-		return err
+	// Any remaining bytes are TLV encoded records. These are the custom
+	// records provided by the user to be sent to the first hop, merged
+	// with the rebalance info, if any.
+	records := tlv.MapToRecords(c.FirstHopCustomRecords)
+	if c.Rebalance != nil {
+		records = append(records, c.Rebalance.record())
+		tlv.SortRecords(records)
 	}
 
-	return nil
+	return lnwire.EncodeRecordsTo(w, records)
 }
 
 func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo,
@@ -1078,11 +1141,34 @@ func deserializePaymentCreationInfo(r io.Reader) (*PaymentCreationInfo,
 	}
 	c.PaymentRequest = payReq
 
-	// Any remaining bytes are TLV encoded records. Currently, these are
-	// only the custom records provided by the user to be sent to the first
-	// hop. But this can easily be extended with further records by merging
-	// the records into a single TLV stream.
-	c.FirstHopCustomRecords, err = lnwire.ParseCustomRecordsFrom(r)
+	// Any remaining bytes are TLV encoded records. These are the custom
+	// records provided by the user to be sent to the first hop, merged
+	// with the rebalance info, if any.
+	tlvMap, err := lnwire.DecodeRecords(r)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding creation info "+
+			"records: %w", err)
+	}
+
+	// The rebalance info type is below the custom range, so it must be
+	// removed from the map before the remaining records are treated as
+	// custom.
+	if rebalanceBytes, ok := tlvMap[rebalanceInfoType]; ok {
+		delete(tlvMap, rebalanceInfoType)
+
+		var (
+			rebalance    = &RebalanceInfo{}
+			rebalanceRec = rebalance.record()
+			br           = bytes.NewReader(rebalanceBytes)
+		)
+		err := rebalanceRec.Decode(br, uint64(len(rebalanceBytes)))
+		if err != nil {
+			return nil, err
+		}
+		c.Rebalance = rebalance
+	}
+
+	c.FirstHopCustomRecords, err = lnwire.NewCustomRecords(tlvMap)
 	if err != nil {
 		return nil, err
 	}
@@ -1248,6 +1334,10 @@ func serializeHop(w io.Writer, h *route.Hop) error {
 		)
 	}
 
+	if h.Trampoline != nil {
+		records = append(records, h.Trampoline.Record())
+	}
+
 	// Final sanity check to absolutely rule out custom records that are not
 	// custom and write into the standard range.
 	if err := h.CustomRecords.Validate(); err != nil {
@@ -1426,6 +1516,24 @@ func deserializeHop(r io.Reader) (*route.Hop, error) {
 		h.TotalAmtMsat = lnwire.MilliSatoshi(totalAmtMsatInt)
 	}
 
+	// The trampoline type is in the custom range, so it must be removed
+	// from the map before the remaining records are treated as custom.
+	trampolineType := uint64(record.TrampolineOnionType)
+	if trampolineBytes, ok := tlvMap[trampolineType]; ok {
+		delete(tlvMap, trampolineType)
+
+		var (
+			trampoline    = &record.Trampoline{}
+			trampolineRec = trampoline.Record()
+			r             = bytes.NewReader(trampolineBytes)
+		)
+		err := trampolineRec.Decode(r, uint64(len(trampolineBytes)))
+		if err != nil {
+			return nil, err
+		}
+		h.Trampoline = trampoline
+	}
+
 	h.CustomRecords = tlvMap
 
 	return h, nil
@@ -0,0 +1,319 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"golang.org/x/time/rate"
+)
+
+var (
+	// paymentsTombstoneKey is the key of the marker that is set in the KV
+	// database once all payments have been migrated to the native SQL
+	// schema. From then on, the KV payments store must no longer be used
+	// as it would silently diverge from the SQL store.
+	paymentsTombstoneKey = []byte("payments-tombstone")
+)
+
+// kvPaymentEntry is a payment read from the KV store that is waiting to be
+// migrated to the SQL store.
+type kvPaymentEntry struct {
+	hash            lntypes.Hash
+	payment         *MPPayment
+	legacyDuplicate bool
+}
+
+// MigratePaymentsToSQL migrates all payments, including their HTLC attempts and
+// any legacy duplicate payments, from the KV backend to the native SQL
+// payments schema. The sequence numbers of the payments are preserved so that
+// pagination offsets handed out to clients remain valid after the migration.
+// Payments are read from the KV store and verified against the SQL store in
+// batches of the given size to bound memory usage and the number of queries.
+func MigratePaymentsToSQL(ctx context.Context, kvBackend kvdb.Backend,
+	sqlDB SQLPaymentQueries, batchSize int) error {
+
+	log.Infof("Starting migration of payments from KV to SQL")
+
+	if batchSize <= 0 {
+		return fmt.Errorf("invalid batch size: %d", batchSize)
+	}
+
+	s := rate.Sometimes{
+		Interval: 30 * time.Second,
+	}
+
+	var (
+		t0         = time.Now()
+		tChunk     = t0
+		chunk      int
+		total      uint64
+		duplicates uint64
+		maxSeqNum  uint64
+		lastKey    []byte
+	)
+	for {
+		batch, bucketSeq, err := fetchKVPaymentBatch(
+			kvBackend, lastKey, batchSize,
+		)
+		if err != nil {
+			return fmt.Errorf("could not read payments: %w", err)
+		}
+
+		// The KV store reserves sequence numbers in blocks, so the
+		// bucket sequence may be ahead of the highest sequence number
+		// in use. We take it into account to never hand out a sequence
+		// number twice.
+		maxSeqNum = max(maxSeqNum, bucketSeq)
+
+		if len(batch) == 0 {
+			break
+		}
+
+		if err := migratePaymentBatch(ctx, sqlDB, batch); err != nil {
+			return err
+		}
+
+		for _, entry := range batch {
+			maxSeqNum = max(maxSeqNum, entry.payment.SequenceNum)
+
+			if entry.legacyDuplicate {
+				duplicates++
+				continue
+			}
+
+			total++
+			lastKey = entry.hash[:]
+		}
+		chunk += len(batch)
+
+		s.Do(func() {
+			elapsed := time.Since(tChunk).Seconds()
+			ratePerSec := float64(chunk) / elapsed
+			log.Infof("Migrated %d payments (%.2f payments/sec)",
+				total, ratePerSec)
+
+			tChunk = time.Now()
+			chunk = 0
+		})
+	}
+
+	// Make sure new payments continue with sequence numbers that are
+	// higher than any of the migrated ones.
+	if err := sqlDB.SetPaymentIndex(ctx, int64(maxSeqNum)); err != nil {
+		return fmt.Errorf("unable to set payment index: %w", err)
+	}
+
+	log.Infof("Migrated %d payments (and %d legacy duplicate payments) "+
+		"from KV to SQL in %v", total, duplicates, time.Since(t0))
+
+	return nil
+}
+
+// fetchKVPaymentBatch reads up to batchSize payments, together with their
+// legacy duplicate payments, from the KV store. Reading starts at the first
+// payment hash that is strictly greater than lastKey, or at the very first
+// payment if lastKey is nil. The sequence of the payments root bucket is
+// returned as well.
+func fetchKVPaymentBatch(kvBackend kvdb.Backend, lastKey []byte,
+	batchSize int) ([]kvPaymentEntry, uint64, error) {
+
+	var (
+		batch     []kvPaymentEntry
+		bucketSeq uint64
+	)
+	err := kvdb.View(kvBackend, func(tx kvdb.RTx) error {
+		payments := tx.ReadBucket(paymentsRootBucket)
+		if payments == nil {
+			return nil
+		}
+		bucketSeq = payments.Sequence()
+
+		cursor := payments.ReadCursor()
+
+		k, _ := cursor.First()
+		if lastKey != nil {
+			k, _ = cursor.Seek(lastKey)
+			if bytes.Equal(k, lastKey) {
+				k, _ = cursor.Next()
+			}
+		}
+
+		numPayments := 0
+		for k != nil && numPayments < batchSize {
+			bucket := payments.NestedReadBucket(k)
+			if bucket == nil {
+				return fmt.Errorf("non bucket element in " +
+					"payments bucket")
+			}
+
+			paymentHash, err := lntypes.MakeHash(k)
+			if err != nil {
+				return err
+			}
+
+			payment, err := fetchPayment(bucket)
+			if err != nil {
+				return fmt.Errorf("unable to fetch payment "+
+					"%v: %w", paymentHash, err)
+			}
+
+			batch = append(batch, kvPaymentEntry{
+				hash:    paymentHash,
+				payment: payment,
+			})
+			numPayments++
+
+			// Older versions of lnd allowed multiple payments to
+			// the same payment hash. We migrate these as well so
+			// that they still show up when listing payments.
+			dupPayments, err := fetchDuplicatePayments(bucket)
+			if err != nil {
+				return fmt.Errorf("unable to fetch duplicate "+
+					"payments for %v: %w", paymentHash, err)
+			}
+
+			for _, dupPayment := range dupPayments {
+				batch = append(batch, kvPaymentEntry{
+					hash:            paymentHash,
+					payment:         dupPayment,
+					legacyDuplicate: true,
+				})
+			}
+
+			k, _ = cursor.Next()
+		}
+
+		return nil
+	}, func() {
+		batch = nil
+		bucketSeq = 0
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return batch, bucketSeq, nil
+}
+
+// migratePaymentBatch inserts the given KV payments together with their HTLC
+// attempts into the SQL database. Afterwards, the whole batch is read back
+// with a constant number of queries and compared against the original
+// payments to make sure the migration was successful.
+func migratePaymentBatch(ctx context.Context, sqlDB SQLPaymentQueries,
+	batch []kvPaymentEntry) error {
+
+	seqNums := make([]int64, 0, len(batch))
+	kvPayments := make(map[int64]*MPPayment, len(batch))
+	for _, entry := range batch {
+		payment := entry.payment
+		seqNum := int64(payment.SequenceNum)
+
+		// The KV store returns an empty, non-nil slice for payments
+		// whose HTLC attempts have all been deleted. We normalize it so
+		// that it can be compared against the migrated payment.
+		if len(payment.HTLCs) == 0 {
+			payment.HTLCs = nil
+		}
+
+		err := insertPayment(
+			ctx, sqlDB, seqNum, entry.hash, payment.Info,
+			payment.Status, payment.FailureReason,
+			entry.legacyDuplicate,
+		)
+		if err != nil {
+			return fmt.Errorf("could not persist payment(seq=%d, "+
+				"hash=%v): %w", seqNum, entry.hash, err)
+		}
+
+		for i := range payment.HTLCs {
+			htlc := &payment.HTLCs[i]
+			err := insertHTLCAttempt(ctx, sqlDB, seqNum, htlc)
+			if err != nil {
+				return fmt.Errorf("could not persist HTLC "+
+					"attempt %d of payment(seq=%d): %w",
+					htlc.AttemptID, seqNum, err)
+			}
+		}
+
+		seqNums = append(seqNums, seqNum)
+		kvPayments[seqNum] = payment
+	}
+
+	// Fetch the whole batch from the SQL store and compare it against the
+	// original payments to ensure the migration was successful.
+	dbPayments, err := sqlDB.GetPaymentsByIDs(ctx, seqNums)
+	if err != nil {
+		return fmt.Errorf("could not get payments after migration: %w",
+			err)
+	}
+	if len(dbPayments) != len(seqNums) {
+		return fmt.Errorf("expected %d migrated payments, got %d",
+			len(seqNums), len(dbPayments))
+	}
+
+	migratedPayments, err := buildPayments(ctx, sqlDB, dbPayments)
+	if err != nil {
+		return fmt.Errorf("could not build migrated payments: %w", err)
+	}
+
+	for _, migratedPayment := range migratedPayments {
+		seqNum := int64(migratedPayment.SequenceNum)
+		err := sqldb.CompareRecords(
+			kvPayments[seqNum], migratedPayment,
+			fmt.Sprintf("payment(seq=%d)", seqNum),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetPaymentsTombstone sets the payments tombstone marker to mark the KV
+// payments store as permanently closed after its content was migrated to the
+// native SQL store. This prevents it from being used again in the future.
+func (d *DB) SetPaymentsTombstone() error {
+	return kvdb.Update(d, func(tx kvdb.RwTx) error {
+		err := AddMarker(tx, paymentsTombstoneKey, []byte("1"))
+		if err != nil {
+			return fmt.Errorf("failed to set tombstone: %w", err)
+		}
+
+		return nil
+	}, func() {})
+}
+
+// GetPaymentsTombstone checks if the payments tombstone marker exists. It
+// returns true if the tombstone is present and false otherwise.
+func (d *DB) GetPaymentsTombstone() (bool, error) {
+	var tombstoneExists bool
+
+	err := kvdb.View(d, func(tx kvdb.RTx) error {
+		_, err := CheckMarkerPresent(tx, paymentsTombstoneKey)
+		switch {
+		case err == ErrMarkerNotPresent:
+			tombstoneExists = false
+
+		case err != nil:
+			return err
+
+		default:
+			tombstoneExists = true
+		}
+
+		return nil
+	}, func() {
+		tombstoneExists = false
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return tombstoneExists, nil
+}
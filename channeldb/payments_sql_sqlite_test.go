@@ -0,0 +1,25 @@
+//go:build !test_db_postgres && test_db_sqlite
+
+package channeldb
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/sqldb"
+)
+
+// newTestPaymentSQLStore creates a PaymentSQLStore backed by a fresh sqlite
+// database for testing.
+func newTestPaymentSQLStore(t *testing.T,
+	opts ...PaymentSQLStoreOption) (*PaymentSQLStore, *sqldb.BaseDB) {
+
+	db := sqldb.NewTestSqliteDB(t).BaseDB
+	executor := sqldb.NewTransactionExecutor(
+		db, func(tx *sql.Tx) SQLPaymentQueries {
+			return db.WithTx(tx)
+		},
+	)
+
+	return NewPaymentSQLStore(executor, opts...), db
+}
@@ -0,0 +1,1303 @@
+package channeldb
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/sqldb"
+	"github.com/lightningnetwork/lnd/sqldb/sqlc"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// defaultPaymentsPageSize is the default number of payments that are
+	// fetched with a single query when iterating over the payments table.
+	defaultPaymentsPageSize = 1000
+)
+
+// SQLPaymentQueries is an interface that defines the set of operations that
+// can be executed against the payments SQL database.
+//
+//nolint:ll,interfacebloat
+type SQLPaymentQueries interface {
+	/*
+		Payment sequence queries.
+	*/
+	NextPaymentIndex(ctx context.Context) (int64, error)
+	SetPaymentIndex(ctx context.Context, currentValue int64) error
+
+	/*
+		Payment queries.
+	*/
+	InsertPayment(ctx context.Context, arg sqlc.InsertPaymentParams) error
+	GetPaymentByIdentifier(ctx context.Context, paymentIdentifier []byte) (sqlc.Payment, error)
+	GetPaymentsByIDs(ctx context.Context, ids []int64) ([]sqlc.Payment, error)
+	GetLegacyDuplicatePayments(ctx context.Context, paymentIdentifier []byte) ([]sqlc.Payment, error)
+	UpdatePaymentStatus(ctx context.Context, arg sqlc.UpdatePaymentStatusParams) error
+	UpdatePaymentFailReason(ctx context.Context, arg sqlc.UpdatePaymentFailReasonParams) error
+	FetchPaymentsByStatus(ctx context.Context, arg sqlc.FetchPaymentsByStatusParams) ([]sqlc.Payment, error)
+	FilterPayments(ctx context.Context, arg sqlc.FilterPaymentsParams) ([]sqlc.Payment, error)
+	CountPayments(ctx context.Context) (int64, error)
+	DeletePayment(ctx context.Context, id int64) error
+	DeletePaymentsByIdentifier(ctx context.Context, paymentIdentifier []byte) (sql.Result, error)
+
+	/*
+		Payment first hop custom record queries.
+	*/
+	InsertPaymentFirstHopCustomRecord(ctx context.Context, arg sqlc.InsertPaymentFirstHopCustomRecordParams) error
+	GetPaymentFirstHopCustomRecordsByPaymentIDs(ctx context.Context, paymentIDs []int64) ([]sqlc.PaymentFirstHopCustomRecord, error)
+
+	/*
+		Payment rebalance queries.
+	*/
+	InsertPaymentRebalance(ctx context.Context, arg sqlc.InsertPaymentRebalanceParams) error
+	GetPaymentRebalancesByPaymentIDs(ctx context.Context, paymentIDs []int64) ([]sqlc.PaymentRebalance, error)
+
+	/*
+		Payment HTLC attempt queries.
+	*/
+	InsertPaymentHTLCAttempt(ctx context.Context, arg sqlc.InsertPaymentHTLCAttemptParams) error
+	GetPaymentHTLCAttemptsByPaymentIDs(ctx context.Context, paymentIDs []int64) ([]sqlc.PaymentHtlcAttempt, error)
+	SettlePaymentHTLCAttempt(ctx context.Context, arg sqlc.SettlePaymentHTLCAttemptParams) (sql.Result, error)
+	FailPaymentHTLCAttempt(ctx context.Context, arg sqlc.FailPaymentHTLCAttemptParams) (sql.Result, error)
+	DeleteFailedPaymentHTLCAttempts(ctx context.Context, paymentID int64) error
+}
+
+// BatchedSQLPaymentQueries is a version of the SQLPaymentQueries that's
+// capable of batched database operations.
+type BatchedSQLPaymentQueries interface {
+	SQLPaymentQueries
+	sqldb.BatchedTx[SQLPaymentQueries]
+}
+
+// PaymentSQLStoreOptions holds the options for the payment SQL store.
+type PaymentSQLStoreOptions struct {
+	// keepFailedPaymentAttempts determines whether failed htlc attempts
+	// are kept on disk or removed to save space.
+	keepFailedPaymentAttempts bool
+
+	// pageSize is the maximum number of payments that are fetched with a
+	// single query when iterating over the payments table.
+	pageSize int32
+}
+
+// defaultPaymentSQLStoreOptions returns the default options for the payment
+// SQL store.
+func defaultPaymentSQLStoreOptions() PaymentSQLStoreOptions {
+	return PaymentSQLStoreOptions{
+		pageSize: defaultPaymentsPageSize,
+	}
+}
+
+// PaymentSQLStoreOption is a functional option that can be used to optionally
+// modify the behavior of the payment SQL store.
+type PaymentSQLStoreOption func(*PaymentSQLStoreOptions)
+
+// WithKeepFailedPaymentAttempts controls whether failed payment attempts are
+// kept on disk after a payment settles.
+func WithKeepFailedPaymentAttempts(keep bool) PaymentSQLStoreOption {
+	return func(o *PaymentSQLStoreOptions) {
+		o.keepFailedPaymentAttempts = keep
+	}
+}
+
+// WithPaymentsPageSize sets the maximum number of payments that are fetched
+// with a single query when iterating over the payments table.
+func WithPaymentsPageSize(pageSize int32) PaymentSQLStoreOption {
+	return func(o *PaymentSQLStoreOptions) {
+		o.pageSize = pageSize
+	}
+}
+
+// PaymentSQLStore is an implementation of the PaymentDB interface that uses a
+// native SQL database as the backend.
+type PaymentSQLStore struct {
+	db   BatchedSQLPaymentQueries
+	opts PaymentSQLStoreOptions
+}
+
+// A compile-time assertion to ensure that PaymentSQLStore implements the
+// PaymentDB interface.
+var _ PaymentDB = (*PaymentSQLStore)(nil)
+
+// NewPaymentSQLStore creates a new PaymentSQLStore instance given an open
+// BatchedSQLPaymentQueries storage backend.
+func NewPaymentSQLStore(db BatchedSQLPaymentQueries,
+	options ...PaymentSQLStoreOption) *PaymentSQLStore {
+
+	opts := defaultPaymentSQLStoreOptions()
+	for _, applyOption := range options {
+		applyOption(&opts)
+	}
+
+	return &PaymentSQLStore{
+		db:   db,
+		opts: opts,
+	}
+}
+
+// InitPayment checks or records the given PaymentCreationInfo with the DB,
+// making sure it does not already exist as an in-flight payment. When this
+// method returns successfully, the payment is guaranteed to be in the
+// Initiated state.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) InitPayment(paymentHash lntypes.Hash,
+	info *PaymentCreationInfo) error {
+
+	ctx := context.TODO()
+
+	var updateErr error
+	writeTxOpt := sqldb.WriteTxOpt()
+	err := s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		// Reset the update error, to avoid carrying over an error
+		// from a previous execution of the db transaction.
+		updateErr = nil
+
+		// Obtain a new sequence number for this payment. This is used
+		// to sort the payments in order of creation, and also acts as
+		// a unique identifier for each payment. Just like the KV
+		// store, we consume a sequence number even if the payment
+		// can't be initiated, so both stores hand out the same
+		// sequence numbers.
+		seqNum, err := db.NextPaymentIndex(ctx)
+		if err != nil {
+			return fmt.Errorf("unable to get next payment index: "+
+				"%w", err)
+		}
+
+		dbPayment, err := db.GetPaymentByIdentifier(ctx, paymentHash[:])
+		switch {
+		// If we already have this payment, we'll check the status to
+		// decide whether we allow retrying the payment or return a
+		// specific error.
+		case err == nil:
+			status := PaymentStatus(dbPayment.Status)
+			if err := status.initializable(); err != nil {
+				updateErr = err
+				return nil
+			}
+
+			// The payment was left in a state where we can retry,
+			// so we remove it together with all its attempts and
+			// start over with a fresh sequence number.
+			err := db.DeletePayment(ctx, dbPayment.ID)
+			if err != nil {
+				return fmt.Errorf("unable to delete previous "+
+					"payment: %w", err)
+			}
+
+		case !errors.Is(err, sql.ErrNoRows):
+			return fmt.Errorf("unable to fetch payment: %w", err)
+		}
+
+		return insertPayment(ctx, db, seqNum, paymentHash, info,
+			StatusInitiated, nil, false)
+	}, func() {
+		updateErr = nil
+	})
+	if err != nil {
+		return fmt.Errorf("unable to init payment: %w", err)
+	}
+
+	return updateErr
+}
+
+// DeleteFailedAttempts deletes all failed htlcs for a payment if configured by
+// the PaymentSQLStore db.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) DeleteFailedAttempts(hash lntypes.Hash) error {
+	if !s.opts.keepFailedPaymentAttempts {
+		const failedHtlcsOnly = true
+		err := s.DeletePayment(hash, failedHtlcsOnly)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RegisterAttempt atomically records the provided HTLCAttemptInfo.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) RegisterAttempt(paymentHash lntypes.Hash,
+	attempt *HTLCAttemptInfo) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	var payment *MPPayment
+	writeTxOpt := sqldb.WriteTxOpt()
+	err := s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		dbPayment, err := fetchDBPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		payment, err = buildPayment(ctx, db, dbPayment)
+		if err != nil {
+			return err
+		}
+
+		// Check if registering a new attempt is allowed.
+		if err := payment.Registrable(); err != nil {
+			return err
+		}
+
+		// Make sure the new attempt is compatible with the attempts
+		// that are already registered for this payment.
+		if err := verifyAttempt(payment, attempt); err != nil {
+			return err
+		}
+
+		err = insertHTLCAttempt(ctx, db, dbPayment.ID, &HTLCAttempt{
+			HTLCAttemptInfo: *attempt,
+		})
+		if err != nil {
+			return err
+		}
+
+		// Retrieve attempt info for the notification and update the
+		// stored status of the payment.
+		payment, err = refreshPayment(ctx, db, dbPayment)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// SettleAttempt marks the given attempt settled with the preimage. If this is
+// a multi shard payment, this might implicitly mean that the full payment
+// succeeded.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) SettleAttempt(hash lntypes.Hash,
+	attemptID uint64, settleInfo *HTLCSettleInfo) (*MPPayment, error) {
+
+	return s.updateAttempt(hash, attemptID, func(ctx context.Context,
+		db SQLPaymentQueries, paymentID int64) (sql.Result, error) {
+
+		return db.SettlePaymentHTLCAttempt(
+			ctx, sqlc.SettlePaymentHTLCAttemptParams{
+				PaymentID:      paymentID,
+				AttemptIndex:   int64(attemptID),
+				SettlePreimage: settleInfo.Preimage[:],
+				SettleTime: sqldb.SQLInt64(
+					timeToUnixNano(settleInfo.SettleTime),
+				),
+			},
+		)
+	})
+}
+
+// FailAttempt marks the given payment attempt failed.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) FailAttempt(hash lntypes.Hash,
+	attemptID uint64, failInfo *HTLCFailInfo) (*MPPayment, error) {
+
+	failMessage, err := encodeFailureMessage(failInfo.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.updateAttempt(hash, attemptID, func(ctx context.Context,
+		db SQLPaymentQueries, paymentID int64) (sql.Result, error) {
+
+		return db.FailPaymentHTLCAttempt(
+			ctx, sqlc.FailPaymentHTLCAttemptParams{
+				PaymentID:    paymentID,
+				AttemptIndex: int64(attemptID),
+				FailReason: sqldb.SQLInt16(
+					int16(failInfo.Reason),
+				),
+				FailTime: sqldb.SQLInt64(
+					timeToUnixNano(failInfo.FailTime),
+				),
+				FailMessage: failMessage,
+				FailSourceIndex: sqldb.SQLInt32(
+					int32(failInfo.FailureSourceIndex),
+				),
+			},
+		)
+	})
+}
+
+// updateAttempt resolves the given HTLC attempt of a payment using the passed
+// update function and returns the updated payment.
+func (s *PaymentSQLStore) updateAttempt(paymentHash lntypes.Hash,
+	attemptID uint64, update func(context.Context, SQLPaymentQueries,
+		int64) (sql.Result, error)) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	var payment *MPPayment
+	writeTxOpt := sqldb.WriteTxOpt()
+	err := s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		dbPayment, err := fetchDBPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		p, err := buildPayment(ctx, db, dbPayment)
+		if err != nil {
+			return err
+		}
+
+		// We can only update attempts of in-flight payments. We allow
+		// updating attempts even if the payment has reached a terminal
+		// condition, since the HTLC outcomes must still be updated.
+		if err := p.Status.updatable(); err != nil {
+			return err
+		}
+
+		var attempt *HTLCAttempt
+		for i := range p.HTLCs {
+			if p.HTLCs[i].AttemptID == attemptID {
+				attempt = &p.HTLCs[i]
+				break
+			}
+		}
+		if attempt == nil {
+			return fmt.Errorf("HTLC with ID %v not registered",
+				attemptID)
+		}
+
+		// Make sure the shard is not already failed or settled.
+		switch {
+		case attempt.Failure != nil:
+			return ErrAttemptAlreadyFailed
+
+		case attempt.Settle != nil:
+			return ErrAttemptAlreadySettled
+		}
+
+		result, err := update(ctx, db, dbPayment.ID)
+		if err != nil {
+			return fmt.Errorf("unable to update HTLC attempt: %w",
+				err)
+		}
+
+		rowsAffected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rowsAffected == 0 {
+			return fmt.Errorf("HTLC with ID %v not updated",
+				attemptID)
+		}
+
+		// Retrieve attempt info for the notification and update the
+		// stored status of the payment.
+		payment, err = refreshPayment(ctx, db, dbPayment)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// Fail transitions a payment into the Failed state, and records the reason the
+// payment failed. After invoking this method, InitPayment should return nil on
+// its next call for this payment hash, allowing the switch to make a
+// subsequent payment.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) Fail(paymentHash lntypes.Hash,
+	reason FailureReason) (*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	var (
+		updateErr error
+		payment   *MPPayment
+	)
+	writeTxOpt := sqldb.WriteTxOpt()
+	err := s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		// Reset the update error, to avoid carrying over an error
+		// from a previous execution of the db transaction.
+		updateErr = nil
+		payment = nil
+
+		dbPayment, err := fetchDBPayment(ctx, db, paymentHash)
+		if errors.Is(err, ErrPaymentNotInitiated) {
+			updateErr = ErrPaymentNotInitiated
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		// We mark the payment as failed as long as it is known. This
+		// lets the last attempt to fail with a terminal write its
+		// failure to the payment store without synchronizing with
+		// other attempts.
+		payment, err = buildPayment(ctx, db, dbPayment)
+		if err != nil {
+			return err
+		}
+
+		payment.FailureReason = &reason
+		if err := payment.setState(); err != nil {
+			return err
+		}
+
+		return db.UpdatePaymentFailReason(
+			ctx, sqlc.UpdatePaymentFailReasonParams{
+				ID:         dbPayment.ID,
+				FailReason: sqldb.SQLInt16(int16(reason)),
+				Status:     int16(payment.Status),
+			},
+		)
+	}, func() {
+		updateErr = nil
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, updateErr
+}
+
+// FetchPayment returns information about a payment from the database.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) FetchPayment(paymentHash lntypes.Hash) (
+	*MPPayment, error) {
+
+	ctx := context.TODO()
+
+	var payment *MPPayment
+	readTxOpt := sqldb.ReadTxOpt()
+	err := s.db.ExecTx(ctx, readTxOpt, func(db SQLPaymentQueries) error {
+		dbPayment, err := fetchDBPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		payment, err = buildPayment(ctx, db, dbPayment)
+
+		return err
+	}, func() {
+		payment = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// FetchInFlightPayments returns all payments with status InFlight.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) FetchInFlightPayments() ([]*MPPayment, error) {
+	ctx := context.TODO()
+	start := time.Now()
+
+	var inFlights []*MPPayment
+	readTxOpt := sqldb.ReadTxOpt()
+	err := s.db.ExecTx(ctx, readTxOpt, func(db SQLPaymentQueries) error {
+		dbPayments, err := db.FetchPaymentsByStatus(
+			ctx, sqlc.FetchPaymentsByStatusParams{
+				InitiatedStatus: int16(StatusInitiated),
+				InFlightStatus:  int16(StatusInFlight),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fetch in-flight "+
+				"payments: %w", err)
+		}
+
+		// Load the HTLC attempts and custom records of the payments in
+		// pages to avoid one query per payment.
+		pageCfg := &sqldb.PagedQueryConfig{
+			PageSize: int(s.opts.pageSize),
+		}
+		buildPage := func(ctx context.Context,
+			page []sqlc.Payment) ([]*MPPayment, error) {
+
+			return buildPayments(ctx, db, page)
+		}
+
+		return sqldb.ExecutePagedQuery(
+			ctx, pageCfg, dbPayments,
+			func(p sqlc.Payment) sqlc.Payment { return p },
+			buildPage, func(_ context.Context, p *MPPayment) error {
+				// Skip the payment if it's terminated. This
+				// should never happen as the stored status is
+				// kept in sync with the payment, but we double
+				// check to be safe.
+				if p.Terminated() {
+					return nil
+				}
+
+				inFlights = append(inFlights, p)
+
+				return nil
+			},
+		)
+	}, func() {
+		inFlights = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Completed scanning for inflight payments: "+
+		"found_inflight=%d, elapsed=%v", len(inFlights),
+		time.Since(start).Round(time.Millisecond))
+
+	return inFlights, nil
+}
+
+// QueryPayments is a query to the payments database which is restricted to a
+// subset of payments by the payments query, containing an offset index and a
+// maximum number of returned payments.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) QueryPayments(query PaymentsQuery) (PaymentsResponse,
+	error) {
+
+	ctx := context.TODO()
+
+	var resp PaymentsResponse
+	readTxOpt := sqldb.ReadTxOpt()
+	err := s.db.ExecTx(ctx, readTxOpt, func(db SQLPaymentQueries) error {
+		params := sqlc.FilterPaymentsParams{
+			Reverse: query.Reversed,
+		}
+
+		// To keep compatibility with the old API, we only return
+		// non-succeeded payments if requested.
+		if !query.IncludeIncomplete {
+			params.Status = sqldb.SQLInt16(int16(StatusSucceeded))
+		}
+
+		// The creation date filters are expressed in Unix seconds
+		// while we store the creation time in nanoseconds. The end
+		// date is inclusive, so we filter for payments created
+		// before the start of the next second.
+		if query.CreationDateStart != 0 {
+			start := time.Unix(query.CreationDateStart, 0)
+			params.CreatedAfter = sqldb.SQLInt64(start.UnixNano())
+		}
+		if query.CreationDateEnd != 0 {
+			end := time.Unix(query.CreationDateEnd+1, 0)
+			params.CreatedBefore = sqldb.SQLInt64(end.UnixNano())
+		}
+
+		// The index offset is always exclusive. In normal order we
+		// start at the next higher index, in reversed order we end at
+		// the next lower index.
+		setOffset := func(offset int64) {
+			if query.Reversed {
+				params.IndexOffsetLet = sqldb.SQLInt64(offset)
+			} else {
+				params.IndexOffsetGet = sqldb.SQLInt64(offset)
+			}
+		}
+		if query.IndexOffset != 0 {
+			setOffset(int64(query.IndexOffset))
+		}
+
+		remaining := query.MaxPayments
+		for remaining > 0 {
+			params.NumLimit = s.opts.pageSize
+			if remaining < uint64(s.opts.pageSize) {
+				params.NumLimit = int32(remaining)
+			}
+
+			dbPayments, err := db.FilterPayments(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to filter payments: "+
+					"%w", err)
+			}
+
+			// Load the HTLC attempts and custom records of the
+			// whole page at once.
+			payments, err := buildPayments(ctx, db, dbPayments)
+			if err != nil {
+				return err
+			}
+			resp.Payments = append(resp.Payments, payments...)
+
+			// If we got fewer payments than we asked for, there
+			// are no more payments to fetch.
+			if len(dbPayments) < int(params.NumLimit) {
+				break
+			}
+
+			remaining -= uint64(len(dbPayments))
+			setOffset(dbPayments[len(dbPayments)-1].ID)
+		}
+
+		// Counting the total number of payments is an optional query
+		// parameter.
+		if query.CountTotal {
+			total, err := db.CountPayments(ctx)
+			if err != nil {
+				return fmt.Errorf("error counting payments: %w",
+					err)
+			}
+
+			resp.TotalCount = uint64(total)
+		}
+
+		return nil
+	}, func() {
+		resp = PaymentsResponse{}
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	// Need to swap the payments slice order if reversed order.
+	if query.Reversed {
+		for l, r := 0, len(resp.Payments)-1; l < r; l, r = l+1, r-1 {
+			resp.Payments[l], resp.Payments[r] =
+				resp.Payments[r], resp.Payments[l]
+		}
+	}
+
+	// Set the first and last index of the returned payments so that the
+	// caller can resume from this point later on.
+	if len(resp.Payments) > 0 {
+		resp.FirstIndexOffset = resp.Payments[0].SequenceNum
+		resp.LastIndexOffset =
+			resp.Payments[len(resp.Payments)-1].SequenceNum
+	}
+
+	return resp, nil
+}
+
+// DeletePayment deletes a payment from the DB given its payment hash. If
+// failedHtlcsOnly is set, only failed HTLC attempts of the payment will be
+// deleted.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) DeletePayment(paymentHash lntypes.Hash,
+	failedHtlcsOnly bool) error {
+
+	ctx := context.TODO()
+
+	writeTxOpt := sqldb.WriteTxOpt()
+	return s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		dbPayment, err := fetchDBPayment(ctx, db, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		// If the payment has inflight HTLCs, we cannot safely delete
+		// the payment information, so we return an error.
+		status := PaymentStatus(dbPayment.Status)
+		if err := status.removable(); err != nil {
+			return fmt.Errorf("payment '%v' has inflight HTLCs"+
+				"and therefore cannot be deleted: %w",
+				paymentHash.String(), err)
+		}
+
+		return deleteSQLPayment(ctx, db, dbPayment, failedHtlcsOnly)
+	}, sqldb.NoOpReset)
+}
+
+// DeletePayments deletes all completed and failed payments from the DB. If
+// failedOnly is set, only failed payments will be considered for deletion. If
+// failedHtlcsOnly is set, the payment itself won't be deleted, only failed HTLC
+// attempts. The method returns the number of deleted payments, which is always
+// 0 if failedHtlcsOnly is set.
+//
+// NOTE: part of the PaymentDB interface.
+func (s *PaymentSQLStore) DeletePayments(failedOnly,
+	failedHtlcsOnly bool) (int, error) {
+
+	ctx := context.TODO()
+
+	var numPayments int
+	writeTxOpt := sqldb.WriteTxOpt()
+	err := s.db.ExecTx(ctx, writeTxOpt, func(db SQLPaymentQueries) error {
+		// First collect all the payments we want to delete, so we
+		// don't modify the table while paginating over it.
+		var toDelete []sqlc.Payment
+		params := sqlc.FilterPaymentsParams{
+			NumLimit: s.opts.pageSize,
+		}
+		for {
+			dbPayments, err := db.FilterPayments(ctx, params)
+			if err != nil {
+				return fmt.Errorf("unable to filter payments: "+
+					"%w", err)
+			}
+
+			for _, dbPayment := range dbPayments {
+				// Legacy duplicate payments are deleted
+				// together with the payment they belong to.
+				if dbPayment.LegacyDuplicate {
+					continue
+				}
+
+				// If the payment has inflight HTLCs, we cannot
+				// safely delete the payment information, so we
+				// skip it.
+				status := PaymentStatus(dbPayment.Status)
+				if err := status.removable(); err != nil {
+					continue
+				}
+
+				// If we requested to only delete failed
+				// payments, we can skip this one if it is not.
+				if failedOnly && status != StatusFailed {
+					continue
+				}
+
+				toDelete = append(toDelete, dbPayment)
+			}
+
+			if len(dbPayments) < int(params.NumLimit) {
+				break
+			}
+
+			params.IndexOffsetGet = sqldb.SQLInt64(
+				dbPayments[len(dbPayments)-1].ID,
+			)
+		}
+
+		for _, dbPayment := range toDelete {
+			err := deleteSQLPayment(
+				ctx, db, dbPayment, failedHtlcsOnly,
+			)
+			if err != nil {
+				return err
+			}
+
+			if !failedHtlcsOnly {
+				numPayments++
+			}
+		}
+
+		return nil
+	}, func() {
+		numPayments = 0
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numPayments, nil
+}
+
+// deleteSQLPayment deletes the given payment together with all its legacy
+// duplicates. If failedHtlcsOnly is set, only the failed HTLC attempts of the
+// payment are deleted.
+func deleteSQLPayment(ctx context.Context, db SQLPaymentQueries,
+	dbPayment sqlc.Payment, failedHtlcsOnly bool) error {
+
+	if failedHtlcsOnly {
+		err := db.DeleteFailedPaymentHTLCAttempts(ctx, dbPayment.ID)
+		if err != nil {
+			return fmt.Errorf("unable to delete failed HTLC "+
+				"attempts: %w", err)
+		}
+
+		_, err = refreshPayment(ctx, db, dbPayment)
+
+		return err
+	}
+
+	_, err := db.DeletePaymentsByIdentifier(
+		ctx, dbPayment.PaymentIdentifier,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to delete payment: %w", err)
+	}
+
+	return nil
+}
+
+// fetchDBPayment fetches the non-duplicate payment row for the given payment
+// hash. ErrPaymentNotInitiated is returned if no such payment exists.
+func fetchDBPayment(ctx context.Context, db SQLPaymentQueries,
+	paymentHash lntypes.Hash) (sqlc.Payment, error) {
+
+	dbPayment, err := db.GetPaymentByIdentifier(ctx, paymentHash[:])
+	if errors.Is(err, sql.ErrNoRows) {
+		return sqlc.Payment{}, ErrPaymentNotInitiated
+	} else if err != nil {
+		return sqlc.Payment{}, fmt.Errorf("unable to fetch payment: "+
+			"%w", err)
+	}
+
+	return dbPayment, nil
+}
+
+// refreshPayment reloads the given payment from the database and persists its
+// freshly derived status so that payments can be filtered by status.
+func refreshPayment(ctx context.Context, db SQLPaymentQueries,
+	dbPayment sqlc.Payment) (*MPPayment, error) {
+
+	payment, err := buildPayment(ctx, db, dbPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	if int16(payment.Status) == dbPayment.Status {
+		return payment, nil
+	}
+
+	err = db.UpdatePaymentStatus(ctx, sqlc.UpdatePaymentStatusParams{
+		ID:     dbPayment.ID,
+		Status: int16(payment.Status),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to update payment status: %w",
+			err)
+	}
+
+	return payment, nil
+}
+
+// insertPayment inserts a payment with the given sequence number into the
+// database along with its first hop custom records.
+func insertPayment(ctx context.Context, db SQLPaymentQueries, seqNum int64,
+	paymentHash lntypes.Hash, info *PaymentCreationInfo,
+	status PaymentStatus, failReason *FailureReason,
+	legacyDuplicate bool) error {
+
+	var dbFailReason sql.NullInt16
+	if failReason != nil {
+		dbFailReason = sqldb.SQLInt16(int16(*failReason))
+	}
+
+	err := db.InsertPayment(ctx, sqlc.InsertPaymentParams{
+		ID:                seqNum,
+		PaymentIdentifier: paymentHash[:],
+		AmountMsat:        int64(info.Value),
+		CreatedAt:         timeToUnixNano(info.CreationTime),
+		PaymentRequest:    info.PaymentRequest,
+		Status:            int16(status),
+		FailReason:        dbFailReason,
+		LegacyDuplicate:   legacyDuplicate,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to insert payment: %w", err)
+	}
+
+	for key, value := range info.FirstHopCustomRecords {
+		err := db.InsertPaymentFirstHopCustomRecord(
+			ctx, sqlc.InsertPaymentFirstHopCustomRecordParams{
+				PaymentID: seqNum,
+				Key:       int64(key),
+				Value:     value,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert first hop custom "+
+				"record: %w", err)
+		}
+	}
+
+	if info.Rebalance != nil {
+		err := db.InsertPaymentRebalance(
+			ctx, sqlc.InsertPaymentRebalanceParams{
+				PaymentID: seqNum,
+				OutgoingChanID: int64(
+					info.Rebalance.OutgoingChanID.ToUint64(),
+				),
+				IncomingChanID: int64(
+					info.Rebalance.IncomingChanID.ToUint64(),
+				),
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to insert payment "+
+				"rebalance: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// insertHTLCAttempt inserts the given HTLC attempt, including its settle or
+// fail info if known, for the payment with the given ID.
+func insertHTLCAttempt(ctx context.Context, db SQLPaymentQueries,
+	paymentID int64, htlc *HTLCAttempt) error {
+
+	routeBlob, err := serializeRouteBlob(htlc.Route)
+	if err != nil {
+		return err
+	}
+
+	params := sqlc.InsertPaymentHTLCAttemptParams{
+		AttemptIndex: int64(htlc.AttemptID),
+		PaymentID:    paymentID,
+		SessionKey:   htlc.sessionKey[:],
+		AttemptTime:  timeToUnixNano(htlc.AttemptTime),
+		Route:        routeBlob,
+	}
+
+	if htlc.Hash != nil {
+		params.PaymentHash = htlc.Hash[:]
+	}
+
+	if htlc.Settle != nil {
+		params.SettlePreimage = htlc.Settle.Preimage[:]
+		params.SettleTime = sqldb.SQLInt64(
+			timeToUnixNano(htlc.Settle.SettleTime),
+		)
+	}
+
+	if htlc.Failure != nil {
+		params.FailReason = sqldb.SQLInt16(int16(htlc.Failure.Reason))
+		params.FailTime = sqldb.SQLInt64(
+			timeToUnixNano(htlc.Failure.FailTime),
+		)
+		params.FailSourceIndex = sqldb.SQLInt32(
+			int32(htlc.Failure.FailureSourceIndex),
+		)
+		params.FailMessage, err = encodeFailureMessage(
+			htlc.Failure.Message,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.InsertPaymentHTLCAttempt(ctx, params)
+	if err != nil {
+		return fmt.Errorf("unable to insert HTLC attempt: %w", err)
+	}
+
+	return nil
+}
+
+// buildPayment constructs an MPPayment from the given payment row by loading
+// its first hop custom records and HTLC attempts.
+func buildPayment(ctx context.Context, db SQLPaymentQueries,
+	dbPayment sqlc.Payment) (*MPPayment, error) {
+
+	payments, err := buildPayments(ctx, db, []sqlc.Payment{dbPayment})
+	if err != nil {
+		return nil, err
+	}
+
+	return payments[0], nil
+}
+
+// buildPayments constructs MPPayments from the given payment rows. The first
+// hop custom records and HTLC attempts of all the payments are loaded with a
+// single query each, so callers must make sure the number of payment rows is
+// bounded by a sane page size.
+func buildPayments(ctx context.Context, db SQLPaymentQueries,
+	dbPayments []sqlc.Payment) ([]*MPPayment, error) {
+
+	if len(dbPayments) == 0 {
+		return nil, nil
+	}
+
+	paymentIDs := make([]int64, len(dbPayments))
+	for i, dbPayment := range dbPayments {
+		paymentIDs[i] = dbPayment.ID
+	}
+
+	dbRecords, err := db.GetPaymentFirstHopCustomRecordsByPaymentIDs(
+		ctx, paymentIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch first hop custom "+
+			"records: %w", err)
+	}
+
+	customRecords := make(map[int64]lnwire.CustomRecords)
+	for _, record := range dbRecords {
+		records, ok := customRecords[record.PaymentID]
+		if !ok {
+			records = make(lnwire.CustomRecords)
+			customRecords[record.PaymentID] = records
+		}
+
+		records[uint64(record.Key)] = record.Value
+	}
+
+	dbRebalances, err := db.GetPaymentRebalancesByPaymentIDs(
+		ctx, paymentIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch payment rebalances: "+
+			"%w", err)
+	}
+
+	rebalances := make(map[int64]*RebalanceInfo, len(dbRebalances))
+	for _, rebalance := range dbRebalances {
+		rebalances[rebalance.PaymentID] = &RebalanceInfo{
+			OutgoingChanID: lnwire.NewShortChanIDFromInt(
+				uint64(rebalance.OutgoingChanID),
+			),
+			IncomingChanID: lnwire.NewShortChanIDFromInt(
+				uint64(rebalance.IncomingChanID),
+			),
+		}
+	}
+
+	dbAttempts, err := db.GetPaymentHTLCAttemptsByPaymentIDs(
+		ctx, paymentIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch HTLC attempts: %w", err)
+	}
+
+	htlcs := make(map[int64][]HTLCAttempt)
+	for _, dbAttempt := range dbAttempts {
+		htlc, err := buildHTLCAttempt(dbAttempt)
+		if err != nil {
+			return nil, err
+		}
+
+		htlcs[dbAttempt.PaymentID] = append(
+			htlcs[dbAttempt.PaymentID], *htlc,
+		)
+	}
+
+	payments := make([]*MPPayment, 0, len(dbPayments))
+	for _, dbPayment := range dbPayments {
+		payment, err := newSQLPayment(
+			dbPayment, customRecords[dbPayment.ID],
+			rebalances[dbPayment.ID], htlcs[dbPayment.ID],
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	return payments, nil
+}
+
+// newSQLPayment constructs an MPPayment from the given payment row and its
+// already loaded first hop custom records, rebalance info and HTLC attempts.
+func newSQLPayment(dbPayment sqlc.Payment,
+	customRecords lnwire.CustomRecords, rebalance *RebalanceInfo,
+	htlcs []HTLCAttempt) (*MPPayment, error) {
+
+	var paymentHash lntypes.Hash
+	copy(paymentHash[:], dbPayment.PaymentIdentifier)
+
+	var failureReason *FailureReason
+	if dbPayment.FailReason.Valid {
+		reason := FailureReason(dbPayment.FailReason.Int16)
+		failureReason = &reason
+	}
+
+	payment := &MPPayment{
+		SequenceNum: uint64(dbPayment.ID),
+		Info: &PaymentCreationInfo{
+			PaymentIdentifier: paymentHash,
+			Value: lnwire.MilliSatoshi(
+				dbPayment.AmountMsat,
+			),
+			CreationTime: unixNanoToTime(dbPayment.CreatedAt),
+			PaymentRequest: append(
+				[]byte{}, dbPayment.PaymentRequest...,
+			),
+			FirstHopCustomRecords: customRecords,
+			Rebalance:             rebalance,
+		},
+		HTLCs:         htlcs,
+		FailureReason: failureReason,
+	}
+
+	// Legacy duplicate payments were stored with an explicit status, so
+	// we use it as is, just like the KV store does.
+	if dbPayment.LegacyDuplicate {
+		payment.Status = PaymentStatus(dbPayment.Status)
+
+		return payment, nil
+	}
+
+	// Set its state and status.
+	if err := payment.setState(); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
+}
+
+// buildHTLCAttempt constructs an HTLCAttempt from the given attempt row.
+func buildHTLCAttempt(dbAttempt sqlc.PaymentHtlcAttempt) (*HTLCAttempt,
+	error) {
+
+	rt, err := deserializeRouteBlob(dbAttempt.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	htlc := &HTLCAttempt{
+		HTLCAttemptInfo: HTLCAttemptInfo{
+			AttemptID:   uint64(dbAttempt.AttemptIndex),
+			Route:       rt,
+			AttemptTime: unixNanoToTime(dbAttempt.AttemptTime),
+		},
+	}
+	copy(htlc.sessionKey[:], dbAttempt.SessionKey)
+
+	if dbAttempt.PaymentHash != nil {
+		hash, err := lntypes.MakeHash(dbAttempt.PaymentHash)
+		if err != nil {
+			return nil, err
+		}
+		htlc.Hash = &hash
+	}
+
+	if dbAttempt.SettlePreimage != nil {
+		preimage, err := lntypes.MakePreimage(dbAttempt.SettlePreimage)
+		if err != nil {
+			return nil, err
+		}
+
+		htlc.Settle = &HTLCSettleInfo{
+			Preimage:   preimage,
+			SettleTime: unixNanoToTime(dbAttempt.SettleTime.Int64),
+		}
+	}
+
+	if dbAttempt.FailReason.Valid {
+		htlc.Failure = &HTLCFailInfo{
+			FailTime: unixNanoToTime(dbAttempt.FailTime.Int64),
+			Reason: HTLCFailReason(
+				dbAttempt.FailReason.Int16,
+			),
+			FailureSourceIndex: uint32(
+				dbAttempt.FailSourceIndex.Int32,
+			),
+		}
+
+		if len(dbAttempt.FailMessage) > 0 {
+			htlc.Failure.Message, err = lnwire.DecodeFailureMessage(
+				bytes.NewReader(dbAttempt.FailMessage), 0,
+			)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return htlc, nil
+}
+
+// serializeRouteBlob serializes the given route using the same encoding as the
+// KV store, followed by a TLV stream holding the first hop amount and the first
+// hop wire custom records.
+func serializeRouteBlob(r route.Route) ([]byte, error) {
+	var b bytes.Buffer
+	if err := SerializeRoute(&b, r); err != nil {
+		return nil, err
+	}
+
+	producers := []tlv.RecordProducer{
+		&r.FirstHopAmount,
+	}
+	tlvData, err := lnwire.MergeAndEncode(
+		producers, nil, r.FirstHopWireCustomRecords,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := b.Write(tlvData); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// deserializeRouteBlob deserializes a route that was serialized with
+// serializeRouteBlob.
+func deserializeRouteBlob(b []byte) (route.Route, error) {
+	r := bytes.NewReader(b)
+	rt, err := DeserializeRoute(r)
+	if err != nil {
+		return route.Route{}, err
+	}
+
+	extraData, err := io.ReadAll(r)
+	if err != nil {
+		return route.Route{}, err
+	}
+
+	if len(extraData) == 0 {
+		return rt, nil
+	}
+
+	customRecords, _, _, err := lnwire.ParseAndExtractCustomRecords(
+		extraData, &rt.FirstHopAmount,
+	)
+	if err != nil {
+		return route.Route{}, err
+	}
+
+	rt.FirstHopWireCustomRecords = customRecords
+
+	return rt, nil
+}
+
+// encodeFailureMessage encodes the given wire failure message. If there is no
+// failure message, nil is returned.
+func encodeFailureMessage(msg lnwire.FailureMessage) ([]byte, error) {
+	if msg == nil {
+		return nil, nil
+	}
+
+	var b bytes.Buffer
+	if err := lnwire.EncodeFailureMessage(&b, msg, 0); err != nil {
+		return nil, err
+	}
+
+	if b.Len() > math.MaxUint16 {
+		return nil, fmt.Errorf("failure message too large: %d bytes",
+			b.Len())
+	}
+
+	return b.Bytes(), nil
+}
+
+// timeToUnixNano converts the given time to unix nanoseconds. A zero time is
+// converted to zero, since calling UnixNano() on a zero time yields an
+// undefined result.
+func timeToUnixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// unixNanoToTime converts the given unix nanoseconds to a time. Zero is
+// interpreted as a zero time.Time value.
+func unixNanoToTime(unixNano int64) time.Time {
+	if unixNano == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, unixNano)
+}
@@ -0,0 +1,362 @@
+//go:build test_db_postgres || test_db_sqlite
+
+package channeldb
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// sortPayments sorts the given payments by their sequence number.
+func sortPayments(payments []*MPPayment) {
+	sort.Slice(payments, func(i, j int) bool {
+		return payments[i].SequenceNum < payments[j].SequenceNum
+	})
+}
+
+// TestPaymentSQLStoreParity runs the same set of operations against the KV
+// payment store and the native SQL payment store and asserts that both stores
+// return exactly the same results.
+func TestPaymentSQLStoreParity(t *testing.T) {
+	t.Parallel()
+
+	kvDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	kvStore := NewPaymentControl(kvDB)
+	sqlStore, _ := newTestPaymentSQLStore(t)
+
+	// fetch asserts that both stores return the same payment for the given
+	// hash and returns it.
+	fetch := func(hash lntypes.Hash) *MPPayment {
+		kvPayment, err := kvStore.FetchPayment(hash)
+		require.NoError(t, err)
+
+		sqlPayment, err := sqlStore.FetchPayment(hash)
+		require.NoError(t, err)
+		require.Equal(t, kvPayment, sqlPayment)
+
+		return sqlPayment
+	}
+
+	// Create a payment that will succeed after a failed first attempt.
+	info1, attempt1, preimg1, err := genInfo(t)
+	require.NoError(t, err)
+	info1.FirstHopCustomRecords = lnwire.CustomRecords{
+		lnwire.MinCustomRecordsTlvType: []byte{1, 2, 3},
+	}
+	info1.Rebalance = &RebalanceInfo{
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+	}
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		err := s.InitPayment(info1.PaymentIdentifier, info1)
+		require.NoError(t, err)
+	}
+	require.Equal(t, StatusInitiated, fetch(info1.PaymentIdentifier).Status)
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		_, err := s.RegisterAttempt(info1.PaymentIdentifier, attempt1)
+		require.NoError(t, err)
+
+		_, err = s.FailAttempt(
+			info1.PaymentIdentifier, attempt1.AttemptID,
+			&HTLCFailInfo{
+				FailTime: time.Unix(100, 0),
+				Message: lnwire.NewTemporaryChannelFailure(
+					nil,
+				),
+				Reason:             HTLCFailMessage,
+				FailureSourceIndex: 1,
+			},
+		)
+		require.NoError(t, err)
+
+		// Failing the same attempt twice is not allowed.
+		_, err = s.FailAttempt(
+			info1.PaymentIdentifier, attempt1.AttemptID,
+			&HTLCFailInfo{},
+		)
+		require.ErrorIs(t, err, ErrAttemptAlreadyFailed)
+	}
+	require.Equal(t, StatusInFlight, fetch(info1.PaymentIdentifier).Status)
+
+	attempt2 := *attempt1
+	attempt2.AttemptID = 1
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		_, err := s.RegisterAttempt(info1.PaymentIdentifier, &attempt2)
+		require.NoError(t, err)
+
+		_, err = s.SettleAttempt(
+			info1.PaymentIdentifier, attempt2.AttemptID,
+			&HTLCSettleInfo{
+				Preimage:   preimg1,
+				SettleTime: time.Unix(200, 0),
+			},
+		)
+		require.NoError(t, err)
+
+		// A succeeded payment can't be initiated again.
+		err = s.InitPayment(info1.PaymentIdentifier, info1)
+		require.ErrorIs(t, err, ErrAlreadyPaid)
+	}
+	require.Equal(t, StatusSucceeded, fetch(info1.PaymentIdentifier).Status)
+
+	// Create a payment that fails and is then retried, which should
+	// assign it a new sequence number.
+	info2, _, _, err := genInfo(t)
+	require.NoError(t, err)
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		err := s.InitPayment(info2.PaymentIdentifier, info2)
+		require.NoError(t, err)
+
+		_, err = s.Fail(info2.PaymentIdentifier, FailureReasonNoRoute)
+		require.NoError(t, err)
+	}
+	failed := fetch(info2.PaymentIdentifier)
+	require.Equal(t, StatusFailed, failed.Status)
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		err := s.InitPayment(info2.PaymentIdentifier, info2)
+		require.NoError(t, err)
+
+		_, err = s.Fail(info2.PaymentIdentifier, FailureReasonTimeout)
+		require.NoError(t, err)
+	}
+	retried := fetch(info2.PaymentIdentifier)
+	require.Greater(t, retried.SequenceNum, failed.SequenceNum)
+
+	// Create a payment that is still in flight.
+	info3, attempt3, _, err := genInfo(t)
+	require.NoError(t, err)
+	attempt3.AttemptID = 2
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		err := s.InitPayment(info3.PaymentIdentifier, info3)
+		require.NoError(t, err)
+
+		_, err = s.RegisterAttempt(info3.PaymentIdentifier, attempt3)
+		require.NoError(t, err)
+
+		// An in-flight payment can't be deleted.
+		err = s.DeletePayment(info3.PaymentIdentifier, false)
+		require.Error(t, err)
+	}
+	require.Equal(t, StatusInFlight, fetch(info3.PaymentIdentifier).Status)
+
+	kvInFlight, err := kvStore.FetchInFlightPayments()
+	require.NoError(t, err)
+	sqlInFlight, err := sqlStore.FetchInFlightPayments()
+	require.NoError(t, err)
+	sortPayments(kvInFlight)
+	require.Len(t, sqlInFlight, 1)
+	require.Equal(t, kvInFlight, sqlInFlight)
+
+	// Both stores must return the same results for a range of payment
+	// queries.
+	queries := []PaymentsQuery{
+		{MaxPayments: 10, IncludeIncomplete: true, CountTotal: true},
+		{MaxPayments: 10, IncludeIncomplete: false},
+		{MaxPayments: 1, IncludeIncomplete: true},
+		{MaxPayments: 1, IncludeIncomplete: true, Reversed: true},
+		{
+			MaxPayments: 10, IncludeIncomplete: true,
+			IndexOffset: 1,
+		},
+		{
+			MaxPayments: 10, IncludeIncomplete: true,
+			IndexOffset: 4, Reversed: true,
+		},
+		{
+			MaxPayments: 10, IncludeIncomplete: true,
+			CreationDateStart: info1.CreationTime.Unix(),
+			CreationDateEnd:   info1.CreationTime.Unix(),
+		},
+		{
+			MaxPayments: 10, IncludeIncomplete: true,
+			CreationDateEnd: 1,
+		},
+	}
+	for _, query := range queries {
+		kvResp, err := kvStore.QueryPayments(query)
+		require.NoError(t, err)
+
+		sqlResp, err := sqlStore.QueryPayments(query)
+		require.NoError(t, err)
+
+		require.Equal(t, kvResp, sqlResp, "query: %v", query)
+	}
+
+	// Delete the failed attempts of the succeeded payment.
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		err := s.DeleteFailedAttempts(info1.PaymentIdentifier)
+		require.NoError(t, err)
+	}
+	require.Len(t, fetch(info1.PaymentIdentifier).HTLCs, 1)
+
+	// Delete all failed payments, then all remaining removable ones.
+	for _, failedOnly := range []bool{true, false} {
+		kvNum, err := kvStore.DeletePayments(failedOnly, false)
+		require.NoError(t, err)
+
+		sqlNum, err := sqlStore.DeletePayments(failedOnly, false)
+		require.NoError(t, err)
+
+		require.Equal(t, 1, sqlNum)
+		require.Equal(t, kvNum, sqlNum)
+	}
+
+	for _, s := range []PaymentDB{kvStore, sqlStore} {
+		_, err := s.FetchPayment(info1.PaymentIdentifier)
+		require.ErrorIs(t, err, ErrPaymentNotInitiated)
+	}
+	fetch(info3.PaymentIdentifier)
+}
+
+// TestPaymentSQLStoreKeepFailedAttempts asserts that failed attempts are only
+// deleted if the store is not configured to keep them.
+func TestPaymentSQLStoreKeepFailedAttempts(t *testing.T) {
+	t.Parallel()
+
+	for _, keep := range []bool{true, false} {
+		store, _ := newTestPaymentSQLStore(
+			t, WithKeepFailedPaymentAttempts(keep),
+		)
+
+		info, attempt, _, err := genInfo(t)
+		require.NoError(t, err)
+
+		hash := info.PaymentIdentifier
+		require.NoError(t, store.InitPayment(hash, info))
+
+		_, err = store.RegisterAttempt(hash, attempt)
+		require.NoError(t, err)
+
+		_, err = store.FailAttempt(
+			hash, attempt.AttemptID, &HTLCFailInfo{
+				Reason: HTLCFailUnreadable,
+			},
+		)
+		require.NoError(t, err)
+
+		_, err = store.Fail(hash, FailureReasonNoRoute)
+		require.NoError(t, err)
+
+		require.NoError(t, store.DeleteFailedAttempts(hash))
+
+		payment, err := store.FetchPayment(hash)
+		require.NoError(t, err)
+
+		if keep {
+			require.Len(t, payment.HTLCs, 1)
+		} else {
+			require.Empty(t, payment.HTLCs)
+		}
+	}
+}
+
+// TestMigratePaymentsToSQL tests that payments, including legacy duplicate
+// payments, are migrated from the KV store to the SQL store unchanged.
+func TestMigratePaymentsToSQL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	kvDB, err := MakeTestDB(t)
+	require.NoError(t, err)
+
+	kvStore := NewPaymentControl(kvDB)
+
+	payments := []*payment{
+		{status: StatusFailed},
+		{status: StatusSucceeded},
+		{status: StatusInFlight},
+		{status: StatusSucceeded},
+	}
+	createTestPayments(t, kvStore, payments)
+
+	// Add a legacy duplicate payment to one of the payments.
+	preimg, err := genPreimage()
+	require.NoError(t, err)
+	appendDuplicatePayment(t, kvDB, payments[1].id, 1000, preimg)
+
+	// Add a rebalance payment to make sure its rebalance info is migrated
+	// as well.
+	rebalanceInfo, _, _, err := genInfo(t)
+	require.NoError(t, err)
+	rebalanceInfo.Rebalance = &RebalanceInfo{
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+	}
+	err = kvStore.InitPayment(rebalanceInfo.PaymentIdentifier, rebalanceInfo)
+	require.NoError(t, err)
+	payments = append(payments, &payment{
+		id:     rebalanceInfo.PaymentIdentifier,
+		status: StatusInitiated,
+	})
+
+	// Use a small batch size to make sure the migration correctly resumes
+	// from the previous batch.
+	sqlStore, sqlDB := newTestPaymentSQLStore(t)
+	require.NoError(t, MigratePaymentsToSQL(ctx, kvDB, sqlDB, 3))
+
+	query := PaymentsQuery{
+		MaxPayments:       100,
+		IncludeIncomplete: true,
+		CountTotal:        true,
+	}
+	kvResp, err := kvStore.QueryPayments(query)
+	require.NoError(t, err)
+
+	sqlResp, err := sqlStore.QueryPayments(query)
+	require.NoError(t, err)
+
+	require.Len(t, sqlResp.Payments, len(payments)+1)
+	require.Equal(t, kvResp, sqlResp)
+
+	for _, p := range payments {
+		kvPayment, err := kvStore.FetchPayment(p.id)
+		require.NoError(t, err)
+
+		sqlPayment, err := sqlStore.FetchPayment(p.id)
+		require.NoError(t, err)
+
+		require.Equal(t, kvPayment, sqlPayment)
+	}
+
+	// New payments must not reuse any of the migrated sequence numbers.
+	info, _, _, err := genInfo(t)
+	require.NoError(t, err)
+	require.NoError(t, sqlStore.InitPayment(info.PaymentIdentifier, info))
+
+	newPayment, err := sqlStore.FetchPayment(info.PaymentIdentifier)
+	require.NoError(t, err)
+	require.Greater(t, newPayment.SequenceNum, uint64(1000))
+
+	// Deleting a payment also removes its legacy duplicates.
+	err = sqlStore.DeletePayment(payments[1].id, false)
+	require.NoError(t, err)
+
+	sqlResp, err = sqlStore.QueryPayments(query)
+	require.NoError(t, err)
+	require.Len(t, sqlResp.Payments, len(payments))
+
+	// Finally, the KV store can be tombstoned once the migration is done.
+	tombstoned, err := kvDB.GetPaymentsTombstone()
+	require.NoError(t, err)
+	require.False(t, tombstoned)
+
+	require.NoError(t, kvDB.SetPaymentsTombstone())
+
+	tombstoned, err = kvDB.GetPaymentsTombstone()
+	require.NoError(t, err)
+	require.True(t, tombstoned)
+}
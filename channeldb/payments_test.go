@@ -95,6 +95,29 @@ var (
 			},
 		},
 	}
+
+	testTrampolineRoute = route.Route{
+		TotalTimeLock: 150,
+		TotalAmount:   1000,
+		SourcePubKey:  vertex,
+		Hops: []*route.Hop{
+			{
+				PubKeyBytes:      vertex,
+				ChannelID:        9876,
+				OutgoingTimeLock: 120,
+				AmtToForward:     900,
+				CustomRecords:    record.CustomSet{},
+				Trampoline: &record.Trampoline{
+					NextNode:     vertex,
+					AmtToForward: 800,
+					OutgoingCltv: 100,
+					MPP: record.NewMPP(
+						800, [32]byte{0x42},
+					),
+				},
+			},
+		},
+	}
 )
 
 func makeFakeInfo(t *testing.T) (*PaymentCreationInfo, *HTLCAttemptInfo) {
@@ -150,6 +173,19 @@ func TestSentPaymentSerialization(t *testing.T) {
 	require.NoError(t, err, "deserialize")
 	require.Equal(t, c, newCreationInfo)
 
+	b.Reset()
+
+	// The rebalance info shares the TLV stream with the custom records.
+	c.Rebalance = &RebalanceInfo{
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+	}
+	require.NoError(t, serializePaymentCreationInfo(&b, c), "serialize")
+
+	newCreationInfo, err = deserializePaymentCreationInfo(&b)
+	require.NoError(t, err, "deserialize")
+	require.Equal(t, c, newCreationInfo)
+
 	b.Reset()
 	require.NoError(t, serializeHTLCAttemptInfo(&b, s), "serialize")
 
@@ -206,6 +242,15 @@ func TestRouteSerialization(t *testing.T) {
 
 	testSerializeRoute(t, testRoute)
 	testSerializeRoute(t, testBlindedRoute)
+
+	// The trampoline record is in the custom range, so make sure it isn't
+	// mistaken for a custom record.
+	var b bytes.Buffer
+	require.NoError(t, SerializeRoute(&b, testTrampolineRoute))
+
+	trampolineRoute, err := DeserializeRoute(&b)
+	require.NoError(t, err)
+	require.Equal(t, testTrampolineRoute, trampolineRoute)
 }
 
 func testSerializeRoute(t *testing.T, route route.Route) {
@@ -801,7 +846,9 @@ func putDuplicatePayment(t *testing.T, duplicateBucket kvdb.RwBucket,
 	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
-	err = serializeTime(&b, info.CreationTime)
+	// Legacy duplicate payments store the creation time in seconds.
+	byteOrder.PutUint64(scratch[:], uint64(info.CreationTime.Unix()))
+	_, err = b.Write(scratch[:])
 	require.NoError(t, err)
 
 	byteOrder.PutUint32(scratch[:4], 0)
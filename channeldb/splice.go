@@ -0,0 +1,285 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+var (
+	// pendingSpliceKey points to the serialized splice that was signed by
+	// both parties but hasn't been applied to the channel yet.
+	pendingSpliceKey = []byte("pending-splice-key")
+
+	// ErrNoPendingSplice is returned when no pending splice has been
+	// persisted for a channel.
+	ErrNoPendingSplice = errors.New("no pending splice")
+)
+
+// PendingSplice holds the state of a splice of a channel that was signed by
+// both parties, but whose transaction hasn't confirmed yet. Until then, both
+// the current commitments and the ones spending the new funding output are
+// valid.
+type PendingSplice struct {
+	// SpliceTx is the fully signed splice transaction.
+	SpliceTx *wire.MsgTx
+
+	// FundingOutpoint is the outpoint of the new funding output that is
+	// created by the splice transaction.
+	FundingOutpoint wire.OutPoint
+
+	// Capacity is the capacity of the channel after the splice.
+	Capacity btcutil.Amount
+
+	// BroadcastHeight is the height at which the splice transaction was
+	// broadcast.
+	BroadcastHeight uint32
+
+	// LocalCommitment is our commitment that spends the new funding
+	// output.
+	LocalCommitment ChannelCommitment
+
+	// RemoteCommitment is the remote party's commitment that spends the
+	// new funding output.
+	RemoteCommitment ChannelCommitment
+
+	// Params are the new parameters of the channel if the splice
+	// reanchors the channel as part of a dynamic commitment. They take
+	// effect along with the new funding output.
+	Params fn.Option[ChannelParams]
+}
+
+// encode serializes the PendingSplice to the given io.Writer.
+func (p *PendingSplice) encode(w io.Writer) error {
+	err := WriteElements(
+		w, p.SpliceTx, p.FundingOutpoint, p.Capacity,
+		p.BroadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, commit := range []*ChannelCommitment{
+		&p.LocalCommitment, &p.RemoteCommitment,
+	} {
+		var b bytes.Buffer
+		if err := serializeChanCommit(&b, commit); err != nil {
+			return err
+		}
+
+		auxData := commit.extractTlvData()
+		if err := auxData.encode(&b); err != nil {
+			return err
+		}
+
+		if err := WriteElement(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, p.Params.IsSome()); err != nil {
+		return err
+	}
+
+	return fn.MapOptionZ(p.Params, func(params ChannelParams) error {
+		return params.encode(w)
+	})
+}
+
+// decodePendingSplice deserializes a PendingSplice from the given io.Reader.
+func decodePendingSplice(r io.Reader) (*PendingSplice, error) {
+	var p PendingSplice
+	err := ReadElements(
+		r, &p.SpliceTx, &p.FundingOutpoint, &p.Capacity,
+		&p.BroadcastHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, commit := range []*ChannelCommitment{
+		&p.LocalCommitment, &p.RemoteCommitment,
+	} {
+		var commitBytes []byte
+		if err := ReadElement(r, &commitBytes); err != nil {
+			return nil, err
+		}
+
+		commitReader := bytes.NewReader(commitBytes)
+		*commit, err = deserializeChanCommit(commitReader)
+		if err != nil {
+			return nil, err
+		}
+
+		var auxData commitTlvData
+		if err := auxData.decode(commitReader); err != nil {
+			return nil, err
+		}
+		commit.amendTlvData(auxData)
+	}
+
+	var hasParams bool
+	if err := ReadElement(r, &hasParams); err != nil {
+		return nil, err
+	}
+	if hasParams {
+		params, err := decodeChannelParams(r)
+		if err != nil {
+			return nil, err
+		}
+		p.Params = fn.Some(params)
+	}
+
+	return &p, nil
+}
+
+// CurrentFundingOutpoint returns the outpoint of the funding output that
+// currently backs the channel. This is the original FundingOutpoint unless the
+// channel was spliced.
+func (c *OpenChannel) CurrentFundingOutpoint() wire.OutPoint {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.SplicedFundingOutpoint.UnwrapOr(c.FundingOutpoint)
+}
+
+// StorePendingSplice persists a splice that was signed by both parties. It
+// must be stored before our signatures for the splice transaction are handed
+// out, so we're able to act on the new funding output once the transaction
+// confirms.
+func (c *OpenChannel) StorePendingSplice(splice *PendingSplice) error {
+	c.Lock()
+	defer c.Unlock()
+
+	var b bytes.Buffer
+	if err := splice.encode(&b); err != nil {
+		return err
+	}
+
+	return kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(pendingSpliceKey, b.Bytes())
+	}, func() {})
+}
+
+// PendingSplice returns the splice of the channel that was signed by both
+// parties but hasn't been applied yet. If there is none, ErrNoPendingSplice
+// is returned.
+func (c *OpenChannel) PendingSplice() (*PendingSplice, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	var splice *PendingSplice
+	err := kvdb.View(c.Db.backend, func(tx kvdb.RTx) error {
+		chanBucket, err := fetchChanBucket(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		switch {
+		case err == nil:
+		case errors.Is(err, ErrNoChanDBExists),
+			errors.Is(err, ErrNoActiveChannels),
+			errors.Is(err, ErrChannelNotFound):
+
+			return ErrNoPendingSplice
+		default:
+			return err
+		}
+
+		spliceBytes := chanBucket.Get(pendingSpliceKey)
+		if spliceBytes == nil {
+			return ErrNoPendingSplice
+		}
+
+		splice, err = decodePendingSplice(bytes.NewReader(spliceBytes))
+
+		return err
+	}, func() {
+		splice = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// ApplySplice makes the output of the pending splice transaction the funding
+// output of the channel, and replaces the current commitments with the ones
+// spending it. The new parameters of a reanchored channel take effect as
+// well. This must only be called once the splice transaction
+// confirmed. Calling ApplySplice without a pending splice is a no-op, which
+// makes it safe to call it from multiple sub-systems.
+func (c *OpenChannel) ApplySplice() error {
+	c.Lock()
+	defer c.Unlock()
+
+	var splice *PendingSplice
+	err := kvdb.Update(c.Db.backend, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		spliceBytes := chanBucket.Get(pendingSpliceKey)
+		if spliceBytes == nil {
+			return nil
+		}
+
+		splice, err = decodePendingSplice(bytes.NewReader(spliceBytes))
+		if err != nil {
+			return fmt.Errorf("unable to decode pending splice: %w",
+				err)
+		}
+
+		channel, err := fetchOpenChannel(chanBucket, &c.FundingOutpoint)
+		if err != nil {
+			return err
+		}
+
+		applySplice(channel, splice)
+		if err := putOpenChannel(chanBucket, channel); err != nil {
+			return err
+		}
+
+		return chanBucket.Delete(pendingSpliceKey)
+	}, func() {
+		splice = nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Update the in-memory representation to keep it in sync with the DB.
+	if splice != nil {
+		applySplice(c, splice)
+	}
+
+	return nil
+}
+
+// applySplice updates the funding related fields of the channel, and possibly
+// its parameters, with the ones of the splice.
+func applySplice(c *OpenChannel, splice *PendingSplice) {
+	c.SplicedFundingOutpoint = fn.Some(splice.FundingOutpoint)
+	c.Capacity = splice.Capacity
+	c.LocalCommitment = splice.LocalCommitment
+	c.RemoteCommitment = splice.RemoteCommitment
+
+	splice.Params.WhenSome(func(params ChannelParams) {
+		applyParams(c, params)
+	})
+}
@@ -0,0 +1,89 @@
+package channeldb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPendingSplice tests that a pending splice can be persisted and that
+// applying it updates the funding output and the commitments of the channel.
+func TestPendingSplice(t *testing.T) {
+	t.Parallel()
+
+	fullDB, err := MakeTestDB(t)
+	require.NoError(t, err, "unable to make test database")
+
+	cdb := fullDB.ChannelStateDB()
+
+	channel := createTestChannel(t, cdb, openChannelOption())
+	chanPoint := channel.FundingOutpoint
+
+	// Before the channel is spliced, the original funding outpoint is the
+	// current one.
+	require.Equal(t, chanPoint, channel.CurrentFundingOutpoint())
+
+	_, err = channel.PendingSplice()
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	// Applying a splice without having stored one is a no-op.
+	require.NoError(t, channel.ApplySplice())
+	require.True(t, channel.SplicedFundingOutpoint.IsNone())
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&chanPoint, []byte{}, nil))
+	spliceTx.AddTxOut(wire.NewTxOut(20_000, bytes.Repeat([]byte{1}, 34)))
+
+	localCommit := channel.LocalCommitment
+	localCommit.LocalBalance = lnwire.MilliSatoshi(19_000)
+	localCommit.CustomBlob = fn.None[[]byte]()
+
+	remoteCommit := channel.RemoteCommitment
+	remoteCommit.RemoteBalance = lnwire.MilliSatoshi(19_000)
+
+	splice := &PendingSplice{
+		SpliceTx: spliceTx,
+		FundingOutpoint: wire.OutPoint{
+			Hash: spliceTx.TxHash(),
+		},
+		Capacity:         btcutil.Amount(20_000),
+		BroadcastHeight:  200,
+		LocalCommitment:  localCommit,
+		RemoteCommitment: remoteCommit,
+	}
+	require.NoError(t, channel.StorePendingSplice(splice))
+
+	// The splice is read back as it was stored.
+	dbSplice, err := channel.PendingSplice()
+	require.NoError(t, err)
+	require.Equal(t, splice, dbSplice)
+
+	// Storing the splice doesn't change the channel yet.
+	require.Equal(t, chanPoint, channel.CurrentFundingOutpoint())
+
+	// Once applied, the splice output is the funding output of the channel
+	// both in memory and on disk, while the channel keeps its identity.
+	require.NoError(t, channel.ApplySplice())
+	require.NoError(t, channel.ApplySplice())
+
+	_, err = channel.PendingSplice()
+	require.ErrorIs(t, err, ErrNoPendingSplice)
+
+	dbChannel, err := cdb.FetchChannel(chanPoint)
+	require.NoError(t, err)
+
+	for _, c := range []*OpenChannel{channel, dbChannel} {
+		require.Equal(t, chanPoint, c.FundingOutpoint)
+		require.Equal(
+			t, splice.FundingOutpoint, c.CurrentFundingOutpoint(),
+		)
+		require.Equal(t, splice.Capacity, c.Capacity)
+		require.Equal(t, localCommit, c.LocalCommitment)
+		require.Equal(t, remoteCommit, c.RemoteCommitment)
+	}
+}
@@ -157,6 +157,13 @@ func (c *chanDBRestorer) openChannelShell(backup chanbackup.Single) (
 		chanType |= channeldb.AnchorOutputsBit
 		chanType |= channeldb.SingleFunderTweaklessBit
 
+	case chanbackup.AcceptorLeaseVersion:
+		chanType = channeldb.LeaseExpirationBit
+		chanType |= channeldb.AcceptorLeaseBit
+		chanType |= channeldb.ZeroHtlcTxFeeBit
+		chanType |= channeldb.AnchorOutputsBit
+		chanType |= channeldb.SingleFunderTweaklessBit
+
 	case chanbackup.SimpleTaprootVersion:
 		chanType = channeldb.ZeroHtlcTxFeeBit
 		chanType |= channeldb.AnchorOutputsBit
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"errors"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var bumpChannelOpenFeeCommand = cli.Command{
+	Name:     "bumpchannelopenfee",
+	Category: "Channels",
+	Usage: "Replace the funding transaction of a pending dual-funded " +
+		"channel.",
+	Description: `
+	Replaces the unconfirmed funding transaction of a pending dual-funded
+	channel we opened with one that pays a higher fee rate. The replacement
+	is negotiated with the remote peer, which keeps its contribution to the
+	channel. The new fee rate must be at least 25/24 of the fee rate of the
+	funding transaction it replaces.
+
+	The channel point of the replacement is returned. Until one of the
+	funding transactions confirms, the channel is pending with each of them.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of " +
+				"the funding transaction",
+		},
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel point. If set, " +
+				"funding_txid and output_index flags and " +
+				"positional arguments will be ignored",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "the fee rate in sat/vbyte of the replacement " +
+				"funding transaction",
+		},
+	},
+	Action: actionDecorator(bumpChannelOpenFee),
+}
+
+func bumpChannelOpenFee(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "bumpchannelopenfee")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("sat_per_vbyte") {
+		return errors.New("sat_per_vbyte must be set")
+	}
+
+	req := &lnrpc.BumpChannelOpenFeeRequest{
+		ChannelPoint: channelPoint,
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	}
+
+	resp, err := client.BumpChannelOpenFee(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var modifyChanParamsCommand = cli.Command{
+	Name:     "modifychanparams",
+	Category: "Channels",
+	Usage:    "Change the parameters of an existing channel.",
+	Description: `
+	Changes the parameters of an existing channel through a dynamic
+	commitment with its peer. Only the parameters that are set are changed.
+	The parameters constrain the remote party, e.g. the dust limit is the
+	dust limit of the commitment of the remote party, and the CSV delay is
+	the delay of its funds on its commitment.
+
+	If the CSV delay is changed or the channel is upgraded to a simple
+	taproot channel, the funds of the channel are moved to a new funding
+	output by a kickoff transaction, and the channel can only be used again
+	once the kickoff transaction confirmed.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "funding_txid",
+			Usage: "the txid of the channel's funding transaction",
+		},
+		cli.IntFlag{
+			Name: "output_index",
+			Usage: "the output index for the funding output of " +
+				"the funding transaction",
+		},
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "(optional) the channel point. If set, " +
+				"funding_txid and output_index flags and " +
+				"positional arguments will be ignored",
+		},
+		cli.Uint64Flag{
+			Name: "dust_limit_sat",
+			Usage: "(optional) the new dust limit in satoshis of " +
+				"the commitment of the remote party",
+		},
+		cli.Uint64Flag{
+			Name: "max_value_in_flight_msat",
+			Usage: "(optional) the new maximum value in " +
+				"millisatoshis of the HTLCs the remote party " +
+				"may have in flight",
+		},
+		cli.Uint64Flag{
+			Name: "htlc_minimum_msat",
+			Usage: "(optional) the new minimum value in " +
+				"millisatoshis of the HTLCs the remote party " +
+				"may offer",
+		},
+		cli.Uint64Flag{
+			Name: "channel_reserve_sat",
+			Usage: "(optional) the new reserve in satoshis the " +
+				"remote party must keep",
+		},
+		cli.Uint64Flag{
+			Name: "csv_delay",
+			Usage: "(optional) the new CSV delay of the funds of " +
+				"the remote party",
+		},
+		cli.Uint64Flag{
+			Name: "max_accepted_htlcs",
+			Usage: "(optional) the new maximum number of HTLCs " +
+				"the remote party may offer",
+		},
+		cli.BoolFlag{
+			Name: "upgrade_to_taproot",
+			Usage: "(optional) upgrade the channel to a simple " +
+				"taproot channel",
+		},
+	},
+	Action: actionDecorator(modifyChanParams),
+}
+
+func modifyChanParams(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "modifychanparams")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.ModifyChannelParamsRequest{
+		ChannelPoint:     channelPoint,
+		UpgradeToTaproot: ctx.Bool("upgrade_to_taproot"),
+	}
+
+	optionalUint64 := func(name string) *uint64 {
+		if !ctx.IsSet(name) {
+			return nil
+		}
+
+		value := ctx.Uint64(name)
+		return &value
+	}
+	req.DustLimitSat = optionalUint64("dust_limit_sat")
+	req.MaxValueInFlightMsat = optionalUint64("max_value_in_flight_msat")
+	req.HtlcMinimumMsat = optionalUint64("htlc_minimum_msat")
+	req.ChannelReserveSat = optionalUint64("channel_reserve_sat")
+
+	optionalUint32 := func(name string) (*uint32, error) {
+		if !ctx.IsSet(name) {
+			return nil, nil
+		}
+
+		value := ctx.Uint64(name)
+		if value > math.MaxUint16 {
+			return nil, fmt.Errorf("%s must not exceed %d", name,
+				math.MaxUint16)
+		}
+
+		value32 := uint32(value)
+		return &value32, nil
+	}
+	req.CsvDelay, err = optionalUint32("csv_delay")
+	if err != nil {
+		return err
+	}
+	req.MaxAcceptedHtlcs, err = optionalUint32("max_accepted_htlcs")
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.ModifyChannelParams(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var listLeaseOffersCommand = cli.Command{
+	Name:     "listleaseoffers",
+	Category: "Channels",
+	Usage:    "List the nodes that sell inbound liquidity.",
+	Description: `
+	Lists the nodes of the graph that advertise lease rates for inbound
+	liquidity in their node announcement, along with the lease fee they
+	charge for leasing the given amount. The lease fee includes the funding
+	weight of the seller, which is paid at the fee rate given by either
+	--conf_target or --sat_per_vbyte.`,
+	ArgsUsage: "[lease_amt]",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "lease_amt",
+			Usage: "the amount in satoshis to lease, for which the " +
+				"lease fee is computed",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks the funding " +
+				"transaction should confirm in",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee rate in sat/vbyte of " +
+				"the funding transaction",
+		},
+	},
+	Action: actionDecorator(listLeaseOffers),
+}
+
+func listLeaseOffers(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	args := ctx.Args()
+	leaseAmt := ctx.Int64("lease_amt")
+	if !ctx.IsSet("lease_amt") && args.Present() {
+		var err error
+		leaseAmt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode lease_amt: %w", err)
+		}
+	}
+
+	req := &lnrpc.ListLeaseOffersRequest{
+		LeaseAmt:    leaseAmt,
+		TargetConf:  int32(ctx.Int64("conf_target")),
+		SatPerVbyte: ctx.Uint64("sat_per_vbyte"),
+	}
+
+	resp, err := client.ListLeaseOffers(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var buyLiquidityCommand = cli.Command{
+	Name:     "buyliquidity",
+	Category: "Channels",
+	Usage:    "Lease inbound liquidity from a node.",
+	Description: `
+	Opens a dual-funded channel with a node that advertises lease rates and
+	leases inbound liquidity from it. The seller contributes up to lease_amt
+	to the channel, and is paid a lease fee of at most max_lease_fee from
+	our contribution. The funds of the seller are locked in the channel
+	until the lease expires.
+
+	The node must be connected as a peer, and both nodes must have enabled
+	protocol.dual-funding. The lease rates of nodes are listed with
+	listleaseoffers.`,
+	ArgsUsage: "node_key local_amt lease_amt max_lease_fee",
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "node_key",
+			Usage: "the identity public key of the node to lease " +
+				"from",
+		},
+		cli.Int64Flag{
+			Name: "local_amt",
+			Usage: "the amount in satoshis we contribute to the " +
+				"channel, from which the lease fee is paid",
+		},
+		cli.Int64Flag{
+			Name:  "lease_amt",
+			Usage: "the amount in satoshis of inbound liquidity to lease",
+		},
+		cli.Int64Flag{
+			Name:  "max_lease_fee",
+			Usage: "the maximum lease fee in satoshis to pay",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks the funding " +
+				"transaction should confirm in",
+		},
+		cli.Uint64Flag{
+			Name: "sat_per_vbyte",
+			Usage: "(optional) a manual fee rate in sat/vbyte of " +
+				"the funding transaction",
+		},
+		cli.BoolFlag{
+			Name: "private",
+			Usage: "make the channel private, such that it won't " +
+				"be announced to the greater network",
+		},
+	},
+	Action: actionDecorator(buyLiquidity),
+}
+
+func buyLiquidity(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "buyliquidity")
+		return nil
+	}
+
+	args := ctx.Args()
+
+	nodeKeyHex := ctx.String("node_key")
+	if !ctx.IsSet("node_key") {
+		if !args.Present() {
+			return errors.New("node_key argument missing")
+		}
+		nodeKeyHex = args.First()
+		args = args.Tail()
+	}
+	nodeKey, err := hex.DecodeString(nodeKeyHex)
+	if err != nil {
+		return fmt.Errorf("unable to decode node_key: %w", err)
+	}
+
+	var amts [3]int64
+	names := []string{"local_amt", "lease_amt", "max_lease_fee"}
+	for i, name := range names {
+		if ctx.IsSet(name) {
+			amts[i] = ctx.Int64(name)
+			continue
+		}
+		if !args.Present() {
+			return fmt.Errorf("%v argument missing", name)
+		}
+		amts[i], err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode %v: %w", name, err)
+		}
+		args = args.Tail()
+	}
+
+	req := &lnrpc.BuyLiquidityRequest{
+		NodePubkey:         nodeKey,
+		LocalFundingAmount: amts[0],
+		LeaseAmt:           amts[1],
+		MaxLeaseFeeSat:     amts[2],
+		TargetConf:         int32(ctx.Int64("conf_target")),
+		SatPerVbyte:        ctx.Uint64("sat_per_vbyte"),
+		Private:            ctx.Bool("private"),
+	}
+
+	resp, err := client.BuyLiquidity(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendOnionCommand = cli.Command{
+	Name:     "sendonion",
+	Category: "Peers",
+	Usage:    "Send an onion message along a blinded path",
+	Description: `
+	Send an onion message along a blinded path. The introduction node of
+	the path must be a connected peer or our own node.
+
+	The final hop payloads are passed as type=hexdata pairs, where the
+	type must be at least 64, e.g.:
+
+	lncli sendonion --blinded_path=<hex> --payload=64=0102 --payload=65=ff
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "blinded_path",
+			Usage: "the hex encoded blinded path to send the " +
+				"message along, in the BOLT 12 encoding",
+		},
+		cli.StringSliceFlag{
+			Name: "payload",
+			Usage: "a type=hexdata pair that is delivered to the " +
+				"final hop, can be specified multiple times",
+		},
+		cli.StringFlag{
+			Name: "reply_path_id",
+			Usage: "if set, a reply path to our node is included " +
+				"in the message, the hex encoded ID is " +
+				"returned to us with any reply",
+		},
+	},
+	Action: actionDecorator(sendOnion),
+}
+
+func sendOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	pathBytes, err := hex.DecodeString(ctx.String("blinded_path"))
+	if err != nil {
+		return fmt.Errorf("invalid blinded path: %w", err)
+	}
+
+	path, err := bolt12.DecodeBlindedPath(bytes.NewReader(pathBytes))
+	if err != nil {
+		return fmt.Errorf("invalid blinded path: %w", err)
+	}
+
+	rpcPath := &lnrpc.BlindedPath{
+		IntroductionNode: path.IntroductionPoint.SerializeCompressed(),
+		BlindingPoint:    path.BlindingPoint.SerializeCompressed(),
+	}
+	for _, hop := range path.BlindedHops {
+		rpcPath.BlindedHops = append(
+			rpcPath.BlindedHops, &lnrpc.BlindedHop{
+				BlindedNode: hop.BlindedNodePub.
+					SerializeCompressed(),
+				EncryptedData: hop.CipherText,
+			},
+		)
+	}
+
+	payloads := make(map[uint64][]byte)
+	for _, payload := range ctx.StringSlice("payload") {
+		parts := strings.SplitN(payload, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid payload %v, expected "+
+				"type=hexdata", payload)
+		}
+
+		typ, err := strconv.ParseUint(parts[0], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid payload type: %w", err)
+		}
+
+		payloads[typ], err = hex.DecodeString(parts[1])
+		if err != nil {
+			return fmt.Errorf("invalid payload data: %w", err)
+		}
+	}
+
+	replyPathID, err := hex.DecodeString(ctx.String("reply_path_id"))
+	if err != nil {
+		return fmt.Errorf("invalid reply path ID: %w", err)
+	}
+
+	resp, err := client.SendOnionMessage(
+		ctxc, &lnrpc.SendOnionMessageRequest{
+			BlindedPath:      rpcPath,
+			FinalHopPayloads: payloads,
+			ReplyPathId:      replyPathID,
+		},
+	)
+
+	printRespJSON(resp)
+
+	return err
+}
+
+var subscribeOnionCommand = cli.Command{
+	Name:     "subscribeonion",
+	Category: "Peers",
+	Usage:    "Subscribe to incoming onion messages addressed to us",
+	Action:   actionDecorator(subscribeOnion),
+}
+
+func subscribeOnion(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeOnionMessages(
+		ctxc, &lnrpc.SubscribeOnionMessagesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(msg)
+	}
+}
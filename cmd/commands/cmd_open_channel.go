@@ -282,6 +282,13 @@ var openChannelCommand = cli.Command{
 				has no bearing on the channel's operation. Max
 				allowed length is 500 characters`,
 		},
+		cli.BoolFlag{
+			Name: "dual_funded",
+			Usage: "(optional) open a dual-funded channel, to " +
+				"which the remote node may contribute funds " +
+				"as well. Its funding transaction can be " +
+				"replaced with bumpchannelopenfee",
+		},
 	},
 	Action: actionDecorator(openChannel),
 }
@@ -326,6 +333,7 @@ func openChannel(ctx *cli.Context) error {
 		RemoteChanReserveSat:       ctx.Uint64("remote_reserve_sats"),
 		FundMax:                    ctx.Bool("fundmax"),
 		Memo:                       ctx.String("memo"),
+		DualFunded:                 ctx.Bool("dual_funded"),
 	}
 
 	switch {
@@ -102,6 +102,13 @@ var (
 		Usage: "(optional) expresses time preference (range -1 to 1)",
 	}
 
+	pathFindingSourceFlag = cli.StringFlag{
+		Name: "path_finding_source",
+		Usage: "(optional) the name of a registered external path " +
+			"finding source that proposes the routes of the " +
+			"payment instead of lnd's own pathfinding",
+	}
+
 	introductionNodeFlag = cli.StringFlag{
 		Name: "introduction_node",
 		Usage: "(blinded paths) the hex encoded, cleartext node ID " +
@@ -201,7 +208,7 @@ func PaymentFlags() []cli.Flag {
 		},
 		dataFlag, inflightUpdatesFlag, maxPartsFlag, jsonFlag,
 		maxShardSizeSatFlag, maxShardSizeMsatFlag, ampFlag,
-		timePrefFlag,
+		timePrefFlag, pathFindingSourceFlag,
 	}
 }
 
@@ -639,6 +646,8 @@ func SendPaymentRequest(ctx *cli.Context, req *routerrpc.SendPaymentRequest,
 	// Set time pref.
 	req.TimePref = ctx.Float64(timePrefFlag.Name)
 
+	req.PathFindingSource = ctx.String(pathFindingSourceFlag.Name)
+
 	// Always print in-flight updates for the table output.
 	printJSON := ctx.Bool(jsonFlag.Name)
 	req.NoInflightUpdates = !ctx.Bool(inflightUpdatesFlag.Name) && printJSON
@@ -1539,7 +1548,8 @@ var forwardingHistoryCommand = cli.Command{
 	Category: "Payments",
 	Usage:    "Query the history of all forwarded HTLCs.",
 	ArgsUsage: "start_time [end_time] [index_offset] [max_events]" +
-		"[--incoming_channel_ids] [--outgoing_channel_ids]",
+		"[--incoming_channel_ids] [--outgoing_channel_ids] " +
+		"[--incoming_peers] [--outgoing_peers] [--fee_aggregates]",
 	Description: `
 	Query the HTLC switch's internal forwarding log for all completed
 	payment circuits (HTLCs) over a particular time range (--start_time and
@@ -1555,7 +1565,14 @@ var forwardingHistoryCommand = cli.Command{
 	callers can use the --max_events param to modify this value.
 
 	Incoming and outgoing channel IDs can be provided to further filter
-	the events. If not provided, all events will be returned.
+	the events. If not provided, all events will be returned. Similarly,
+	the public keys of incoming and outgoing peers can be provided to only
+	return events that were received from or forwarded to any of the
+	channels with those peers.
+
+	If --fee_aggregates is set, the response will also contain the
+	forwarding volume and fees of all matching events in the time range,
+	aggregated per outgoing channel and day.
 
 	Finally, callers can skip a series of events using the --index_offset
 	parameter. Each response will contain the offset index of the last
@@ -1597,6 +1614,23 @@ var forwardingHistoryCommand = cli.Command{
 				"channel to filter events by; can be " +
 				"specified multiple times in the same command",
 		},
+		cli.StringSliceFlag{
+			Name: "incoming_peers",
+			Usage: "the hex encoded public key of the incoming " +
+				"peer to filter events by; can be " +
+				"specified multiple times in the same command",
+		},
+		cli.StringSliceFlag{
+			Name: "outgoing_peers",
+			Usage: "the hex encoded public key of the outgoing " +
+				"peer to filter events by; can be " +
+				"specified multiple times in the same command",
+		},
+		cli.BoolFlag{
+			Name: "fee_aggregates",
+			Usage: "also return the forwarding volume and fees " +
+				"aggregated per outgoing channel and day",
+		},
 	},
 	Action: actionDecorator(forwardingHistory),
 }
@@ -1671,11 +1705,12 @@ func forwardingHistory(ctx *cli.Context) error {
 	lookupPeerAlias := !ctx.Bool("skip_peer_alias_lookup")
 
 	req := &lnrpc.ForwardingHistoryRequest{
-		StartTime:       startTime,
-		EndTime:         endTime,
-		IndexOffset:     indexOffset,
-		NumMaxEvents:    maxEvents,
-		PeerAliasLookup: lookupPeerAlias,
+		StartTime:            startTime,
+		EndTime:              endTime,
+		IndexOffset:          indexOffset,
+		NumMaxEvents:         maxEvents,
+		PeerAliasLookup:      lookupPeerAlias,
+		IncludeFeeAggregates: ctx.Bool("fee_aggregates"),
 	}
 	outgoingChannelIDs := ctx.Int64Slice("outgoing_chan_ids")
 	if len(outgoingChannelIDs) != 0 {
@@ -1692,6 +1727,25 @@ func forwardingHistory(ctx *cli.Context) error {
 			req.IncomingChanIds[i] = uint64(c)
 		}
 	}
+
+	for _, peer := range ctx.StringSlice("incoming_peers") {
+		pubKey, err := hex.DecodeString(peer)
+		if err != nil {
+			return fmt.Errorf("unable to decode incoming peer: %w",
+				err)
+		}
+		req.IncomingPeers = append(req.IncomingPeers, pubKey)
+	}
+
+	for _, peer := range ctx.StringSlice("outgoing_peers") {
+		pubKey, err := hex.DecodeString(peer)
+		if err != nil {
+			return fmt.Errorf("unable to decode outgoing peer: %w",
+				err)
+		}
+		req.OutgoingPeers = append(req.OutgoingPeers, pubKey)
+	}
+
 	resp, err := client.ForwardingHistory(ctxc, req)
 	if err != nil {
 		return err
@@ -0,0 +1,119 @@
+package commands
+
+import (
+	"errors"
+	"io"
+
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var rebalanceCommand = cli.Command{
+	Name:     "rebalance",
+	Category: "Channels",
+	Usage: "Move liquidity between two of our channels with a " +
+		"circular payment.",
+	Description: `
+	Move liquidity out of one of our channels and into another one by
+	paying an invoice of our own node along a circular route.
+
+	Channels that aren't given are chosen by their local balance ratio:
+	the outgoing channel is the one with the highest ratio above the
+	configured maximum ratio, the incoming channel the one with the lowest
+	ratio below the configured minimum ratio.
+
+	If no amount is given, the amount that brings both channels closest to
+	an even balance is moved, up to the configured maximum amount.
+
+	With --dry_run, only a route is searched for and no payment is made.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "outgoing_chan_id",
+			Usage: "the channel to move liquidity out of",
+		},
+		cli.Uint64Flag{
+			Name:  "incoming_chan_id",
+			Usage: "the channel to move liquidity into",
+		},
+		cli.Int64Flag{
+			Name:  "amt",
+			Usage: "the amount in satoshis to move",
+		},
+		cli.Uint64Flag{
+			Name: "max_fee_ppm",
+			Usage: "the maximum fee in parts per million of the " +
+				"amount, defaults to the configured maximum",
+		},
+		cli.BoolFlag{
+			Name: "dry_run",
+			Usage: "only search for a route without making a " +
+				"payment",
+		},
+	},
+	Action: actionDecorator(rebalance),
+}
+
+func rebalance(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	if ctx.NArg() > 0 {
+		return errors.New("rebalance takes no positional arguments")
+	}
+
+	req := &routerrpc.RebalanceRequest{
+		OutgoingChanId: ctx.Uint64("outgoing_chan_id"),
+		IncomingChanId: ctx.Uint64("incoming_chan_id"),
+		AmtMsat:        ctx.Int64("amt") * 1000,
+		MaxFeePpm:      ctx.Uint64("max_fee_ppm"),
+		DryRun:         ctx.Bool("dry_run"),
+	}
+
+	client := routerrpc.NewRouterClient(conn)
+	resp, err := client.Rebalance(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var subscribeRebalancesCommand = cli.Command{
+	Name:     "subscriberebalances",
+	Category: "Channels",
+	Usage:    "Print an update whenever a rebalance starts or resolves.",
+	Description: `
+	Subscribe to the rebalances of the node, both the requested ones and
+	the ones started by the automatic rebalancer, and print an update
+	whenever one of them is started or resolved.`,
+	Action: actionDecorator(subscribeRebalances),
+}
+
+func subscribeRebalances(ctx *cli.Context) error {
+	ctxc := getContext()
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+	stream, err := client.SubscribeRebalances(
+		ctxc, &routerrpc.SubscribeRebalancesRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
@@ -0,0 +1,159 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+// spliceFlags are the flags shared by the splice commands.
+var spliceFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "funding_txid",
+		Usage: "the txid of the channel's funding transaction",
+	},
+	cli.IntFlag{
+		Name: "output_index",
+		Usage: "the output index for the funding output of the " +
+			"funding transaction",
+	},
+	cli.StringFlag{
+		Name: "chan_point",
+		Usage: "(optional) the channel point. If set, " +
+			"funding_txid and output_index flags and " +
+			"positional arguments will be ignored",
+	},
+	cli.Int64Flag{
+		Name:  "amt",
+		Usage: "the amount in satoshis to splice",
+	},
+	cli.Int64Flag{
+		Name: "conf_target",
+		Usage: "(optional) the number of blocks that the " +
+			"splice transaction *should* confirm in, will be " +
+			"used for fee estimation",
+	},
+	cli.Uint64Flag{
+		Name: "sat_per_vbyte",
+		Usage: "(optional) a manual fee expressed in " +
+			"sat/vbyte that should be used when crafting " +
+			"the splice transaction",
+	},
+}
+
+var spliceInCommand = cli.Command{
+	Name:     "splicein",
+	Category: "Channels",
+	Usage:    "Add funds of the wallet to an existing channel.",
+	Description: `
+	Splices funds of the wallet into an existing channel. The channel can
+	only be used again once the splice transaction confirmed. Only private
+	channels with peers that support splicing can be spliced.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags:     spliceFlags,
+	Action:    actionDecorator(spliceIn),
+}
+
+func spliceIn(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "splicein")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_vbyte") {
+		return fmt.Errorf("either conf_target or sat_per_vbyte " +
+			"should be set, but not both")
+	}
+
+	resp, err := client.SpliceIn(ctxc, &lnrpc.SpliceInRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var spliceOutCommand = cli.Command{
+	Name:     "spliceout",
+	Category: "Channels",
+	Usage:    "Remove funds from an existing channel.",
+	Description: `
+	Splices funds out of an existing channel to an on-chain address. The
+	fees of the splice transaction are deducted from the amount. If no
+	address is given, a new address of the wallet is used. The channel can
+	only be used again once the splice transaction confirmed. Only private
+	channels with peers that support splicing can be spliced.
+
+	The format for a channel_point is 'funding_txid:output_index'.`,
+	ArgsUsage: "funding_txid [output_index]",
+	Flags: append(spliceFlags, cli.StringFlag{
+		Name:  "addr",
+		Usage: "(optional) the address to send the funds to",
+	}),
+	Action: actionDecorator(spliceOut),
+}
+
+func spliceOut(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments and flags were provided.
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		cli.ShowCommandHelp(ctx, "spliceout")
+		return nil
+	}
+
+	channelPoint, err := parseChannelPoint(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsSet("amt") {
+		return fmt.Errorf("amt argument missing")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_vbyte") {
+		return fmt.Errorf("either conf_target or sat_per_vbyte " +
+			"should be set, but not both")
+	}
+
+	resp, err := client.SpliceOut(ctxc, &lnrpc.SpliceOutRequest{
+		ChannelPoint: channelPoint,
+		Amount:       ctx.Int64("amt"),
+		Addr:         ctx.String("addr"),
+		TargetConf:   int32(ctx.Int64("conf_target")),
+		SatPerVbyte:  ctx.Uint64("sat_per_vbyte"),
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,240 @@
+//go:build feeautopilotrpc
+// +build feeautopilotrpc
+
+package commands
+
+import (
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc/feeautopilotrpc"
+	"github.com/urfave/cli"
+)
+
+func getFeeAutopilotClient(
+	ctx *cli.Context) (feeautopilotrpc.FeeAutopilotClient, func()) {
+
+	conn := getClientConn(ctx, false)
+
+	cleanUp := func() {
+		conn.Close()
+	}
+
+	return feeautopilotrpc.NewFeeAutopilotClient(conn), cleanUp
+}
+
+var feeAutopilotStatusCommand = cli.Command{
+	Name:        "status",
+	Usage:       "Get the status and strategy of the fee autopilot.",
+	Description: "",
+	Action:      actionDecorator(feeAutopilotStatus),
+}
+
+func feeAutopilotStatus(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getFeeAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &feeautopilotrpc.StatusRequest{}
+
+	resp, err := client.Status(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeAutopilotEnableCommand = cli.Command{
+	Name:        "enable",
+	Usage:       "Let the fee autopilot update the fee rates.",
+	Description: "",
+	Action:      actionDecorator(feeAutopilotEnable),
+}
+
+var feeAutopilotDisableCommand = cli.Command{
+	Name:  "disable",
+	Usage: "Stop the fee autopilot from updating the fee rates.",
+	Description: `
+	Stop the fee autopilot from updating the fee rates. It keeps tracking
+	the forwarding flow, so recommendations can still be listed.`,
+	Action: actionDecorator(feeAutopilotDisable),
+}
+
+func feeAutopilotEnable(ctx *cli.Context) error {
+	return modifyFeeAutopilotStatus(ctx, true)
+}
+
+func feeAutopilotDisable(ctx *cli.Context) error {
+	return modifyFeeAutopilotStatus(ctx, false)
+}
+
+func modifyFeeAutopilotStatus(ctx *cli.Context, enable bool) error {
+	ctxc := getContext()
+	client, cleanUp := getFeeAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &feeautopilotrpc.ModifyStatusRequest{
+		Enable: enable,
+	}
+
+	resp, err := client.ModifyStatus(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeAutopilotSetStrategyCommand = cli.Command{
+	Name:      "setstrategy",
+	Usage:     "Replace the strategy that determines the fee rates.",
+	ArgsUsage: "liquidity|demand",
+	Description: `
+	Replace the strategy of the fee autopilot. The 'liquidity' strategy
+	prices channels by how far their local balance is from the target
+	ratio. The 'demand' strategy adjusts the fee rates step by step based
+	on the forwarding flow.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "min_fee_rate_ppm",
+			Usage: "the minimum outbound fee rate in ppm",
+			Value: 1,
+		},
+		cli.Uint64Flag{
+			Name:  "max_fee_rate_ppm",
+			Usage: "the maximum outbound fee rate in ppm",
+			Value: 2500,
+		},
+		cli.Uint64Flag{
+			Name:  "max_inbound_discount_ppm",
+			Usage: "the maximum inbound discount in ppm",
+			Value: 500,
+		},
+		cli.Float64Flag{
+			Name: "target_ratio",
+			Usage: "the share of the capacity the liquidity " +
+				"strategy aims to keep on our side",
+			Value: 0.5,
+		},
+		cli.Float64Flag{
+			Name: "demand_step",
+			Usage: "the relative step by which the demand " +
+				"strategy adjusts the fee rates",
+			Value: 0.1,
+		},
+	},
+	Action: actionDecorator(feeAutopilotSetStrategy),
+}
+
+func feeAutopilotSetStrategy(ctx *cli.Context) error {
+	ctxc := getContext()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "setstrategy")
+	}
+
+	var strategyType feeautopilotrpc.StrategyType
+	switch ctx.Args().First() {
+	case "liquidity":
+		strategyType = feeautopilotrpc.StrategyType_LIQUIDITY
+
+	case "demand":
+		strategyType = feeautopilotrpc.StrategyType_DEMAND
+
+	default:
+		return fmt.Errorf("unknown strategy: %v", ctx.Args().First())
+	}
+
+	client, cleanUp := getFeeAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &feeautopilotrpc.SetStrategyRequest{
+		Strategy: &feeautopilotrpc.Strategy{
+			Type:          strategyType,
+			MinFeeRatePpm: uint32(ctx.Uint64("min_fee_rate_ppm")),
+			MaxFeeRatePpm: uint32(ctx.Uint64("max_fee_rate_ppm")),
+			MaxInboundDiscountPpm: uint32(
+				ctx.Uint64("max_inbound_discount_ppm"),
+			),
+			TargetRatio: ctx.Float64("target_ratio"),
+			DemandStep:  ctx.Float64("demand_step"),
+		},
+	}
+
+	resp, err := client.SetStrategy(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeAutopilotRecommendationsCommand = cli.Command{
+	Name:        "recommendations",
+	Usage:       "List the fee rates recommended for each channel.",
+	Description: "",
+	Action:      actionDecorator(feeAutopilotRecommendations),
+}
+
+func feeAutopilotRecommendations(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getFeeAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &feeautopilotrpc.ListRecommendationsRequest{}
+
+	resp, err := client.ListRecommendations(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var feeAutopilotRunCommand = cli.Command{
+	Name:        "run",
+	Usage:       "Apply the recommended fee rates right away.",
+	Description: "",
+	Action:      actionDecorator(feeAutopilotRun),
+}
+
+func feeAutopilotRun(ctx *cli.Context) error {
+	ctxc := getContext()
+	client, cleanUp := getFeeAutopilotClient(ctx)
+	defer cleanUp()
+
+	req := &feeautopilotrpc.RunRequest{}
+
+	resp, err := client.Run(ctxc, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+// feeAutopilotCommands will return the set of commands to enable for
+// feeautopilotrpc builds.
+func feeAutopilotCommands() []cli.Command {
+	return []cli.Command{
+		{
+			Name:        "feeautopilot",
+			Category:    "Fee Autopilot",
+			Usage:       "Interact with a running fee autopilot.",
+			Description: "",
+			Subcommands: []cli.Command{
+				feeAutopilotStatusCommand,
+				feeAutopilotEnableCommand,
+				feeAutopilotDisableCommand,
+				feeAutopilotSetStrategyCommand,
+				feeAutopilotRecommendationsCommand,
+				feeAutopilotRunCommand,
+			},
+		},
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !feeautopilotrpc
+// +build !feeautopilotrpc
+
+package commands
+
+import "github.com/urfave/cli"
+
+// feeAutopilotCommands will return nil for non-feeautopilotrpc builds.
+func feeAutopilotCommands() []cli.Command {
+	return nil
+}
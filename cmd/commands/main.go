@@ -463,6 +463,12 @@ func Main() {
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
+		spliceInCommand,
+		spliceOutCommand,
+		modifyChanParamsCommand,
+		bumpChannelOpenFeeCommand,
+		listLeaseOffersCommand,
+		buyLiquidityCommand,
 		listPeersCommand,
 		walletBalanceCommand,
 		ChannelBalanceCommand,
@@ -512,6 +518,8 @@ func Main() {
 		deletePaymentsCommand,
 		sendCustomCommand,
 		subscribeCustomCommand,
+		sendOnionCommand,
+		subscribeOnionCommand,
 		fishCompletionCommand,
 		listAliasesCommand,
 		estimateRouteFeeCommand,
@@ -520,6 +528,7 @@ func Main() {
 
 	// Add any extra commands determined by build flags.
 	app.Commands = append(app.Commands, autopilotCommands()...)
+	app.Commands = append(app.Commands, feeAutopilotCommands()...)
 	app.Commands = append(app.Commands, invoicesCommands()...)
 	app.Commands = append(app.Commands, neutrinoCommands()...)
 	app.Commands = append(app.Commands, routerCommands()...)
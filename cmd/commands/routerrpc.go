@@ -14,5 +14,7 @@ func routerCommands() []cli.Command {
 		getCfgCommand,
 		setCfgCommand,
 		updateChanStatusCommand,
+		rebalanceCommand,
+		subscribeRebalancesCommand,
 	}
 }
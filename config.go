@@ -29,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/chanbackup"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
@@ -384,6 +385,8 @@ type Config struct {
 
 	Autopilot *lncfg.AutoPilot `group:"Autopilot" namespace:"autopilot"`
 
+	FeeAutopilot *lncfg.FeeAutopilot `group:"feeautopilot" namespace:"feeautopilot"`
+
 	Tor *lncfg.Tor `group:"Tor" namespace:"tor"`
 
 	SubRPCServers *subRPCServerConfigs `group:"subrpc"`
@@ -481,6 +484,8 @@ type Config struct {
 
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
+	Tracing *lncfg.Tracing `group:"tracing" namespace:"tracing"`
+
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
 
 	Watchtower *lncfg.Watchtower `group:"watchtower" namespace:"watchtower"`
@@ -501,6 +506,8 @@ type Config struct {
 
 	Sweeper *lncfg.Sweeper `group:"sweeper" namespace:"sweeper"`
 
+	LiquidityAds *lncfg.LiquidityAds `group:"liquidityads" namespace:"liquidityads"`
+
 	Htlcswitch *lncfg.Htlcswitch `group:"htlcswitch" namespace:"htlcswitch"`
 
 	GRPC *GRPCConfig `group:"grpc" namespace:"grpc"`
@@ -640,6 +647,19 @@ func DefaultConfig() Config {
 				"top_centrality": 1.0,
 			},
 		},
+		FeeAutopilot: &lncfg.FeeAutopilot{
+			Strategy:           feeautopilot.StrategyLiquidity,
+			Interval:           feeautopilot.DefaultInterval,
+			FlowWindow:         feeautopilot.DefaultFlowWindow,
+			MinUpdateInterval:  feeautopilot.DefaultMinUpdateInterval,
+			MinRelativeChange:  feeautopilot.DefaultMinRelativeChange,
+			MaxUpdatesPerRun:   feeautopilot.DefaultMaxUpdatesPerRun,
+			MinFeeRate:         feeautopilot.DefaultMinFeeRate,
+			MaxFeeRate:         feeautopilot.DefaultMaxFeeRate,
+			MaxInboundDiscount: feeautopilot.DefaultMaxInboundDiscount,
+			TargetRatio:        feeautopilot.DefaultTargetRatio,
+			DemandStep:         feeautopilot.DefaultDemandStep,
+		},
 		PaymentsExpirationGracePeriod: defaultPaymentsExpirationGracePeriod,
 		TrickleDelay:                  defaultTrickleDelay,
 		ChanStatusSampleInterval:      defaultChanStatusSampleInterval,
@@ -670,6 +690,7 @@ func DefaultConfig() Config {
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
 		Prometheus: lncfg.DefaultPrometheus(),
+		Tracing:    lncfg.DefaultTracing(),
 		Watchtower: lncfg.DefaultWatchtowerCfg(defaultTowerDir),
 		HealthChecks: &lncfg.HealthCheckConfig{
 			ChainCheck: &lncfg.CheckConfig{
@@ -748,7 +769,8 @@ func DefaultConfig() Config {
 		RemoteSigner: &lncfg.RemoteSigner{
 			Timeout: lncfg.DefaultRemoteSignerRPCTimeout,
 		},
-		Sweeper: lncfg.DefaultSweeperConfig(),
+		Sweeper:      lncfg.DefaultSweeperConfig(),
+		LiquidityAds: lncfg.DefaultLiquidityAds(),
 		Htlcswitch: &lncfg.Htlcswitch{
 			MailboxDeliveryTimeout: htlcswitch.DefaultMailboxDeliveryTimeout,
 			QuiescenceTimeout:      lncfg.DefaultQuiescenceTimeout,
@@ -1789,9 +1811,12 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		cfg.RPCMiddleware,
 		cfg.RemoteSigner,
 		cfg.Sweeper,
+		cfg.LiquidityAds,
+		cfg.Tracing,
 		cfg.Htlcswitch,
 		cfg.Invoices,
 		cfg.Routing,
+		cfg.FeeAutopilot,
 		cfg.Pprof,
 		cfg.Gossip,
 	)
@@ -1799,6 +1824,12 @@ func ValidateConfig(cfg Config, interceptor signal.Interceptor, fileParser,
 		return nil, err
 	}
 
+	// Liquidity is only leased through dual-funded channels.
+	if cfg.LiquidityAds.Active && !cfg.ProtocolOptions.DualFunding {
+		return nil, mkErr("liquidityads.active requires " +
+			"protocol.dual-funding")
+	}
+
 	// Finally, ensure that the user's color is correctly formatted,
 	// otherwise the server will not be able to start after the unlocking
 	// the wallet.
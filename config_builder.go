@@ -47,6 +47,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
 	"github.com/lightningnetwork/lnd/lnwallet/rpcwallet"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/monitoring"
 	"github.com/lightningnetwork/lnd/msgmux"
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"github.com/lightningnetwork/lnd/signal"
@@ -924,6 +925,14 @@ type DatabaseInstances struct {
 	// InvoiceDB is the database that stores information about invoices.
 	InvoiceDB invoices.InvoiceDB
 
+	// PaymentDB is the database that stores information about outgoing
+	// payments and their HTLC attempts.
+	PaymentDB channeldb.PaymentDB
+
+	// ForwardingLogDB is the database that stores the forwarding history
+	// of the node.
+	ForwardingLogDB channeldb.ForwardingLogDB
+
 	// MacaroonDB is the database that stores macaroon root keys.
 	MacaroonDB kvdb.Backend
 
@@ -1060,6 +1069,17 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 		channeldb.OptionWithDecayedLogDB(dbs.DecayedLogDB),
 	}
 
+	// If Prometheus monitoring is enabled, then the duration of the
+	// transactions of the channel state and graph databases is exported.
+	if cfg.Prometheus.Enabled() {
+		databaseBackends.ChanStateDB = monitoring.InstrumentDB(
+			databaseBackends.ChanStateDB, "channeldb",
+		)
+		databaseBackends.GraphDB = monitoring.InstrumentDB(
+			databaseBackends.GraphDB, "graph",
+		)
+	}
+
 	// Otherwise, we'll open two instances, one for the state we only need
 	// locally, and the other for things we want to ensure are replicated.
 	dbs.ChanStateDB, err = channeldb.CreateWithBackend(
@@ -1127,7 +1147,7 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 				}
 
 				migFn, ok := getSQLMigration(
-					ctx, version, dbs.ChanStateDB.Backend,
+					ctx, version, dbs.ChanStateDB,
 					*d.cfg.ActiveNetParams.GenesisHash,
 				)
 				if !ok {
@@ -1175,6 +1195,11 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 
 			return nil, nil, err
 		}
+
+		dbs.PaymentDB = d.getPaymentStore(baseDB, dbs.ChanStateDB)
+		dbs.ForwardingLogDB = d.getForwardingLogStore(
+			baseDB, dbs.ChanStateDB,
+		)
 	} else {
 		// Check if the invoice bucket tombstone is set. If it is, we
 		// need to return and ask the user switch back to using the
@@ -1195,7 +1220,44 @@ func (d *DefaultDatabaseBuilder) BuildDatabase(
 			return nil, nil, err
 		}
 
+		// Likewise, refuse to use the KV payments store if the payments
+		// have already been migrated to the native SQL store.
+		ripPayments, err := dbs.ChanStateDB.GetPaymentsTombstone()
+		if err != nil {
+			err = fmt.Errorf("unable to check payments "+
+				"tombstone: %w", err)
+			d.logger.Error(err)
+
+			return nil, nil, err
+		}
+		if ripPayments {
+			err = fmt.Errorf("payments bucket tombstoned, please " +
+				"switch back to native SQL")
+			d.logger.Error(err)
+
+			return nil, nil, err
+		}
+
+		// The same applies to the forwarding log.
+		ripFwdLog, err := dbs.ChanStateDB.GetForwardingLogTombstone()
+		if err != nil {
+			err = fmt.Errorf("unable to check forwarding log "+
+				"tombstone: %w", err)
+			d.logger.Error(err)
+
+			return nil, nil, err
+		}
+		if ripFwdLog {
+			err = fmt.Errorf("forwarding log bucket tombstoned, " +
+				"please switch back to native SQL")
+			d.logger.Error(err)
+
+			return nil, nil, err
+		}
+
 		dbs.InvoiceDB = dbs.ChanStateDB
+		dbs.PaymentDB = channeldb.NewPaymentControl(dbs.ChanStateDB)
+		dbs.ForwardingLogDB = dbs.ChanStateDB.ForwardingLog()
 
 		graphStore, err = graphdb.NewKVStore(
 			databaseBackends.GraphDB, graphDBOptions...,
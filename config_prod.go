@@ -6,6 +6,7 @@ import (
 	"context"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
 	graphdb "github.com/lightningnetwork/lnd/graph/db"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/sqldb"
@@ -25,13 +26,29 @@ func (d *DefaultDatabaseBuilder) getGraphStore(_ *sqldb.BaseDB,
 	return graphdb.NewKVStore(kvBackend, opts...)
 }
 
+// getPaymentStore returns a channeldb.PaymentDB backed by the KV payment
+// store of the channel state DB.
+func (d *DefaultDatabaseBuilder) getPaymentStore(_ *sqldb.BaseDB,
+	chanStateDB *channeldb.DB) channeldb.PaymentDB {
+
+	return channeldb.NewPaymentControl(chanStateDB)
+}
+
+// getForwardingLogStore returns a channeldb.ForwardingLogDB backed by the KV
+// forwarding log of the channel state DB.
+func (d *DefaultDatabaseBuilder) getForwardingLogStore(_ *sqldb.BaseDB,
+	chanStateDB *channeldb.DB) channeldb.ForwardingLogDB {
+
+	return chanStateDB.ForwardingLog()
+}
+
 // getSQLMigration returns a migration function for the given version.
 //
 // NOTE: this is a no-op for the production build since all migrations that are
 // in production will also be in development builds, and so they are not
 // defined behind a build tag.
 func getSQLMigration(ctx context.Context, version int,
-	kvBackend kvdb.Backend,
+	chanStateDB *channeldb.DB,
 	chain chainhash.Hash) (func(tx *sqlc.Queries) error, bool) {
 
 	return nil, false
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
 	graphdb "github.com/lightningnetwork/lnd/graph/db"
 	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/sqldb"
@@ -39,20 +40,71 @@ func (d *DefaultDatabaseBuilder) getGraphStore(baseDB *sqldb.BaseDB,
 	)
 }
 
-// graphSQLMigration is the version number for the graph migration
-// that migrates the KV graph to the native SQL schema.
-const graphSQLMigration = 9
+// getPaymentStore returns a channeldb.PaymentDB backed by a
+// channeldb.PaymentSQLStore implementation.
+func (d *DefaultDatabaseBuilder) getPaymentStore(baseDB *sqldb.BaseDB,
+	_ *channeldb.DB) channeldb.PaymentDB {
+
+	paymentExecutor := sqldb.NewTransactionExecutor(
+		baseDB, func(tx *sql.Tx) channeldb.SQLPaymentQueries {
+			return baseDB.WithTx(tx)
+		},
+	)
+
+	return channeldb.NewPaymentSQLStore(
+		paymentExecutor, channeldb.WithKeepFailedPaymentAttempts(
+			d.cfg.KeepFailedPaymentAttempts,
+		),
+	)
+}
+
+// getForwardingLogStore returns a channeldb.ForwardingLogDB backed by a
+// channeldb.ForwardingLogSQLStore implementation.
+func (d *DefaultDatabaseBuilder) getForwardingLogStore(baseDB *sqldb.BaseDB,
+	_ *channeldb.DB) channeldb.ForwardingLogDB {
+
+	fwdLogExecutor := sqldb.NewTransactionExecutor(
+		baseDB, func(tx *sql.Tx) channeldb.SQLForwardingLogQueries {
+			return baseDB.WithTx(tx)
+		},
+	)
+
+	return channeldb.NewForwardingLogSQLStore(fwdLogExecutor)
+}
+
+const (
+	// graphSQLMigration is the version number for the graph migration
+	// that migrates the KV graph to the native SQL schema.
+	graphSQLMigration = 9
+
+	// paymentSQLMigration is the version number for the payments
+	// migration that migrates the KV payments to the native SQL schema.
+	paymentSQLMigration = 11
+
+	// paymentMigrationBatchSize is the number of payments that will be
+	// migrated in a single batch.
+	paymentMigrationBatchSize = 1000
+
+	// forwardingLogSQLMigration is the version number for the forwarding
+	// log migration that migrates the KV forwarding events to the native
+	// SQL schema.
+	forwardingLogSQLMigration = 13
+
+	// forwardingLogMigrationBatchSize is the number of forwarding events
+	// that will be migrated in a single batch.
+	forwardingLogMigrationBatchSize = 10000
+)
 
 // getSQLMigration returns a migration function for the given version.
 func getSQLMigration(ctx context.Context, version int,
-	kvBackend kvdb.Backend,
+	chanStateDB *channeldb.DB,
 	chain chainhash.Hash) (func(tx *sqlc.Queries) error, bool) {
 
 	switch version {
 	case graphSQLMigration:
 		return func(tx *sqlc.Queries) error {
 			err := graphdb.MigrateGraphToSQL(
-				ctx, kvBackend, tx, chain,
+				ctx, chanStateDB.Backend, tx, chain,
 			)
 			if err != nil {
 				return fmt.Errorf("failed to migrate graph "+
@@ -61,6 +113,40 @@ func getSQLMigration(ctx context.Context, version int,
 
 			return nil
 		}, true
+
+	case paymentSQLMigration:
+		return func(tx *sqlc.Queries) error {
+			err := channeldb.MigratePaymentsToSQL(
+				ctx, chanStateDB.Backend, tx,
+				paymentMigrationBatchSize,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to migrate payments "+
+					"to SQL: %w", err)
+			}
+
+			// Set the payments tombstone to indicate that the
+			// migration has been completed and the KV payments
+			// store must not be used anymore.
+			return chanStateDB.SetPaymentsTombstone()
+		}, true
+
+	case forwardingLogSQLMigration:
+		return func(tx *sqlc.Queries) error {
+			err := channeldb.MigrateForwardingLogToSQL(
+				ctx, chanStateDB.Backend, tx,
+				forwardingLogMigrationBatchSize,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to migrate "+
+					"forwarding log to SQL: %w", err)
+			}
+
+			// Set the forwarding log tombstone to indicate that
+			// the migration has been completed and the KV
+			// forwarding log must not be used anymore.
+			return chanStateDB.SetForwardingLogTombstone()
+		}, true
 	}
 
 	// No version was matched, so we return false to indicate that no
@@ -2,6 +2,7 @@ package contractcourt
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"slices"
 	"sync"
@@ -313,9 +314,11 @@ func newChainWatcher(cfg chainWatcherConfig) (*chainWatcher, error) {
 	heightHint := chanState.DeriveHeightHint()
 
 	// We'll register for a notification to be dispatched if the funding
-	// output is spent.
+	// output is spent. If the channel was spliced, this is the output of
+	// the latest splice transaction.
+	fundingOutpoint := chanState.CurrentFundingOutpoint()
 	spendNtfn, err := cfg.notifier.RegisterSpendNtfn(
-		&chanState.FundingOutpoint, fundingPkScript, heightHint,
+		&fundingOutpoint, fundingPkScript, heightHint,
 	)
 	if err != nil {
 		return nil, err
@@ -652,7 +655,12 @@ func newChainSet(chanState *channeldb.OpenChannel) (*chainSet, error) {
 // notifications to all subscribers.
 func (c *chainWatcher) closeObserver() {
 	defer c.wg.Done()
-	defer c.fundingSpendNtfn.Cancel()
+
+	// The spend subscription is replaced once the channel is spliced, so
+	// we make sure to cancel the latest one.
+	defer func() {
+		c.fundingSpendNtfn.Cancel()
+	}()
 
 	log.Infof("Close observer for ChannelPoint(%v) active",
 		c.cfg.chanState.FundingOutpoint)
@@ -1364,6 +1372,16 @@ func (c *chainWatcher) handleCommitSpend(
 
 	commitTxBroadcast := commitSpend.SpendingTx
 
+	// If the funding output was spent by a splice transaction, the channel
+	// lives on with the output of the splice as its new funding output.
+	ok, err := c.handleSpliceSpend(commitSpend)
+	if err != nil {
+		return fmt.Errorf("handle splice spend: %w", err)
+	}
+	if ok {
+		return nil
+	}
+
 	// First, we'll construct the chainset which includes all the data we
 	// need to dispatch an event to our subscribers about this possible
 	// channel close event.
@@ -1381,7 +1399,7 @@ func (c *chainWatcher) handleCommitSpend(
 
 	// We'll go on to check whether it could be our own commitment that was
 	// published and know is confirmed.
-	ok, err := c.handleKnownLocalState(
+	ok, err = c.handleKnownLocalState(
 		commitSpend, broadcastStateNum, chainSet,
 	)
 	if err != nil {
@@ -1458,6 +1476,71 @@ func (c *chainWatcher) handleCommitSpend(
 	return nil
 }
 
+// handleSpliceSpend checks whether the funding output was spent by the
+// splice transaction of the channel. If so, the splice is applied to the
+// channel, unless that happened already, and we start watching the new funding
+// output.
+func (c *chainWatcher) handleSpliceSpend(
+	spend *chainntnfs.SpendDetail) (bool, error) {
+
+	chanState := c.cfg.chanState
+
+	splice, err := chanState.PendingSplice()
+	switch {
+	// The splice may have been applied by the peer already once it
+	// confirmed, in which case the channel state on disk points to the
+	// funding output created by the spending transaction.
+	case errors.Is(err, channeldb.ErrNoPendingSplice):
+		if err := chanState.Refresh(); err != nil {
+			log.Debugf("Unable to refresh ChannelPoint(%v): %v",
+				chanState.FundingOutpoint, err)
+
+			return false, nil
+		}
+
+		spliceOutpoint := chanState.CurrentFundingOutpoint()
+		if spliceOutpoint.Hash != *spend.SpenderTxHash {
+			return false, nil
+		}
+
+	case err != nil:
+		return false, err
+
+	case splice.SpliceTx.TxHash() != *spend.SpenderTxHash:
+		return false, nil
+
+	default:
+		if err := chanState.ApplySplice(); err != nil {
+			return false, fmt.Errorf("unable to apply splice: %w",
+				err)
+		}
+	}
+
+	fundingOutpoint := chanState.CurrentFundingOutpoint()
+
+	log.Infof("ChannelPoint(%v) was spliced in tx %v at height %v, new "+
+		"funding outpoint: %v", chanState.FundingOutpoint,
+		spend.SpenderTxHash, spend.SpendingHeight, fundingOutpoint)
+
+	fundingPkScript, err := deriveFundingPkScript(chanState)
+	if err != nil {
+		return false, err
+	}
+
+	spendNtfn, err := c.cfg.notifier.RegisterSpendNtfn(
+		&fundingOutpoint, fundingPkScript,
+		uint32(spend.SpendingHeight),
+	)
+	if err != nil {
+		return false, err
+	}
+
+	c.fundingSpendNtfn.Cancel()
+	c.fundingSpendNtfn = spendNtfn
+
+	return true, nil
+}
+
 // checkFundingSpend performs a non-blocking read on the spendNtfn channel to
 // check whether there's a commit spend already. Returns the spend details if
 // found.
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/chainio"
 	"github.com/lightningnetwork/lnd/chainntnfs"
@@ -713,3 +714,105 @@ func TestChainWatcherLocalForceCloseDetect(t *testing.T) {
 		})
 	}
 }
+
+// TestChainWatcherSplice tests that the chain watcher applies a pending splice
+// once the splice transaction spends the funding output, and afterwards
+// detects closes of the channel that spend the new funding output.
+func TestChainWatcherSplice(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit,
+	)
+	require.NoError(t, err, "unable to create test channels")
+
+	// Alice splices some funds out of the channel. We only need both
+	// parties' view of the splice commitments, so the splice transaction
+	// just spends the funding output.
+	const contribution = -btcutil.Amount(100_000)
+	aliceState := aliceChannel.State()
+	chanPoint := aliceState.FundingOutpoint
+
+	fundingOutput, err := aliceChannel.SpliceFundingOutput(
+		aliceState.Capacity + contribution,
+	)
+	require.NoError(t, err)
+
+	spliceTx := wire.NewMsgTx(2)
+	spliceTx.AddTxIn(wire.NewTxIn(&chanPoint, []byte{}, nil))
+	spliceTx.AddTxOut(&fundingOutput)
+	spliceOutpoint := wire.OutPoint{Hash: spliceTx.TxHash()}
+
+	aliceSplice, err := aliceChannel.NewSpliceCommitments(
+		spliceOutpoint, fundingOutput, contribution, 0,
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSpliceCommitments(
+		spliceOutpoint, fundingOutput, 0, contribution,
+	)
+	require.NoError(t, err)
+
+	err = aliceState.StorePendingSplice(aliceSplice.PendingSplice(
+		spliceTx, 100,
+	))
+	require.NoError(t, err)
+
+	aliceNotifier := &lnmock.ChainNotifier{
+		SpendChan: make(chan *chainntnfs.SpendDetail, 1),
+		EpochChan: make(chan *chainntnfs.BlockEpoch),
+		ConfChan:  make(chan *chainntnfs.TxConfirmation),
+	}
+	aliceChainWatcher, err := newChainWatcher(chainWatcherConfig{
+		chanState:           aliceState,
+		notifier:            aliceNotifier,
+		signer:              aliceChannel.Signer,
+		extractStateNumHint: lnwallet.GetStateNumHint,
+	})
+	require.NoError(t, err, "unable to create chain watcher")
+	require.NoError(t, aliceChainWatcher.Start())
+	defer aliceChainWatcher.Stop()
+
+	chanEvents := aliceChainWatcher.SubscribeChannelEvents()
+
+	// The splice transaction confirms, which must not be mistaken for a
+	// close of the channel.
+	spliceHash := spliceTx.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash:  &spliceHash,
+		SpendingTx:     spliceTx,
+		SpendingHeight: 101,
+	}
+
+	require.Eventually(t, func() bool {
+		return aliceState.CurrentFundingOutpoint() == spliceOutpoint
+	}, 5*time.Second, 10*time.Millisecond)
+
+	select {
+	case <-chanEvents.RemoteUnilateralClosure:
+		t.Fatalf("unexpected close event")
+	case <-chanEvents.CooperativeClosure:
+		t.Fatalf("unexpected close event")
+	default:
+	}
+
+	// Bob now broadcasts his commitment that spends the splice output,
+	// which must be detected as a remote unilateral close.
+	bobCommit := bobSplice.LocalCommitment.CommitTx
+	bobTxHash := bobCommit.TxHash()
+	aliceNotifier.SpendChan <- &chainntnfs.SpendDetail{
+		SpenderTxHash:  &bobTxHash,
+		SpendingTx:     bobCommit,
+		SpendingHeight: 110,
+	}
+
+	select {
+	case uniClose := <-chanEvents.RemoteUnilateralClosure:
+		require.NotNil(t, uniClose.CommitResolution)
+		require.Equal(
+			t, chanPoint, uniClose.ChannelCloseSummary.ChanPoint,
+		)
+
+	case <-time.After(time.Second * 15):
+		t.Fatalf("didn't receive unilateral close event")
+	}
+}
@@ -46,14 +46,14 @@ type commitSweepResolver struct {
 	// chanPoint is the channel point of the original contract.
 	chanPoint wire.OutPoint
 
-	// channelInitiator denotes whether the party responsible for resolving
-	// the contract initiated the channel.
-	channelInitiator bool
+	// leaseSeller denotes whether the party responsible for resolving the
+	// contract sold the lease of a leased channel.
+	leaseSeller bool
 
 	// leaseExpiry denotes the additional waiting period the contract must
 	// hold until it can be resolved. This waiting period is known as the
 	// expiration of a script-enforced leased channel and only applies to
-	// the channel initiator.
+	// the seller of the lease.
 	//
 	// NOTE: This value should only be set when the contract belongs to a
 	// leased channel.
@@ -246,14 +246,14 @@ func (c *commitSweepResolver) SupplementState(state *channeldb.OpenChannel) {
 		c.leaseExpiry = state.ThawHeight
 	}
 	c.localChanCfg = state.LocalChanCfg
-	c.channelInitiator = state.IsInitiator
+	c.leaseSeller = state.ChanType.IsLeaseSeller(state.IsInitiator)
 	c.chanType = state.ChanType
 }
 
 // hasCLTV denotes whether the resolver must wait for an additional CLTV to
 // expire before resolving the contract.
 func (c *commitSweepResolver) hasCLTV() bool {
-	return c.channelInitiator && c.leaseExpiry > 0
+	return c.leaseSeller && c.leaseExpiry > 0
 }
 
 // Encode writes an encoded version of the ContractResolver into the passed
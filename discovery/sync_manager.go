@@ -937,6 +937,29 @@ func (m *SyncManager) gossipSyncers() map[route.Vertex]*GossipSyncer {
 	return syncers
 }
 
+// SyncerStates returns the number of gossip syncers of each sync type that are
+// in each state, including the pinned ones.
+func (m *SyncManager) SyncerStates() map[SyncerType]map[string]int {
+	m.syncersMu.Lock()
+	defer m.syncersMu.Unlock()
+
+	states := make(map[SyncerType]map[string]int)
+	count := func(syncers map[route.Vertex]*GossipSyncer) {
+		for _, syncer := range syncers {
+			syncType := syncer.SyncType()
+			if states[syncType] == nil {
+				states[syncType] = make(map[string]int)
+			}
+			states[syncType][syncer.syncState().String()]++
+		}
+	}
+	count(m.inactiveSyncers)
+	count(m.activeSyncers)
+	count(m.pinnedActiveSyncers)
+
+	return states
+}
+
 // markGraphSynced allows us to report that the initial historical sync has
 // completed.
 func (m *SyncManager) markGraphSynced() {
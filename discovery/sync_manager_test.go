@@ -125,6 +125,13 @@ func TestSyncManagerNumActiveSyncers(t *testing.T) {
 		s := assertSyncerExistence(t, syncMgr, peer)
 		assertSyncerStatus(t, s, chansSynced, PassiveSync)
 	}
+
+	// The syncers of each type are reported in their current state.
+	require.Equal(t, map[SyncerType]map[string]int{
+		PinnedSync:  {chansSynced.String(): numPinnedSyncers},
+		ActiveSync:  {chansSynced.String(): numActiveSyncers},
+		PassiveSync: {chansSynced.String(): numInactiveSyncers},
+	}, syncMgr.SyncerStates())
 }
 
 // TestSyncManagerNewActiveSyncerAfterDisconnect ensures that we can regain an
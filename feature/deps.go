@@ -90,6 +90,12 @@ var deps = depDesc{
 	lnwire.Bolt11BlindedPathsOptional: {
 		lnwire.RouteBlindingOptional: {},
 	},
+	lnwire.SpliceOptionalStaging: {
+		lnwire.QuiescenceOptional: {},
+	},
+	lnwire.DynamicCommitmentsOptionalStaging: {
+		lnwire.QuiescenceOptional: {},
+	},
 }
 
 // ValidateDeps asserts that a feature vector sets all features and their
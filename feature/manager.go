@@ -73,10 +73,27 @@ type Config struct {
 	// forwarding experimental endorsement.
 	NoExperimentalEndorsement bool
 
+	// NoOnionMessages unsets any bits that signal support for forwarding
+	// onion messages.
+	NoOnionMessages bool
+
 	// NoRbfCoopClose unsets any bits that signal support for using RBF for
 	// coop close.
 	NoRbfCoopClose bool
 
+	// NoSplicing unsets any bits that signal support for splicing funds
+	// into and out of existing channels.
+	NoSplicing bool
+
+	// NoDynamicCommitments unsets any bits that signal support for
+	// changing the parameters of existing channels with dynamic
+	// commitments.
+	NoDynamicCommitments bool
+
+	// NoDualFunding unsets any bits that signal support for opening
+	// channels with the v2 channel establishment protocol.
+	NoDualFunding bool
+
 	// CustomFeatures is a set of custom features to advertise in each
 	// set.
 	CustomFeatures map[Set][]lnwire.FeatureBit
@@ -208,6 +225,14 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 		}
 		if cfg.NoQuiescence {
 			raw.Unset(lnwire.QuiescenceOptional)
+
+			// Splicing and dynamic commitments are built on top
+			// of quiescence, so they can't be supported without
+			// it.
+			raw.Unset(lnwire.SpliceOptionalStaging)
+			raw.Unset(lnwire.SpliceRequiredStaging)
+			raw.Unset(lnwire.DynamicCommitmentsOptionalStaging)
+			raw.Unset(lnwire.DynamicCommitmentsRequiredStaging)
 		}
 		if cfg.NoTaprootOverlay {
 			raw.Unset(lnwire.SimpleTaprootOverlayChansOptional)
@@ -217,10 +242,26 @@ func newManager(cfg Config, desc setDesc) (*Manager, error) {
 			raw.Unset(lnwire.ExperimentalEndorsementOptional)
 			raw.Unset(lnwire.ExperimentalEndorsementRequired)
 		}
+		if cfg.NoOnionMessages {
+			raw.Unset(lnwire.OnionMessagesOptional)
+			raw.Unset(lnwire.OnionMessagesRequired)
+		}
 		if cfg.NoRbfCoopClose {
 			raw.Unset(lnwire.RbfCoopCloseOptionalStaging)
 			raw.Unset(lnwire.RbfCoopCloseOptional)
 		}
+		if cfg.NoSplicing {
+			raw.Unset(lnwire.SpliceOptionalStaging)
+			raw.Unset(lnwire.SpliceRequiredStaging)
+		}
+		if cfg.NoDynamicCommitments {
+			raw.Unset(lnwire.DynamicCommitmentsOptionalStaging)
+			raw.Unset(lnwire.DynamicCommitmentsRequiredStaging)
+		}
+		if cfg.NoDualFunding {
+			raw.Unset(lnwire.DualFundOptional)
+			raw.Unset(lnwire.DualFundRequired)
+		}
 
 		for _, custom := range cfg.CustomFeatures[set] {
 			if custom > set.Maximum() {
@@ -0,0 +1,604 @@
+package feeautopilot
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultInterval is the default time between two runs of the fee
+	// autopilot.
+	DefaultInterval = time.Hour
+
+	// DefaultFlowWindow is the default time window of the forwarding
+	// history the payment flow of a channel is computed over.
+	DefaultFlowWindow = 24 * time.Hour
+
+	// DefaultMinUpdateInterval is the default minimum time between two
+	// policy updates of the same channel.
+	DefaultMinUpdateInterval = 6 * time.Hour
+
+	// DefaultMinRelativeChange is the default minimum relative change of a
+	// fee rate that warrants a policy update.
+	DefaultMinRelativeChange = 0.1
+
+	// DefaultMaxUpdatesPerRun is the default maximum number of channel
+	// policies that are updated in a single run.
+	DefaultMaxUpdatesPerRun = 10
+)
+
+var (
+	// ErrInactive is returned when the fee autopilot is asked to apply
+	// fee rates while it isn't active.
+	ErrInactive = errors.New("fee autopilot is not active")
+)
+
+// LocalChannel is one of our channels along with its current forwarding
+// policy.
+type LocalChannel struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel id of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// Policy is our current policy of the channel.
+	Policy *models.ChannelEdgePolicy
+}
+
+// Config holds the configuration of the fee autopilot.
+type Config struct {
+	// Active determines whether the fee autopilot applies the fee rates
+	// of its strategy when it's started.
+	Active bool
+
+	// Strategy computes the fee rates of the channels.
+	Strategy Strategy
+
+	// FlowWindow is the time window of the forwarding history the payment
+	// flow of a channel is computed over.
+	FlowWindow time.Duration
+
+	// MinUpdateInterval is the minimum time between two policy updates of
+	// the same channel.
+	MinUpdateInterval time.Duration
+
+	// MinRelativeChange is the minimum relative change of the outbound or
+	// inbound fee rate of a channel that warrants a policy update.
+	MinRelativeChange float64
+
+	// MaxUpdatesPerRun is the maximum number of channel policies that are
+	// updated in a single run. The channels whose fee rates change the
+	// most are updated first.
+	MaxUpdatesPerRun int
+
+	// FetchChannels returns our open channels along with their current
+	// policies.
+	FetchChannels func(context.Context) ([]LocalChannel, error)
+
+	// ForwardingLog is the forwarding history the payment flow is
+	// computed from.
+	ForwardingLog channeldb.ForwardingLogDB
+
+	// SubscribeHtlcEvents subscribes to the htlc events of the switch,
+	// which report the HTLCs that failed for lack of balance.
+	SubscribeHtlcEvents func() (*subscribe.Client, error)
+
+	// UpdatePolicy updates the policy of a channel and announces it.
+	UpdatePolicy func(context.Context, wire.OutPoint,
+		routing.ChannelPolicy) error
+
+	// Ticker determines how often the fee autopilot runs.
+	Ticker ticker.Ticker
+
+	// Clock is used to determine the forwarding history window and the
+	// time since the last policy update of a channel.
+	Clock clock.Clock
+}
+
+// Validate checks that the fee autopilot config is usable.
+func (c *Config) Validate() error {
+	if c.Strategy == nil {
+		return errors.New("fee strategy must be set")
+	}
+
+	if err := c.Strategy.Validate(); err != nil {
+		return err
+	}
+
+	if c.FlowWindow <= 0 {
+		return errors.New("flow window must be positive")
+	}
+
+	if c.MinUpdateInterval < 0 {
+		return errors.New("min update interval must not be negative")
+	}
+
+	if c.MinRelativeChange < 0 {
+		return errors.New("min relative change must not be negative")
+	}
+
+	if c.MaxUpdatesPerRun <= 0 {
+		return errors.New("max updates per run must be positive")
+	}
+
+	return nil
+}
+
+// Recommendation holds the fee rates the strategy recommends for a channel.
+type Recommendation struct {
+	ChannelState
+
+	// NewRates are the recommended fee rates.
+	NewRates FeeRates
+
+	// RateLimited is true if the recommended fee rates are held back to
+	// limit the number of channel updates that are gossiped.
+	RateLimited bool
+
+	// policy is the current policy of the channel.
+	policy *models.ChannelEdgePolicy
+}
+
+// Changed returns true if the recommended fee rates differ from the current
+// ones.
+func (r *Recommendation) Changed() bool {
+	return r.NewRates != r.Rates
+}
+
+// relativeChange returns the larger of the relative changes of the outbound
+// and the inbound fee rate.
+func (r *Recommendation) relativeChange() float64 {
+	change := func(old, new float64) float64 {
+		return math.Abs(new-old) / math.Max(math.Abs(old), 1)
+	}
+
+	return math.Max(
+		change(float64(r.Rates.FeeRate), float64(r.NewRates.FeeRate)),
+		change(
+			float64(r.Rates.InboundFeeRate),
+			float64(r.NewRates.InboundFeeRate),
+		),
+	)
+}
+
+// Manager periodically computes the fee rates of our channels from their
+// liquidity and payment flow, and updates the channel policies accordingly.
+// The updates are rate limited, so that we don't spam the network with
+// channel updates.
+type Manager struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *Config
+
+	// runMu ensures that only one run applies fee rates at a time.
+	runMu sync.Mutex
+
+	// mu protects the fields below.
+	mu       sync.Mutex
+	active   bool
+	strategy Strategy
+
+	// balanceFailures counts the HTLCs per channel that failed for lack of
+	// outbound liquidity since the last run.
+	balanceFailures map[lnwire.ShortChannelID]uint64
+
+	lastRun    time.Time
+	numUpdates uint64
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewManager creates a new fee autopilot.
+func NewManager(cfg *Config) (*Manager, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Manager{
+		cfg:             cfg,
+		active:          cfg.Active,
+		strategy:        cfg.Strategy,
+		balanceFailures: make(map[lnwire.ShortChannelID]uint64),
+		quit:            make(chan struct{}),
+	}, nil
+}
+
+// Start starts the fee autopilot.
+func (m *Manager) Start() error {
+	if !m.started.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Fee autopilot starting")
+
+	client, err := m.cfg.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+
+	m.cfg.Ticker.Resume()
+
+	m.wg.Add(2)
+	go m.htlcEventLoop(client)
+	go m.runLoop()
+
+	return nil
+}
+
+// Stop stops the fee autopilot.
+func (m *Manager) Stop() error {
+	if !m.stopped.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Fee autopilot shutting down...")
+	defer log.Debug("Fee autopilot shutdown complete")
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.cfg.Ticker.Stop()
+
+	return nil
+}
+
+// IsActive returns whether the fee autopilot applies the fee rates of its
+// strategy.
+func (m *Manager) IsActive() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.active
+}
+
+// SetActive enables or disables applying the fee rates of the strategy. While
+// disabled, the fee autopilot still tracks the payment flow and can be asked
+// for recommendations.
+func (m *Manager) SetActive(active bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	log.Infof("Setting fee autopilot active=%v", active)
+
+	m.active = active
+}
+
+// Strategy returns the current fee strategy.
+func (m *Manager) Strategy() Strategy {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.strategy
+}
+
+// SetStrategy replaces the fee strategy. It is used from the next run on.
+func (m *Manager) SetStrategy(strategy Strategy) error {
+	if err := strategy.Validate(); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	log.Infof("Setting fee strategy to %v", strategy.Name())
+
+	m.strategy = strategy
+
+	return nil
+}
+
+// LastRun returns the time the fee rates were last applied. It is zero if
+// they haven't been applied yet.
+func (m *Manager) LastRun() time.Time {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.lastRun
+}
+
+// NumUpdates returns the number of channel policies that were updated since
+// the fee autopilot was started.
+func (m *Manager) NumUpdates() uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.numUpdates
+}
+
+// htlcEventLoop counts the HTLCs that failed for lack of outbound liquidity.
+func (m *Manager) htlcEventLoop(client *subscribe.Client) {
+	defer m.wg.Done()
+	defer client.Cancel()
+
+	for {
+		select {
+		case e := <-client.Updates():
+			event, ok := e.(*htlcswitch.LinkFailEvent)
+			if !ok || event.Incoming || event.LinkError == nil {
+				continue
+			}
+
+			insufficientBalance := event.LinkError.FailureDetail ==
+				htlcswitch.OutgoingFailureInsufficientBalance
+			if !insufficientBalance {
+				continue
+			}
+
+			m.mu.Lock()
+			m.balanceFailures[event.OutgoingCircuit.ChanID]++
+			m.mu.Unlock()
+
+		case <-client.Quit():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// runLoop applies the fee rates of the strategy on every tick of the ticker.
+func (m *Manager) runLoop() {
+	defer m.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			if !m.IsActive() {
+				continue
+			}
+
+			if _, err := m.run(ctx); err != nil {
+				log.Errorf("Unable to apply fee rates: %v", err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// Recommendations returns the fee rates the strategy recommends for each of
+// our channels, without applying them.
+func (m *Manager) Recommendations(
+	ctx context.Context) ([]Recommendation, error) {
+
+	m.mu.Lock()
+	strategy := m.strategy
+	failures := make(map[lnwire.ShortChannelID]uint64, len(
+		m.balanceFailures,
+	))
+	for chanID, n := range m.balanceFailures {
+		failures[chanID] = n
+	}
+	m.mu.Unlock()
+
+	return m.recommendations(ctx, strategy, failures)
+}
+
+// recommendations computes the fee rates of our channels with the given
+// strategy.
+func (m *Manager) recommendations(ctx context.Context, strategy Strategy,
+	failures map[lnwire.ShortChannelID]uint64) ([]Recommendation, error) {
+
+	now := m.cfg.Clock.Now()
+
+	channels, err := m.cfg.FetchChannels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := m.queryFlows(now)
+	if err != nil {
+		return nil, err
+	}
+
+	recs := make([]Recommendation, 0, len(channels))
+	for _, channel := range channels {
+		policy := channel.Policy
+		inboundFee := policy.InboundFee.UnwrapOr(lnwire.Fee{})
+
+		state := ChannelState{
+			ChanPoint:    channel.ChanPoint,
+			ChanID:       channel.ChanID,
+			Capacity:     channel.Capacity,
+			LocalBalance: channel.LocalBalance,
+			Rates: FeeRates{
+				FeeRate: uint32(
+					policy.FeeProportionalMillionths,
+				),
+				InboundFeeRate: inboundFee.FeeRate,
+			},
+		}
+		if flow, ok := flows[channel.ChanID]; ok {
+			state.Flow = *flow
+		}
+		state.Flow.InsufficientBalanceFailures =
+			failures[channel.ChanID]
+
+		rec := Recommendation{
+			ChannelState: state,
+			NewRates:     strategy.FeeRates(&state),
+			policy:       policy,
+		}
+
+		// Small changes and channels that were updated recently are
+		// held back, so that we don't spam the network.
+		sinceUpdate := now.Sub(policy.LastUpdate)
+		rec.RateLimited = rec.Changed() &&
+			(sinceUpdate < m.cfg.MinUpdateInterval ||
+				rec.relativeChange() < m.cfg.MinRelativeChange)
+
+		recs = append(recs, rec)
+	}
+
+	return recs, nil
+}
+
+// queryFlows returns the payment flow per channel within the flow window.
+func (m *Manager) queryFlows(
+	now time.Time) (map[lnwire.ShortChannelID]*ChannelFlow, error) {
+
+	flows := make(map[lnwire.ShortChannelID]*ChannelFlow)
+	flow := func(chanID lnwire.ShortChannelID) *ChannelFlow {
+		f, ok := flows[chanID]
+		if !ok {
+			f = &ChannelFlow{}
+			flows[chanID] = f
+		}
+
+		return f
+	}
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-m.cfg.FlowWindow),
+		EndTime:      now,
+		NumMaxEvents: channeldb.MaxResponseEvents,
+	}
+	for {
+		timeSlice, err := m.cfg.ForwardingLog.Query(query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			incoming := flow(event.IncomingChanID)
+			incoming.IncomingAmt += event.AmtIn
+			incoming.NumIncoming++
+
+			outgoing := flow(event.OutgoingChanID)
+			outgoing.OutgoingAmt += event.AmtOut
+			outgoing.NumOutgoing++
+		}
+
+		numEvents := len(timeSlice.ForwardingEvents)
+		if numEvents < int(query.NumMaxEvents) {
+			return flows, nil
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// Run applies the fee rates of the strategy right away. It returns the number
+// of channel policies that were updated.
+func (m *Manager) Run(ctx context.Context) (int, error) {
+	if !m.IsActive() {
+		return 0, ErrInactive
+	}
+
+	return m.run(ctx)
+}
+
+// run applies the fee rates of the strategy to the channels whose updates
+// aren't held back. It returns the number of channel policies that were
+// updated.
+func (m *Manager) run(ctx context.Context) (int, error) {
+	m.runMu.Lock()
+	defer m.runMu.Unlock()
+
+	// The failures are consumed by this run, so that a channel's fee rate
+	// is only raised again if it keeps failing HTLCs.
+	m.mu.Lock()
+	strategy := m.strategy
+	failures := m.balanceFailures
+	m.balanceFailures = make(map[lnwire.ShortChannelID]uint64)
+	m.mu.Unlock()
+
+	recs, err := m.recommendations(ctx, strategy, failures)
+	if err != nil {
+		return 0, err
+	}
+
+	recs = slices.DeleteFunc(recs, func(r Recommendation) bool {
+		return !r.Changed() || r.RateLimited
+	})
+	slices.SortFunc(recs, func(a, b Recommendation) int {
+		return cmp.Compare(b.relativeChange(), a.relativeChange())
+	})
+	if len(recs) > m.cfg.MaxUpdatesPerRun {
+		recs = recs[:m.cfg.MaxUpdatesPerRun]
+	}
+
+	var numUpdates int
+	for _, rec := range recs {
+		log.Debugf("Updating fee rates of channel %v from %v ppm "+
+			"(inbound %v ppm) to %v ppm (inbound %v ppm)",
+			rec.ChanPoint, rec.Rates.FeeRate,
+			rec.Rates.InboundFeeRate, rec.NewRates.FeeRate,
+			rec.NewRates.InboundFeeRate)
+
+		policy := channelPolicy(rec.policy, rec.NewRates)
+		err := m.cfg.UpdatePolicy(ctx, rec.ChanPoint, policy)
+		if err != nil {
+			log.Warnf("Unable to update policy of channel %v: %v",
+				rec.ChanPoint, err)
+
+			continue
+		}
+
+		numUpdates++
+	}
+
+	log.Infof("Updated the fee rates of %d channels", numUpdates)
+
+	m.mu.Lock()
+	m.lastRun = m.cfg.Clock.Now()
+	m.numUpdates += uint64(numUpdates)
+	m.mu.Unlock()
+
+	return numUpdates, nil
+}
+
+// channelPolicy returns the given policy with its proportional fee rates
+// replaced. All other parameters are kept.
+func channelPolicy(edge *models.ChannelEdgePolicy,
+	rates FeeRates) routing.ChannelPolicy {
+
+	inboundFee := edge.InboundFee.UnwrapOr(lnwire.Fee{})
+	minHTLC := edge.MinHTLC
+
+	return routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: edge.FeeBaseMSat,
+			FeeRate: rates.FeeRate,
+			InboundFee: fn.Some(models.InboundFee{
+				Base: inboundFee.BaseFee,
+				Rate: rates.InboundFeeRate,
+			}),
+		},
+		TimeLockDelta: uint32(edge.TimeLockDelta),
+		MaxHTLC:       edge.MaxHTLC,
+		MinHTLC:       &minHTLC,
+	}
+}
@@ -0,0 +1,218 @@
+package feeautopilot
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+// mockForwardingLog is a forwarding log that returns a fixed set of events.
+type mockForwardingLog struct {
+	channeldb.ForwardingLogDB
+
+	events []channeldb.ForwardingEvent
+}
+
+func (m *mockForwardingLog) Query(
+	q channeldb.ForwardingEventQuery) (channeldb.ForwardingLogTimeSlice,
+	error) {
+
+	return channeldb.ForwardingLogTimeSlice{
+		ForwardingEventQuery: q,
+		ForwardingEvents:     m.events,
+		LastIndexOffset:      uint32(len(m.events)),
+	}, nil
+}
+
+// testChannel returns a local channel with the given balance, fee rate and
+// time of the last policy update.
+func testChannel(index uint32, localBalance lnwire.MilliSatoshi,
+	feeRate lnwire.MilliSatoshi, lastUpdate time.Time) LocalChannel {
+
+	return LocalChannel{
+		ChanPoint:    wire.OutPoint{Index: index},
+		ChanID:       lnwire.NewShortChanIDFromInt(uint64(index)),
+		Capacity:     1_000_000,
+		LocalBalance: localBalance,
+		Policy: &models.ChannelEdgePolicy{
+			LastUpdate:                lastUpdate,
+			TimeLockDelta:             80,
+			MinHTLC:                   1000,
+			MaxHTLC:                   500_000_000,
+			FeeBaseMSat:               1000,
+			FeeProportionalMillionths: feeRate,
+			InboundFee: fn.Some(lnwire.Fee{
+				BaseFee: -10,
+			}),
+		},
+	}
+}
+
+// TestManager tests that the fee autopilot applies the fee rates of its
+// strategy, holding back the updates that would spam the network.
+func TestManager(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_000_000, 0)
+	longAgo := now.Add(-DefaultMinUpdateInterval * 2)
+
+	channels := []LocalChannel{
+		// A depleted channel that is far from its fee rate.
+		testChannel(0, 0, 100, longAgo),
+
+		// A depleted channel that is closer to its fee rate.
+		testChannel(1, 0, 900, longAgo),
+
+		// A channel at its target ratio with the matching fee rate.
+		testChannel(2, 500_000_000, 600, longAgo),
+
+		// A depleted channel that was updated recently.
+		testChannel(3, 0, 100, now.Add(-time.Minute)),
+
+		// A depleted channel whose fee rate barely changes.
+		testChannel(4, 0, 1090, longAgo),
+	}
+	channels[4].Policy.InboundFee = fn.Some(lnwire.Fee{FeeRate: -400})
+
+	htlcEvents := subscribe.NewServer()
+	require.NoError(t, htlcEvents.Start())
+	t.Cleanup(func() {
+		require.NoError(t, htlcEvents.Stop())
+	})
+
+	var (
+		mu      sync.Mutex
+		updates = make(map[wire.OutPoint]routing.ChannelPolicy)
+	)
+	fwdLog := &mockForwardingLog{
+		events: []channeldb.ForwardingEvent{{
+			IncomingChanID: channels[2].ChanID,
+			OutgoingChanID: channels[0].ChanID,
+			AmtIn:          1100,
+			AmtOut:         1000,
+		}},
+	}
+
+	testTicker := ticker.NewForce(time.Hour)
+	manager, err := NewManager(&Config{
+		Strategy: &LiquidityStrategy{
+			FeeBounds:   testBounds,
+			TargetRatio: 0.5,
+		},
+		FlowWindow:        DefaultFlowWindow,
+		MinUpdateInterval: DefaultMinUpdateInterval,
+		MinRelativeChange: DefaultMinRelativeChange,
+		MaxUpdatesPerRun:  1,
+		FetchChannels: func(context.Context) ([]LocalChannel, error) {
+			return channels, nil
+		},
+		ForwardingLog:       fwdLog,
+		SubscribeHtlcEvents: htlcEvents.Subscribe,
+		UpdatePolicy: func(_ context.Context, chanPoint wire.OutPoint,
+			policy routing.ChannelPolicy) error {
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			updates[chanPoint] = policy
+
+			return nil
+		},
+		Ticker: testTicker,
+		Clock:  clock.NewTestClock(now),
+	})
+	require.NoError(t, err)
+	require.NoError(t, manager.Start())
+	t.Cleanup(func() {
+		require.NoError(t, manager.Stop())
+	})
+
+	// Balance failures are attributed to the outgoing channel.
+	require.NoError(t, htlcEvents.SendUpdate(&htlcswitch.LinkFailEvent{
+		HtlcKey: htlcswitch.HtlcKey{
+			OutgoingCircuit: models.CircuitKey{
+				ChanID: channels[1].ChanID,
+			},
+		},
+		LinkError: htlcswitch.NewDetailedLinkError(
+			&lnwire.FailTemporaryChannelFailure{},
+			htlcswitch.OutgoingFailureInsufficientBalance,
+		),
+	}))
+
+	var recs []Recommendation
+	require.Eventually(t, func() bool {
+		recs, err = manager.Recommendations(context.Background())
+		require.NoError(t, err)
+
+		return recs[1].Flow.InsufficientBalanceFailures == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.Len(t, recs, len(channels))
+	require.EqualValues(t, 1000, recs[0].Flow.OutgoingAmt)
+	require.EqualValues(t, 1100, recs[2].Flow.IncomingAmt)
+
+	depleted := FeeRates{FeeRate: 1100, InboundFeeRate: -400}
+	require.Equal(t, depleted, recs[0].NewRates)
+	require.False(t, recs[0].RateLimited)
+	require.False(t, recs[2].Changed())
+	require.True(t, recs[3].RateLimited)
+	require.True(t, recs[4].RateLimited)
+
+	// Fee rates are only applied while the fee autopilot is active.
+	_, err = manager.Run(context.Background())
+	require.ErrorIs(t, err, ErrInactive)
+
+	manager.SetActive(true)
+	numUpdates, err := manager.Run(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, numUpdates)
+
+	// Only the channel with the largest change is updated, and all its
+	// other policy parameters are kept.
+	mu.Lock()
+	require.Len(t, updates, 1)
+	policy, ok := updates[channels[0].ChanPoint]
+	mu.Unlock()
+	require.True(t, ok)
+
+	minHTLC := lnwire.MilliSatoshi(1000)
+	require.Equal(t, routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee: 1000,
+			FeeRate: 1100,
+			InboundFee: fn.Some(models.InboundFee{
+				Base: -10,
+				Rate: -400,
+			}),
+		},
+		TimeLockDelta: 80,
+		MaxHTLC:       500_000_000,
+		MinHTLC:       &minHTLC,
+	}, policy)
+
+	// The next tick updates the policies again.
+	testTicker.Force <- now
+	require.Eventually(t, func() bool {
+		return manager.NumUpdates() == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, now, manager.LastRun())
+
+	// The run consumed the balance failures.
+	recs, err = manager.Recommendations(context.Background())
+	require.NoError(t, err)
+	require.Zero(t, recs[1].Flow.InsufficientBalanceFailures)
+}
@@ -0,0 +1,321 @@
+package feeautopilot
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// StrategyLiquidity is the name of the strategy that sets the fee
+	// rates of a channel based on its local liquidity ratio.
+	StrategyLiquidity = "liquidity"
+
+	// StrategyDemand is the name of the strategy that adjusts the fee
+	// rates of a channel based on the demand for its liquidity.
+	StrategyDemand = "demand"
+
+	// DefaultMinFeeRate is the default minimum outbound fee rate in parts
+	// per million.
+	DefaultMinFeeRate = 1
+
+	// DefaultMaxFeeRate is the default maximum outbound fee rate in parts
+	// per million.
+	DefaultMaxFeeRate = 2500
+
+	// DefaultMaxInboundDiscount is the default maximum inbound discount in
+	// parts per million.
+	DefaultMaxInboundDiscount = 500
+
+	// DefaultTargetRatio is the default share of a channel's capacity
+	// that the liquidity strategy aims to keep on our side.
+	DefaultTargetRatio = 0.5
+
+	// DefaultDemandStep is the default relative step by which the demand
+	// strategy adjusts the fee rates.
+	DefaultDemandStep = 0.1
+)
+
+// FeeRates are the proportional fee rates of a channel.
+type FeeRates struct {
+	// FeeRate is the outbound fee rate in parts per million.
+	FeeRate uint32
+
+	// InboundFeeRate is the inbound fee rate in parts per million. A
+	// negative rate is a discount for HTLCs coming in through the channel.
+	InboundFeeRate int32
+}
+
+// ChannelFlow is the payment flow through a channel.
+type ChannelFlow struct {
+	// IncomingAmt is the amount that was received through the channel
+	// and forwarded to another channel.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount that was forwarded out through the
+	// channel.
+	OutgoingAmt lnwire.MilliSatoshi
+
+	// NumIncoming is the number of forwards received through the
+	// channel.
+	NumIncoming uint64
+
+	// NumOutgoing is the number of forwards sent out through the channel.
+	NumOutgoing uint64
+
+	// InsufficientBalanceFailures is the number of HTLCs that couldn't be
+	// forwarded through the channel because it lacked outbound liquidity.
+	InsufficientBalanceFailures uint64
+}
+
+// ChannelState is the state of a channel that a strategy bases its fee rates
+// on.
+type ChannelState struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel id of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance lnwire.MilliSatoshi
+
+	// Rates are the current fee rates of the channel.
+	Rates FeeRates
+
+	// Flow is the recent payment flow through the channel.
+	Flow ChannelFlow
+}
+
+// localRatio returns the share of the channel's capacity that is on our side.
+func (c *ChannelState) localRatio() float64 {
+	if c.Capacity == 0 {
+		return 0
+	}
+
+	capacity := lnwire.NewMSatFromSatoshis(c.Capacity)
+
+	return min(float64(c.LocalBalance)/float64(capacity), 1)
+}
+
+// Strategy computes the fee rates of a channel.
+type Strategy interface {
+	// Name returns the name of the strategy.
+	Name() string
+
+	// Validate checks that the parameters of the strategy are sane.
+	Validate() error
+
+	// FeeRates returns the fee rates the channel should have.
+	FeeRates(ch *ChannelState) FeeRates
+}
+
+// FeeBounds are the bounds of the fee rates a strategy may set.
+type FeeBounds struct {
+	// MinFeeRate is the minimum outbound fee rate in parts per million.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the maximum outbound fee rate in parts per million.
+	MaxFeeRate uint32
+
+	// MaxInboundDiscount is the maximum inbound discount in parts per
+	// million. Inbound fee rates are kept between minus this value and
+	// zero, so that senders that don't know about inbound fees are never
+	// charged more than they expect.
+	MaxInboundDiscount uint32
+}
+
+// Validate checks that the fee bounds are sane.
+func (b *FeeBounds) Validate() error {
+	if b.MinFeeRate > b.MaxFeeRate {
+		return fmt.Errorf("min fee rate %v exceeds max fee rate %v",
+			b.MinFeeRate, b.MaxFeeRate)
+	}
+
+	if b.MaxInboundDiscount > math.MaxInt32 {
+		return fmt.Errorf("max inbound discount %v exceeds maximum",
+			b.MaxInboundDiscount)
+	}
+
+	return nil
+}
+
+// clamp returns the fee rates limited to the bounds.
+func (b *FeeBounds) clamp(feeRate, discount float64) FeeRates {
+	feeRate = math.Min(math.Max(feeRate, float64(b.MinFeeRate)),
+		float64(b.MaxFeeRate))
+	discount = math.Min(math.Max(discount, 0),
+		float64(b.MaxInboundDiscount))
+
+	return FeeRates{
+		FeeRate:        uint32(math.Round(feeRate)),
+		InboundFeeRate: -int32(math.Round(discount)),
+	}
+}
+
+// LiquidityStrategy sets the fee rates of a channel based on how far its
+// local liquidity ratio is from the target. A depleted channel gets a high
+// outbound fee rate, which slows down its depletion, and an inbound discount,
+// which attracts payments that refill it.
+type LiquidityStrategy struct {
+	FeeBounds
+
+	// TargetRatio is the share of the channel's capacity that should be
+	// on our side. At this ratio, the outbound fee rate is halfway between
+	// the bounds and no inbound discount is given.
+	TargetRatio float64
+}
+
+// A compile-time check to ensure LiquidityStrategy implements the Strategy
+// interface.
+var _ Strategy = (*LiquidityStrategy)(nil)
+
+// Name returns the name of the strategy.
+//
+// NOTE: Part of the Strategy interface.
+func (s *LiquidityStrategy) Name() string {
+	return StrategyLiquidity
+}
+
+// Validate checks that the parameters of the strategy are sane.
+//
+// NOTE: Part of the Strategy interface.
+func (s *LiquidityStrategy) Validate() error {
+	if s.TargetRatio <= 0 || s.TargetRatio >= 1 {
+		return fmt.Errorf("target ratio %v must be in the range (0, 1)",
+			s.TargetRatio)
+	}
+
+	return s.FeeBounds.Validate()
+}
+
+// FeeRates returns the fee rates the channel should have.
+//
+// NOTE: Part of the Strategy interface.
+func (s *LiquidityStrategy) FeeRates(ch *ChannelState) FeeRates {
+	// The depletion of the channel runs from zero if all funds are on our
+	// side to one if all funds are on the remote side, passing one half at
+	// the target ratio.
+	ratio := ch.localRatio()
+
+	var depletion float64
+	if ratio >= s.TargetRatio {
+		depletion = 0.5 * (1 - ratio) / (1 - s.TargetRatio)
+	} else {
+		depletion = 0.5 + 0.5*(s.TargetRatio-ratio)/s.TargetRatio
+	}
+
+	feeRange := float64(s.MaxFeeRate - s.MinFeeRate)
+	feeRate := float64(s.MinFeeRate) + feeRange*depletion
+
+	// The discount only kicks in once the channel is below its target.
+	discount := float64(s.MaxInboundDiscount) * math.Max(2*depletion-1, 0)
+
+	return s.clamp(feeRate, discount)
+}
+
+// DemandStrategy adjusts the fee rates of a channel step by step based on the
+// demand for its outbound liquidity. The fee rate is raised while the channel
+// is drained or fails HTLCs for lack of balance, and lowered while nothing is
+// forwarded through it. The inbound discount follows the net flow, so that
+// drained channels attract payments that refill them.
+type DemandStrategy struct {
+	FeeBounds
+
+	// Step is the relative step by which the outbound fee rate is
+	// adjusted. The inbound discount is adjusted by this share of the
+	// maximum discount.
+	Step float64
+}
+
+// A compile-time check to ensure DemandStrategy implements the Strategy
+// interface.
+var _ Strategy = (*DemandStrategy)(nil)
+
+// Name returns the name of the strategy.
+//
+// NOTE: Part of the Strategy interface.
+func (s *DemandStrategy) Name() string {
+	return StrategyDemand
+}
+
+// Validate checks that the parameters of the strategy are sane.
+//
+// NOTE: Part of the Strategy interface.
+func (s *DemandStrategy) Validate() error {
+	if s.Step <= 0 || s.Step > 1 {
+		return fmt.Errorf("demand step %v must be in the range (0, 1]",
+			s.Step)
+	}
+
+	return s.FeeBounds.Validate()
+}
+
+// FeeRates returns the fee rates the channel should have.
+//
+// NOTE: Part of the Strategy interface.
+func (s *DemandStrategy) FeeRates(ch *ChannelState) FeeRates {
+	var (
+		flow         = ch.Flow
+		feeRate      = float64(ch.Rates.FeeRate)
+		discount     = -float64(min(ch.Rates.InboundFeeRate, 0))
+		discountStep = s.Step * float64(s.MaxInboundDiscount)
+	)
+
+	switch {
+	// The outbound liquidity of the channel is in demand, so we raise its
+	// price and the discount for refilling it.
+	case flow.InsufficientBalanceFailures > 0,
+		flow.OutgoingAmt > flow.IncomingAmt:
+
+		feeRate = math.Max(feeRate*(1+s.Step), feeRate+1)
+		discount += discountStep
+
+	// Nothing was forwarded through the channel, so we lower its price.
+	// There is no need to attract payments into it either.
+	case flow.OutgoingAmt == 0:
+		feeRate = math.Floor(feeRate * (1 - s.Step))
+		discount -= discountStep
+
+	// The channel is being refilled, so the discount can be reduced.
+	case flow.IncomingAmt > flow.OutgoingAmt:
+		discount -= discountStep
+	}
+
+	return s.clamp(feeRate, discount)
+}
+
+// NewStrategy creates the strategy with the given name.
+func NewStrategy(name string, bounds FeeBounds, targetRatio,
+	demandStep float64) (Strategy, error) {
+
+	var strategy Strategy
+	switch name {
+	case StrategyLiquidity:
+		strategy = &LiquidityStrategy{
+			FeeBounds:   bounds,
+			TargetRatio: targetRatio,
+		}
+
+	case StrategyDemand:
+		strategy = &DemandStrategy{
+			FeeBounds: bounds,
+			Step:      demandStep,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown fee strategy: %v", name)
+	}
+
+	if err := strategy.Validate(); err != nil {
+		return nil, err
+	}
+
+	return strategy, nil
+}
@@ -0,0 +1,150 @@
+package feeautopilot
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var testBounds = FeeBounds{
+	MinFeeRate:         100,
+	MaxFeeRate:         1100,
+	MaxInboundDiscount: 400,
+}
+
+// TestLiquidityStrategy tests that the liquidity strategy prices channels by
+// their distance from the target ratio.
+func TestLiquidityStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &LiquidityStrategy{
+		FeeBounds:   testBounds,
+		TargetRatio: 0.5,
+	}
+	require.NoError(t, strategy.Validate())
+
+	tests := []struct {
+		name         string
+		localBalance lnwire.MilliSatoshi
+		expected     FeeRates
+	}{
+		{
+			name:         "all local",
+			localBalance: 1_000_000_000,
+			expected:     FeeRates{FeeRate: 100},
+		},
+		{
+			name:         "at target",
+			localBalance: 500_000_000,
+			expected:     FeeRates{FeeRate: 600},
+		},
+		{
+			name:         "below target",
+			localBalance: 250_000_000,
+			expected: FeeRates{
+				FeeRate:        850,
+				InboundFeeRate: -200,
+			},
+		},
+		{
+			name:         "depleted",
+			localBalance: 0,
+			expected: FeeRates{
+				FeeRate:        1100,
+				InboundFeeRate: -400,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates := strategy.FeeRates(&ChannelState{
+				Capacity:     1_000_000,
+				LocalBalance: test.localBalance,
+			})
+			require.Equal(t, test.expected, rates)
+		})
+	}
+
+	invalid := &LiquidityStrategy{FeeBounds: testBounds, TargetRatio: 1}
+	require.Error(t, invalid.Validate())
+}
+
+// TestDemandStrategy tests that the demand strategy follows the flow through
+// a channel.
+func TestDemandStrategy(t *testing.T) {
+	t.Parallel()
+
+	strategy := &DemandStrategy{
+		FeeBounds: testBounds,
+		Step:      0.5,
+	}
+	require.NoError(t, strategy.Validate())
+
+	current := FeeRates{FeeRate: 500, InboundFeeRate: -100}
+
+	tests := []struct {
+		name     string
+		flow     ChannelFlow
+		expected FeeRates
+	}{
+		{
+			name: "balance failures",
+			flow: ChannelFlow{
+				InsufficientBalanceFailures: 1,
+			},
+			expected: FeeRates{FeeRate: 750, InboundFeeRate: -300},
+		},
+		{
+			name: "net outflow",
+			flow: ChannelFlow{
+				IncomingAmt: 1000,
+				OutgoingAmt: 2000,
+			},
+			expected: FeeRates{FeeRate: 750, InboundFeeRate: -300},
+		},
+		{
+			name:     "idle",
+			flow:     ChannelFlow{},
+			expected: FeeRates{FeeRate: 250},
+		},
+		{
+			name: "net inflow",
+			flow: ChannelFlow{
+				IncomingAmt: 2000,
+				OutgoingAmt: 1000,
+			},
+			expected: FeeRates{FeeRate: 500},
+		},
+		{
+			name: "balanced",
+			flow: ChannelFlow{
+				IncomingAmt: 1000,
+				OutgoingAmt: 1000,
+			},
+			expected: current,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			rates := strategy.FeeRates(&ChannelState{
+				Rates: current,
+				Flow:  test.flow,
+			})
+			require.Equal(t, test.expected, rates)
+		})
+	}
+
+	// The fee rate is kept within its bounds.
+	rates := strategy.FeeRates(&ChannelState{
+		Rates: FeeRates{FeeRate: 1000},
+		Flow:  ChannelFlow{InsufficientBalanceFailures: 1},
+	})
+	require.EqualValues(t, 1100, rates.FeeRate)
+}
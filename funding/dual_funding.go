@@ -0,0 +1,2315 @@
+package funding
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// TODO(dual-funding): The negotiation of a dual-funded channel is only kept
+// in memory, so it's lost if we restart or the peer disconnects. Channels
+// whose commitments were signed are persisted and wait for their funding
+// transaction to confirm, but their funding transaction can't be replaced
+// anymore.
+
+var (
+	// errFundingConfirmed is returned if a replacement of the funding
+	// transaction is aborted because a funding transaction confirmed.
+	errFundingConfirmed = errors.New("funding transaction confirmed")
+)
+
+// dualFundingState is the state of the negotiation of a dual-funded channel.
+type dualFundingState uint8
+
+const (
+	// dualAwaitingAccept is the state of the opener after it sent
+	// open_channel2.
+	dualAwaitingAccept dualFundingState = iota
+
+	// dualConstructing is the state while the funding transaction is
+	// constructed interactively.
+	dualConstructing
+
+	// dualAwaitingCommitSig is the state after the funding transaction
+	// was constructed, until the commitments were signed.
+	dualAwaitingCommitSig
+
+	// dualAwaitingTxSigs is the state after the commitments were signed,
+	// until the signatures for the funding transaction were exchanged.
+	dualAwaitingTxSigs
+
+	// dualSigned is the state once the funding transaction was fully
+	// signed and broadcast. It can still be replaced until it confirms.
+	dualSigned
+
+	// dualAwaitingRbfAck is the state of the opener after it sent
+	// tx_init_rbf to replace the funding transaction.
+	dualAwaitingRbfAck
+)
+
+// String returns a human-readable representation of the state.
+func (s dualFundingState) String() string {
+	switch s {
+	case dualAwaitingAccept:
+		return "awaitingAccept"
+	case dualConstructing:
+		return "constructing"
+	case dualAwaitingCommitSig:
+		return "awaitingCommitSig"
+	case dualAwaitingTxSigs:
+		return "awaitingTxSigs"
+	case dualSigned:
+		return "signed"
+	case dualAwaitingRbfAck:
+		return "awaitingRbfAck"
+	default:
+		return "unknown"
+	}
+}
+
+// fundingCandidate is a funding transaction that was constructed for a
+// dual-funded channel. Once its funding transaction was replaced, a channel
+// has several candidates, of which only one confirms.
+type fundingCandidate struct {
+	// constructed is the interactively constructed funding transaction.
+	// Our witnesses are added to it once the commitments were signed.
+	constructed *interactivetx.ConstructedTx
+
+	// chanPoint is the funding outpoint of the candidate.
+	chanPoint wire.OutPoint
+
+	// channel is the pending channel of the candidate, which is set once
+	// it was persisted.
+	channel *channeldb.OpenChannel
+
+	// lnChan and commits are used to sign the commitments of a
+	// replacement candidate.
+	lnChan  *lnwallet.LightningChannel
+	commits *lnwallet.SpliceCommitments
+
+	// localIdx and remoteIdx are the indexes of the inputs of each party.
+	localIdx  []int
+	remoteIdx []int
+
+	// sentTxSigs is true once we sent our signatures for the funding
+	// transaction, which allows the remote party to broadcast it.
+	sentTxSigs bool
+}
+
+// dualFunding is the negotiation of a dual-funded channel with a peer.
+type dualFunding struct {
+	// mu guards all fields below that aren't immutable.
+	mu sync.Mutex
+
+	// chanID is the channel ID derived from the revocation basepoints of
+	// both parties, which identifies the channel until its funding
+	// transaction confirmed.
+	chanID lnwire.ChannelID
+
+	// pendingChanID is the pending channel ID of open_channel2.
+	pendingChanID PendingChanID
+
+	peer    lnpeer.Peer
+	peerKey *btcec.PublicKey
+
+	// initiator is true if we opened the channel.
+	initiator bool
+
+	// localAmt and remoteAmt are the contributions of both parties to the
+	// funding output.
+	localAmt  btcutil.Amount
+	remoteAmt btcutil.Amount
+
+	// feeRate and lockTime are the fee rate and locktime of the current
+	// funding transaction.
+	feeRate  chainfee.SatPerKWeight
+	lockTime uint32
+
+	// funding are the wallet inputs and change output that fund our
+	// contribution.
+	funding *lnwallet.InteractiveFunding
+
+	// fundingOutput is the funding output of the channel.
+	fundingOutput *wire.TxOut
+
+	session *interactivetx.Session
+	state   dualFundingState
+
+	// candidate is the funding transaction that is being negotiated.
+	candidate *fundingCandidate
+
+	// signed is the latest funding transaction that was fully signed.
+	signed *fundingCandidate
+
+	// rbf is true if the candidate replaces the signed funding
+	// transaction. The replacement funding, fee rate and locktime are
+	// adopted once it was fully signed.
+	rbf         bool
+	rbfFunding  *lnwallet.InteractiveFunding
+	rbfFeeRate  chainfee.SatPerKWeight
+	rbfLockTime uint32
+
+	// rbfResp receives the result of a replacement we initiated.
+	rbfResp chan fn.Result[wire.OutPoint]
+
+	forwardingPolicy models.ForwardingPolicy
+
+	// requestFunds and maxLeaseFee are set if we opened the channel and
+	// requested to lease liquidity from the remote party.
+	requestFunds *lnwire.RequestFunds
+	maxLeaseFee  btcutil.Amount
+
+	updates chan *lnrpc.OpenStatusUpdate
+	err     chan error
+}
+
+// bumpFeeRequest is a request to replace the funding transaction of a pending
+// dual-funded channel with one that pays a higher fee rate.
+type bumpFeeRequest struct {
+	chanPoint wire.OutPoint
+	feeRate   chainfee.SatPerKWeight
+	resp      chan fn.Result[wire.OutPoint]
+}
+
+// handleInitDualFundingMsg creates a channel reservation for a dual-funded
+// channel, then sends open_channel2 to the remote peer.
+func (f *Manager) handleInitDualFundingMsg(msg *InitFundingMsg) {
+	var (
+		peerKey        = msg.Peer.IdentityKey()
+		localAmt       = msg.LocalFundingAmt
+		minHtlcIn      = msg.MinHtlcIn
+		remoteCsvDelay = msg.RemoteCsvDelay
+		maxValue       = msg.MaxValueInFlight
+		maxHtlcs       = msg.MaxHtlcs
+		maxCSV         = msg.MaxLocalCsv
+	)
+
+	if maxCSV == 0 {
+		maxCSV = f.cfg.MaxLocalCSVDelay
+	}
+
+	log.Infof("Initiating dual-funded fundingRequest(local_amt=%v, "+
+		"chain_hash=%v, peer=%x, min_confs=%v)", localAmt,
+		msg.ChainHash, peerKey.SerializeCompressed(), msg.MinConfs)
+
+	if !hasFeatures(
+		msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) {
+
+		msg.Err <- fmt.Errorf("peer %x doesn't support dual-funded "+
+			"channels", peerKey.SerializeCompressed())
+		return
+	}
+
+	switch {
+	case msg.PushAmt != 0:
+		msg.Err <- errors.New("push amount not supported for " +
+			"dual-funded channels")
+		return
+
+	case msg.ChanFunder != nil || len(msg.Outpoints) != 0:
+		msg.Err <- errors.New("custom funding not supported for " +
+			"dual-funded channels")
+		return
+
+	case msg.SubtractFees || msg.FundUpToMaxAmt != 0:
+		msg.Err <- errors.New("subtracting fees and funding up to a " +
+			"maximum amount not supported for dual-funded channels")
+		return
+
+	case msg.RemoteChanReserve != 0:
+		msg.Err <- errors.New("the channel reserve of dual-funded " +
+			"channels can't be set")
+		return
+	}
+
+	var chanID PendingChanID
+	if msg.PendingChanID == zeroID {
+		chanID = f.nextPendingChanID()
+	} else {
+		chanID = msg.PendingChanID
+		if _, err := f.getReservationCtx(peerKey, chanID); err == nil {
+			msg.Err <- fmt.Errorf("pendingChannelID(%x) "+
+				"already present", chanID[:])
+			return
+		}
+	}
+
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, msg.Peer, msg.ShutdownScript,
+		f.selectShutdownScript,
+	)
+	if err != nil {
+		msg.Err <- err
+		return
+	}
+
+	chanType, commitType, err := negotiateCommitmentType(
+		msg.ChannelType, msg.Peer.LocalFeatures(),
+		msg.Peer.RemoteFeatures(),
+	)
+	if err != nil {
+		log.Errorf("channel type negotiation failed: %v", err)
+		msg.Err <- err
+		return
+	}
+
+	// The funding output of a dual-funded channel is a P2WSH multi-sig
+	// output, and its funding transaction is always confirmed.
+	leased := msg.LeaseAmt != 0
+	err = checkDualFundedChanType(chanType, commitType, leased)
+	if err != nil {
+		msg.Err <- err
+		return
+	}
+
+	commitFeePerKw, err := f.cfg.FeeEstimator.EstimateFeePerKW(3)
+	if err != nil {
+		msg.Err <- err
+		return
+	}
+	if commitType.HasAnchors() &&
+		commitFeePerKw > f.cfg.MaxAnchorsCommitFeeRate {
+
+		commitFeePerKw = f.cfg.MaxAnchorsCommitFeeRate
+	}
+
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		msg.Err <- err
+		return
+	}
+
+	// The lease we request starts at our best height, and the channel is
+	// marked as leased until it expires.
+	var (
+		requestFunds *lnwire.RequestFunds
+		thawHeight   uint32
+	)
+	if leased {
+		requestFunds = &lnwire.RequestFunds{
+			RequestedAmount: msg.LeaseAmt,
+			BlockHeight:     uint32(bestHeight),
+		}
+		thawHeight = requestFunds.LeaseExpiry()
+	}
+
+	// As the opener, we pay for the funding output and the common fields
+	// of the funding transaction.
+	var weight input.TxWeightEstimator
+	weight.AddP2WSHOutput()
+	funding, err := f.cfg.Wallet.FundInteractiveTx(
+		localAmt, msg.FundingFeePerKw, weight,
+	)
+	if err != nil {
+		msg.Err <- err
+		return
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(funding.Inputs))
+	for _, in := range funding.Inputs {
+		outpoints = append(outpoints, in.OutPoint)
+	}
+
+	var channelFlags lnwire.FundingFlag
+	if !msg.Private {
+		channelFlags = lnwire.FFAnnounceChannel
+	}
+
+	// Once the reservation exists, the inputs are released along with its
+	// funding intent if it's canceled.
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:       &msg.ChainHash,
+		PendingChanID:   chanID,
+		NodeID:          peerKey,
+		NodeAddr:        msg.Peer.Address(),
+		LocalFundingAmt: localAmt,
+		CommitFeePerKw:  commitFeePerKw,
+		FundingFeePerKw: msg.FundingFeePerKw,
+		Flags:           channelFlags,
+		MinConfs:        msg.MinConfs,
+		CommitType:      commitType,
+		ChanFunder: chanfunding.NewInteractiveAssembler(
+			true, thawHeight, outpoints, f.cfg.Wallet,
+		),
+		ScidAliasFeature: hasFeatures(
+			msg.Peer.LocalFeatures(), msg.Peer.RemoteFeatures(),
+			lnwire.ScidAliasOptional,
+		),
+		Memo: msg.Memo,
+	}
+	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil {
+		f.cfg.Wallet.ReleaseInteractiveInputs(funding.Inputs)
+		msg.Err <- err
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
+	if remoteCsvDelay == 0 {
+		remoteCsvDelay = f.cfg.RequiredRemoteDelay(localAmt)
+	}
+	if minHtlcIn == 0 {
+		minHtlcIn = f.cfg.DefaultMinHtlcIn
+	}
+	if maxValue == 0 {
+		maxValue = f.cfg.RequiredRemoteMaxValue(localAmt)
+	}
+	if maxHtlcs == 0 {
+		maxHtlcs = f.cfg.RequiredRemoteMaxHTLCs(localAmt)
+	}
+
+	ourContribution := reservation.OurContribution()
+	forwardingPolicy := f.defaultForwardingPolicy(
+		ourContribution.ChannelStateBounds,
+	)
+	if msg.BaseFee != nil {
+		forwardingPolicy.BaseFee = lnwire.MilliSatoshi(*msg.BaseFee)
+	}
+	if msg.FeeRate != nil {
+		forwardingPolicy.FeeRate = lnwire.MilliSatoshi(*msg.FeeRate)
+	}
+
+	df := &dualFunding{
+		pendingChanID:    chanID,
+		peer:             msg.Peer,
+		peerKey:          peerKey,
+		initiator:        true,
+		localAmt:         localAmt,
+		feeRate:          msg.FundingFeePerKw,
+		lockTime:         uint32(bestHeight),
+		funding:          funding,
+		state:            dualAwaitingAccept,
+		forwardingPolicy: *forwardingPolicy,
+		requestFunds:     requestFunds,
+		maxLeaseFee:      msg.MaxLeaseFee,
+		updates:          msg.Updates,
+		err:              msg.Err,
+	}
+
+	peerIDKey := newSerializedKey(peerKey)
+	f.resMtx.Lock()
+	if _, ok := f.activeReservations[peerIDKey]; !ok {
+		f.activeReservations[peerIDKey] = make(pendingChannels)
+	}
+	resCtx := &reservationWithCtx{
+		chanAmt:          localAmt,
+		forwardingPolicy: *forwardingPolicy,
+		remoteCsvDelay:   remoteCsvDelay,
+		remoteMinHtlc:    minHtlcIn,
+		remoteMaxValue:   maxValue,
+		remoteMaxHtlcs:   maxHtlcs,
+		maxLocalCsv:      maxCSV,
+		channelType:      chanType,
+		dualFunding:      df,
+		reservation:      reservation,
+		peer:             msg.Peer,
+		updates:          msg.Updates,
+		err:              msg.Err,
+	}
+	f.activeReservations[peerIDKey][chanID] = resCtx
+	f.resMtx.Unlock()
+
+	defer resCtx.updateTimestamp()
+
+	// The reserve depends on the contribution of the remote party, so we
+	// only check the other constraints for now.
+	bounds := &channeldb.ChannelStateBounds{
+		ChanReserve: dualFundedReserve(
+			localAmt, ourContribution.DustLimit,
+		),
+		MaxPendingAmount: maxValue,
+		MinHTLC:          minHtlcIn,
+		MaxAcceptedHtlcs: maxHtlcs,
+	}
+	commitParams := &channeldb.CommitmentParams{
+		DustLimit: ourContribution.DustLimit,
+		CsvDelay:  remoteCsvDelay,
+	}
+	err = lnwallet.VerifyConstraints(
+		bounds, commitParams, resCtx.maxLocalCsv, localAmt,
+	)
+	if err != nil {
+		_, reserveErr := f.cancelReservationCtx(peerKey, chanID, false)
+		if reserveErr != nil {
+			log.Errorf("unable to cancel reservation: %v",
+				reserveErr)
+		}
+
+		msg.Err <- err
+		return
+	}
+
+	secondPoint, err := secondCommitmentPoint(reservation)
+	if err != nil {
+		_, reserveErr := f.cancelReservationCtx(peerKey, chanID, false)
+		if reserveErr != nil {
+			log.Errorf("unable to cancel reservation: %v",
+				reserveErr)
+		}
+
+		msg.Err <- err
+		return
+	}
+
+	log.Infof("Starting dual-funded funding workflow with %v for "+
+		"pending_id(%x), committype=%v", msg.Peer.Address(), chanID,
+		commitType)
+
+	reservation.SetState(lnwallet.SentOpenChannel)
+
+	ourRevPoint := ourContribution.RevocationBasePoint.PubKey
+	openMsg := &lnwire.OpenChannel2{
+		ChainHash:             *f.cfg.Wallet.Cfg.NetParams.GenesisHash,
+		PendingChannelID:      chanID,
+		FundingFeePerKw:       uint32(msg.FundingFeePerKw),
+		CommitFeePerKw:        uint32(commitFeePerKw),
+		FundingAmount:         localAmt,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      maxValue,
+		HtlcMinimum:           minHtlcIn,
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		LockTime:              df.lockTime,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourRevPoint,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		ChannelFlags:          channelFlags,
+		UpfrontShutdownScript: shutdown,
+		ChannelType:           chanType,
+		RequestFunds:          requestFunds,
+	}
+	if err := msg.Peer.SendMessage(true, openMsg); err != nil {
+		e := fmt.Errorf("unable to send funding request message: %w",
+			err)
+		log.Errorf(e.Error())
+
+		_, err := f.cancelReservationCtx(peerKey, chanID, false)
+		if err != nil {
+			log.Errorf("unable to cancel reservation: %v", err)
+		}
+
+		msg.Err <- e
+	}
+}
+
+// fundeeProcessOpenChannel2 creates a channel reservation for a dual-funded
+// channel proposed by the remote peer, contributing the amount set by the
+// channel acceptor, then responds with accept_channel2.
+//
+//nolint:funlen
+func (f *Manager) fundeeProcessOpenChannel2(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel2) {
+
+	peerKey := peer.IdentityKey()
+	cid := newChanIdentifier(msg.PendingChannelID)
+
+	if !hasFeatures(
+		peer.LocalFeatures(), peer.RemoteFeatures(),
+		lnwire.DualFundOptional,
+	) {
+
+		f.failFundingFlow(peer, cid, errors.New("dual-funded "+
+			"channels not supported"))
+		return
+	}
+
+	err := f.validateInboundFunding(peer, msg.FundingAmount)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// The channel acceptor decides on the channel like on a single-funded
+	// channel, and may contribute funds to it.
+	chanReq := &chanacceptor.ChannelAcceptRequest{
+		Node: peerKey,
+		OpenChanMsg: &lnwire.OpenChannel{
+			ChainHash:             msg.ChainHash,
+			PendingChannelID:      msg.PendingChannelID,
+			FundingAmount:         msg.FundingAmount,
+			DustLimit:             msg.DustLimit,
+			MaxValueInFlight:      msg.MaxValueInFlight,
+			HtlcMinimum:           msg.HtlcMinimum,
+			FeePerKiloWeight:      msg.CommitFeePerKw,
+			CsvDelay:              msg.CsvDelay,
+			MaxAcceptedHTLCs:      msg.MaxAcceptedHTLCs,
+			FundingKey:            msg.FundingKey,
+			RevocationPoint:       msg.RevocationPoint,
+			PaymentPoint:          msg.PaymentPoint,
+			DelayedPaymentPoint:   msg.DelayedPaymentPoint,
+			HtlcPoint:             msg.HtlcPoint,
+			FirstCommitmentPoint:  msg.FirstCommitmentPoint,
+			ChannelFlags:          msg.ChannelFlags,
+			UpfrontShutdownScript: msg.UpfrontShutdownScript,
+			ChannelType:           msg.ChannelType,
+		},
+		DualFunded: true,
+	}
+	acceptorResp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if acceptorResp.RejectChannel() {
+		f.failFundingFlow(peer, cid, acceptorResp.ChanAcceptError)
+		return
+	}
+
+	contribution := acceptorResp.FundingContribution
+
+	log.Infof("Recv'd dual-funded fundingRequest(amt=%v, contribution=%v, "+
+		"delay=%v, pendingId=%x) from peer(%x)", msg.FundingAmount,
+		contribution, msg.CsvDelay, msg.PendingChannelID,
+		peerKey.SerializeCompressed())
+
+	chanType, commitType, err := negotiateCommitmentType(
+		msg.ChannelType, peer.LocalFeatures(), peer.RemoteFeatures(),
+	)
+	if err != nil {
+		log.Errorf("channel type negotiation failed: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	leased := msg.RequestFunds != nil
+	err = checkDualFundedChanType(chanType, commitType, leased)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	if acceptorResp.ZeroConf {
+		f.failFundingFlow(peer, cid, errors.New("zero-conf not "+
+			"supported for dual-funded channels"))
+		return
+	}
+
+	// If the opener requests to lease liquidity from us, then we
+	// contribute the amount we lease to it, regardless of the
+	// contribution set by the channel acceptor.
+	var (
+		soldLease  *lease
+		thawHeight uint32
+	)
+	if leased {
+		soldLease, err = f.sellLease(msg)
+		if err != nil {
+			log.Errorf("Unable to sell lease to peer(%x): %v",
+				peerKey.SerializeCompressed(), err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+		contribution = soldLease.amt
+		thawHeight = soldLease.expiry
+
+		log.Infof("Selling lease of %v until height %v for fee of %v "+
+			"to peer(%x)", soldLease.amt, soldLease.expiry,
+			soldLease.fee, peerKey.SerializeCompressed())
+	}
+
+	capacity := msg.FundingAmount + contribution
+	if capacity > f.cfg.MaxChanSize {
+		f.failFundingFlow(
+			peer, cid,
+			lnwallet.ErrChanTooLarge(capacity, f.cfg.MaxChanSize),
+		)
+		return
+	}
+
+	// We add our contribution with wallet inputs that also pay the fees
+	// for themselves and our change output.
+	funding := &lnwallet.InteractiveFunding{}
+	if contribution > 0 {
+		funding, err = f.cfg.Wallet.FundInteractiveTx(
+			contribution,
+			chainfee.SatPerKWeight(msg.FundingFeePerKw),
+			input.TxWeightEstimator{},
+		)
+		if err != nil {
+			log.Errorf("Unable to fund contribution: %v", err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(funding.Inputs))
+	for _, in := range funding.Inputs {
+		outpoints = append(outpoints, in.OutPoint)
+	}
+
+	req := &lnwallet.InitFundingReserveMsg{
+		ChainHash:        &msg.ChainHash,
+		PendingChanID:    msg.PendingChannelID,
+		NodeID:           peerKey,
+		NodeAddr:         peer.Address(),
+		LocalFundingAmt:  contribution,
+		RemoteFundingAmt: msg.FundingAmount,
+		CommitFeePerKw:   chainfee.SatPerKWeight(msg.CommitFeePerKw),
+		FundingFeePerKw: chainfee.SatPerKWeight(
+			msg.FundingFeePerKw,
+		),
+		Flags:      msg.ChannelFlags,
+		MinConfs:   1,
+		CommitType: commitType,
+		ChanFunder: chanfunding.NewInteractiveAssembler(
+			false, thawHeight, outpoints, f.cfg.Wallet,
+		),
+		ScidAliasFeature: hasFeatures(
+			peer.LocalFeatures(), peer.RemoteFeatures(),
+			lnwire.ScidAliasOptional,
+		),
+	}
+	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
+	if err != nil {
+		log.Errorf("Unable to initialize reservation: %v", err)
+		f.cfg.Wallet.ReleaseInteractiveInputs(funding.Inputs)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	numConfsReq := f.cfg.NumRequiredConfs(capacity, 0)
+	if acceptorResp.MinAcceptDepth != 0 {
+		numConfsReq = acceptorResp.MinAcceptDepth
+	}
+	reservation.SetNumConfsRequired(numConfsReq)
+
+	ourContribution := reservation.OurContribution()
+	maxDustLimit := ourContribution.DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	chanReserve := dualFundedReserve(capacity, maxDustLimit)
+
+	remoteCsvDelay := f.cfg.RequiredRemoteDelay(capacity)
+	if acceptorResp.CSVDelay != 0 {
+		remoteCsvDelay = acceptorResp.CSVDelay
+	}
+	remoteMaxValue := f.cfg.RequiredRemoteMaxValue(capacity)
+	if acceptorResp.InFlightTotal != 0 {
+		remoteMaxValue = acceptorResp.InFlightTotal
+	}
+	maxHtlcs := f.cfg.RequiredRemoteMaxHTLCs(capacity)
+	if acceptorResp.HtlcLimit != 0 {
+		maxHtlcs = acceptorResp.HtlcLimit
+	}
+	minHtlc := f.cfg.DefaultMinHtlcIn
+	if acceptorResp.MinHtlcIn != 0 {
+		minHtlc = acceptorResp.MinHtlcIn
+	}
+
+	df := &dualFunding{
+		pendingChanID: msg.PendingChannelID,
+		peer:          peer,
+		peerKey:       peerKey,
+		localAmt:      contribution,
+		remoteAmt:     msg.FundingAmount,
+		feeRate:       chainfee.SatPerKWeight(msg.FundingFeePerKw),
+		lockTime:      msg.LockTime,
+		funding:       funding,
+		state:         dualConstructing,
+		forwardingPolicy: *f.defaultForwardingPolicy(
+			ourContribution.ChannelStateBounds,
+		),
+		err: make(chan error, 1),
+	}
+
+	// The negotiation is locked until we responded, as it can be aborted
+	// once it's tracked.
+	df.mu.Lock()
+	defer df.mu.Unlock()
+
+	peerIDKey := newSerializedKey(peerKey)
+	f.resMtx.Lock()
+	if _, ok := f.activeReservations[peerIDKey]; !ok {
+		f.activeReservations[peerIDKey] = make(pendingChannels)
+	}
+	resCtx := &reservationWithCtx{
+		reservation:       reservation,
+		chanAmt:           capacity,
+		forwardingPolicy:  df.forwardingPolicy,
+		remoteCsvDelay:    remoteCsvDelay,
+		remoteMinHtlc:     minHtlc,
+		remoteMaxValue:    remoteMaxValue,
+		remoteMaxHtlcs:    maxHtlcs,
+		remoteChanReserve: chanReserve,
+		maxLocalCsv:       f.cfg.MaxLocalCSVDelay,
+		channelType:       chanType,
+		dualFunding:       df,
+		err:               df.err,
+		peer:              peer,
+	}
+	f.activeReservations[peerIDKey][msg.PendingChannelID] = resCtx
+	f.resMtx.Unlock()
+
+	defer resCtx.updateTimestamp()
+
+	stateBounds := &channeldb.ChannelStateBounds{
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+	}
+	commitParams := &channeldb.CommitmentParams{
+		DustLimit: msg.DustLimit,
+		CsvDelay:  msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		stateBounds, commitParams, f.cfg.MaxLocalCSVDelay, true,
+	)
+	if err != nil {
+		log.Errorf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	// The opener pays the lease fee from its contribution, and we commit
+	// to cap our forwarding fees during the lease.
+	if soldLease != nil {
+		if err := reservation.AddLeaseFee(soldLease.fee); err != nil {
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+		capLeaseForwardingFees(&df.forwardingPolicy, &soldLease.rates)
+		resCtx.forwardingPolicy = df.forwardingPolicy
+	}
+
+	shutdown, err := getUpfrontShutdownScript(
+		f.cfg.EnableUpfrontShutdown, peer, acceptorResp.UpfrontShutdown,
+		f.selectShutdownScript,
+	)
+	if err != nil {
+		f.failFundingFlow(
+			peer, cid,
+			fmt.Errorf("getUpfrontShutdownScript error: %w", err),
+		)
+		return
+	}
+	reservation.SetOurUpfrontShutdown(shutdown)
+
+	cfg := channeldb.ChannelConfig{
+		ChannelStateBounds: channeldb.ChannelStateBounds{
+			MaxPendingAmount: remoteMaxValue,
+			ChanReserve:      chanReserve,
+			MinHTLC:          minHtlc,
+			MaxAcceptedHtlcs: maxHtlcs,
+		},
+		CommitmentParams: channeldb.CommitmentParams{
+			DustLimit: msg.DustLimit,
+			CsvDelay:  remoteCsvDelay,
+		},
+		MultiSigKey: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.FundingKey),
+		},
+		RevocationBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.RevocationPoint),
+		},
+		PaymentBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.PaymentPoint),
+		},
+		DelayBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.DelayedPaymentPoint),
+		},
+		HtlcBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.HtlcPoint),
+		},
+	}
+	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:        msg.FundingAmount,
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig:        &cfg,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
+	}
+	err = reservation.ProcessSingleContribution(remoteContribution)
+	if err != nil {
+		log.Errorf("unable to add contribution reservation: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	secondPoint, err := secondCommitmentPoint(reservation)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	df.chanID = lnwire.NewChanIDFromBasepoints(
+		msg.RevocationPoint, ourContribution.RevocationBasePoint.PubKey,
+	)
+	df.fundingOutput, err = dualFundingOutput(
+		ourContribution.MultiSigKey.PubKey, msg.FundingKey, capacity,
+	)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	if _, loaded := f.dualFundings.LoadOrStore(df.chanID, df); loaded {
+		f.failFundingFlow(peer, cid, fmt.Errorf("channel %v already "+
+			"negotiated", df.chanID))
+		return
+	}
+	df.session = newDualFundingSession(df, funding, df.lockTime)
+
+	log.Infof("Sending dual-funded fundingResp for pending_id(%x), "+
+		"chan_id=%v", msg.PendingChannelID, df.chanID)
+
+	var willFund *lnwire.WillFund
+	if soldLease != nil {
+		willFund, err = f.signLease(
+			soldLease, ourContribution.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			f.failDualFunding(df, err, false)
+			return
+		}
+	}
+
+	ourRevPoint := ourContribution.RevocationBasePoint.PubKey
+	acceptMsg := &lnwire.AcceptChannel2{
+		PendingChannelID:      msg.PendingChannelID,
+		FundingAmount:         contribution,
+		DustLimit:             ourContribution.DustLimit,
+		MaxValueInFlight:      remoteMaxValue,
+		HtlcMinimum:           minHtlc,
+		MinAcceptDepth:        uint32(numConfsReq),
+		CsvDelay:              remoteCsvDelay,
+		MaxAcceptedHTLCs:      maxHtlcs,
+		FundingKey:            ourContribution.MultiSigKey.PubKey,
+		RevocationPoint:       ourRevPoint,
+		PaymentPoint:          ourContribution.PaymentBasePoint.PubKey,
+		DelayedPaymentPoint:   ourContribution.DelayBasePoint.PubKey,
+		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
+		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
+		SecondCommitmentPoint: secondPoint,
+		UpfrontShutdownScript: shutdown,
+		ChannelType:           chanType,
+		WillFund:              willFund,
+	}
+	if err := peer.SendMessage(true, acceptMsg); err != nil {
+		log.Errorf("unable to send fundingResp: %v", err)
+		f.failDualFunding(df, err, false)
+	}
+}
+
+// funderProcessAcceptChannel2 processes the response of the remote peer to a
+// dual-funded channel we proposed, then starts the interactive construction
+// of the funding transaction.
+func (f *Manager) funderProcessAcceptChannel2(peer lnpeer.Peer,
+	msg *lnwire.AcceptChannel2) {
+
+	pendingChanID := msg.PendingChannelID
+	peerKey := peer.IdentityKey()
+
+	resCtx, err := f.getReservationCtx(peerKey, pendingChanID)
+	if err != nil || resCtx.dualFunding == nil {
+		log.Warnf("Can't find dual-funded reservation (peerKey:%x, "+
+			"chan_id:%x)", peerKey.SerializeCompressed(),
+			pendingChanID)
+		return
+	}
+
+	defer resCtx.updateTimestamp()
+
+	df := resCtx.dualFunding
+	df.mu.Lock()
+	defer df.mu.Unlock()
+
+	if df.state != dualAwaitingAccept {
+		return
+	}
+
+	log.Infof("Recv'd dual-funded fundingResponse for pending_id(%x), "+
+		"contribution=%v", pendingChanID[:], msg.FundingAmount)
+
+	cid := newChanIdentifier(pendingChanID)
+
+	// The acceptor must echo the channel type we proposed.
+	if resCtx.channelType != nil {
+		if msg.ChannelType == nil {
+			f.failFundingFlow(peer, cid, errors.New("explicit "+
+				"channel type not echoed back"))
+			return
+		}
+		proposedFeatures := lnwire.RawFeatureVector(*resCtx.channelType)
+		ackedFeatures := lnwire.RawFeatureVector(*msg.ChannelType)
+		if !proposedFeatures.Equals(&ackedFeatures) {
+			f.failFundingFlow(peer, cid, errors.New("channel "+
+				"type mismatch"))
+			return
+		}
+	} else if msg.ChannelType != nil {
+		f.failFundingFlow(peer, cid, errors.New("received "+
+			"unexpected channel type"))
+		return
+	}
+
+	if msg.MinAcceptDepth > chainntnfs.MaxNumConfs {
+		err := lnwallet.ErrNumConfsTooLarge(
+			msg.MinAcceptDepth, chainntnfs.MaxNumConfs,
+		)
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	minDepth := msg.MinAcceptDepth
+	if minDepth == 0 {
+		minDepth = 1
+	}
+
+	// If we requested a lease, then the remote party must have committed
+	// to it, and we pay the lease fee from our contribution.
+	var boughtLease *lease
+	switch {
+	case df.requestFunds != nil:
+		boughtLease, err = verifyLease(
+			df.requestFunds, msg.WillFund, peerKey, msg.FundingKey,
+			msg.FundingAmount, uint32(df.feeRate), df.maxLeaseFee,
+		)
+		if err != nil {
+			log.Warnf("Unacceptable lease: %v", err)
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+
+	case msg.WillFund != nil:
+		f.failFundingFlow(peer, cid, errors.New("received "+
+			"unexpected lease"))
+		return
+	}
+
+	reservation := resCtx.reservation
+	if err := reservation.AddRemoteFunding(msg.FundingAmount); err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	if boughtLease != nil {
+		if err := reservation.AddLeaseFee(boughtLease.fee); err != nil {
+			f.failFundingFlow(peer, cid, err)
+			return
+		}
+
+		log.Infof("Bought lease of %v until height %v for fee of %v "+
+			"from peer(%x)", boughtLease.amt, boughtLease.expiry,
+			boughtLease.fee, peerKey.SerializeCompressed())
+	}
+	capacity := reservation.Capacity()
+	reservation.SetNumConfsRequired(uint16(minDepth))
+
+	ourContribution := reservation.OurContribution()
+	maxDustLimit := ourContribution.DustLimit
+	if msg.DustLimit > maxDustLimit {
+		maxDustLimit = msg.DustLimit
+	}
+	chanReserve := dualFundedReserve(capacity, maxDustLimit)
+	resCtx.remoteChanReserve = chanReserve
+	resCtx.chanAmt = capacity
+
+	bounds := channeldb.ChannelStateBounds{
+		ChanReserve:      chanReserve,
+		MaxPendingAmount: msg.MaxValueInFlight,
+		MinHTLC:          msg.HtlcMinimum,
+		MaxAcceptedHtlcs: msg.MaxAcceptedHTLCs,
+	}
+	commitParams := channeldb.CommitmentParams{
+		DustLimit: msg.DustLimit,
+		CsvDelay:  msg.CsvDelay,
+	}
+	err = reservation.CommitConstraints(
+		&bounds, &commitParams, resCtx.maxLocalCsv, false,
+	)
+	if err != nil {
+		log.Warnf("Unacceptable channel constraints: %v", err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	cfg := channeldb.ChannelConfig{
+		ChannelStateBounds: channeldb.ChannelStateBounds{
+			MaxPendingAmount: resCtx.remoteMaxValue,
+			ChanReserve:      chanReserve,
+			MinHTLC:          resCtx.remoteMinHtlc,
+			MaxAcceptedHtlcs: resCtx.remoteMaxHtlcs,
+		},
+		CommitmentParams: channeldb.CommitmentParams{
+			DustLimit: msg.DustLimit,
+			CsvDelay:  resCtx.remoteCsvDelay,
+		},
+		MultiSigKey: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.FundingKey),
+		},
+		RevocationBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.RevocationPoint),
+		},
+		PaymentBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.PaymentPoint),
+		},
+		DelayBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.DelayedPaymentPoint),
+		},
+		HtlcBasePoint: keychain.KeyDescriptor{
+			PubKey: copyPubKey(msg.HtlcPoint),
+		},
+	}
+	remoteContribution := &lnwallet.ChannelContribution{
+		FundingAmount:        msg.FundingAmount,
+		FirstCommitmentPoint: msg.FirstCommitmentPoint,
+		ChannelConfig:        &cfg,
+		UpfrontShutdown:      msg.UpfrontShutdownScript,
+	}
+	err = reservation.ProcessContribution(remoteContribution)
+	if err != nil {
+		log.Errorf("Unable to process contribution from %x: %v",
+			peerKey.SerializeCompressed(), err)
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+
+	df.remoteAmt = msg.FundingAmount
+	df.chanID = lnwire.NewChanIDFromBasepoints(
+		ourContribution.RevocationBasePoint.PubKey, msg.RevocationPoint,
+	)
+	df.fundingOutput, err = dualFundingOutput(
+		ourContribution.MultiSigKey.PubKey, msg.FundingKey, capacity,
+	)
+	if err != nil {
+		f.failFundingFlow(peer, cid, err)
+		return
+	}
+	if _, loaded := f.dualFundings.LoadOrStore(df.chanID, df); loaded {
+		f.failFundingFlow(peer, cid, fmt.Errorf("channel %v already "+
+			"negotiated", df.chanID))
+		return
+	}
+
+	log.Infof("Constructing funding transaction of dual-funded "+
+		"chan_id=%v for pending_id(%x)", df.chanID, pendingChanID[:])
+
+	df.state = dualConstructing
+	df.session = newDualFundingSession(df, df.funding, df.lockTime)
+	if err := f.startDualFundingSession(df); err != nil {
+		f.failDualFunding(df, err, true)
+	}
+}
+
+// startDualFundingSession sends the first message of the construction
+// session of the funding transaction, which is sent by the opener.
+func (f *Manager) startDualFundingSession(df *dualFunding) error {
+	startMsg, err := df.session.Start()
+	if err != nil {
+		return err
+	}
+
+	return df.peer.SendMessage(true, startMsg)
+}
+
+// newDualFundingSession creates a session to construct a funding transaction
+// of the dual-funded channel with the given wallet funding and locktime.
+func newDualFundingSession(df *dualFunding,
+	funding *lnwallet.InteractiveFunding,
+	lockTime uint32) *interactivetx.Session {
+
+	// The session assigns serial IDs to the inputs and outputs, so it gets
+	// its own copies.
+	inputs := make([]*interactivetx.Input, 0, len(funding.Inputs))
+	for _, in := range funding.Inputs {
+		in := *in
+		inputs = append(inputs, &in)
+	}
+
+	var outputs []*interactivetx.Output
+	if df.initiator {
+		fundingOutput := *df.fundingOutput
+		outputs = append(outputs, &interactivetx.Output{
+			TxOut: &fundingOutput,
+		})
+	}
+	funding.Change.WhenSome(func(change wire.TxOut) {
+		outputs = append(outputs, &interactivetx.Output{
+			TxOut: &change,
+		})
+	})
+
+	return interactivetx.NewSession(interactivetx.Config{
+		ChanID:       df.chanID,
+		Initiator:    df.initiator,
+		LockTime:     lockTime,
+		LocalInputs:  inputs,
+		LocalOutputs: outputs,
+	})
+}
+
+// loadDualFunding returns the locked negotiation of the dual-funded channel
+// with the given ID, if it's still active. The caller must unlock it.
+func (f *Manager) loadDualFunding(chanID lnwire.ChannelID) (*dualFunding,
+	bool) {
+
+	df, ok := f.dualFundings.Load(chanID)
+	if !ok {
+		return nil, false
+	}
+
+	df.mu.Lock()
+
+	// The negotiation may have been aborted while we waited for the lock.
+	if cur, ok := f.dualFundings.Load(chanID); !ok || cur != df {
+		df.mu.Unlock()
+		return nil, false
+	}
+
+	return df, true
+}
+
+// dualFundingChanID returns the channel ID of a message that is part of the
+// negotiation of a dual-funded channel.
+func dualFundingChanID(msg lnwire.Message) (lnwire.ChannelID, bool) {
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		return msg.ChannelID, true
+	case *lnwire.TxAddOutput:
+		return msg.ChannelID, true
+	case *lnwire.TxRemoveInput:
+		return msg.ChannelID, true
+	case *lnwire.TxRemoveOutput:
+		return msg.ChannelID, true
+	case *lnwire.TxComplete:
+		return msg.ChannelID, true
+	case *lnwire.TxSignatures:
+		return msg.ChannelID, true
+	case *lnwire.TxInitRbf:
+		return msg.ChannelID, true
+	case *lnwire.TxAckRbf:
+		return msg.ChannelID, true
+	case *lnwire.TxAbort:
+		return msg.ChannelID, true
+	case *lnwire.CommitSig:
+		return msg.ChanID, true
+	default:
+		return lnwire.ChannelID{}, false
+	}
+}
+
+// processDualFundingMsg processes a message of the remote peer that advances
+// the negotiation of a dual-funded channel. The negotiation is aborted if the
+// message can't be processed.
+func (f *Manager) processDualFundingMsg(peer lnpeer.Peer,
+	msg lnwire.Message) {
+
+	chanID, ok := dualFundingChanID(msg)
+	if !ok {
+		return
+	}
+
+	df, ok := f.loadDualFunding(chanID)
+	if !ok {
+		log.Warnf("Received %v for unknown dual-funded channel %v",
+			msg.MsgType(), chanID)
+		return
+	}
+	defer df.mu.Unlock()
+
+	if !df.peerKey.IsEqual(peer.IdentityKey()) {
+		log.Warnf("Received %v for dual-funded channel %v from "+
+			"unexpected peer %x", msg.MsgType(), chanID,
+			peer.IdentityKey().SerializeCompressed())
+		return
+	}
+	df.peer = peer
+
+	// As long as the reservation exists, it must not be pruned while the
+	// negotiation advances.
+	resCtx, err := f.getReservationCtx(df.peerKey, df.pendingChanID)
+	if err == nil {
+		defer resCtx.updateTimestamp()
+	}
+
+	err = f.handleDualFundingMsg(df, msg)
+	if err == nil {
+		return
+	}
+
+	log.Errorf("Negotiation of dual-funded channel %v failed in state "+
+		"%v: %v", chanID, df.state, err)
+
+	f.failDualFunding(df, err, true)
+}
+
+// handleDualFundingMsg dispatches a message of the remote peer based on its
+// type.
+func (f *Manager) handleDualFundingMsg(df *dualFunding,
+	msg lnwire.Message) error {
+
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete:
+
+		return f.receiveConstructionMsg(df, msg)
+
+	case *lnwire.CommitSig:
+		return f.receiveDualCommitSig(df, msg)
+
+	case *lnwire.TxSignatures:
+		return f.receiveDualTxSignatures(df, msg)
+
+	case *lnwire.TxInitRbf:
+		return f.receiveTxInitRbf(df, msg)
+
+	case *lnwire.TxAckRbf:
+		return f.receiveTxAckRbf(df, msg)
+
+	case *lnwire.TxAbort:
+		// There's nothing to abort once the funding transaction was
+		// signed, unless it's being replaced. This is also the case
+		// if the remote party acknowledges an abort we sent.
+		if df.state == dualSigned {
+			log.Debugf("Ignoring tx_abort for dual-funded channel "+
+				"%v: %v", df.chanID, string(msg.Data))
+			return nil
+		}
+
+		return msg
+
+	default:
+		return fmt.Errorf("unexpected message %v", msg.MsgType())
+	}
+}
+
+// receiveConstructionMsg processes a message of the construction session of
+// the funding transaction. Once the construction is complete, the
+// commitments spending the funding output are signed.
+func (f *Manager) receiveConstructionMsg(df *dualFunding,
+	msg lnwire.Message) error {
+
+	if df.state != dualConstructing {
+		return fmt.Errorf("unexpected %v in state %v", msg.MsgType(),
+			df.state)
+	}
+
+	reply, err := df.session.ReceiveMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	err = fn.MapOptionZ(reply, func(m lnwire.Message) error {
+		return df.peer.SendMessage(true, m)
+	})
+	if err != nil {
+		return err
+	}
+
+	if !df.session.Complete() {
+		return nil
+	}
+
+	return f.signDualCommitment(df)
+}
+
+// signDualCommitment checks the constructed funding transaction and signs the
+// commitment of the remote party. The acceptor of the initial funding
+// transaction only signs once it received the signature of the opener.
+func (f *Manager) signDualCommitment(df *dualFunding) error {
+	constructed, err := df.session.Tx()
+	if err != nil {
+		return err
+	}
+
+	fundingIdx, err := findFundingOutput(constructed.Tx, df.fundingOutput)
+	if err != nil {
+		return err
+	}
+	err = checkContributions(constructed, fundingIdx, df.localAmt,
+		df.remoteAmt)
+	if err != nil {
+		return err
+	}
+
+	cand := &fundingCandidate{
+		constructed: constructed,
+		chanPoint: wire.OutPoint{
+			Hash:  constructed.Tx.TxHash(),
+			Index: uint32(fundingIdx),
+		},
+	}
+	df.candidate = cand
+	df.state = dualAwaitingCommitSig
+
+	log.Infof("Constructed funding transaction %v of dual-funded "+
+		"channel %v", cand.chanPoint.Hash, df.chanID)
+
+	// The commitments of a replacement are derived from the persisted
+	// channel, as they only spend another funding output.
+	if df.rbf {
+		cand.lnChan, err = f.newLightningChannel(df.signed.channel)
+		if err != nil {
+			return err
+		}
+		cand.commits, err = cand.lnChan.NewRbfCommitments(
+			cand.chanPoint,
+		)
+		if err != nil {
+			return err
+		}
+		sig, err := cand.lnChan.SignSpliceCommitment(cand.commits)
+		if err != nil {
+			return err
+		}
+
+		return df.peer.SendMessage(true, &lnwire.CommitSig{
+			ChanID:    df.chanID,
+			CommitSig: sig,
+		})
+	}
+
+	resCtx, err := f.getReservationCtx(df.peerKey, df.pendingChanID)
+	if err != nil {
+		return err
+	}
+	err = resCtx.reservation.ProcessInteractiveTx(
+		constructed.Tx, cand.chanPoint,
+	)
+	if err != nil {
+		return err
+	}
+
+	if !df.initiator {
+		return nil
+	}
+
+	_, sig := resCtx.reservation.OurSignatures()
+	commitSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	return df.peer.SendMessage(true, &lnwire.CommitSig{
+		ChanID:    df.chanID,
+		CommitSig: commitSig,
+	})
+}
+
+// receiveDualCommitSig processes the signature of the remote party for our
+// commitment, which persists the channel of the candidate. Then our inputs to
+// the funding transaction are signed.
+func (f *Manager) receiveDualCommitSig(df *dualFunding,
+	msg *lnwire.CommitSig) error {
+
+	if df.state != dualAwaitingCommitSig {
+		return fmt.Errorf("unexpected commit_sig in state %v",
+			df.state)
+	}
+	if len(msg.HtlcSigs) != 0 {
+		return fmt.Errorf("received %d HTLC signatures for initial "+
+			"commitment", len(msg.HtlcSigs))
+	}
+
+	cand := df.candidate
+	if df.rbf {
+		err := cand.lnChan.ReceiveSpliceCommitment(
+			cand.commits, msg.CommitSig,
+		)
+		if err != nil {
+			return err
+		}
+		if err := f.persistRbfCandidate(df, cand); err != nil {
+			return err
+		}
+	} else if err := f.completeDualReservation(df, cand, msg); err != nil {
+		return err
+	}
+
+	f.trackDualFundedChannel(df, cand.channel)
+
+	tx := cand.constructed.Tx
+	_, cand.localIdx = cand.constructed.PartyInputs(lntypes.Local)
+	_, cand.remoteIdx = cand.constructed.PartyInputs(lntypes.Remote)
+	witnesses, err := f.cfg.Wallet.SignInteractiveInputs(
+		tx, cand.constructed.PrevOutputFetcher(), cand.localIdx,
+	)
+	if err != nil {
+		return err
+	}
+	for i, idx := range cand.localIdx {
+		tx.TxIn[idx].Witness = witnesses[i]
+	}
+	df.state = dualAwaitingTxSigs
+
+	if !sendTxSigsFirst(cand.constructed, f.cfg.IDKey, df.peerKey) {
+		return nil
+	}
+
+	return f.sendDualTxSignatures(df, cand)
+}
+
+// completeDualReservation completes the reservation of the initial funding
+// transaction with the signature of the remote party, which persists the
+// channel. The acceptor then sends its signature for the commitment of the
+// opener.
+func (f *Manager) completeDualReservation(df *dualFunding,
+	cand *fundingCandidate, msg *lnwire.CommitSig) error {
+
+	resCtx, err := f.getReservationCtx(df.peerKey, df.pendingChanID)
+	if err != nil {
+		return err
+	}
+
+	commitSig, err := msg.CommitSig.ToSignature()
+	if err != nil {
+		return err
+	}
+
+	if df.initiator {
+		cand.channel, err = resCtx.reservation.CompleteReservation(
+			nil, commitSig,
+		)
+	} else {
+		reservation := resCtx.reservation
+		cand.channel, err = reservation.CompleteReservationSingle(
+			&cand.chanPoint, commitSig,
+			fn.None[lnwallet.AuxFundingDesc](),
+		)
+	}
+	if err != nil {
+		return err
+	}
+
+	// The channel is persisted, so the reservation isn't needed anymore.
+	f.deleteReservationCtx(df.peerKey, df.pendingChanID)
+
+	if df.initiator {
+		return nil
+	}
+
+	_, sig := resCtx.reservation.OurSignatures()
+	ourSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return err
+	}
+
+	return df.peer.SendMessage(true, &lnwire.CommitSig{
+		ChanID:    df.chanID,
+		CommitSig: ourSig,
+	})
+}
+
+// persistRbfCandidate persists the channel backed by a replacement funding
+// transaction next to the channel backed by the transaction it replaces.
+func (f *Manager) persistRbfCandidate(df *dualFunding,
+	cand *fundingCandidate) error {
+
+	// We fetch a fresh copy of the replaced channel, which only differs in
+	// its funding transaction and commitments.
+	channel, err := f.cfg.ChannelDB.FetchChannel(df.signed.chanPoint)
+	if err != nil {
+		return err
+	}
+	channel.FundingOutpoint = cand.chanPoint
+	channel.FundingTxn = cand.constructed.Tx
+	channel.LocalCommitment = cand.commits.LocalCommitment
+	channel.RemoteCommitment = cand.commits.RemoteCommitment
+
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return err
+	}
+	err = channel.SyncPending(df.peer.Address(), uint32(bestHeight))
+	if err != nil {
+		return err
+	}
+	cand.channel = channel
+
+	return nil
+}
+
+// trackDualFundedChannel starts waiting for the funding transaction of a
+// persisted dual-funded channel to confirm.
+func (f *Manager) trackDualFundedChannel(df *dualFunding,
+	channel *channeldb.OpenChannel) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(channel.FundingOutpoint)
+	log.Debugf("Creating chan barrier for ChanID(%v)", chanID)
+
+	err := f.saveInitialForwardingPolicy(chanID, &df.forwardingPolicy)
+	if err != nil {
+		log.Errorf("Unable to store the forwarding policy: %v", err)
+	}
+
+	if err := df.peer.AddPendingChannel(chanID, f.quit); err != nil {
+		log.Errorf("Unable to add pending channel %v with peer %x: %v",
+			chanID, df.peerKey.SerializeCompressed(), err)
+	}
+
+	if err := f.cfg.WatchNewChannel(channel, df.peerKey); err != nil {
+		log.Errorf("Unable to send new ChannelPoint(%v) for "+
+			"arbitration: %v", channel.FundingOutpoint, err)
+	}
+
+	f.localDiscoverySignals.Store(chanID, make(chan struct{}))
+
+	f.cfg.NotifyPendingOpenChannelEvent(
+		channel.FundingOutpoint, channel, df.peerKey,
+	)
+
+	f.wg.Add(1)
+	go f.advanceFundingState(channel, df.pendingChanID, df.updates)
+}
+
+// sendDualTxSignatures sends our signatures for the funding transaction of
+// the candidate.
+func (f *Manager) sendDualTxSignatures(df *dualFunding,
+	cand *fundingCandidate) error {
+
+	tx := cand.constructed.Tx
+	witnesses := make([]wire.TxWitness, 0, len(cand.localIdx))
+	for _, idx := range cand.localIdx {
+		witnesses = append(witnesses, tx.TxIn[idx].Witness)
+	}
+
+	// Once sent, the remote party may broadcast the funding transaction,
+	// so we consider the signatures sent even if sending them fails.
+	cand.sentTxSigs = true
+
+	return df.peer.SendMessage(true, &lnwire.TxSignatures{
+		ChannelID: df.chanID,
+		TxID:      tx.TxHash(),
+		Witnesses: witnesses,
+	})
+}
+
+// receiveDualTxSignatures processes the signatures of the remote party for
+// the funding transaction, then broadcasts the fully signed transaction.
+func (f *Manager) receiveDualTxSignatures(df *dualFunding,
+	msg *lnwire.TxSignatures) error {
+
+	if df.state != dualAwaitingTxSigs {
+		return fmt.Errorf("unexpected tx_signatures in state %v",
+			df.state)
+	}
+
+	cand := df.candidate
+	tx := cand.constructed.Tx
+	if msg.TxID != tx.TxHash() {
+		return fmt.Errorf("tx_signatures for unknown transaction %v",
+			msg.TxID)
+	}
+	if len(msg.Witnesses) != len(cand.remoteIdx) {
+		return fmt.Errorf("received %d witnesses for %d inputs",
+			len(msg.Witnesses), len(cand.remoteIdx))
+	}
+
+	signedTx := tx.Copy()
+	for i, idx := range cand.remoteIdx {
+		signedTx.TxIn[idx].Witness = msg.Witnesses[i]
+	}
+	err := verifyFundingTx(
+		signedTx, cand.constructed.PrevOutputFetcher(),
+		cand.remoteIdx,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := cand.channel.UpdateFundingTxn(signedTx); err != nil {
+		return err
+	}
+	f.publishDualFundingTx(signedTx, cand.chanPoint)
+
+	df.signed = cand
+	df.candidate = nil
+	df.session = nil
+	df.state = dualSigned
+
+	switch {
+	case df.rbf:
+		df.funding = df.rbfFunding
+		df.feeRate = df.rbfFeeRate
+		df.lockTime = df.rbfLockTime
+		df.rbf = false
+		df.rbfFunding = nil
+
+		if df.rbfResp != nil {
+			df.rbfResp <- fn.Ok(cand.chanPoint)
+			df.rbfResp = nil
+		}
+
+	case df.initiator:
+		upd := &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid:        cand.chanPoint.Hash[:],
+					OutputIndex: cand.chanPoint.Index,
+				},
+			},
+			PendingChanId: df.pendingChanID[:],
+		}
+
+		select {
+		case df.updates <- upd:
+		case <-f.quit:
+			return nil
+		}
+	}
+
+	if cand.sentTxSigs {
+		return nil
+	}
+
+	return f.sendDualTxSignatures(df, cand)
+}
+
+// publishDualFundingTx broadcasts a fully signed funding transaction.
+func (f *Manager) publishDualFundingTx(tx *wire.MsgTx,
+	chanPoint wire.OutPoint) {
+
+	log.Infof("Broadcasting funding tx for ChannelPoint(%v): %v",
+		chanPoint, tx.TxHash())
+
+	label := labels.MakeLabel(labels.LabelTypeChannelOpen, nil)
+	if err := f.cfg.PublishTransaction(tx, label); err != nil {
+		log.Errorf("Unable to broadcast funding tx for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
+}
+
+// BumpFundingFee replaces the funding transaction of a pending dual-funded
+// channel we opened with one that pays the given fee rate. The replacement is
+// negotiated with the remote peer, and its channel point is returned once it
+// was fully signed.
+func (f *Manager) BumpFundingFee(chanPoint wire.OutPoint,
+	feeRate chainfee.SatPerKWeight) (wire.OutPoint, error) {
+
+	req := &bumpFeeRequest{
+		chanPoint: chanPoint,
+		feeRate:   feeRate,
+		resp:      make(chan fn.Result[wire.OutPoint], 1),
+	}
+
+	select {
+	case f.bumpFeeRequests <- req:
+	case <-f.quit:
+		return wire.OutPoint{}, ErrFundingManagerShuttingDown
+	}
+
+	select {
+	case res := <-req.resp:
+		return res.Unpack()
+	case <-f.quit:
+		return wire.OutPoint{}, ErrFundingManagerShuttingDown
+	}
+}
+
+// handleBumpFeeRequest starts the negotiation of a replacement funding
+// transaction by sending tx_init_rbf.
+func (f *Manager) handleBumpFeeRequest(req *bumpFeeRequest) {
+	var df *dualFunding
+	f.dualFundings.Range(func(chanID lnwire.ChannelID,
+		d *dualFunding) bool {
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		if d.signed != nil && d.signed.chanPoint == req.chanPoint {
+			df = d
+			return false
+		}
+
+		return true
+	})
+	if df == nil {
+		req.resp <- fn.Err[wire.OutPoint](fmt.Errorf("no pending "+
+			"dual-funded channel with funding transaction %v",
+			req.chanPoint))
+		return
+	}
+
+	df, ok := f.loadDualFunding(df.chanID)
+	if !ok {
+		req.resp <- fn.Err[wire.OutPoint](fmt.Errorf("funding of "+
+			"ChannelPoint(%v) is complete", req.chanPoint))
+		return
+	}
+	defer df.mu.Unlock()
+
+	minFeeRate := minRbfFeeRate(df.feeRate)
+	switch {
+	case !df.initiator:
+		req.resp <- fn.Err[wire.OutPoint](errors.New("only the " +
+			"opener can replace the funding transaction"))
+		return
+
+	case df.state != dualSigned:
+		req.resp <- fn.Err[wire.OutPoint](fmt.Errorf("funding "+
+			"transaction of ChannelPoint(%v) is being replaced",
+			req.chanPoint))
+		return
+
+	case req.feeRate < minFeeRate:
+		req.resp <- fn.Err[wire.OutPoint](fmt.Errorf("fee rate %v "+
+			"must be at least %v", req.feeRate, minFeeRate))
+		return
+	}
+
+	var weight input.TxWeightEstimator
+	weight.AddP2WSHOutput()
+	funding, err := df.funding.ReplacementFunding(
+		df.localAmt, req.feeRate, weight,
+	)
+	if err != nil {
+		req.resp <- fn.Err[wire.OutPoint](err)
+		return
+	}
+
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		req.resp <- fn.Err[wire.OutPoint](err)
+		return
+	}
+
+	log.Infof("Replacing funding transaction of ChannelPoint(%v) at fee "+
+		"rate %v", req.chanPoint, req.feeRate)
+
+	df.rbf = true
+	df.rbfFunding = funding
+	df.rbfFeeRate = req.feeRate
+	df.rbfLockTime = uint32(bestHeight)
+	df.rbfResp = req.resp
+	df.state = dualAwaitingRbfAck
+
+	err = df.peer.SendMessage(true, &lnwire.TxInitRbf{
+		ChannelID: df.chanID,
+		LockTime:  df.rbfLockTime,
+		FeeRate:   uint32(req.feeRate),
+		FundingContribution: tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0](
+				uint64(df.localAmt),
+			),
+		),
+	})
+	if err != nil {
+		f.failDualFunding(df, err, false)
+	}
+}
+
+// receiveTxInitRbf processes the request of the opener to replace the funding
+// transaction. Our contribution is funded by the same inputs at the new fee
+// rate.
+func (f *Manager) receiveTxInitRbf(df *dualFunding,
+	msg *lnwire.TxInitRbf) error {
+
+	if df.initiator || df.state != dualSigned {
+		return fmt.Errorf("unexpected tx_init_rbf in state %v",
+			df.state)
+	}
+
+	feeRate := chainfee.SatPerKWeight(msg.FeeRate)
+	if feeRate < minRbfFeeRate(df.feeRate) {
+		return fmt.Errorf("fee rate %v must be at least %v", feeRate,
+			minRbfFeeRate(df.feeRate))
+	}
+	err := checkRbfContribution(msg.FundingContribution, df.remoteAmt)
+	if err != nil {
+		return err
+	}
+
+	funding := df.funding
+	if df.localAmt > 0 {
+		funding, err = df.funding.ReplacementFunding(
+			df.localAmt, feeRate, input.TxWeightEstimator{},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Infof("Peer %x replaces funding transaction of dual-funded "+
+		"channel %v at fee rate %v", df.peerKey.SerializeCompressed(),
+		df.chanID, feeRate)
+
+	df.rbf = true
+	df.rbfFunding = funding
+	df.rbfFeeRate = feeRate
+	df.rbfLockTime = msg.LockTime
+	df.session = newDualFundingSession(df, funding, msg.LockTime)
+	df.state = dualConstructing
+
+	return df.peer.SendMessage(true, &lnwire.TxAckRbf{
+		ChannelID: df.chanID,
+		FundingContribution: tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0](
+				uint64(df.localAmt),
+			),
+		),
+	})
+}
+
+// receiveTxAckRbf processes the acknowledgment of our request to replace the
+// funding transaction, which starts its construction.
+func (f *Manager) receiveTxAckRbf(df *dualFunding,
+	msg *lnwire.TxAckRbf) error {
+
+	if df.state != dualAwaitingRbfAck {
+		return fmt.Errorf("unexpected tx_ack_rbf in state %v",
+			df.state)
+	}
+
+	err := checkRbfContribution(msg.FundingContribution, df.remoteAmt)
+	if err != nil {
+		return err
+	}
+
+	df.session = newDualFundingSession(df, df.rbfFunding, df.rbfLockTime)
+	df.state = dualConstructing
+
+	return f.startDualFundingSession(df)
+}
+
+// checkRbfContribution checks that the remote party keeps its contribution to
+// the funding output when the funding transaction is replaced.
+func checkRbfContribution(contribution lnwire.FundingContributionTLV,
+	remoteAmt btcutil.Amount) error {
+
+	amt := remoteAmt
+	contribution.WhenSomeV(func(v uint64) {
+		amt = btcutil.Amount(v)
+	})
+	if amt != remoteAmt {
+		return fmt.Errorf("contribution of %v doesn't match the "+
+			"contribution of %v to the replaced transaction", amt,
+			remoteAmt)
+	}
+
+	return nil
+}
+
+// failDualFunding aborts the negotiation of the funding transaction that is in
+// progress. If a funding transaction was already signed, only its replacement
+// is aborted, otherwise the whole negotiation is. The caller must hold the
+// lock of the negotiation.
+func (f *Manager) failDualFunding(df *dualFunding, fundingErr error,
+	notifyPeer bool) {
+
+	log.Debugf("Failing negotiation of dual-funded channel %v for "+
+		"pending_id=%x in state %v: %v", df.chanID, df.pendingChanID,
+		df.state, fundingErr)
+
+	// The remote party doesn't know the channel ID before it accepted the
+	// channel, so the funding flow fails like a single-funded one.
+	if df.state == dualAwaitingAccept {
+		f.failFundingFlow(
+			df.peer, newChanIdentifier(df.pendingChanID),
+			fundingErr,
+		)
+		return
+	}
+
+	if notifyPeer {
+		abort := &lnwire.TxAbort{
+			ChannelID: df.chanID,
+			Data:      errorData(fundingErr),
+		}
+		if err := df.peer.SendMessage(false, abort); err != nil {
+			log.Errorf("Unable to send tx_abort: %v", err)
+		}
+	}
+
+	cand := df.candidate
+	switch {
+	// The remote party may broadcast a funding transaction we signed, so
+	// its channel is kept until a conflicting transaction confirms or it
+	// times out.
+	case cand != nil && cand.sentTxSigs:
+		log.Warnf("Keeping ChannelPoint(%v) of aborted negotiation as "+
+			"its funding transaction was signed", cand.chanPoint)
+
+	case cand != nil && cand.channel != nil:
+		f.abandonFunding(cand.channel)
+
+		chanID := lnwire.NewChanIDFromOutPoint(cand.chanPoint)
+		if err := df.peer.RemovePendingChannel(chanID); err != nil {
+			log.Errorf("Unable to remove channel %v with peer "+
+				"%x: %v", chanID,
+				df.peerKey.SerializeCompressed(), err)
+		}
+
+		// A replacement spends the inputs of the signed funding
+		// transaction, so they're only released for the initial one.
+		if df.signed == nil {
+			f.cfg.Wallet.ReleaseInteractiveInputs(df.funding.Inputs)
+		}
+
+	// Before the channel was persisted, its inputs are released along
+	// with the reservation.
+	case df.signed == nil:
+		_, err := f.cancelReservationCtx(
+			df.peerKey, df.pendingChanID, false,
+		)
+		if err != nil {
+			log.Errorf("unable to cancel reservation: %v", err)
+		}
+	}
+
+	if df.rbfResp != nil {
+		df.rbfResp <- fn.Err[wire.OutPoint](fundingErr)
+	}
+	df.session = nil
+	df.candidate = nil
+	df.rbf = false
+	df.rbfFunding = nil
+	df.rbfResp = nil
+
+	if df.signed != nil {
+		df.state = dualSigned
+		return
+	}
+
+	f.dualFundings.Delete(df.chanID)
+
+	select {
+	case df.err <- fundingErr:
+	default:
+	}
+}
+
+// abandonFunding closes a pending dual-funded channel whose funding
+// transaction won't confirm and stops waiting for its confirmation.
+func (f *Manager) abandonFunding(channel *channeldb.OpenChannel) {
+	log.Infof("Abandoning funding transaction of ChannelPoint(%v)",
+		channel.FundingOutpoint)
+
+	if err := cancelPendingChannel(channel); err != nil {
+		log.Errorf("Unable to abandon ChannelPoint(%v): %v",
+			channel.FundingOutpoint, err)
+	}
+
+	f.abandonMtx.Lock()
+	defer f.abandonMtx.Unlock()
+
+	signal, ok := f.abandonedFundings[channel.FundingOutpoint]
+	if !ok {
+		signal = make(chan struct{})
+		f.abandonedFundings[channel.FundingOutpoint] = signal
+	}
+
+	select {
+	case <-signal:
+	default:
+		close(signal)
+	}
+}
+
+// abandonSignal returns the signal that is closed once the funding
+// transaction of the pending dual-funded channel with the given channel point
+// is abandoned.
+func (f *Manager) abandonSignal(chanPoint wire.OutPoint) <-chan struct{} {
+	f.abandonMtx.Lock()
+	defer f.abandonMtx.Unlock()
+
+	signal, ok := f.abandonedFundings[chanPoint]
+	if !ok {
+		signal = make(chan struct{})
+		f.abandonedFundings[chanPoint] = signal
+	}
+
+	return signal
+}
+
+// removeAbandonSignal removes the signal of the given channel point once we
+// stopped waiting for its funding transaction.
+func (f *Manager) removeAbandonSignal(chanPoint wire.OutPoint) {
+	f.abandonMtx.Lock()
+	delete(f.abandonedFundings, chanPoint)
+	f.abandonMtx.Unlock()
+}
+
+// completeDualFunding is called once the funding transaction of a dual-funded
+// channel confirmed. The pending channels backed by the funding transactions
+// it replaced or was replaced by are abandoned, and the negotiation of the
+// channel is complete.
+func (f *Manager) completeDualFunding(confirmed *channeldb.OpenChannel,
+	pendingChanID PendingChanID) {
+
+	f.dualFundings.Range(func(chanID lnwire.ChannelID,
+		df *dualFunding) bool {
+
+		if df.pendingChanID != pendingChanID ||
+			!df.peerKey.IsEqual(confirmed.IdentityPub) {
+
+			return true
+		}
+
+		df.mu.Lock()
+		if df.state != dualSigned {
+			f.failDualFunding(df, errFundingConfirmed, true)
+		}
+		f.dualFundings.Delete(chanID)
+		df.mu.Unlock()
+
+		return false
+	})
+
+	channels, err := f.cfg.ChannelDB.FetchOpenChannels(
+		confirmed.IdentityPub,
+	)
+	if err != nil {
+		log.Errorf("Unable to fetch channels of peer %x: %v",
+			confirmed.IdentityPub.SerializeCompressed(), err)
+		return
+	}
+
+	for _, channel := range channels {
+		if !channel.IsPending || !channel.ChanType.IsDualFunder() ||
+			channel.FundingOutpoint == confirmed.FundingOutpoint {
+
+			continue
+		}
+
+		if !spendsSameInput(channel.FundingTxn, confirmed.FundingTxn) {
+			continue
+		}
+
+		log.Infof("Funding transaction of ChannelPoint(%v) was "+
+			"replaced by ChannelPoint(%v)", channel.FundingOutpoint,
+			confirmed.FundingOutpoint)
+
+		f.abandonFunding(channel)
+	}
+}
+
+// checkDualFundedChanType checks that the negotiated channel type is
+// supported for dual-funded channels, and whether a liquidity lease is
+// negotiated for the channel.
+func checkDualFundedChanType(chanType *lnwire.ChannelType,
+	commitType lnwallet.CommitmentType, leased bool) error {
+
+	if commitType.IsTaproot() {
+		return fmt.Errorf("commitment type %v not supported for "+
+			"dual-funded channels", commitType)
+	}
+
+	if err := checkLeaseCommitType(commitType, leased); err != nil {
+		return err
+	}
+
+	if chanType == nil {
+		return nil
+	}
+
+	featureVec := lnwire.RawFeatureVector(*chanType)
+	if featureVec.IsSet(lnwire.ZeroConfRequired) ||
+		featureVec.IsSet(lnwire.ScidAliasRequired) {
+
+		return errors.New("zero-conf and scid-alias not supported " +
+			"for dual-funded channels")
+	}
+
+	return nil
+}
+
+// secondCommitmentPoint returns our commitment point for the second state of
+// the reserved channel, which is sent along with the first one when opening a
+// dual-funded channel.
+func secondCommitmentPoint(
+	reservation *lnwallet.ChannelReservation) (*btcec.PublicKey, error) {
+
+	secret, err := reservation.ChanState().RevocationProducer.AtIndex(1)
+	if err != nil {
+		return nil, err
+	}
+
+	return input.ComputeCommitmentPoint(secret[:]), nil
+}
+
+// dualFundingOutput returns the P2WSH multi-sig funding output of a
+// dual-funded channel.
+func dualFundingOutput(localKey, remoteKey *btcec.PublicKey,
+	capacity btcutil.Amount) (*wire.TxOut, error) {
+
+	_, fundingOutput, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+		int64(capacity),
+	)
+
+	return fundingOutput, err
+}
+
+// dualFundedReserve returns the channel reserve of a dual-funded channel,
+// which is one percent of its capacity but at least the dust limit.
+func dualFundedReserve(capacity, dustLimit btcutil.Amount) btcutil.Amount {
+	reserve := capacity / 100
+	if reserve < dustLimit {
+		return dustLimit
+	}
+
+	return reserve
+}
+
+// minRbfFeeRate returns the minimum fee rate of a funding transaction that
+// replaces one with the given fee rate.
+func minRbfFeeRate(feeRate chainfee.SatPerKWeight) chainfee.SatPerKWeight {
+	return feeRate * 25 / 24
+}
+
+// findFundingOutput returns the index of the funding output in the
+// constructed funding transaction.
+func findFundingOutput(tx *wire.MsgTx, fundingOutput *wire.TxOut) (int,
+	error) {
+
+	idx := -1
+	for i, txOut := range tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingOutput.PkScript) {
+			continue
+		}
+		if idx != -1 {
+			return 0, fmt.Errorf("%w: multiple funding outputs",
+				interactivetx.ErrInvalidTx)
+		}
+
+		idx = i
+	}
+
+	switch {
+	case idx == -1:
+		return 0, fmt.Errorf("%w: missing funding output",
+			interactivetx.ErrInvalidTx)
+
+	case tx.TxOut[idx].Value != fundingOutput.Value:
+		return 0, fmt.Errorf("%w: funding output value %v, expected "+
+			"%v", interactivetx.ErrInvalidTx, tx.TxOut[idx].Value,
+			fundingOutput.Value)
+	}
+
+	return idx, nil
+}
+
+// checkContributions checks that the inputs each party added to the funding
+// transaction fund its contribution to the funding output, on top of the other
+// outputs it added.
+func checkContributions(constructed *interactivetx.ConstructedTx,
+	fundingIdx int, localAmt, remoteAmt btcutil.Amount) error {
+
+	fundingOutput := constructed.Outputs[fundingIdx]
+	for _, party := range []lntypes.ChannelParty{
+		lntypes.Local, lntypes.Remote,
+	} {
+
+		in, out := constructed.PartyAmounts(party)
+		if fundingOutput.Party == party {
+			out -= btcutil.Amount(fundingOutput.TxOut.Value)
+		}
+
+		amt := localAmt
+		if party == lntypes.Remote {
+			amt = remoteAmt
+		}
+		if in-out < amt {
+			return fmt.Errorf("%w: %v inputs of %v and outputs of "+
+				"%v don't fund contribution of %v",
+				interactivetx.ErrInvalidTx, party, in, out, amt)
+		}
+	}
+
+	return nil
+}
+
+// sendTxSigsFirst returns true if we must send our signatures for the funding
+// transaction first, which is the case if we contributed less than the remote
+// party, with ties broken by the node keys.
+func sendTxSigsFirst(constructed *interactivetx.ConstructedTx, localKey,
+	remoteKey *btcec.PublicKey) bool {
+
+	localIn, _ := constructed.PartyAmounts(lntypes.Local)
+	remoteIn, _ := constructed.PartyAmounts(lntypes.Remote)
+
+	return localIn < remoteIn || (localIn == remoteIn &&
+		bytes.Compare(
+			localKey.SerializeCompressed(),
+			remoteKey.SerializeCompressed(),
+		) < 0)
+}
+
+// verifyFundingTx verifies the witnesses of the given inputs of the funding
+// transaction.
+func verifyFundingTx(tx *wire.MsgTx, prevOuts txscript.PrevOutputFetcher,
+	indexes []int) error {
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for _, idx := range indexes {
+		prevOut := prevOuts.FetchPrevOutput(
+			tx.TxIn[idx].PreviousOutPoint,
+		)
+		if prevOut == nil {
+			return fmt.Errorf("unknown output spent by input %d",
+				idx)
+		}
+
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags,
+			nil, sigHashes, prevOut.Value, prevOuts,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid witness for input %d: %w",
+				idx, err)
+		}
+	}
+
+	return nil
+}
+
+// isFundingTxSigned returns true if the funding transaction of the channel is
+// known and fully signed.
+func isFundingTxSigned(channel *channeldb.OpenChannel) bool {
+	if channel.FundingTxn == nil {
+		return false
+	}
+
+	for _, txIn := range channel.FundingTxn.TxIn {
+		if len(txIn.Witness) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// spendsSameInput returns true if both transactions spend a common output,
+// which makes them conflict.
+func spendsSameInput(a, b *wire.MsgTx) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	spent := make(map[wire.OutPoint]struct{}, len(a.TxIn))
+	for _, txIn := range a.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, txIn := range b.TxIn {
+		if _, ok := spent[txIn.PreviousOutPoint]; ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,247 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/stretchr/testify/require"
+)
+
+// testConstructedTx returns a constructed funding transaction with one input
+// of each party, the funding output added by the local party and a change
+// output of each party.
+func testConstructedTx(localIn, remoteIn, fundingAmt, localChange,
+	remoteChange btcutil.Amount) *interactivetx.ConstructedTx {
+
+	newInput := func(idx uint32, amt btcutil.Amount,
+		party lntypes.ChannelParty) *interactivetx.Input {
+
+		return &interactivetx.Input{
+			OutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{byte(idx + 1)},
+				Index: idx,
+			},
+			PrevOut: &wire.TxOut{Value: int64(amt)},
+			Party:   party,
+		}
+	}
+	newOutput := func(amt btcutil.Amount, script byte,
+		party lntypes.ChannelParty) *interactivetx.Output {
+
+		return &interactivetx.Output{
+			TxOut: &wire.TxOut{
+				Value:    int64(amt),
+				PkScript: []byte{script},
+			},
+			Party: party,
+		}
+	}
+
+	constructed := &interactivetx.ConstructedTx{
+		Tx: wire.NewMsgTx(2),
+		Inputs: []*interactivetx.Input{
+			newInput(0, localIn, lntypes.Local),
+			newInput(1, remoteIn, lntypes.Remote),
+		},
+		Outputs: []*interactivetx.Output{
+			newOutput(fundingAmt, 0, lntypes.Local),
+			newOutput(localChange, 1, lntypes.Local),
+			newOutput(remoteChange, 2, lntypes.Remote),
+		},
+	}
+	for _, in := range constructed.Inputs {
+		constructed.Tx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: in.OutPoint,
+		})
+	}
+	for _, out := range constructed.Outputs {
+		constructed.Tx.AddTxOut(out.TxOut)
+	}
+
+	return constructed
+}
+
+// TestCheckContributions asserts that the inputs of each party must fund its
+// contribution to the funding output.
+func TestCheckContributions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name         string
+		localIn      btcutil.Amount
+		remoteIn     btcutil.Amount
+		localChange  btcutil.Amount
+		remoteChange btcutil.Amount
+		expectErr    bool
+	}{
+		{
+			name:         "both contributions funded",
+			localIn:      70_000,
+			remoteIn:     50_000,
+			localChange:  9_000,
+			remoteChange: 9_000,
+		},
+		{
+			name:         "local contribution not funded",
+			localIn:      70_000,
+			remoteIn:     50_000,
+			localChange:  10_001,
+			remoteChange: 9_000,
+			expectErr:    true,
+		},
+		{
+			name:         "remote contribution not funded",
+			localIn:      70_000,
+			remoteIn:     50_000,
+			localChange:  9_000,
+			remoteChange: 10_001,
+			expectErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			constructed := testConstructedTx(
+				tc.localIn, tc.remoteIn, 100_000,
+				tc.localChange, tc.remoteChange,
+			)
+			err := checkContributions(
+				constructed, 0, 60_000, 40_000,
+			)
+			if tc.expectErr {
+				require.ErrorIs(
+					t, err, interactivetx.ErrInvalidTx,
+				)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestFindFundingOutput asserts that the funding transaction must contain
+// exactly one funding output with the expected value.
+func TestFindFundingOutput(t *testing.T) {
+	t.Parallel()
+
+	fundingOutput := &wire.TxOut{Value: 100_000, PkScript: []byte{0}}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(&wire.TxOut{Value: 1_000, PkScript: []byte{1}})
+	tx.AddTxOut(&wire.TxOut{Value: 100_000, PkScript: []byte{0}})
+
+	idx, err := findFundingOutput(tx, fundingOutput)
+	require.NoError(t, err)
+	require.Equal(t, 1, idx)
+
+	// A funding output with a different value is rejected.
+	tx.TxOut[1].Value = 99_999
+	_, err = findFundingOutput(tx, fundingOutput)
+	require.ErrorIs(t, err, interactivetx.ErrInvalidTx)
+
+	// So are several funding outputs.
+	tx.TxOut[1].Value = 100_000
+	tx.AddTxOut(&wire.TxOut{Value: 100_000, PkScript: []byte{0}})
+	_, err = findFundingOutput(tx, fundingOutput)
+	require.ErrorIs(t, err, interactivetx.ErrInvalidTx)
+
+	// And a missing one.
+	_, err = findFundingOutput(wire.NewMsgTx(2), fundingOutput)
+	require.ErrorIs(t, err, interactivetx.ErrInvalidTx)
+}
+
+// TestSendTxSigsFirst asserts that the party that contributed less inputs
+// sends its signatures first, with ties broken by the node keys.
+func TestSendTxSigsFirst(t *testing.T) {
+	t.Parallel()
+
+	privA, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	privB, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	lowKey, highKey := privA.PubKey(), privB.PubKey()
+	if string(lowKey.SerializeCompressed()) >
+		string(highKey.SerializeCompressed()) {
+
+		lowKey, highKey = highKey, lowKey
+	}
+
+	less := testConstructedTx(40_000, 60_000, 90_000, 0, 0)
+	require.True(t, sendTxSigsFirst(less, highKey, lowKey))
+
+	more := testConstructedTx(60_000, 40_000, 90_000, 0, 0)
+	require.False(t, sendTxSigsFirst(more, lowKey, highKey))
+
+	tie := testConstructedTx(50_000, 50_000, 90_000, 0, 0)
+	require.True(t, sendTxSigsFirst(tie, lowKey, highKey))
+	require.False(t, sendTxSigsFirst(tie, highKey, lowKey))
+}
+
+// TestMinRbfFeeRate asserts that a replacement funding transaction must pay
+// at least 25/24 of the fee rate it replaces.
+func TestMinRbfFeeRate(t *testing.T) {
+	t.Parallel()
+
+	require.EqualValues(t, 2500, minRbfFeeRate(2400))
+	require.EqualValues(t, 253, minRbfFeeRate(243))
+}
+
+// TestDualFundedReserve asserts that the reserve of a dual-funded channel is
+// one percent of its capacity, but at least the dust limit.
+func TestDualFundedReserve(t *testing.T) {
+	t.Parallel()
+
+	require.EqualValues(t, 10_000, dualFundedReserve(1_000_000, 354))
+	require.EqualValues(t, 354, dualFundedReserve(20_000, 354))
+}
+
+// TestIsFundingTxSigned asserts that a funding transaction is only considered
+// signed if all its inputs have a witness.
+func TestIsFundingTxSigned(t *testing.T) {
+	t.Parallel()
+
+	channel := &channeldb.OpenChannel{}
+	require.False(t, isFundingTxSigned(channel))
+
+	channel.FundingTxn = wire.NewMsgTx(2)
+	channel.FundingTxn.AddTxIn(&wire.TxIn{
+		Witness: wire.TxWitness{{1}},
+	})
+	channel.FundingTxn.AddTxIn(&wire.TxIn{})
+	require.False(t, isFundingTxSigned(channel))
+
+	channel.FundingTxn.TxIn[1].Witness = wire.TxWitness{{2}}
+	require.True(t, isFundingTxSigned(channel))
+}
+
+// TestSpendsSameInput asserts that funding transactions conflict if they spend
+// a common output.
+func TestSpendsSameInput(t *testing.T) {
+	t.Parallel()
+
+	newTx := func(ops ...wire.OutPoint) *wire.MsgTx {
+		tx := wire.NewMsgTx(2)
+		for _, op := range ops {
+			tx.AddTxIn(&wire.TxIn{PreviousOutPoint: op})
+		}
+
+		return tx
+	}
+
+	opA := wire.OutPoint{Hash: chainhash.Hash{1}}
+	opB := wire.OutPoint{Hash: chainhash.Hash{2}}
+	opC := wire.OutPoint{Hash: chainhash.Hash{3}}
+
+	require.True(t, spendsSameInput(newTx(opA, opB), newTx(opB, opC)))
+	require.False(t, spendsSameInput(newTx(opA), newTx(opB, opC)))
+	require.False(t, spendsSameInput(nil, newTx(opA)))
+}
@@ -0,0 +1,194 @@
+package funding
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// leaseHeightTolerance is the maximum number of blocks the start height of a
+// requested lease may differ from our best height. Both parties may not have
+// processed the latest blocks yet when the lease is negotiated.
+const leaseHeightTolerance = 6
+
+var (
+	// ErrLeaseNotOffered is returned if a peer requests to lease
+	// liquidity from us, but we don't sell any.
+	ErrLeaseNotOffered = errors.New("liquidity leases not offered")
+)
+
+// LeasePolicy is the policy with which we sell inbound liquidity to peers
+// that request it when they open a dual-funded channel with us.
+type LeasePolicy struct {
+	// Rates are the rates we advertise in our node announcement and
+	// charge for a lease.
+	Rates lnwire.LeaseRates
+
+	// MinLeaseAmt is the smallest amount we lease. Requests for less are
+	// rejected.
+	MinLeaseAmt btcutil.Amount
+
+	// MaxLeaseAmt is the largest amount we lease in a single channel.
+	// Requests for more are served up to this amount.
+	MaxLeaseAmt btcutil.Amount
+}
+
+// lease is a liquidity lease that was negotiated for a dual-funded channel.
+type lease struct {
+	// amt is the amount the seller contributes to the channel.
+	amt btcutil.Amount
+
+	// fee is the lease fee the buyer pays to the seller.
+	fee btcutil.Amount
+
+	// expiry is the height at which the lease expires.
+	expiry uint32
+
+	// rates are the rates of the seller.
+	rates lnwire.LeaseRates
+}
+
+// newLease decides on the lease requested by the opener of a dual-funded
+// channel, given our best height and the fee rate of the funding
+// transaction.
+func (p *LeasePolicy) newLease(req *lnwire.RequestFunds, bestHeight uint32,
+	fundingFeePerKw uint32) (*lease, error) {
+
+	if req.BlockHeight+leaseHeightTolerance < bestHeight ||
+		req.BlockHeight > bestHeight+leaseHeightTolerance {
+
+		return nil, fmt.Errorf("lease start height %v too far from "+
+			"best height %v", req.BlockHeight, bestHeight)
+	}
+
+	if req.RequestedAmount < p.MinLeaseAmt {
+		return nil, fmt.Errorf("requested lease of %v below minimum "+
+			"of %v", req.RequestedAmount, p.MinLeaseAmt)
+	}
+
+	amt := min(req.RequestedAmount, p.MaxLeaseAmt)
+
+	return &lease{
+		amt:    amt,
+		fee:    p.Rates.LeaseFee(amt, fundingFeePerKw),
+		expiry: req.LeaseExpiry(),
+		rates:  p.Rates,
+	}, nil
+}
+
+// sellLease decides on the lease requested by the opener of a dual-funded
+// channel with our lease policy.
+func (f *Manager) sellLease(msg *lnwire.OpenChannel2) (*lease, error) {
+	policy, err := f.cfg.LeasePolicy.UnwrapOrErr(ErrLeaseNotOffered)
+	if err != nil {
+		return nil, err
+	}
+
+	_, bestHeight, err := f.cfg.Wallet.Cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.newLease(
+		msg.RequestFunds, uint32(bestHeight), msg.FundingFeePerKw,
+	)
+}
+
+// signLease signs the commitment to a lease we sold with our node key.
+func (f *Manager) signLease(l *lease,
+	fundingKey *btcec.PublicKey) (*lnwire.WillFund, error) {
+
+	commitment := lnwire.LeaseCommitment(fundingKey, l.expiry, &l.rates)
+	sig, err := f.cfg.SignMessage(f.cfg.IDKeyLoc, commitment, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign lease: %w", err)
+	}
+
+	wireSig, err := lnwire.NewSigFromSignature(sig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwire.WillFund{
+		Signature: wireSig,
+		Rates:     l.rates,
+	}, nil
+}
+
+// verifyLease verifies the commitment of the seller to the lease we requested
+// and returns the negotiated lease. The lease fee may not exceed the given
+// maximum.
+func verifyLease(req *lnwire.RequestFunds, willFund *lnwire.WillFund,
+	sellerKey, fundingKey *btcec.PublicKey, amt btcutil.Amount,
+	fundingFeePerKw uint32, maxFee btcutil.Amount) (*lease, error) {
+
+	if willFund == nil {
+		return nil, errors.New("requested lease not accepted")
+	}
+	if amt == 0 {
+		return nil, errors.New("lease seller didn't contribute")
+	}
+
+	sig, err := willFund.Signature.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	commitment := lnwire.LeaseCommitment(
+		fundingKey, req.LeaseExpiry(), &willFund.Rates,
+	)
+	if !sig.Verify(chainhash.HashB(commitment), sellerKey) {
+		return nil, errors.New("invalid lease signature")
+	}
+
+	fee := willFund.Rates.LeaseFee(amt, fundingFeePerKw)
+	if fee > maxFee {
+		return nil, fmt.Errorf("lease fee of %v exceeds maximum of %v",
+			fee, maxFee)
+	}
+
+	return &lease{
+		amt:    amt,
+		fee:    fee,
+		expiry: req.LeaseExpiry(),
+		rates:  willFund.Rates,
+	}, nil
+}
+
+// capLeaseForwardingFees caps the forwarding fees of a channel whose lease
+// we sold to the maximum fees we committed to.
+func capLeaseForwardingFees(policy *models.ForwardingPolicy,
+	rates *lnwire.LeaseRates) {
+
+	maxBaseFee := lnwire.MilliSatoshi(rates.ChannelFeeMaxBase)
+	policy.BaseFee = min(policy.BaseFee, maxBaseFee)
+
+	maxFeeRate := lnwire.MilliSatoshi(rates.MaxFeeRatePPM())
+	policy.FeeRate = min(policy.FeeRate, maxFeeRate)
+}
+
+// checkLeaseCommitType checks that the commitment type of a dual-funded
+// channel matches whether a lease is negotiated. The funds of the seller are
+// locked until the lease expires with the script enforced lease commitment
+// type.
+func checkLeaseCommitType(commitType lnwallet.CommitmentType,
+	leased bool) error {
+
+	isLease := commitType == lnwallet.CommitmentTypeScriptEnforcedLease
+	switch {
+	case leased && !isLease:
+		return fmt.Errorf("commitment type %v can't be used for "+
+			"leased channels", commitType)
+
+	case !leased && isLease:
+		return errors.New("script enforced lease commitment type " +
+			"requires a liquidity lease")
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+package funding
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/ecdsa"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+var testLeaseRates = lnwire.LeaseRates{
+	FundingWeight:             400,
+	LeaseFeeBasis:             50,
+	ChannelFeeMaxProportional: 1,
+	LeaseFeeBase:              1_000,
+	ChannelFeeMaxBase:         2_000,
+}
+
+// TestNewLease asserts that a requested lease is served up to the maximum
+// amount of our policy, and rejected if it's too small or doesn't start at
+// our best height.
+func TestNewLease(t *testing.T) {
+	t.Parallel()
+
+	policy := &LeasePolicy{
+		Rates:       testLeaseRates,
+		MinLeaseAmt: 100_000,
+		MaxLeaseAmt: 1_000_000,
+	}
+
+	req := &lnwire.RequestFunds{
+		RequestedAmount: 2_000_000,
+		BlockHeight:     1_000,
+	}
+	l, err := policy.newLease(req, 1_002, 1_000)
+	require.NoError(t, err)
+	require.EqualValues(t, 1_000_000, l.amt)
+	require.EqualValues(t, 1_000+5_000+400, l.fee)
+	require.EqualValues(t, 1_000+lnwire.LeaseDuration, l.expiry)
+
+	// A lease that starts too far from our best height is rejected.
+	_, err = policy.newLease(req, 1_000+leaseHeightTolerance+1, 1_000)
+	require.Error(t, err)
+	_, err = policy.newLease(req, 1_000-leaseHeightTolerance-1, 1_000)
+	require.Error(t, err)
+
+	// So is one that's too small.
+	req.RequestedAmount = 99_999
+	_, err = policy.newLease(req, 1_000, 1_000)
+	require.Error(t, err)
+}
+
+// TestVerifyLease asserts that the buyer of a lease only accepts a lease that
+// is signed by the seller and doesn't exceed its maximum fee.
+func TestVerifyLease(t *testing.T) {
+	t.Parallel()
+
+	sellerPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	fundingPriv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	sellerKey := sellerPriv.PubKey()
+	fundingKey := fundingPriv.PubKey()
+
+	req := &lnwire.RequestFunds{
+		RequestedAmount: 1_000_000,
+		BlockHeight:     1_000,
+	}
+	signLease := func(priv *btcec.PrivateKey) *lnwire.WillFund {
+		commitment := lnwire.LeaseCommitment(
+			fundingKey, req.LeaseExpiry(), &testLeaseRates,
+		)
+		sig := ecdsa.Sign(priv, chainhash.HashB(commitment))
+		wireSig, err := lnwire.NewSigFromSignature(sig)
+		require.NoError(t, err)
+
+		return &lnwire.WillFund{
+			Signature: wireSig,
+			Rates:     testLeaseRates,
+		}
+	}
+	willFund := signLease(sellerPriv)
+
+	l, err := verifyLease(
+		req, willFund, sellerKey, fundingKey, 1_000_000, 1_000, 6_400,
+	)
+	require.NoError(t, err)
+	require.EqualValues(t, 6_400, l.fee)
+	require.EqualValues(t, req.LeaseExpiry(), l.expiry)
+
+	// A lease fee above our maximum is rejected.
+	_, err = verifyLease(
+		req, willFund, sellerKey, fundingKey, 1_000_000, 1_000, 6_399,
+	)
+	require.Error(t, err)
+
+	// So is a lease that isn't signed by the seller.
+	_, err = verifyLease(
+		req, signLease(fundingPriv), sellerKey, fundingKey, 1_000_000,
+		1_000, 6_400,
+	)
+	require.Error(t, err)
+
+	// Or that wasn't committed to at all.
+	_, err = verifyLease(
+		req, nil, sellerKey, fundingKey, 1_000_000, 1_000, 6_400,
+	)
+	require.Error(t, err)
+}
+
+// TestCapLeaseForwardingFees asserts that the forwarding fees of a channel
+// whose lease we sold are capped to the fees we committed to.
+func TestCapLeaseForwardingFees(t *testing.T) {
+	t.Parallel()
+
+	policy := &models.ForwardingPolicy{
+		BaseFee: 5_000,
+		FeeRate: 500,
+	}
+	capLeaseForwardingFees(policy, &testLeaseRates)
+	require.EqualValues(t, 2_000, policy.BaseFee)
+	require.EqualValues(t, 500, policy.FeeRate)
+
+	policy.FeeRate = 5_000
+	capLeaseForwardingFees(policy, &testLeaseRates)
+	require.EqualValues(t, 1_000, policy.FeeRate)
+}
+
+// TestCheckLeaseCommitType asserts that leased dual-funded channels must use
+// the script enforced lease commitment type, and only those.
+func TestCheckLeaseCommitType(t *testing.T) {
+	t.Parallel()
+
+	lease := lnwallet.CommitmentTypeScriptEnforcedLease
+	anchors := lnwallet.CommitmentTypeAnchorsZeroFeeHtlcTx
+
+	require.NoError(t, checkLeaseCommitType(lease, true))
+	require.NoError(t, checkLeaseCommitType(anchors, false))
+	require.Error(t, checkLeaseCommitType(lease, false))
+	require.Error(t, checkLeaseCommitType(anchors, true))
+}
@@ -121,6 +121,15 @@ var (
 	ErrConfirmationTimeout = errors.New("timeout waiting for funding " +
 		"confirmation")
 
+	// ErrFundingAbandoned is an error returned when we stop waiting for
+	// the funding transaction of a dual-funded channel to confirm because
+	// a conflicting funding transaction confirmed instead.
+	ErrFundingAbandoned = errors.New("funding transaction abandoned")
+
+	// errPeerDisconnected is returned if the funding flow is canceled
+	// because the peer disconnected.
+	errPeerDisconnected = errors.New("peer disconnected")
+
 	// errUpfrontShutdownScriptNotSupported is returned if an upfront
 	// shutdown script is set for a peer that does not support the feature
 	// bit.
@@ -162,6 +171,9 @@ type reservationWithCtx struct {
 	// the channel.
 	channelType *lnwire.ChannelType
 
+	// dualFunding is the negotiation of the channel if it's dual-funded.
+	dualFunding *dualFunding
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -295,6 +307,20 @@ type InitFundingMsg struct {
 	// channel that will be useful to our future selves.
 	Memo []byte
 
+	// DualFunded indicates that the channel is opened with the interactive
+	// dual-funding protocol, which allows the remote peer to contribute
+	// to the channel as well.
+	DualFunded bool
+
+	// LeaseAmt is the amount of inbound liquidity we request to lease
+	// from the remote peer of a dual-funded channel. If it's zero, then no
+	// lease is requested. A lease requires the script enforced lease
+	// channel type.
+	LeaseAmt btcutil.Amount
+
+	// MaxLeaseFee is the maximum lease fee we pay for the requested lease.
+	MaxLeaseFee btcutil.Amount
+
 	// Updates is a channel which updates to the opening status of the
 	// channel are sent on.
 	Updates chan *lnrpc.OpenStatusUpdate
@@ -569,6 +595,11 @@ type Config struct {
 	// AuxResolver is an optional interface that can be used to modify the
 	// way contracts are resolved.
 	AuxResolver fn.Option[lnwallet.AuxContractResolver]
+
+	// LeasePolicy is the policy with which we sell inbound liquidity
+	// through liquidity ads. If it's not set, then we reject requests to
+	// lease liquidity from us.
+	LeasePolicy fn.Option[LeasePolicy]
 }
 
 // Manager acts as an orchestrator/bridge between the wallet's
@@ -637,6 +668,26 @@ type Manager struct {
 
 	handleChannelReadyBarriers *lnutils.SyncMap[lnwire.ChannelID, struct{}]
 
+	// dualFundings tracks the negotiations of dual-funded channels by the
+	// channel ID derived from the revocation basepoints of both parties.
+	// A negotiation is kept until the funding transaction confirms, so
+	// that it can be replaced in the meantime.
+	dualFundings *lnutils.SyncMap[lnwire.ChannelID, *dualFunding]
+
+	// abandonedFundings holds the signals that are closed once the
+	// funding transaction of a pending dual-funded channel is abandoned
+	// because a conflicting funding transaction confirmed.
+	//
+	// NOTE: This map is protected by the abandonMtx.
+	abandonedFundings map[wire.OutPoint]chan struct{}
+
+	// abandonMtx guards the abandonedFundings map.
+	abandonMtx sync.Mutex
+
+	// bumpFeeRequests is a channel used to receive requests to replace
+	// the funding transaction of a pending dual-funded channel.
+	bumpFeeRequests chan *bumpFeeRequest
+
 	quit chan struct{}
 	wg   sync.WaitGroup
 }
@@ -704,7 +755,12 @@ func NewFundingManager(cfg Config) (*Manager, error) {
 		pendingMusigNonces: make(
 			map[lnwire.ChannelID]*musig2.Nonces,
 		),
-		quit: make(chan struct{}),
+		dualFundings: &lnutils.SyncMap[
+			lnwire.ChannelID, *dualFunding,
+		]{},
+		abandonedFundings: make(map[wire.OutPoint]chan struct{}),
+		bumpFeeRequests:   make(chan *bumpFeeRequest),
+		quit:              make(chan struct{}),
 	}, nil
 }
 
@@ -755,6 +811,13 @@ func (f *Manager) start() error {
 				chanType.HasFundingTx() &&
 				channel.IsInitiator {
 
+				f.rebroadcastFundingTx(channel)
+			} else if chanType.IsDualFunder() &&
+				isFundingTxSigned(channel) {
+
+				// Both parties of a dual-funded channel
+				// rebroadcast its funding transaction once it
+				// was fully signed.
 				f.rebroadcastFundingTx(channel)
 			}
 		} else if channel.ChanType.IsSingleFunder() &&
@@ -858,6 +921,23 @@ func (f *Manager) nextPendingChanID() PendingChanID {
 func (f *Manager) CancelPeerReservations(nodePub [33]byte) {
 	log.Debugf("Cancelling all reservations for peer %x", nodePub[:])
 
+	// The negotiations of dual-funded channels can't be resumed once the
+	// peer reconnects, so they're aborted first.
+	f.dualFundings.Range(func(chanID lnwire.ChannelID,
+		df *dualFunding) bool {
+
+		if !bytes.Equal(df.peerKey.SerializeCompressed(), nodePub[:]) {
+			return true
+		}
+
+		df.mu.Lock()
+		f.failDualFunding(df, errPeerDisconnected, false)
+		f.dualFundings.Delete(chanID)
+		df.mu.Unlock()
+
+		return true
+	})
+
 	f.resMtx.Lock()
 	defer f.resMtx.Unlock()
 
@@ -879,7 +959,7 @@ func (f *Manager) CancelPeerReservations(nodePub [33]byte) {
 				"node=%x: %v", nodePub[:], err)
 		}
 
-		resCtx.err <- fmt.Errorf("peer disconnected")
+		resCtx.err <- errPeerDisconnected
 		delete(nodeReservations, pendingID)
 	}
 
@@ -968,33 +1048,36 @@ func (f *Manager) failFundingFlow(peer lnpeer.Peer, cid *chanIdentifier,
 		ctx.err <- fundingErr
 	}
 
-	// We only send the exact error if it is part of out whitelisted set of
-	// errors (lnwire.FundingError or lnwallet.ReservationError).
-	var msg lnwire.ErrorData
+	errMsg := &lnwire.Error{
+		ChanID: cid.tempChanID,
+		Data:   errorData(fundingErr),
+	}
+
+	log.Debugf("Sending funding error to peer (%x): %v",
+		peer.IdentityKey().SerializeCompressed(), spew.Sdump(errMsg))
+	if err := peer.SendMessage(false, errMsg); err != nil {
+		log.Errorf("unable to send error message to peer %v", err)
+	}
+}
+
+// errorData returns the data of the error message that is sent to the remote
+// peer if the funding flow fails with the given error. We only send the exact
+// error if it is part of our whitelisted set of errors (lnwire.FundingError or
+// lnwallet.ReservationError).
+func errorData(fundingErr error) lnwire.ErrorData {
 	switch e := fundingErr.(type) {
 	// Let the actual error message be sent to the remote for the
 	// whitelisted types.
 	case lnwallet.ReservationError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 	case lnwire.FundingError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 	case chanacceptor.ChanAcceptError:
-		msg = lnwire.ErrorData(e.Error())
+		return lnwire.ErrorData(e.Error())
 
 	// For all other error types we just send a generic error.
 	default:
-		msg = lnwire.ErrorData("funding failed due to internal error")
-	}
-
-	errMsg := &lnwire.Error{
-		ChanID: cid.tempChanID,
-		Data:   msg,
-	}
-
-	log.Debugf("Sending funding error to peer (%x): %v",
-		peer.IdentityKey().SerializeCompressed(), spew.Sdump(errMsg))
-	if err := peer.SendMessage(false, errMsg); err != nil {
-		log.Errorf("unable to send error message to peer %v", err)
+		return lnwire.ErrorData("funding failed due to internal error")
 	}
 }
 
@@ -1050,6 +1133,20 @@ func (f *Manager) reservationCoordinator() {
 				f.wg.Add(1)
 				go f.handleChannelReady(fmsg.peer, msg)
 
+			case *lnwire.OpenChannel2:
+				f.fundeeProcessOpenChannel2(fmsg.peer, msg)
+
+			case *lnwire.AcceptChannel2:
+				f.funderProcessAcceptChannel2(fmsg.peer, msg)
+
+			case *lnwire.TxAddInput, *lnwire.TxAddOutput,
+				*lnwire.TxRemoveInput, *lnwire.TxRemoveOutput,
+				*lnwire.TxComplete, *lnwire.TxSignatures,
+				*lnwire.TxInitRbf, *lnwire.TxAckRbf,
+				*lnwire.TxAbort, *lnwire.CommitSig:
+
+				f.processDualFundingMsg(fmsg.peer, msg)
+
 			case *lnwire.Warning:
 				f.handleWarningMsg(fmsg.peer, msg)
 
@@ -1059,6 +1156,9 @@ func (f *Manager) reservationCoordinator() {
 		case req := <-f.fundingRequests:
 			f.handleInitFundingMsg(req)
 
+		case req := <-f.bumpFeeRequests:
+			f.handleBumpFeeRequest(req)
+
 		case <-zombieSweepTicker.C:
 			f.pruneZombieReservations()
 
@@ -1068,6 +1168,25 @@ func (f *Manager) reservationCoordinator() {
 	}
 }
 
+// newLightningChannel creates the state machine of the given channel with the
+// auxiliary components of the funding manager.
+func (f *Manager) newLightningChannel(
+	channel *channeldb.OpenChannel) (*lnwallet.LightningChannel, error) {
+
+	var chanOpts []lnwallet.ChannelOpt
+	f.cfg.AuxLeafStore.WhenSome(func(s lnwallet.AuxLeafStore) {
+		chanOpts = append(chanOpts, lnwallet.WithLeafStore(s))
+	})
+	f.cfg.AuxSigner.WhenSome(func(s lnwallet.AuxSigner) {
+		chanOpts = append(chanOpts, lnwallet.WithAuxSigner(s))
+	})
+	f.cfg.AuxResolver.WhenSome(func(s lnwallet.AuxContractResolver) {
+		chanOpts = append(chanOpts, lnwallet.WithAuxResolver(s))
+	})
+
+	return lnwallet.NewLightningChannel(nil, channel, nil, chanOpts...)
+}
+
 // advanceFundingState will advance the channel through the steps after the
 // funding transaction is broadcasted, up until the point where the channel is
 // ready for operation. This includes waiting for the funding transaction to
@@ -1086,6 +1205,11 @@ func (f *Manager) advanceFundingState(channel *channeldb.OpenChannel,
 	// transaction to confirm.
 	if channel.IsPending {
 		err := f.advancePendingChannelState(channel, pendingChanID)
+		if errors.Is(err, ErrFundingAbandoned) {
+			log.Infof("Funding transaction of ChannelPoint(%v) "+
+				"was replaced", channel.FundingOutpoint)
+			return
+		}
 		if err != nil {
 			log.Errorf("Unable to advance pending state of "+
 				"ChannelPoint(%v): %v",
@@ -1094,21 +1218,8 @@ func (f *Manager) advanceFundingState(channel *channeldb.OpenChannel,
 		}
 	}
 
-	var chanOpts []lnwallet.ChannelOpt
-	f.cfg.AuxLeafStore.WhenSome(func(s lnwallet.AuxLeafStore) {
-		chanOpts = append(chanOpts, lnwallet.WithLeafStore(s))
-	})
-	f.cfg.AuxSigner.WhenSome(func(s lnwallet.AuxSigner) {
-		chanOpts = append(chanOpts, lnwallet.WithAuxSigner(s))
-	})
-	f.cfg.AuxResolver.WhenSome(func(s lnwallet.AuxContractResolver) {
-		chanOpts = append(chanOpts, lnwallet.WithAuxResolver(s))
-	})
-
 	// We create the state-machine object which wraps the database state.
-	lnChannel, err := lnwallet.NewLightningChannel(
-		nil, channel, nil, chanOpts...,
-	)
+	lnChannel, err := f.newLightningChannel(channel)
 	if err != nil {
 		log.Errorf("Unable to create LightningChannel(%v): %v",
 			channel.FundingOutpoint, err)
@@ -1337,6 +1448,8 @@ func (f *Manager) advancePendingChannelState(channel *channeldb.OpenChannel,
 	confChannel, err := f.waitForFundingWithTimeout(channel)
 	if err == ErrConfirmationTimeout {
 		return f.fundingTimeout(channel, pendingChanID)
+	} else if err == ErrFundingAbandoned {
+		return err
 	} else if err != nil {
 		return fmt.Errorf("error waiting for funding "+
 			"confirmation for ChannelPoint(%v): %v",
@@ -1402,6 +1515,13 @@ func (f *Manager) advancePendingChannelState(channel *channeldb.OpenChannel,
 			channel.FundingOutpoint, err)
 	}
 
+	// The funding transaction of a dual-funded channel may have been
+	// replaced, in which case the channels backed by the transactions that
+	// conflict with the confirmed one are abandoned.
+	if channel.ChanType.IsDualFunder() {
+		f.completeDualFunding(channel, pendingChanID)
+	}
+
 	return nil
 }
 
@@ -1415,16 +1535,11 @@ func (f *Manager) ProcessFundingMsg(msg lnwire.Message, peer lnpeer.Peer) {
 	}
 }
 
-// fundeeProcessOpenChannel creates an initial 'ChannelReservation' within the
-// wallet, then responds to the source peer with an accept channel message
-// progressing the funding workflow.
-//
-// TODO(roasbeef): add error chan to all, let channelManager handle
-// error+propagate.
-//
-//nolint:funlen
-func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
-	msg *lnwire.OpenChannel) {
+// validateInboundFunding checks that a channel of the given amount proposed by
+// the remote peer respects our limits on the number of pending channels and
+// the channel size, and that we're synced to the chain.
+func (f *Manager) validateInboundFunding(peer lnpeer.Peer,
+	amt btcutil.Amount) error {
 
 	// Check number of pending channels to be smaller than maximum allowed
 	// number and send ErrorGeneric to remote peer if condition is
@@ -1432,8 +1547,6 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	peerPubKey := peer.IdentityKey()
 	peerIDKey := newSerializedKey(peerPubKey)
 
-	amt := msg.FundingAmount
-
 	// We get all pending channels for this peer. This is the list of the
 	// active reservations and the channels pending open in the database.
 	f.resMtx.RLock()
@@ -1450,15 +1563,11 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	}
 	f.resMtx.RUnlock()
 
-	// Create the channel identifier.
-	cid := newChanIdentifier(msg.PendingChannelID)
-
 	// Also count the channels that are already pending. There we don't know
 	// the underlying intent anymore, unfortunately.
 	channels, err := f.cfg.ChannelDB.FetchOpenChannels(peerPubKey)
 	if err != nil {
-		f.failFundingFlow(peer, cid, err)
-		return
+		return err
 	}
 
 	for _, c := range channels {
@@ -1476,21 +1585,17 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 	// TODO(roasbeef): modify to only accept a _single_ pending channel per
 	// block unless white listed
 	if numPending >= f.cfg.MaxPendingChannels {
-		f.failFundingFlow(peer, cid, lnwire.ErrMaxPendingChannels)
-
-		return
+		return lnwire.ErrMaxPendingChannels
 	}
 
 	// Ensure that the pendingChansLimit is respected.
 	pendingChans, err := f.cfg.ChannelDB.FetchPendingChannels()
 	if err != nil {
-		f.failFundingFlow(peer, cid, err)
-		return
+		return err
 	}
 
 	if len(pendingChans) > pendingChansLimit {
-		f.failFundingFlow(peer, cid, lnwire.ErrMaxPendingChannels)
-		return
+		return lnwire.ErrMaxPendingChannels
 	}
 
 	// We'll also reject any requests to create channels until we're fully
@@ -1501,27 +1606,44 @@ func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
 		if err != nil {
 			log.Errorf("unable to query wallet: %v", err)
 		}
-		err := errors.New("Synchronizing blockchain")
-		f.failFundingFlow(peer, cid, err)
-		return
+		return errors.New("Synchronizing blockchain")
 	}
 
 	// Ensure that the remote party respects our maximum channel size.
 	if amt > f.cfg.MaxChanSize {
-		f.failFundingFlow(
-			peer, cid,
-			lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize),
-		)
-		return
+		return lnwallet.ErrChanTooLarge(amt, f.cfg.MaxChanSize)
 	}
 
 	// We'll, also ensure that the remote party isn't attempting to propose
 	// a channel that's below our current min channel size.
 	if amt < f.cfg.MinChanSize {
-		f.failFundingFlow(
-			peer, cid,
-			lnwallet.ErrChanTooSmall(amt, f.cfg.MinChanSize),
-		)
+		return lnwallet.ErrChanTooSmall(amt, f.cfg.MinChanSize)
+	}
+
+	return nil
+}
+
+// fundeeProcessOpenChannel creates an initial 'ChannelReservation' within the
+// wallet, then responds to the source peer with an accept channel message
+// progressing the funding workflow.
+//
+// TODO(roasbeef): add error chan to all, let channelManager handle
+// error+propagate.
+//
+//nolint:funlen
+func (f *Manager) fundeeProcessOpenChannel(peer lnpeer.Peer,
+	msg *lnwire.OpenChannel) {
+
+	peerPubKey := peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	amt := msg.FundingAmount
+
+	// Create the channel identifier.
+	cid := newChanIdentifier(msg.PendingChannelID)
+
+	if err := f.validateInboundFunding(peer, amt); err != nil {
+		f.failFundingFlow(peer, cid, err)
 		return
 	}
 
@@ -2921,26 +3043,8 @@ func (f *Manager) fundingTimeout(c *channeldb.OpenChannel,
 	// We'll get a timeout if the number of blocks mined since the channel
 	// was initiated reaches MaxWaitNumBlocksFundingConf and we are not the
 	// channel initiator.
-	localBalance := c.LocalCommitment.LocalBalance.ToSatoshis()
-	closeInfo := &channeldb.ChannelCloseSummary{
-		ChainHash:               c.ChainHash,
-		ChanPoint:               c.FundingOutpoint,
-		RemotePub:               c.IdentityPub,
-		Capacity:                c.Capacity,
-		SettledBalance:          localBalance,
-		CloseType:               channeldb.FundingCanceled,
-		RemoteCurrentRevocation: c.RemoteCurrentRevocation,
-		RemoteNextRevocation:    c.RemoteNextRevocation,
-		LocalChanConfig:         c.LocalChanCfg,
-	}
-
-	// Close the channel with us as the initiator because we are timing the
-	// channel out.
-	if err := c.CloseChannel(
-		closeInfo, channeldb.ChanStatusLocalCloseInitiator,
-	); err != nil {
-		return fmt.Errorf("failed closing channel %v: %w",
-			c.FundingOutpoint, err)
+	if err := cancelPendingChannel(c); err != nil {
+		return err
 	}
 
 	// Notify other subsystems about the funding timeout.
@@ -2986,6 +3090,32 @@ func (f *Manager) fundingTimeout(c *channeldb.OpenChannel,
 	return timeoutErr
 }
 
+// cancelPendingChannel closes the pending channel whose funding transaction
+// won't confirm, with us as the initiator of the close.
+func cancelPendingChannel(c *channeldb.OpenChannel) error {
+	localBalance := c.LocalCommitment.LocalBalance.ToSatoshis()
+	closeInfo := &channeldb.ChannelCloseSummary{
+		ChainHash:               c.ChainHash,
+		ChanPoint:               c.FundingOutpoint,
+		RemotePub:               c.IdentityPub,
+		Capacity:                c.Capacity,
+		SettledBalance:          localBalance,
+		CloseType:               channeldb.FundingCanceled,
+		RemoteCurrentRevocation: c.RemoteCurrentRevocation,
+		RemoteNextRevocation:    c.RemoteNextRevocation,
+		LocalChanConfig:         c.LocalChanCfg,
+	}
+
+	if err := c.CloseChannel(
+		closeInfo, channeldb.ChanStatusLocalCloseInitiator,
+	); err != nil {
+		return fmt.Errorf("failed closing channel %v: %w",
+			c.FundingOutpoint, err)
+	}
+
+	return nil
+}
+
 // waitForFundingWithTimeout is a wrapper around waitForFundingConfirmation and
 // waitForTimeout that will return ErrConfirmationTimeout if we are not the
 // channel initiator and the MaxWaitNumBlocksFundingConf has passed from the
@@ -3001,16 +3131,26 @@ func (f *Manager) waitForFundingWithTimeout(
 	f.wg.Add(1)
 	go f.waitForFundingConfirmation(ch, cancelChan, confChan)
 
-	// If we are not the initiator, we have no money at stake and will
-	// timeout waiting for the funding transaction to confirm after a
-	// while.
-	if !ch.IsInitiator && !ch.IsZeroConf() {
+	// If we have no money at stake, we will timeout waiting for the
+	// funding transaction to confirm after a while.
+	if fundingCanTimeOut(ch) {
 		f.wg.Add(1)
 		go f.waitForTimeout(ch, cancelChan, timeoutChan)
 	}
 	defer close(cancelChan)
 
+	// The funding transaction of a dual-funded channel may be replaced,
+	// in which case we stop waiting once the replacement confirmed.
+	var abandoned <-chan struct{}
+	if ch.ChanType.IsDualFunder() {
+		abandoned = f.abandonSignal(ch.FundingOutpoint)
+		defer f.removeAbandonSignal(ch.FundingOutpoint)
+	}
+
 	select {
+	case <-abandoned:
+		return nil, ErrFundingAbandoned
+
 	case err := <-timeoutChan:
 		if err != nil {
 			return nil, err
@@ -3031,6 +3171,24 @@ func (f *Manager) waitForFundingWithTimeout(
 	}
 }
 
+// fundingCanTimeOut returns true if we stop waiting for the funding
+// transaction of the given pending channel to confirm after a while, which is
+// the case if we have no money at stake. For a dual-funded channel, this is
+// also the case as long as its funding transaction isn't fully signed, as we
+// can't broadcast it ourselves then.
+func fundingCanTimeOut(ch *channeldb.OpenChannel) bool {
+	switch {
+	case ch.IsZeroConf():
+		return false
+
+	case ch.ChanType.IsDualFunder():
+		return !isFundingTxSigned(ch) || ch.InitialLocalBalance == 0
+
+	default:
+		return !ch.IsInitiator
+	}
+}
+
 // makeFundingScript re-creates the funding script for the funding transaction
 // of the target channel.
 func makeFundingScript(channel *channeldb.OpenChannel) ([]byte, error) {
@@ -4680,6 +4838,12 @@ func getUpfrontShutdownScript(enableUpfrontShutdown bool, peer lnpeer.Peer,
 // wallet, then sends a funding request to the remote peer kicking off the
 // funding workflow.
 func (f *Manager) handleInitFundingMsg(msg *InitFundingMsg) {
+	// Dual-funded channels are negotiated with the interactive protocol.
+	if msg.DualFunded {
+		f.handleInitDualFundingMsg(msg)
+		return
+	}
+
 	var (
 		peerKey        = msg.Peer.IdentityKey()
 		localAmt       = msg.LocalFundingAmt
@@ -5082,6 +5246,25 @@ func (f *Manager) handleErrorMsg(peer lnpeer.Peer, msg *lnwire.Error) {
 	chanID := msg.ChanID
 	peerKey := peer.IdentityKey()
 
+	// If the error is tied to the negotiation of a dual-funded channel,
+	// we'll abort the negotiation.
+	if df, ok := f.loadDualFunding(chanID); ok {
+		defer df.mu.Unlock()
+
+		if !df.peerKey.IsEqual(peerKey) {
+			return
+		}
+
+		fundingErr := fmt.Errorf("received funding error from %x: %v",
+			peerKey.SerializeCompressed(), msg.Error(),
+		)
+		log.Errorf(fundingErr.Error())
+
+		f.failDualFunding(df, fundingErr, false)
+
+		return
+	}
+
 	// First, we'll attempt to retrieve and cancel the funding workflow
 	// that this error was tied to. If we're unable to do so, then we'll
 	// exit early as this was an unwarranted error.
@@ -5144,6 +5327,14 @@ func (f *Manager) pruneZombieReservations() {
 			pendingChanID[:])
 		log.Warnf(err.Error())
 
+		if df := resCtx.dualFunding; df != nil {
+			df.mu.Lock()
+			f.failDualFunding(df, err, true)
+			df.mu.Unlock()
+
+			continue
+		}
+
 		chanID := lnwire.NewChanIDFromOutPoint(
 			*resCtx.reservation.FundingOutpoint(),
 		)
@@ -5258,7 +5449,15 @@ func (f *Manager) IsPendingChannel(pendingChanID PendingChanID,
 	_, ok := f.activeReservations[peerIDKey][pendingChanID]
 	f.resMtx.RUnlock()
 
-	return ok
+	if ok {
+		return true
+	}
+
+	// The negotiation of a dual-funded channel is identified by the
+	// channel ID derived from the revocation basepoints of both parties.
+	df, ok := f.dualFundings.Load(pendingChanID)
+
+	return ok && df.peerKey.IsEqual(peer.IdentityKey())
 }
 
 func copyPubKey(pub *btcec.PublicKey) *btcec.PublicKey {
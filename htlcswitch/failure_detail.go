@@ -54,6 +54,11 @@ const (
 	// OutgoingFailureForwardsDisabled is returned when the switch is
 	// configured to disallow forwards.
 	OutgoingFailureForwardsDisabled
+
+	// OutgoingFailureGeneralBucketFull is returned when an htlc that may
+	// only use the general resources of the outgoing channel is forwarded
+	// while these are exhausted.
+	OutgoingFailureGeneralBucketFull
 )
 
 // FailureString returns the string representation of a failure detail.
@@ -91,6 +96,9 @@ func (fd OutgoingFailure) FailureString() string {
 	case OutgoingFailureForwardsDisabled:
 		return "node configured to disallow forwards"
 
+	case OutgoingFailureGeneralBucketFull:
+		return "general htlc slots or liquidity exhausted"
+
 	default:
 		return "unknown failure detail"
 	}
@@ -117,6 +117,10 @@ type Payload struct {
 	// totalAmtMsat holds the info provided in total_amount_msat when
 	// parsed from a TLV onion payload.
 	totalAmtMsat lnwire.MilliSatoshi
+
+	// trampoline holds the instructions for forwarding the payment as a
+	// trampoline node, if they were included in the final hop payload.
+	trampoline *record.Trampoline
 }
 
 // NewLegacyPayload builds a Payload from the amount, cltv, and next hop
@@ -148,6 +152,7 @@ func ParseTLVPayload(r io.Reader) (*Payload, map[tlv.Type][]byte, error) {
 		encryptedData []byte
 		blindingPoint *btcec.PublicKey
 		metadata      []byte
+		trampoline    = &record.Trampoline{}
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -160,6 +165,7 @@ func ParseTLVPayload(r io.Reader) (*Payload, map[tlv.Type][]byte, error) {
 		amp.Record(),
 		record.NewMetadataRecord(&metadata),
 		record.NewTotalAmtMsatBlinded(&totalAmtMsat),
+		trampoline.Record(),
 	)
 	if err != nil {
 		return nil, nil, err
@@ -196,6 +202,12 @@ func ParseTLVPayload(r io.Reader) (*Payload, map[tlv.Type][]byte, error) {
 		metadata = nil
 	}
 
+	// If no trampoline instructions were parsed, set the trampoline field
+	// on the resulting payload to nil.
+	if _, ok := parsedTypes[record.TrampolineOnionType]; !ok {
+		trampoline = nil
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -212,6 +224,7 @@ func ParseTLVPayload(r io.Reader) (*Payload, map[tlv.Type][]byte, error) {
 		blindingPoint: blindingPoint,
 		customRecords: customRecords,
 		totalAmtMsat:  lnwire.MilliSatoshi(totalAmtMsat),
+		trampoline:    trampoline,
 	}, parsedTypes, nil
 }
 
@@ -275,6 +288,7 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasAMP := parsedTypes[record.AMPOnionType]
 	_, hasEncryptedData := parsedTypes[record.EncryptedDataOnionType]
 	_, hasBlinding := parsedTypes[record.BlindingPointOnionType]
+	_, hasTrampoline := parsedTypes[record.TrampolineOnionType]
 
 	// All cleartext hops (including final hop) and the final hop in a
 	// blinded path require the forwading amount and expiry TLVs to be set.
@@ -375,6 +389,24 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Trampoline instructions are only addressed to the final hop of the
+	// outer onion.
+	case !isFinalHop && hasTrampoline:
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// A trampoline payment is forwarded as a whole, so it can't be split
+	// or sent through a blinded path to the trampoline node.
+	case hasTrampoline && (hasMPP || hasAMP || hasEncryptedData):
+		return ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
 	}
 
 	return nil
@@ -427,6 +459,12 @@ func (h *Payload) TotalAmtMsat() lnwire.MilliSatoshi {
 	return h.totalAmtMsat
 }
 
+// Trampoline returns the trampoline instructions parsed from the onion payload,
+// or nil if the payment should not be forwarded as a trampoline node.
+func (h *Payload) Trampoline() *record.Trampoline {
+	return h.trampoline
+}
+
 // getMinRequiredViolation checks for unrecognized required (even) fields in the
 // standard range and returns the lowest required type. Always returning the
 // lowest required type allows a failure message to be deterministic.
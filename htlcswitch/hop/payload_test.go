@@ -22,6 +22,22 @@ var (
 
 const testUnknownRequiredType = 0x80
 
+// testTrampolineRecord is an encoded trampoline record with a zero amount and
+// expiry that is addressed to an all-zero next node.
+var testTrampolineRecord = append([]byte{
+	// trampoline (type / length)
+	0xfe, 0x00, 0x01, 0x02, 0x98, 0x27,
+	// trampoline.amount
+	0x02, 0x00,
+	// trampoline.cltv
+	0x04, 0x00,
+	// trampoline.next_node (type / length)
+	0x0e, 0x21,
+},
+	// trampoline.next_node (value)
+	make([]byte, 33)...,
+)
+
 type decodePayloadTest struct {
 	name               string
 	payload            []byte
@@ -35,6 +51,8 @@ type decodePayloadTest struct {
 	shouldHaveBlinding bool
 	shouldHaveMetadata bool
 	shouldHaveTotalAmt bool
+
+	shouldHaveTrampoline bool
 }
 
 var decodePayloadTests = []decodePayloadTest{
@@ -507,6 +525,57 @@ var decodePayloadTests = []decodePayloadTest{
 			FinalHop:  false,
 		},
 	},
+	{
+		name:       "final hop with trampoline",
+		isFinalHop: true,
+		payload: append([]byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+		}, testTrampolineRecord...),
+		shouldHaveTrampoline: true,
+	},
+	{
+		name:       "intermediate hop with trampoline",
+		isFinalHop: false,
+		payload: append([]byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		}, testTrampolineRecord...),
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name:       "final hop with trampoline and mpp",
+		isFinalHop: true,
+		payload: append([]byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// mpp
+			0x08, 0x21,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x08,
+		}, testTrampolineRecord...),
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.TrampolineOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  true,
+		},
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -621,6 +690,13 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		require.Zero(t, p.TotalAmtMsat())
 	}
 
+	if test.shouldHaveTrampoline {
+		require.NotNil(t, p.Trampoline())
+		require.Equal(t, [33]byte{}, p.Trampoline().NextNode)
+	} else {
+		require.Nil(t, p.Trampoline())
+	}
+
 	// Convert expected nil map to empty map, because we always expect an
 	// initiated map from the payload.
 	expCustomRecords := make(record.CustomSet)
@@ -148,6 +148,14 @@ type HtlcInfo struct {
 
 	// OutgoingAmt is the amount of the htlc on our outgoing channel.
 	OutgoingAmt lnwire.MilliSatoshi
+
+	// IncomingEndorsed indicates whether the htlc was endorsed by the
+	// previous hop.
+	IncomingEndorsed bool
+
+	// Bucket is the resource bucket of the outgoing channel that a
+	// forwarded htlc was assigned to by the reputation tracker.
+	Bucket ResourceBucket
 }
 
 // String returns a string representation of a htlc.
@@ -174,6 +182,15 @@ func (h HtlcInfo) String() string {
 		details = append(details, str)
 	}
 
+	// If the htlc was assigned to a bucket, we include the endorsement
+	// signal it was assigned by.
+	if h.Bucket != ResourceBucketNone {
+		str := fmt.Sprintf("incoming endorsed: %v, bucket: %v",
+			h.IncomingEndorsed, h.Bucket)
+
+		details = append(details, str)
+	}
+
 	return strings.Join(details, ", ")
 }
 
@@ -49,6 +49,24 @@ type InvoiceDatabase interface {
 	HodlUnsubscribeAll(subscriber chan<- interface{})
 }
 
+// TrampolineForwarder is an interface which represents the subsystem that
+// forwards payments for which we were chosen as trampoline node.
+type TrampolineForwarder interface {
+	// NotifyTrampolineHtlc forwards the htlc to the next node of the
+	// trampoline instructions. The return value describes how the htlc
+	// should be resolved. If the htlc cannot be resolved immediately, the
+	// resolution is sent on the passed in hodlChan once the outgoing
+	// payment completes. Calling it again for the same htlc after a
+	// restart resumes the existing payment.
+	NotifyTrampolineHtlc(payHash lntypes.Hash,
+		amt lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
+		circuitKey models.CircuitKey, hodlChan chan<- interface{},
+		trampoline *record.Trampoline) (invoices.HtlcResolution, error)
+
+	// HodlUnsubscribeAll unsubscribes from all htlc resolutions.
+	HodlUnsubscribeAll(subscriber chan<- interface{})
+}
+
 // packetHandler is an interface used exclusively by the Switch to handle
 // htlcPacket and pass them to the link implementation.
 type packetHandler interface {
@@ -184,6 +202,11 @@ type ChannelUpdateHandler interface {
 	// quiescence is a holdover until we have downstream protocols that use
 	// it.
 	InitStfu() <-chan fn.Result[lntypes.ChannelParty]
+
+	// QuiescenceInitiator returns the ChannelParty that initiated the
+	// quiescence of the channel. If the channel isn't quiescent, an error
+	// is returned.
+	QuiescenceInitiator() fn.Result[lntypes.ChannelParty]
 }
 
 // CommitHookID is a value that is used to uniquely identify hooks in the
@@ -34,6 +34,9 @@ import (
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -131,6 +134,10 @@ type ChannelLinkConfig struct {
 	// in thread-safe manner.
 	Registry InvoiceDatabase
 
+	// TrampolineForwarder forwards the htlcs for which we were chosen as
+	// trampoline node. If nil, such htlcs are rejected.
+	TrampolineForwarder TrampolineForwarder
+
 	// PreimageCache is a global witness beacon that houses any new
 	// preimages discovered by other links. We'll use this to add new
 	// witnesses that we discover which will notify any sub-systems
@@ -294,6 +301,12 @@ type ChannelLinkConfig struct {
 	// should forward experimental endorsement signals.
 	ShouldFwdExpEndorsement func() bool
 
+	// ReputationTracker tracks the reputation of the incoming channels of
+	// the htlcs forwarded on this link, which decides whether they may
+	// use its protected resources. It is nil if the reputation-based
+	// jamming mitigation is disabled.
+	ReputationTracker *ReputationTracker
+
 	// AuxTrafficShaper is an optional auxiliary traffic shaper that can be
 	// used to manage the bandwidth of the link.
 	AuxTrafficShaper fn.Option[AuxTrafficShaper]
@@ -418,6 +431,16 @@ type channelLink struct {
 	// allows contexts that either block or cancel on those depending on
 	// the use case.
 	cg *fn.ContextGuard
+
+	// commitSpan is the span of our latest CommitSig that the remote party
+	// hasn't answered with a RevokeAndAck yet. It covers the round trip
+	// of the commitment update.
+	commitSpan trace.Span
+
+	// buckets tracks the resources of the channel that the htlcs forwarded
+	// on this link use. It is only set if the reputation tracker is
+	// enabled.
+	buckets *resourceBuckets
 }
 
 // hookMap is a data structure that is used to track the hooks that need to be
@@ -623,11 +646,22 @@ func (l *channelLink) Stop() {
 	// As the link is stopping, we are no longer interested in htlc
 	// resolutions coming from the invoice registry.
 	l.cfg.Registry.HodlUnsubscribeAll(l.hodlQueue.ChanIn())
+	if l.cfg.TrampolineForwarder != nil {
+		l.cfg.TrampolineForwarder.HodlUnsubscribeAll(
+			l.hodlQueue.ChanIn(),
+		)
+	}
 
 	if l.cfg.ChainEvents.Cancel != nil {
 		l.cfg.ChainEvents.Cancel()
 	}
 
+	// The resolution of the htlcs forwarded on this link won't be reported
+	// anymore, so the reputation tracker can forget them.
+	if l.cfg.ReputationTracker != nil {
+		l.cfg.ReputationTracker.ForgetHtlcs(l.ShortChanID())
+	}
+
 	// Ensure the channel for the timer is drained.
 	if l.updateFeeTimer != nil {
 		if !l.updateFeeTimer.Stop() {
@@ -645,6 +679,11 @@ func (l *channelLink) Stop() {
 	l.cg.Quit()
 	l.cg.WgWait()
 
+	// A quiescent channel is handed over to the sub-system that stopped
+	// the link, such as a splice, so the link must no longer disconnect
+	// the peer once the quiescence timeout is reached.
+	l.quiescer.CancelTimeout()
+
 	// Now that the htlcManager has completely exited, reset the packet
 	// courier. This allows the mailbox to revaluate any lingering Adds that
 	// were delivered but didn't make it on a commitment to be failed back
@@ -790,6 +829,14 @@ func (l *channelLink) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 	return out
 }
 
+// QuiescenceInitiator returns the ChannelParty that initiated the quiescence
+// of the channel, or an error if the channel isn't quiescent.
+//
+// NOTE: Part of the ChannelUpdateHandler interface.
+func (l *channelLink) QuiescenceInitiator() fn.Result[lntypes.ChannelParty] {
+	return l.quiescer.QuiescenceInitiator()
+}
+
 // isReestablished returns true if the link has successfully completed the
 // channel reestablishment dance.
 func (l *channelLink) isReestablished() bool {
@@ -1494,6 +1541,18 @@ func (l *channelLink) processHtlcResolution(resolution invoices.HtlcResolution,
 		)
 		return nil
 
+	// Trampoline htlcs carry the failure that should be sent back to the
+	// sender.
+	case *TrampolineFailResolution:
+		l.log.Debugf("received trampoline failure for %v: %v",
+			circuitKey, res.Failure)
+
+		l.sendHTLCError(
+			htlc.add, htlc.sourceRef, NewLinkError(res.Failure),
+			htlc.obfuscator, true,
+		)
+		return nil
+
 	// Fail if we do not get a settle of fail resolution, since we
 	// are only expecting to handle settles and fails.
 	default:
@@ -1580,6 +1639,18 @@ func (l *channelLink) handleDownstreamUpdateAdd(ctx context.Context,
 		)
 	}
 
+	// Forwarded HTLCs that don't fit into the bucket they are assigned to
+	// are failed back before they are added to the channel.
+	bucket, linkErr := l.assignBucket(pkt, htlc)
+	if linkErr != nil {
+		l.log.Debugf("Unable to handle downstream HTLC - %v",
+			linkErr.FailureDetail.FailureString())
+
+		l.mailBox.FailAddWithDetail(pkt, OutgoingFailureGeneralBucketFull)
+
+		return linkErr
+	}
+
 	// A new payment has been initiated via the downstream channel,
 	// so we add the new HTLC to our local log, then update the
 	// commitment chains.
@@ -1623,6 +1694,8 @@ func (l *channelLink) handleDownstreamUpdateAdd(ctx context.Context,
 	pkt.outgoingHTLCID = index
 	htlc.ID = index
 
+	l.addBucketHtlc(index, pkt, bucket)
+
 	l.log.Debugf("queueing keystone of ADD open circuit: %s->%s",
 		pkt.inKey(), pkt.outKey())
 
@@ -1642,6 +1715,8 @@ func (l *channelLink) handleDownstreamUpdateAdd(ctx context.Context,
 			IncomingAmt:      pkt.incomingAmount,
 			OutgoingTimeLock: htlc.Expiry,
 			OutgoingAmt:      htlc.Amount,
+			IncomingEndorsed: isEndorsed(pkt.inWireCustomRecords),
+			Bucket:           bucket,
 		},
 		getEventType(pkt),
 	)
@@ -2037,6 +2112,14 @@ func (l *channelLink) updateCommitTx(ctx context.Context) error {
 	err = l.cfg.Peer.SendMessage(false, commitSig)
 	if err != nil {
 		l.log.Errorf("failed to send CommitSig: %v", err)
+	} else {
+		numHtlcs := len(newCommit.PendingHTLCs)
+		_, l.commitSpan = tracer.Start(
+			ctx, "channelLink.commitRoundTrip", trace.WithAttributes(
+				attribute.String("chan_id", l.ChanID().String()),
+				attribute.Int("htlcs", numHtlcs),
+			),
+		)
 	}
 
 	// Now that we have sent out a new CommitSig, we invoke the outgoing set
@@ -2852,6 +2935,8 @@ func (l *channelLink) processRemoteSettleFails(fwdPkg *channeldb.FwdPkg) {
 				continue
 			}
 
+			l.resolveBucketHtlc(msg.ID, true)
+
 			settlePacket := &htlcPacket{
 				outgoingChanID: l.ShortChanID(),
 				outgoingHTLCID: msg.ID,
@@ -2877,6 +2962,8 @@ func (l *channelLink) processRemoteSettleFails(fwdPkg *channeldb.FwdPkg) {
 				continue
 			}
 
+			l.resolveBucketHtlc(msg.ID, false)
+
 			// Fetch the reason the HTLC was canceled so we can
 			// continue to propagate it. This failure originated
 			// from another node, so the linkFailure field is not
@@ -3338,6 +3425,121 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg) {
 	l.forwardBatch(reforward, switchPackets...)
 }
 
+// isEndorsed returns whether the custom records of an htlc carry a positive
+// experimental endorsement signal.
+func isEndorsed(records lnwire.CustomRecords) bool {
+	value, ok := records[uint64(lnwire.ExperimentalEndorsementType)]
+
+	return ok && len(value) == 1 && value[0] == lnwire.ExperimentalEndorsed
+}
+
+// reputationHtlc returns the description of a forwarded htlc for the
+// reputation tracker.
+func (l *channelLink) reputationHtlc(pkt *htlcPacket) *ReputationHtlc {
+	var fee lnwire.MilliSatoshi
+	if pkt.incomingAmount > pkt.amount {
+		fee = pkt.incomingAmount - pkt.amount
+	}
+
+	return &ReputationHtlc{
+		IncomingChanID: pkt.incomingChanID,
+		OutgoingChanID: l.ShortChanID(),
+		Fee:            fee,
+		IncomingExpiry: pkt.incomingTimeout,
+		Height:         l.cfg.BestHeight(),
+	}
+}
+
+// assignBucket returns the resource bucket of the channel that a forwarded
+// htlc may use. Endorsed htlcs whose incoming channel has good reputation may
+// use the protected resources and are forwarded as endorsed, while all other
+// htlcs are limited to the general resources and are forwarded as unendorsed.
+// An error is returned if the htlc doesn't fit into its bucket.
+func (l *channelLink) assignBucket(pkt *htlcPacket,
+	htlc *lnwire.UpdateAddHTLC) (ResourceBucket, *LinkError) {
+
+	tracker := l.cfg.ReputationTracker
+	if tracker == nil || pkt.incomingChanID == hop.Source {
+		return ResourceBucketNone, nil
+	}
+
+	if l.buckets == nil {
+		remoteCfg := l.channel.State().RemoteChanCfg
+		l.buckets = newResourceBuckets(
+			tracker.cfg.GeneralBucketShare,
+			remoteCfg.MaxAcceptedHtlcs, remoteCfg.MaxPendingAmount,
+		)
+	}
+
+	bucket := ResourceBucketGeneral
+	if isEndorsed(pkt.inWireCustomRecords) &&
+		tracker.GoodReputation(l.reputationHtlc(pkt)) {
+
+		bucket = ResourceBucketProtected
+	}
+
+	if bucket == ResourceBucketGeneral &&
+		!l.buckets.generalAvailable(htlc.Amount) {
+
+		return bucket, NewDetailedLinkError(
+			lnwire.NewTemporaryChannelFailure(nil),
+			OutgoingFailureGeneralBucketFull,
+		)
+	}
+
+	// Only htlcs that use protected resources are endorsed towards the
+	// next hop. The signal is only overwritten if it is forwarded at all.
+	endorseType := uint64(lnwire.ExperimentalEndorsementType)
+	if _, ok := htlc.CustomRecords[endorseType]; ok {
+		endorseValue := lnwire.ExperimentalUnendorsed
+		if bucket == ResourceBucketProtected {
+			endorseValue = lnwire.ExperimentalEndorsed
+		}
+
+		records := htlc.CustomRecords.Copy()
+		records[endorseType] = []byte{endorseValue}
+		htlc.CustomRecords = records
+	}
+
+	return bucket, nil
+}
+
+// addBucketHtlc adds the forwarded htlc with the given outgoing index to its
+// bucket and reports it to the reputation tracker.
+func (l *channelLink) addBucketHtlc(index uint64, pkt *htlcPacket,
+	bucket ResourceBucket) {
+
+	if bucket == ResourceBucketNone {
+		return
+	}
+
+	l.buckets.add(index, &bucketedHtlc{
+		incomingCircuit: pkt.inKey(),
+		amount:          pkt.amount,
+		bucket:          bucket,
+	})
+
+	l.cfg.ReputationTracker.AddHtlc(
+		pkt.inKey(), l.reputationHtlc(pkt),
+		bucket == ResourceBucketProtected,
+	)
+}
+
+// resolveBucketHtlc removes the htlc with the given outgoing index from its
+// bucket and reports its resolution to the reputation tracker.
+func (l *channelLink) resolveBucketHtlc(index uint64, settled bool) {
+	if l.buckets == nil {
+		return
+	}
+
+	htlc, ok := l.buckets.remove(index)
+	if !ok {
+		return
+	}
+
+	l.cfg.ReputationTracker.ResolveHtlc(htlc.incomingCircuit, settled)
+}
+
 // experimentalEndorsement returns the value to set for our outgoing
 // experimental endorsement field, and a boolean indicating whether it should
 // be populated on the outgoing htlc.
@@ -3383,7 +3585,7 @@ func (l *channelLink) experimentalEndorsement(
 func (l *channelLink) processExitHop(add lnwire.UpdateAddHTLC,
 	sourceRef channeldb.AddRef, obfuscator hop.ErrorEncrypter,
 	fwdInfo hop.ForwardingInfo, heightNow uint32,
-	payload invoices.Payload) error {
+	payload *hop.Payload) error {
 
 	// If hodl.ExitSettle is requested, we will not validate the final hop's
 	// ADD, nor will we settle the corresponding invoice or respond with the
@@ -3437,6 +3639,14 @@ func (l *channelLink) processExitHop(add lnwire.UpdateAddHTLC,
 		return nil
 	}
 
+	// If the sender chose us as trampoline node, the htlc isn't paying one
+	// of our invoices, so we hand it to the trampoline forwarder instead.
+	if trampoline := payload.Trampoline(); trampoline != nil {
+		return l.processTrampolineHop(
+			add, sourceRef, obfuscator, heightNow, trampoline,
+		)
+	}
+
 	// Notify the invoiceRegistry of the exit hop htlc. If we crash right
 	// after this, this code will be re-executed after restart. We will
 	// receive back a resolution event.
@@ -3473,6 +3683,56 @@ func (l *channelLink) processExitHop(add lnwire.UpdateAddHTLC,
 	return l.processHtlcResolution(event, htlc)
 }
 
+// processTrampolineHop handles an exit hop htlc that carries trampoline
+// instructions by forwarding the payment to the next node.
+func (l *channelLink) processTrampolineHop(add lnwire.UpdateAddHTLC,
+	sourceRef channeldb.AddRef, obfuscator hop.ErrorEncrypter,
+	heightNow uint32, trampoline *record.Trampoline) error {
+
+	// If we don't act as trampoline node, we treat the instructions like
+	// any other even record that we don't understand.
+	if l.cfg.TrampolineForwarder == nil {
+		l.log.Errorf("rejected trampoline htlc(%x) as trampoline "+
+			"forwarding is disabled", add.PaymentHash)
+
+		failure := NewLinkError(lnwire.NewInvalidOnionPayload(
+			uint64(record.TrampolineOnionType), 0,
+		))
+		l.sendHTLCError(add, sourceRef, failure, obfuscator, true)
+
+		return nil
+	}
+
+	// As with invoice payments, this is re-executed after a restart, in
+	// which case the forwarder resumes the existing payment.
+	circuitKey := models.CircuitKey{
+		ChanID: l.ShortChanID(),
+		HtlcID: add.ID,
+	}
+
+	event, err := l.cfg.TrampolineForwarder.NotifyTrampolineHtlc(
+		add.PaymentHash, add.Amount, add.Expiry, int32(heightNow),
+		circuitKey, l.hodlQueue.ChanIn(), trampoline,
+	)
+	if err != nil {
+		return err
+	}
+
+	htlc := hodlHtlc{
+		add:        add,
+		sourceRef:  sourceRef,
+		obfuscator: obfuscator,
+	}
+
+	// If the event is nil, the outgoing payment is still in flight.
+	if event == nil {
+		l.hodlMap[circuitKey] = htlc
+		return nil
+	}
+
+	return l.processHtlcResolution(event, htlc)
+}
+
 // settleHTLC settles the HTLC on the channel.
 func (l *channelLink) settleHTLC(preimage lntypes.Preimage,
 	htlcIndex uint64, sourceRef channeldb.AddRef) error {
@@ -4402,6 +4662,17 @@ func (l *channelLink) processRemoteCommitSig(ctx context.Context,
 	return nil
 }
 
+// endCommitSpan ends the span of the commitment round trip in flight, if any,
+// recording the given error.
+func (l *channelLink) endCommitSpan(err error) {
+	if l.commitSpan == nil {
+		return
+	}
+
+	tracing.End(l.commitSpan, err)
+	l.commitSpan = nil
+}
+
 // processRemoteRevokeAndAck takes a `RevokeAndAck` msg sent from the remote and
 // processes it.
 func (l *channelLink) processRemoteRevokeAndAck(ctx context.Context,
@@ -4412,6 +4683,7 @@ func (l *channelLink) processRemoteRevokeAndAck(ctx context.Context,
 
 	// We now process the message and advance our remote commit chain.
 	fwdPkg, remoteHTLCs, err := l.channel.ReceiveRevocation(msg)
+	l.endCommitSpan(err)
 	if err != nil {
 		// TODO(halseth): force close?
 		l.failf(
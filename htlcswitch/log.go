@@ -4,6 +4,7 @@ import (
 	"github.com/btcsuite/btclog/v2"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/htlcswitch/hop"
+	"github.com/lightningnetwork/lnd/tracing"
 )
 
 // log is a logger that is initialized with no output filters.  This
@@ -11,6 +12,9 @@ import (
 // requests it.
 var log btclog.Logger
 
+// tracer creates the spans of local htlcs and commitment updates.
+var tracer = tracing.Tracer("htlcswitch")
+
 // The default amount of logging is none.
 func init() {
 	logger := build.NewSubLogger("HSWC", nil)
@@ -54,6 +54,10 @@ type MailBox interface {
 	// OutgoingFailureDownstreamHtlcAdd FailureDetail.
 	FailAdd(pkt *htlcPacket)
 
+	// FailAddWithDetail fails an UpdateAddHTLC that exists within the
+	// mailbox like FailAdd, but with the given FailureDetail.
+	FailAddWithDetail(pkt *htlcPacket, detail OutgoingFailure)
+
 	// MessageOutBox returns a channel that any new messages ready for
 	// delivery will be sent on.
 	MessageOutBox() chan lnwire.Message
@@ -687,6 +691,14 @@ func (m *memoryMailBox) DustPackets() (lnwire.MilliSatoshi,
 // generated LinkError will show an OutgoingFailureDownstreamHtlcAdd
 // FailureDetail.
 func (m *memoryMailBox) FailAdd(pkt *htlcPacket) {
+	m.FailAddWithDetail(pkt, OutgoingFailureDownstreamHtlcAdd)
+}
+
+// FailAddWithDetail fails an UpdateAddHTLC that exists within the mailbox like
+// FailAdd, but with the given FailureDetail.
+func (m *memoryMailBox) FailAddWithDetail(pkt *htlcPacket,
+	detail OutgoingFailure) {
+
 	// First, remove the packet from mailbox. If we didn't find the packet
 	// because it has already been acked, we'll exit early to avoid sending
 	// a duplicate fail message through the switch.
@@ -729,10 +741,8 @@ func (m *memoryMailBox) FailAdd(pkt *htlcPacket) {
 	}
 
 	// Create a link error containing the temporary channel failure and a
-	// detail which indicates the we failed to add the htlc.
-	linkError := NewDetailedLinkError(
-		failure, OutgoingFailureDownstreamHtlcAdd,
-	)
+	// detail which indicates why we failed to add the htlc.
+	linkError := NewDetailedLinkError(failure, detail)
 
 	failPkt := &htlcPacket{
 		incomingChanID: pkt.incomingChanID,
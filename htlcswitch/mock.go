@@ -960,6 +960,12 @@ func (f *mockChannelLink) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 	return c
 }
 
+func (f *mockChannelLink) QuiescenceInitiator() fn.Result[lntypes.ChannelParty] {
+	return fn.Errf[lntypes.ChannelParty](
+		"QuiescenceInitiator not implemented",
+	)
+}
+
 func (f *mockChannelLink) FundingCustomBlob() fn.Option[tlv.Blob] {
 	return fn.None[tlv.Blob]()
 }
@@ -95,6 +95,11 @@ type Quiescer interface {
 	// the channel has been quiescent and then resets the quiescer state to
 	// its initial state.
 	Resume()
+
+	// CancelTimeout cancels the quiescence timeout without resuming the
+	// channel. This is used when another sub-system takes over the
+	// quiescent channel, which happens when the link is stopped.
+	CancelTimeout()
 }
 
 // QuiescerCfg is a config structure used to initialize a quiescer giving it the
@@ -523,6 +528,14 @@ func (q *QuiescerLive) resume() {
 	q.resumeQueue = nil
 }
 
+// CancelTimeout cancels the quiescence timeout without resuming the channel.
+func (q *QuiescerLive) CancelTimeout() {
+	q.Lock()
+	defer q.Unlock()
+
+	q.cancelTimeout()
+}
+
 // startTimeout starts the timeout function that fires if the quiescer remains
 // in a quiesced state for too long. If this function is called multiple times
 // only the last one will have an effect.
@@ -565,6 +578,7 @@ func (q *quiescerNoop) SendOwedStfu() error          { return nil }
 func (q *quiescerNoop) IsQuiescent() bool            { return false }
 func (q *quiescerNoop) OnResume(hook func())         { hook() }
 func (q *quiescerNoop) Resume()                      {}
+func (q *quiescerNoop) CancelTimeout()               {}
 func (q *quiescerNoop) QuiescenceInitiator() fn.Result[lntypes.ChannelParty] {
 	return fn.Err[lntypes.ChannelParty](ErrNoQuiescenceInitiator)
 }
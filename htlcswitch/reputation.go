@@ -0,0 +1,336 @@
+package htlcswitch
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultRevenueWindow is the default window over which the revenue
+	// of an outgoing channel is averaged.
+	DefaultRevenueWindow = 14 * 24 * time.Hour
+
+	// DefaultReputationMultiplier is the default factor by which the
+	// window over which the reputation of an incoming channel is averaged
+	// exceeds the revenue window.
+	DefaultReputationMultiplier = 12
+
+	// DefaultResolutionPeriod is the default time within which forwarded
+	// htlcs are expected to resolve.
+	DefaultResolutionPeriod = 90 * time.Second
+
+	// DefaultGeneralBucketShare is the default share of the htlc slots
+	// and liquidity of a channel that htlcs without good reputation may
+	// use.
+	DefaultGeneralBucketShare = 0.5
+
+	// expectedBlockTime is the time between two blocks that is assumed to
+	// estimate for how long an htlc can be held.
+	expectedBlockTime = 10 * time.Minute
+)
+
+// ReputationConfig holds the configuration of the reputation tracker.
+type ReputationConfig struct {
+	// RevenueWindow is the window over which the revenue of an outgoing
+	// channel is averaged.
+	RevenueWindow time.Duration
+
+	// ReputationMultiplier is the factor by which the window over which
+	// the reputation of an incoming channel is averaged exceeds the
+	// revenue window.
+	ReputationMultiplier uint32
+
+	// ResolutionPeriod is the time within which forwarded htlcs are
+	// expected to resolve. Endorsed htlcs that are held for longer count
+	// against the reputation of their incoming channel.
+	ResolutionPeriod time.Duration
+
+	// GeneralBucketShare is the share of the htlc slots and liquidity of
+	// an outgoing channel that htlcs may use if they aren't endorsed or
+	// their incoming channel lacks reputation.
+	GeneralBucketShare float64
+
+	// Clock is used to time the resolution of htlcs and the decay of the
+	// averages.
+	Clock clock.Clock
+}
+
+// Validate checks that the reputation config is usable.
+func (c *ReputationConfig) Validate() error {
+	if c.RevenueWindow <= 0 {
+		return errors.New("revenue window must be positive")
+	}
+
+	if c.ReputationMultiplier == 0 {
+		return errors.New("reputation multiplier must be positive")
+	}
+
+	if c.ResolutionPeriod <= 0 {
+		return errors.New("resolution period must be positive")
+	}
+
+	if c.GeneralBucketShare <= 0 || c.GeneralBucketShare > 1 {
+		return fmt.Errorf("general bucket share %v not in (0, 1]",
+			c.GeneralBucketShare)
+	}
+
+	return nil
+}
+
+// decayingAverage is a sum of values that decays exponentially over time.
+// A value that was added one window ago contributes with a weight of 1/e.
+type decayingAverage struct {
+	window     time.Duration
+	value      float64
+	lastUpdate time.Time
+}
+
+// valueAt returns the decayed value at the given time.
+func (d *decayingAverage) valueAt(now time.Time) float64 {
+	elapsed := now.Sub(d.lastUpdate)
+	if elapsed <= 0 {
+		return d.value
+	}
+
+	return d.value * math.Exp(-elapsed.Seconds()/d.window.Seconds())
+}
+
+// add decays the average up to the given time and adds the value.
+func (d *decayingAverage) add(value float64, now time.Time) {
+	d.value = d.valueAt(now) + value
+	d.lastUpdate = now
+}
+
+// ReputationHtlc describes a forwarded htlc to the reputation tracker.
+type ReputationHtlc struct {
+	// IncomingChanID is the channel the htlc was received on.
+	IncomingChanID lnwire.ShortChannelID
+
+	// OutgoingChanID is the channel the htlc is forwarded on.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// Fee is the fee we earn if the htlc is settled.
+	Fee lnwire.MilliSatoshi
+
+	// IncomingExpiry is the expiry height of the incoming htlc.
+	IncomingExpiry uint32
+
+	// Height is the current block height.
+	Height uint32
+}
+
+// inFlightHtlc is a forwarded htlc that isn't resolved yet.
+type inFlightHtlc struct {
+	htlc     *ReputationHtlc
+	endorsed bool
+	risk     float64
+	addTime  time.Time
+}
+
+// ReputationTracker tracks the reputation of our incoming channels and the
+// revenue of our outgoing channels, based on the fees of the htlcs that are
+// forwarded between them. An incoming channel has good reputation towards an
+// outgoing channel if the fees it earned us, minus the cost of the endorsed
+// htlcs it held for too long, exceed the revenue of the outgoing channel and
+// the damage its in-flight htlcs could still cause.
+type ReputationTracker struct {
+	cfg *ReputationConfig
+
+	// mu protects the fields below.
+	mu         sync.Mutex
+	reputation map[lnwire.ShortChannelID]*decayingAverage
+	revenue    map[lnwire.ShortChannelID]*decayingAverage
+	inFlight   map[models.CircuitKey]*inFlightHtlc
+}
+
+// NewReputationTracker creates a new reputation tracker.
+func NewReputationTracker(cfg *ReputationConfig) (*ReputationTracker,
+	error) {
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &ReputationTracker{
+		cfg:        cfg,
+		reputation: make(map[lnwire.ShortChannelID]*decayingAverage),
+		revenue:    make(map[lnwire.ShortChannelID]*decayingAverage),
+		inFlight:   make(map[models.CircuitKey]*inFlightHtlc),
+	}, nil
+}
+
+// risk returns the cost of the htlc to us if it's held until it expires,
+// which is the fee it could have earned in every resolution period of that
+// time.
+func (r *ReputationTracker) risk(htlc *ReputationHtlc) float64 {
+	if htlc.IncomingExpiry <= htlc.Height {
+		return 0
+	}
+
+	blocks := htlc.IncomingExpiry - htlc.Height
+	holdTime := time.Duration(blocks) * expectedBlockTime
+	periods := math.Ceil(
+		holdTime.Seconds() / r.cfg.ResolutionPeriod.Seconds(),
+	)
+
+	return float64(htlc.Fee) * periods
+}
+
+// average returns the decaying average of the channel, creating it if it
+// doesn't exist yet.
+func average(averages map[lnwire.ShortChannelID]*decayingAverage,
+	chanID lnwire.ShortChannelID, window time.Duration,
+	now time.Time) *decayingAverage {
+
+	avg, ok := averages[chanID]
+	if !ok {
+		avg = &decayingAverage{
+			window:     window,
+			lastUpdate: now,
+		}
+		averages[chanID] = avg
+	}
+
+	return avg
+}
+
+// reputationWindow returns the window over which reputation is averaged.
+func (r *ReputationTracker) reputationWindow() time.Duration {
+	return r.cfg.RevenueWindow * time.Duration(r.cfg.ReputationMultiplier)
+}
+
+// GoodReputation returns whether the incoming channel of the htlc has enough
+// reputation for the htlc to use the protected resources of the outgoing
+// channel.
+func (r *ReputationTracker) GoodReputation(htlc *ReputationHtlc) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.cfg.Clock.Now()
+
+	reputation := average(
+		r.reputation, htlc.IncomingChanID, r.reputationWindow(), now,
+	).valueAt(now)
+	revenue := average(
+		r.revenue, htlc.OutgoingChanID, r.cfg.RevenueWindow, now,
+	).valueAt(now)
+
+	// The endorsed htlcs of the incoming channel that are still in flight
+	// could be held until they expire, so their risk is accounted for as
+	// if that happened.
+	inFlightRisk := r.risk(htlc)
+	for _, h := range r.inFlight {
+		if h.endorsed && h.htlc.IncomingChanID == htlc.IncomingChanID {
+			inFlightRisk += h.risk
+		}
+	}
+
+	return reputation-inFlightRisk >= revenue
+}
+
+// AddHtlc records that the htlc with the given incoming circuit was forwarded,
+// either endorsed or not.
+func (r *ReputationTracker) AddHtlc(key models.CircuitKey,
+	htlc *ReputationHtlc, endorsed bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.inFlight[key] = &inFlightHtlc{
+		htlc:     htlc,
+		endorsed: endorsed,
+		risk:     r.risk(htlc),
+		addTime:  r.cfg.Clock.Now(),
+	}
+}
+
+// ResolveHtlc updates the reputation of the incoming channel and the revenue
+// of the outgoing channel of the htlc with the given incoming circuit. The fee
+// of a settled htlc counts towards both, while every resolution period for
+// which an endorsed htlc was held counts against the reputation.
+func (r *ReputationTracker) ResolveHtlc(key models.CircuitKey,
+	settled bool) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.inFlight[key]
+	if !ok {
+		return
+	}
+	delete(r.inFlight, key)
+
+	var (
+		now      = r.cfg.Clock.Now()
+		fee      = float64(h.htlc.Fee)
+		holdTime = now.Sub(h.addTime)
+	)
+
+	var effectiveFee float64
+	if settled {
+		effectiveFee = fee
+
+		average(
+			r.revenue, h.htlc.OutgoingChanID, r.cfg.RevenueWindow,
+			now,
+		).add(fee, now)
+	}
+
+	if h.endorsed {
+		periods := math.Floor(
+			holdTime.Seconds() / r.cfg.ResolutionPeriod.Seconds(),
+		)
+		effectiveFee -= fee * periods
+	}
+
+	average(
+		r.reputation, h.htlc.IncomingChanID, r.reputationWindow(), now,
+	).add(effectiveFee, now)
+}
+
+// ForgetHtlcs drops the in-flight htlcs that were forwarded on the outgoing
+// channel without affecting reputation or revenue. It is called when the link
+// of the channel stops, as their resolution won't be reported anymore.
+func (r *ReputationTracker) ForgetHtlcs(outgoing lnwire.ShortChannelID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, h := range r.inFlight {
+		if h.htlc.OutgoingChanID == outgoing {
+			delete(r.inFlight, key)
+		}
+	}
+}
+
+// Reputation returns the current reputation of the incoming channel.
+func (r *ReputationTracker) Reputation(chanID lnwire.ShortChannelID) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	avg, ok := r.reputation[chanID]
+	if !ok {
+		return 0
+	}
+
+	return avg.valueAt(r.cfg.Clock.Now())
+}
+
+// Revenue returns the current revenue of the outgoing channel.
+func (r *ReputationTracker) Revenue(chanID lnwire.ShortChannelID) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	avg, ok := r.revenue[chanID]
+	if !ok {
+		return 0
+	}
+
+	return avg.valueAt(r.cfg.Clock.Now())
+}
@@ -0,0 +1,136 @@
+package htlcswitch
+
+import (
+	"math"
+
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ResourceBucket identifies the share of the htlc slots and liquidity of an
+// outgoing channel that a forwarded htlc uses.
+type ResourceBucket uint8
+
+const (
+	// ResourceBucketNone means that the htlc isn't assigned to a bucket,
+	// because it's a local payment or the reputation tracker is disabled.
+	ResourceBucketNone ResourceBucket = iota
+
+	// ResourceBucketGeneral means that the htlc uses the general
+	// resources, which are limited to a share of the channel.
+	ResourceBucketGeneral
+
+	// ResourceBucketProtected means that the htlc may use all resources
+	// of the channel, as it is endorsed and its incoming channel has good
+	// reputation.
+	ResourceBucketProtected
+)
+
+// String returns a human-readable representation of the bucket.
+func (b ResourceBucket) String() string {
+	switch b {
+	case ResourceBucketNone:
+		return "none"
+
+	case ResourceBucketGeneral:
+		return "general"
+
+	case ResourceBucketProtected:
+		return "protected"
+
+	default:
+		return "unknown"
+	}
+}
+
+// bucketedHtlc is an htlc on the outgoing side of a link that uses the
+// resources of one of its buckets.
+type bucketedHtlc struct {
+	incomingCircuit models.CircuitKey
+	amount          lnwire.MilliSatoshi
+	bucket          ResourceBucket
+}
+
+// bucketUsage is the number of htlcs in a bucket and their total amount.
+type bucketUsage struct {
+	slots  int
+	amount lnwire.MilliSatoshi
+}
+
+// resourceBuckets tracks the forwarded htlcs on the outgoing side of a link
+// by bucket. The general bucket is limited to a share of the htlc slots and
+// the liquidity that the remote party accepts, while the htlcs of the
+// protected bucket are only limited by the channel itself.
+type resourceBuckets struct {
+	generalSlots     int
+	generalLiquidity lnwire.MilliSatoshi
+
+	general   bucketUsage
+	protected bucketUsage
+
+	// htlcs are the htlcs in the buckets by their outgoing htlc index.
+	htlcs map[uint64]*bucketedHtlc
+}
+
+// newResourceBuckets creates the buckets of a channel whose remote party
+// accepts the given number of htlcs and pending amount.
+func newResourceBuckets(share float64, maxHtlcs uint16,
+	maxPending lnwire.MilliSatoshi) *resourceBuckets {
+
+	return &resourceBuckets{
+		generalSlots: int(math.Floor(share * float64(maxHtlcs))),
+		generalLiquidity: lnwire.MilliSatoshi(
+			math.Floor(share * float64(maxPending)),
+		),
+		htlcs: make(map[uint64]*bucketedHtlc),
+	}
+}
+
+// generalAvailable returns whether the general bucket can take an htlc over
+// the given amount.
+func (b *resourceBuckets) generalAvailable(amt lnwire.MilliSatoshi) bool {
+	return b.general.slots < b.generalSlots &&
+		b.general.amount+amt <= b.generalLiquidity
+}
+
+// usage returns the usage of the given bucket.
+func (b *resourceBuckets) usage(bucket ResourceBucket) *bucketUsage {
+	switch bucket {
+	case ResourceBucketGeneral:
+		return &b.general
+
+	case ResourceBucketProtected:
+		return &b.protected
+
+	default:
+		return nil
+	}
+}
+
+// add adds the htlc with the given outgoing index to its bucket.
+func (b *resourceBuckets) add(index uint64, htlc *bucketedHtlc) {
+	usage := b.usage(htlc.bucket)
+	if usage == nil {
+		return
+	}
+
+	usage.slots++
+	usage.amount += htlc.amount
+	b.htlcs[index] = htlc
+}
+
+// remove removes the htlc with the given outgoing index from its bucket and
+// returns it, if it is known.
+func (b *resourceBuckets) remove(index uint64) (*bucketedHtlc, bool) {
+	htlc, ok := b.htlcs[index]
+	if !ok {
+		return nil, false
+	}
+	delete(b.htlcs, index)
+
+	usage := b.usage(htlc.bucket)
+	usage.slots--
+	usage.amount -= htlc.amount
+
+	return htlc, true
+}
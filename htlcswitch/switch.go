@@ -28,6 +28,9 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/lightningnetwork/lnd/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -545,7 +548,24 @@ func (s *Switch) CleanStore(keepPids map[uint64]struct{}) error {
 // package in order to send the htlc update. The attemptID used MUST be unique
 // for this HTLC, and MUST be used only once, otherwise the switch might reject
 // it.
-func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID, attemptID uint64,
+func (s *Switch) SendHTLC(ctx context.Context, firstHop lnwire.ShortChannelID,
+	attemptID uint64, htlc *lnwire.UpdateAddHTLC) error {
+
+	_, span := tracer.Start(ctx, "Switch.SendHTLC", trace.WithAttributes(
+		attribute.String("first_hop", firstHop.String()),
+		attribute.Int64("attempt_id", int64(attemptID)),
+		attribute.Int64("amt_msat", int64(htlc.Amount)),
+	))
+
+	err := s.sendHTLC(firstHop, attemptID, htlc)
+	tracing.End(span, err)
+
+	return err
+}
+
+// sendHTLC hands the htlc of a local payment attempt to the link of its first
+// hop.
+func (s *Switch) sendHTLC(firstHop lnwire.ShortChannelID, attemptID uint64,
 	htlc *lnwire.UpdateAddHTLC) error {
 
 	// Generate and send new update packet, if error will be received on
@@ -728,7 +728,7 @@ func testSwitchSendHtlcMapping(t *testing.T, zeroConf, useAlias bool, alias,
 		Amount:      1,
 	}
 
-	err = s.SendHTLC(outgoingSCID, 0, htlc)
+	err = s.SendHTLC(context.Background(), outgoingSCID, 0, htlc)
 	require.NoError(t, err)
 }
 
@@ -2364,7 +2364,9 @@ func testSkipLinkLocalForward(t *testing.T, eligible bool,
 	// We'll attempt to send out a new HTLC that has Alice as the first
 	// outgoing link. This should fail as Alice isn't yet able to forward
 	// any active HTLC's.
-	err = s.SendHTLC(aliceChannelLink.ShortChanID(), 0, addMsg)
+	err = s.SendHTLC(
+		context.Background(), aliceChannelLink.ShortChanID(), 0, addMsg,
+	)
 	if err == nil {
 		t.Fatalf("local forward should fail due to inactive link")
 	}
@@ -2693,7 +2695,8 @@ func TestSwitchSendPayment(t *testing.T) {
 	errChan := make(chan error)
 	go func() {
 		err := s.SendHTLC(
-			aliceChannelLink.ShortChanID(), paymentID, update,
+			context.Background(), aliceChannelLink.ShortChanID(),
+			paymentID, update,
 		)
 		if err != nil {
 			errChan <- err
@@ -3200,7 +3203,8 @@ func TestInvalidFailure(t *testing.T) {
 
 	// Send the request.
 	err = s.SendHTLC(
-		aliceChannelLink.ShortChanID(), paymentID, update,
+		context.Background(), aliceChannelLink.ShortChanID(), paymentID,
+		update,
 	)
 	require.NoError(t, err, "unable to send payment")
 
@@ -3552,7 +3556,8 @@ func (n *threeHopNetwork) sendThreeHopPayment(t *testing.T) (*lnwire.UpdateAddHT
 	require.NoError(t, err, "unable to add invoice in carol registry")
 
 	if err := n.aliceServer.htlcSwitch.SendHTLC(
-		n.firstBobChannelLink.ShortChanID(), pid, htlc,
+		context.Background(), n.firstBobChannelLink.ShortChanID(), pid,
+		htlc,
 	); err != nil {
 		t.Fatalf("could not send htlc")
 	}
@@ -4344,7 +4349,8 @@ func TestSwitchDustForwarding(t *testing.T) {
 	// anchor channel) we are overexposed in fees (maxFeeExposure) that's
 	// why the HTLC is failed back.
 	err = n.bobServer.htlcSwitch.SendHTLC(
-		aliceBobFirstHop, uint64(bobAttemptID), failingHtlc,
+		context.Background(), aliceBobFirstHop, uint64(bobAttemptID),
+		failingHtlc,
 	)
 	require.Nil(t, err)
 
@@ -4385,7 +4391,8 @@ func TestSwitchDustForwarding(t *testing.T) {
 	}
 
 	err = n.bobServer.htlcSwitch.SendHTLC(
-		aliceBobFirstHop, uint64(bobAttemptID), nondustHtlc,
+		context.Background(), aliceBobFirstHop, uint64(bobAttemptID),
+		nondustHtlc,
 	)
 	require.NoError(t, err)
 	assertAlmostDust(n.firstBobChannelLink, bobMbox, lntypes.Local)
@@ -4426,7 +4433,8 @@ func TestSwitchDustForwarding(t *testing.T) {
 	carolAttemptID := 0
 
 	err = n.carolServer.htlcSwitch.SendHTLC(
-		n.carolChannelLink.ShortChanID(), uint64(carolAttemptID),
+		context.Background(), n.carolChannelLink.ShortChanID(),
+		uint64(carolAttemptID),
 		carolHtlc,
 	)
 	require.NoError(t, err)
@@ -4469,7 +4477,8 @@ func TestSwitchDustForwarding(t *testing.T) {
 	assertAlmostDust(n.aliceChannelLink, aliceMbox, lntypes.Remote)
 
 	err = n.aliceServer.htlcSwitch.SendHTLC(
-		n.aliceChannelLink.ShortChanID(), uint64(aliceAttemptID),
+		context.Background(), n.aliceChannelLink.ShortChanID(),
+		uint64(aliceAttemptID),
 		aliceMultihopHtlc,
 	)
 	require.Nil(t, err)
@@ -4555,7 +4564,9 @@ func sendDustHtlcs(t *testing.T, n *threeHopNetwork, alice bool,
 			// before all numHTLCs*2 HTLC's are sent due to double
 			// counting. Get around this by continuing to send
 			// until successful.
-			err = sendingSwitch.SendHTLC(sid, attemptID, htlc)
+			err = sendingSwitch.SendHTLC(
+				context.Background(), sid, attemptID, htlc,
+			)
 			if err == nil {
 				break
 			}
@@ -4660,7 +4671,7 @@ func TestSwitchMailboxDust(t *testing.T) {
 
 	// Sending one more HTLC to Alice should result in the fee threshold
 	// being breached.
-	err = s.SendHTLC(aliceChanID, 0, addMsg)
+	err = s.SendHTLC(context.Background(), aliceChanID, 0, addMsg)
 	require.ErrorIs(t, err, errFeeExposureExceeded)
 
 	// We'll now call ForwardPackets from Bob to ensure that the mailbox
@@ -794,7 +794,7 @@ func preparePayment(sendingPeer, receivingPeer lnpeer.Peer,
 	// Send payment and expose err channel.
 	return invoice, func() error {
 		err := sender.htlcSwitch.SendHTLC(
-			firstHop, pid, htlc,
+			context.Background(), firstHop, pid, htlc,
 		)
 		if err != nil {
 			return err
@@ -1360,7 +1360,9 @@ func (n *twoHopNetwork) makeHoldPayment(sendingPeer, receivingPeer lnpeer.Peer,
 	}
 
 	// Send payment and expose err channel.
-	err = sender.htlcSwitch.SendHTLC(firstHop, pid, htlc)
+	err = sender.htlcSwitch.SendHTLC(
+		context.Background(), firstHop, pid, htlc,
+	)
 	if err != nil {
 		paymentErr <- err
 		return paymentErr
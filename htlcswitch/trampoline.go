@@ -0,0 +1,41 @@
+package htlcswitch
+
+import (
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TrampolineFailResolution is an implementation of the invoices.HtlcResolution
+// interface which is returned when the payment we forwarded as trampoline node
+// failed.
+type TrampolineFailResolution struct {
+	// circuitKey is the key of the htlc for which we have a resolution.
+	circuitKey models.CircuitKey
+
+	// Failure is the failure that is sent back to the sender.
+	Failure lnwire.FailureMessage
+}
+
+// A compile-time check to ensure TrampolineFailResolution implements the
+// invoices.HtlcResolution interface.
+var _ invoices.HtlcResolution = (*TrampolineFailResolution)(nil)
+
+// NewTrampolineFailResolution returns a resolution that fails the trampoline
+// htlc with the given failure.
+func NewTrampolineFailResolution(key models.CircuitKey,
+	failure lnwire.FailureMessage) *TrampolineFailResolution {
+
+	return &TrampolineFailResolution{
+		circuitKey: key,
+		Failure:    failure,
+	}
+}
+
+// CircuitKey returns the circuit key for the htlc that we have a resolution
+// for.
+//
+// NOTE: Part of the invoices.HtlcResolution interface.
+func (r *TrampolineFailResolution) CircuitKey() models.CircuitKey {
+	return r.circuitKey
+}
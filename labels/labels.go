@@ -68,6 +68,13 @@ const (
 
 	// LabelTypeSweepTransaction is used to label sweeps.
 	LabelTypeSweepTransaction LabelType = "sweep"
+
+	// LabelTypeSpliceTransaction is used to label splices.
+	LabelTypeSpliceTransaction LabelType = "splice"
+
+	// LabelTypeKickoffTransaction is used to label the kickoff
+	// transactions that reanchor a channel.
+	LabelTypeKickoffTransaction LabelType = "kickoff"
 )
 
 // LabelField is used to tag a value within a label.
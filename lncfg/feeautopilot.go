@@ -0,0 +1,46 @@
+package lncfg
+
+import (
+	"errors"
+	"time"
+)
+
+// FeeAutopilot holds the configuration options for the fee autopilot, which
+// sets the fee rates of our channels based on their liquidity and flow.
+//
+//nolint:ll
+type FeeAutopilot struct {
+	Active             bool          `long:"active" description:"If the fee autopilot should update the fee rates of the channels. If false, it can still be enabled at runtime through the FeeAutopilot RPC service."`
+	Strategy           string        `long:"strategy" choice:"liquidity" choice:"demand" description:"The strategy that determines the fee rates. 'liquidity' prices channels by how far their local balance is from the target ratio, 'demand' adjusts the fee rates step by step based on the forwarding flow."`
+	Interval           time.Duration `long:"interval" description:"The time between two runs of the fee autopilot."`
+	FlowWindow         time.Duration `long:"flowwindow" description:"The time window of the forwarding history the flow through a channel is computed over."`
+	MinUpdateInterval  time.Duration `long:"minupdateinterval" description:"The minimum time between two policy updates of the same channel."`
+	MinRelativeChange  float64       `long:"minrelativechange" description:"The minimum relative change of the outbound or inbound fee rate of a channel that warrants a policy update."`
+	MaxUpdatesPerRun   int           `long:"maxupdatesperrun" description:"The maximum number of channel policies that are updated in a single run."`
+	MinFeeRate         uint32        `long:"minfeerate" description:"The minimum outbound fee rate in parts per million."`
+	MaxFeeRate         uint32        `long:"maxfeerate" description:"The maximum outbound fee rate in parts per million."`
+	MaxInboundDiscount uint32        `long:"maxinbounddiscount" description:"The maximum inbound discount in parts per million. Inbound fee rates are kept between minus this value and zero."`
+	TargetRatio        float64       `long:"targetratio" description:"The share of a channel's capacity that the liquidity strategy aims to keep on our side. Valid values are in (0, 1)."`
+	DemandStep         float64       `long:"demandstep" description:"The relative step by which the demand strategy adjusts the fee rates. Valid values are in (0, 1]."`
+}
+
+// Validate checks that the fee autopilot config options are sane. The
+// parameters of the strategies are validated when it is created.
+//
+// NOTE: this is part of the Validator interface.
+func (f *FeeAutopilot) Validate() error {
+	if f.Interval <= 0 {
+		return errors.New("fee autopilot interval must be positive")
+	}
+
+	if f.FlowWindow <= 0 {
+		return errors.New("fee autopilot flow window must be positive")
+	}
+
+	if f.MaxUpdatesPerRun <= 0 {
+		return errors.New("fee autopilot max updates per run must be " +
+			"positive")
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package lncfg
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// DefaultLeaseFeeBase is the default base lease fee in satoshis.
+	DefaultLeaseFeeBase = 1_000
+
+	// DefaultLeaseFeeBasis is the default proportional lease fee in basis
+	// points of the leased amount.
+	DefaultLeaseFeeBasis = 50
+
+	// DefaultLeaseFundingWeight is the default weight of the inputs and
+	// outputs we add to the funding transaction of a lease, which is
+	// roughly that of two P2WKH inputs and a change output.
+	DefaultLeaseFundingWeight = 600
+
+	// DefaultLeaseChannelFeeMaxBaseMSat is the default maximum base
+	// forwarding fee we charge during a lease.
+	DefaultLeaseChannelFeeMaxBaseMSat = 5_000
+
+	// DefaultLeaseChannelFeeMaxPPM is the default maximum proportional
+	// forwarding fee we charge during a lease.
+	DefaultLeaseChannelFeeMaxPPM = 5_000
+
+	// DefaultMinLeaseSize is the default smallest amount we lease.
+	DefaultMinLeaseSize = 100_000
+
+	// DefaultMaxLeaseSize is the default largest amount we lease in a
+	// single channel.
+	DefaultMaxLeaseSize = 5_000_000
+)
+
+// LiquidityAds holds the configuration of the inbound liquidity we sell to
+// peers that open dual-funded channels with us, and advertise in our node
+// announcement.
+//
+//nolint:ll
+type LiquidityAds struct {
+	Active bool `long:"active" description:"If set, then lnd will advertise lease rates in its node announcement and lease inbound liquidity to peers that request it when opening a dual-funded channel. Requires protocol.dual-funding."`
+
+	LeaseFeeBase uint32 `long:"leasefeebase" description:"The base lease fee in satoshis charged for each lease."`
+
+	LeaseFeeBasis uint16 `long:"leasefeebasis" description:"The proportional lease fee in basis points of the leased amount."`
+
+	FundingWeight uint16 `long:"fundingweight" description:"The weight of the inputs and outputs lnd adds to the funding transaction of a lease, which the buyer pays for at the fee rate of the funding transaction."`
+
+	ChannelFeeMaxBaseMSat uint32 `long:"channelfeemaxbasemsat" description:"The maximum base forwarding fee in millisatoshis lnd commits to charge in a leased channel until the lease expires."`
+
+	ChannelFeeMaxPPM uint32 `long:"channelfeemaxppm" description:"The maximum proportional forwarding fee in parts per million lnd commits to charge in a leased channel until the lease expires. Must be a multiple of 1000."`
+
+	MinLeaseSize int64 `long:"minleasesize" description:"The smallest amount in satoshis lnd leases. Requests for less are rejected."`
+
+	MaxLeaseSize int64 `long:"maxleasesize" description:"The largest amount in satoshis lnd leases in a single channel. Requests for more are served up to this amount."`
+}
+
+// DefaultLiquidityAds returns the default configuration of liquidity ads.
+func DefaultLiquidityAds() *LiquidityAds {
+	return &LiquidityAds{
+		LeaseFeeBase:          DefaultLeaseFeeBase,
+		LeaseFeeBasis:         DefaultLeaseFeeBasis,
+		FundingWeight:         DefaultLeaseFundingWeight,
+		ChannelFeeMaxBaseMSat: DefaultLeaseChannelFeeMaxBaseMSat,
+		ChannelFeeMaxPPM:      DefaultLeaseChannelFeeMaxPPM,
+		MinLeaseSize:          DefaultMinLeaseSize,
+		MaxLeaseSize:          DefaultMaxLeaseSize,
+	}
+}
+
+// Validate checks the configuration of liquidity ads.
+func (l *LiquidityAds) Validate() error {
+	if !l.Active {
+		return nil
+	}
+
+	// The maximum proportional fee is advertised in thousandths.
+	if l.ChannelFeeMaxPPM%1000 != 0 {
+		return fmt.Errorf("channelfeemaxppm=%v must be a multiple of "+
+			"1000", l.ChannelFeeMaxPPM)
+	}
+	if l.ChannelFeeMaxPPM/1000 > math.MaxUint16 {
+		return fmt.Errorf("channelfeemaxppm=%v must be at most %v",
+			l.ChannelFeeMaxPPM, math.MaxUint16*1000)
+	}
+
+	if l.MinLeaseSize <= 0 {
+		return fmt.Errorf("minleasesize=%v must be positive",
+			l.MinLeaseSize)
+	}
+	if l.MaxLeaseSize < l.MinLeaseSize {
+		return fmt.Errorf("maxleasesize=%v must be at least "+
+			"minleasesize=%v", l.MaxLeaseSize, l.MinLeaseSize)
+	}
+
+	return nil
+}
+
+// LeaseRates returns the lease rates to advertise in our node announcement.
+func (l *LiquidityAds) LeaseRates() lnwire.LeaseRates {
+	return lnwire.LeaseRates{
+		FundingWeight:             l.FundingWeight,
+		LeaseFeeBasis:             l.LeaseFeeBasis,
+		ChannelFeeMaxProportional: uint16(l.ChannelFeeMaxPPM / 1000),
+		LeaseFeeBase:              l.LeaseFeeBase,
+		ChannelFeeMaxBase:         l.ChannelFeeMaxBaseMSat,
+	}
+}
+
+// Compile-time constraint to ensure LiquidityAds implements the Validator
+// interface.
+var _ Validator = (*LiquidityAds)(nil)
@@ -0,0 +1,82 @@
+package lncfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLiquidityAdsValidate tests the validation of the liquidity ads config.
+func TestLiquidityAdsValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		modify    func(*LiquidityAds)
+		expectErr bool
+	}{
+		{
+			name:   "default",
+			modify: func(*LiquidityAds) {},
+		},
+		{
+			name: "inactive config isn't validated",
+			modify: func(l *LiquidityAds) {
+				l.Active = false
+				l.MinLeaseSize = 0
+			},
+		},
+		{
+			name: "fee rate not a multiple of 1000",
+			modify: func(l *LiquidityAds) {
+				l.ChannelFeeMaxPPM = 1500
+			},
+			expectErr: true,
+		},
+		{
+			name: "fee rate too large",
+			modify: func(l *LiquidityAds) {
+				l.ChannelFeeMaxPPM = 70_000_000
+			},
+			expectErr: true,
+		},
+		{
+			name: "zero min lease size",
+			modify: func(l *LiquidityAds) {
+				l.MinLeaseSize = 0
+			},
+			expectErr: true,
+		},
+		{
+			name: "max lease size below min",
+			modify: func(l *LiquidityAds) {
+				l.MaxLeaseSize = l.MinLeaseSize - 1
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultLiquidityAds()
+			cfg.Active = true
+			tc.modify(cfg)
+
+			err := cfg.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// The proportional fee is advertised in thousandths.
+	rates := DefaultLiquidityAds().LeaseRates()
+	require.EqualValues(t, 5, rates.ChannelFeeMaxProportional)
+	require.EqualValues(
+		t, DefaultLeaseChannelFeeMaxPPM, rates.MaxFeeRatePPM(),
+	)
+}
@@ -39,6 +39,18 @@ type ProtocolOptions struct {
 	// the new experimental RBF coop close feature.
 	RbfCoopClose bool `long:"rbf-coop-close" description:"if set, then lnd will signal that it supports the new RBF based coop close protocol, taproot channels are not supported"`
 
+	// Splicing should be set if we want to signal support for the
+	// experimental splicing protocol.
+	Splicing bool `long:"splicing" description:"if set, then lnd will signal that it supports splicing funds into and out of existing private channels, taproot channels are not supported"`
+
+	// DynamicCommitments should be set if we want to signal support for
+	// the experimental dynamic commitments protocol.
+	DynamicCommitments bool `long:"dynamic-commitments" description:"if set, then lnd will signal that it supports changing the parameters of existing channels, and upgrading private anchor channels to taproot channels"`
+
+	// DualFunding should be set if we want to signal support for the v2
+	// channel establishment protocol.
+	DualFunding bool `long:"dual-funding" description:"if set, then lnd will signal that it supports opening channels with an interactively constructed funding transaction, to which both parties may contribute funds"`
+
 	// NoAnchors should be set if we don't want to support opening or accepting
 	// channels having the anchor commitment type.
 	NoAnchors bool `long:"no-anchors" description:"disable support for anchor commitments"`
@@ -74,6 +86,10 @@ type ProtocolOptions struct {
 	// NoExperimentalEndorsementOption disables experimental endorsement.
 	NoExperimentalEndorsementOption bool `long:"no-experimental-endorsement" description:"do not forward experimental endorsement signals"`
 
+	// NoOnionMessagesOption disables sending, receiving and forwarding of
+	// onion messages.
+	NoOnionMessagesOption bool `long:"no-onion-messages" description:"do not send, receive or forward onion messages"`
+
 	// CustomMessage allows the custom message APIs to handle messages with
 	// the provided protocol numbers, which fall outside the custom message
 	// number range.
@@ -145,6 +161,11 @@ func (l *ProtocolOptions) NoExperimentalEndorsement() bool {
 	return l.NoExperimentalEndorsementOption
 }
 
+// NoOnionMessages returns true if onion messaging is disabled.
+func (l *ProtocolOptions) NoOnionMessages() bool {
+	return l.NoOnionMessagesOption
+}
+
 // NoQuiescence returns true if quiescence is disabled.
 func (l *ProtocolOptions) NoQuiescence() bool {
 	return false
@@ -0,0 +1,70 @@
+package lncfg
+
+import (
+	"fmt"
+)
+
+const (
+	// DefaultTracingEndpoint is the default address of the OTLP collector
+	// spans are exported to.
+	DefaultTracingEndpoint = "localhost:4317"
+
+	// DefaultTracingSampleRatio is the default ratio of traces that are
+	// sampled.
+	DefaultTracingSampleRatio = 1.0
+
+	// DefaultTracingServiceName is the default service name spans are
+	// reported under.
+	DefaultTracingServiceName = "lnd"
+)
+
+// Tracing holds the configuration of the OpenTelemetry traces lnd exports to
+// an OTLP collector.
+//
+//nolint:ll
+type Tracing struct {
+	Active bool `long:"active" description:"If set, then lnd will export OpenTelemetry traces of RPC calls, payments, pathfinding and the htlc switch to an OTLP collector."`
+
+	Endpoint string `long:"endpoint" description:"The host:port of the OTLP gRPC collector spans are exported to."`
+
+	Insecure bool `long:"insecure" description:"If set, then the connection to the collector isn't encrypted. Should only be used for a collector on localhost."`
+
+	SampleRatio float64 `long:"sampleratio" description:"The ratio of traces that are sampled, between 0 and 1. Traces started by an RPC caller that are sampled remotely are always sampled."`
+
+	ServiceName string `long:"servicename" description:"The service name spans are reported under, which allows to tell several nodes apart that export to the same collector."`
+}
+
+// DefaultTracing returns the default configuration of tracing.
+func DefaultTracing() *Tracing {
+	return &Tracing{
+		Endpoint:    DefaultTracingEndpoint,
+		SampleRatio: DefaultTracingSampleRatio,
+		ServiceName: DefaultTracingServiceName,
+	}
+}
+
+// Validate checks the configuration of tracing.
+func (t *Tracing) Validate() error {
+	if !t.Active {
+		return nil
+	}
+
+	if t.Endpoint == "" {
+		return fmt.Errorf("tracing.endpoint must be set")
+	}
+
+	if t.SampleRatio < 0 || t.SampleRatio > 1 {
+		return fmt.Errorf("sampleratio=%v must be between 0 and 1",
+			t.SampleRatio)
+	}
+
+	if t.ServiceName == "" {
+		return fmt.Errorf("tracing.servicename must be set")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Tracing implements the Validator
+// interface.
+var _ Validator = (*Tracing)(nil)
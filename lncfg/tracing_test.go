@@ -0,0 +1,75 @@
+package lncfg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestTracingValidate tests the validation of the tracing config.
+func TestTracingValidate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		modify    func(*Tracing)
+		expectErr bool
+	}{
+		{
+			name:   "default",
+			modify: func(*Tracing) {},
+		},
+		{
+			name: "inactive config isn't validated",
+			modify: func(tr *Tracing) {
+				tr.Active = false
+				tr.Endpoint = ""
+			},
+		},
+		{
+			name: "no endpoint",
+			modify: func(tr *Tracing) {
+				tr.Endpoint = ""
+			},
+			expectErr: true,
+		},
+		{
+			name: "sample ratio too large",
+			modify: func(tr *Tracing) {
+				tr.SampleRatio = 1.5
+			},
+			expectErr: true,
+		},
+		{
+			name: "negative sample ratio",
+			modify: func(tr *Tracing) {
+				tr.SampleRatio = -0.1
+			},
+			expectErr: true,
+		},
+		{
+			name: "no service name",
+			modify: func(tr *Tracing) {
+				tr.ServiceName = ""
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := DefaultTracing()
+			cfg.Active = true
+			tc.modify(cfg)
+
+			err := cfg.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/lightningnetwork/lnd/rpcperms"
 	"github.com/lightningnetwork/lnd/signal"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/tracing"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"github.com/lightningnetwork/lnd/watchtower"
 	"google.golang.org/grpc"
@@ -279,6 +280,27 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 		defer runtimePprof.StopCPUProfile()
 	}
 
+	// If tracing is enabled, we export the spans of RPC calls, payments,
+	// pathfinding and the switch to an OTLP collector. Pending spans are
+	// flushed on shutdown.
+	if cfg.Tracing.Active {
+		shutdownTracing, err := tracing.Setup(ctx, &tracing.Config{
+			Endpoint:       cfg.Tracing.Endpoint,
+			Insecure:       cfg.Tracing.Insecure,
+			SampleRatio:    cfg.Tracing.SampleRatio,
+			ServiceName:    cfg.Tracing.ServiceName,
+			ServiceVersion: build.Version(),
+		})
+		if err != nil {
+			return mkErr("unable to set up tracing", err)
+		}
+		defer func() {
+			if err := shutdownTracing(ctx); err != nil {
+				ltndLog.Warnf("Unable to flush spans: %v", err)
+			}
+		}()
+	}
+
 	// Run configuration dependent DB pre-initialization. Note that this
 	// needs to be done early and once during the startup process, before
 	// any DB access.
@@ -378,6 +400,12 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 		grpc.KeepaliveEnforcementPolicy(clientKeepalive),
 	)
 
+	// With tracing enabled, each RPC call gets a span that continues the
+	// trace context the caller sent in the gRPC metadata.
+	if cfg.Tracing.Active {
+		serverOpts = append(serverOpts, tracing.ServerOption())
+	}
+
 	grpcServer := grpc.NewServer(serverOpts...)
 	defer grpcServer.Stop()
 
@@ -784,6 +812,16 @@ func Main(cfg *Config, lisCfg ListenerCfg, implCfg *ImplementationCfg,
 	// We transition the server state to Active, as the server is up.
 	interceptorChain.SetServerActive()
 
+	// If Prometheus monitoring is enabled, then we also export the
+	// internals of the server's subsystems now that they're running.
+	if cfg.Prometheus.Enabled() {
+		err := monitoring.RegisterCollectors(server.monitoringConfig())
+		if err != nil {
+			return mkErr("unable to register Prometheus collectors",
+				err)
+		}
+	}
+
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll start the autopilot agent immediately. It will be
 	// stopped together with the autopilot service.
@@ -0,0 +1,18 @@
+//go:build feeautopilotrpc
+// +build feeautopilotrpc
+
+package feeautopilotrpc
+
+import (
+	"github.com/lightningnetwork/lnd/feeautopilot"
+)
+
+// Config is the primary configuration struct for the fee autopilot RPC
+// server. It contains all the items required for the rpc server to carry out
+// its duties. The fields with struct tags are meant to be parsed as normal
+// configuration options, while if able to be populated, the latter fields
+// MUST also be specified.
+type Config struct {
+	// Manager is the running fee autopilot.
+	Manager *feeautopilot.Manager
+}
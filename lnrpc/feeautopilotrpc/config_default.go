@@ -0,0 +1,7 @@
+//go:build !feeautopilotrpc
+// +build !feeautopilotrpc
+
+package feeautopilotrpc
+
+// Config is empty for non-feeautopilotrpc builds.
+type Config struct{}
@@ -0,0 +1,353 @@
+//go:build feeautopilotrpc
+// +build feeautopilotrpc
+
+package feeautopilotrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightningnetwork/lnd/feeautopilot"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon-bakery.v2/bakery"
+)
+
+const (
+	// subServerName is the name of the sub rpc server. We'll use this name
+	// to register ourselves, and we also require that the main
+	// SubServerConfigDispatcher instance recognize tt as the name of our
+	// RPC service.
+	subServerName = "FeeAutopilotRPC"
+)
+
+var (
+	// macPermissions maps RPC calls to the permissions they require.
+	macPermissions = map[string][]bakery.Op{
+		"/feeautopilotrpc.FeeAutopilot/Status": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/feeautopilotrpc.FeeAutopilot/ModifyStatus": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/feeautopilotrpc.FeeAutopilot/SetStrategy": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/feeautopilotrpc.FeeAutopilot/ListRecommendations": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/feeautopilotrpc.FeeAutopilot/Run": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+	}
+)
+
+// ServerShell is a shell struct holding a reference to the actual sub-server.
+// It is used to register the gRPC sub-server with the root server before we
+// have the necessary dependencies to populate the actual sub-server.
+type ServerShell struct {
+	FeeAutopilotServer
+}
+
+// Server is a sub-server of the main RPC server: the fee autopilot RPC. This
+// sub RPC server allows external callers to access the status of the fee
+// autopilot currently active within lnd, as well as configuring it at
+// runtime.
+type Server struct {
+	started  int32 // To be used atomically.
+	shutdown int32 // To be used atomically.
+
+	// Required by the grpc-gateway/v2 library for forward compatibility.
+	// Must be after the atomically used variables to not break struct
+	// alignment.
+	UnimplementedFeeAutopilotServer
+
+	cfg *Config
+
+	manager *feeautopilot.Manager
+}
+
+// A compile time check to ensure that Server fully implements the
+// FeeAutopilotServer gRPC service.
+var _ FeeAutopilotServer = (*Server)(nil)
+
+// New returns a new instance of the feeautopilotrpc FeeAutopilot sub-server.
+// We also return the set of permissions for the macaroons that we may create
+// within this method.
+func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
+	// We don't create any new macaroons for this subserver, instead reuse
+	// existing offchain permissions.
+	server := &Server{
+		cfg:     cfg,
+		manager: cfg.Manager,
+	}
+
+	return server, macPermissions, nil
+}
+
+// Start launches any helper goroutines required for the Server to function.
+// The fee autopilot itself is started by the main server.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Stop signals any active goroutines for a graceful closure.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Stop() error {
+	if atomic.AddInt32(&s.shutdown, 1) != 1 {
+		return nil
+	}
+
+	return nil
+}
+
+// Name returns a unique string representation of the sub-server. This can be
+// used to identify the sub-server and also de-duplicate them.
+//
+// NOTE: This is part of the lnrpc.SubServer interface.
+func (s *Server) Name() string {
+	return subServerName
+}
+
+// RegisterWithRootServer will be called by the root gRPC server to direct a
+// sub RPC server to register itself with the main gRPC root server. Until this
+// is called, each sub-server won't be able to have
+// requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRootServer(grpcServer *grpc.Server) error {
+	// We make sure that we register it with the main gRPC server to ensure
+	// all our methods are routed properly.
+	RegisterFeeAutopilotServer(grpcServer, r)
+
+	log.Debugf("FeeAutopilot RPC server successfully registered with " +
+		"root gRPC server")
+
+	return nil
+}
+
+// RegisterWithRestServer will be called by the root REST mux to direct a sub
+// RPC server to register itself with the main REST mux server. Until this is
+// called, each sub-server won't be able to have requests routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) RegisterWithRestServer(ctx context.Context,
+	mux *runtime.ServeMux, dest string, opts []grpc.DialOption) error {
+
+	// We make sure that we register it with the main REST server to ensure
+	// all our methods are routed properly.
+	err := RegisterFeeAutopilotHandlerFromEndpoint(ctx, mux, dest, opts)
+	if err != nil {
+		log.Errorf("Could not register FeeAutopilot REST server "+
+			"with root REST server: %v", err)
+		return err
+	}
+
+	log.Debugf("FeeAutopilot REST server successfully registered with " +
+		"root REST server")
+	return nil
+}
+
+// CreateSubServer populates the subserver's dependencies using the passed
+// SubServerConfigDispatcher. This method should fully initialize the
+// sub-server instance, making it ready for action. It returns the macaroon
+// permissions that the sub-server wishes to pass on to the root server for all
+// methods routed towards it.
+//
+// NOTE: This is part of the lnrpc.GrpcHandler interface.
+func (r *ServerShell) CreateSubServer(
+	configRegistry lnrpc.SubServerConfigDispatcher) (lnrpc.SubServer,
+	lnrpc.MacaroonPerms, error) {
+
+	subServer, macPermissions, err := createNewSubServer(configRegistry)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r.FeeAutopilotServer = subServer
+	return subServer, macPermissions, nil
+}
+
+// Status returns whether the fee autopilot is active along with its current
+// strategy.
+//
+// NOTE: Part of the FeeAutopilotServer interface.
+func (s *Server) Status(_ context.Context,
+	_ *StatusRequest) (*StatusResponse, error) {
+
+	strategy, err := marshalStrategy(s.manager.Strategy())
+	if err != nil {
+		return nil, err
+	}
+
+	var lastRun int64
+	if t := s.manager.LastRun(); !t.IsZero() {
+		lastRun = t.Unix()
+	}
+
+	return &StatusResponse{
+		Active:     s.manager.IsActive(),
+		Strategy:   strategy,
+		LastRun:    lastRun,
+		NumUpdates: s.manager.NumUpdates(),
+	}, nil
+}
+
+// ModifyStatus enables or disables updating the fee rates of the channels.
+//
+// NOTE: Part of the FeeAutopilotServer interface.
+func (s *Server) ModifyStatus(_ context.Context,
+	in *ModifyStatusRequest) (*ModifyStatusResponse, error) {
+
+	s.manager.SetActive(in.Enable)
+
+	return &ModifyStatusResponse{}, nil
+}
+
+// SetStrategy replaces the strategy that determines the fee rates.
+//
+// NOTE: Part of the FeeAutopilotServer interface.
+func (s *Server) SetStrategy(_ context.Context,
+	in *SetStrategyRequest) (*SetStrategyResponse, error) {
+
+	strategy, err := unmarshalStrategy(in.Strategy)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.manager.SetStrategy(strategy); err != nil {
+		return nil, err
+	}
+
+	return &SetStrategyResponse{}, nil
+}
+
+// ListRecommendations returns the fee rates the current strategy recommends
+// for each channel, without applying them.
+//
+// NOTE: Part of the FeeAutopilotServer interface.
+func (s *Server) ListRecommendations(ctx context.Context,
+	_ *ListRecommendationsRequest) (*ListRecommendationsResponse, error) {
+
+	recs, err := s.manager.Recommendations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ListRecommendationsResponse{
+		Recommendations: make([]*ChannelRecommendation, 0, len(recs)),
+	}
+	for _, rec := range recs {
+		var (
+			flow     = rec.Flow
+			rates    = rec.Rates
+			newRates = rec.NewRates
+		)
+		resp.Recommendations = append(
+			resp.Recommendations, &ChannelRecommendation{
+				ChannelPoint:         rec.ChanPoint.String(),
+				ChanId:               rec.ChanID.ToUint64(),
+				Capacity:             int64(rec.Capacity),
+				LocalBalanceMsat:     uint64(rec.LocalBalance),
+				IncomingMsat:         uint64(flow.IncomingAmt),
+				OutgoingMsat:         uint64(flow.OutgoingAmt),
+				FeeRatePpm:           rates.FeeRate,
+				InboundFeeRatePpm:    rates.InboundFeeRate,
+				NewFeeRatePpm:        newRates.FeeRate,
+				NewInboundFeeRatePpm: newRates.InboundFeeRate,
+				RateLimited:          rec.RateLimited,
+
+				InsufficientBalanceFailures: flow.
+					InsufficientBalanceFailures,
+			},
+		)
+	}
+
+	return resp, nil
+}
+
+// Run applies the recommended fee rates right away.
+//
+// NOTE: Part of the FeeAutopilotServer interface.
+func (s *Server) Run(ctx context.Context, _ *RunRequest) (*RunResponse,
+	error) {
+
+	numUpdates, err := s.manager.Run(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunResponse{
+		NumUpdates: uint32(numUpdates),
+	}, nil
+}
+
+// unmarshalStrategy creates the fee strategy described by the RPC message.
+func unmarshalStrategy(s *Strategy) (feeautopilot.Strategy, error) {
+	if s == nil {
+		return nil, errors.New("strategy must be set")
+	}
+
+	var name string
+	switch s.Type {
+	case StrategyType_LIQUIDITY:
+		name = feeautopilot.StrategyLiquidity
+
+	case StrategyType_DEMAND:
+		name = feeautopilot.StrategyDemand
+
+	default:
+		return nil, fmt.Errorf("unknown strategy type: %v", s.Type)
+	}
+
+	bounds := feeautopilot.FeeBounds{
+		MinFeeRate:         s.MinFeeRatePpm,
+		MaxFeeRate:         s.MaxFeeRatePpm,
+		MaxInboundDiscount: s.MaxInboundDiscountPpm,
+	}
+
+	return feeautopilot.NewStrategy(
+		name, bounds, s.TargetRatio, s.DemandStep,
+	)
+}
+
+// marshalStrategy returns the RPC message that describes the fee strategy.
+func marshalStrategy(strategy feeautopilot.Strategy) (*Strategy, error) {
+	switch s := strategy.(type) {
+	case *feeautopilot.LiquidityStrategy:
+		return &Strategy{
+			Type:                  StrategyType_LIQUIDITY,
+			MinFeeRatePpm:         s.MinFeeRate,
+			MaxFeeRatePpm:         s.MaxFeeRate,
+			MaxInboundDiscountPpm: s.MaxInboundDiscount,
+			TargetRatio:           s.TargetRatio,
+		}, nil
+
+	case *feeautopilot.DemandStrategy:
+		return &Strategy{
+			Type:                  StrategyType_DEMAND,
+			MinFeeRatePpm:         s.MinFeeRate,
+			MaxFeeRatePpm:         s.MaxFeeRate,
+			MaxInboundDiscountPpm: s.MaxInboundDiscount,
+			DemandStep:            s.Step,
+		}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown strategy: %v", strategy.Name())
+	}
+}
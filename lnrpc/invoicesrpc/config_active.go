@@ -7,9 +7,11 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -74,4 +76,27 @@ type Config struct {
 	// ParseAuxData is a function that can be used to parse the auxiliary
 	// data from the invoice.
 	ParseAuxData func(message proto.Message) error
+
+	// BestHeight returns the current best block height that this node is
+	// aware of.
+	BestHeight func() (uint32, error)
+
+	// QueryBlindedRoutes can be used to generate a few routes to this node
+	// that can then be used in the construction of a blinded payment path.
+	QueryBlindedRoutes func(lnwire.MilliSatoshi) ([]*route.Route, error)
+
+	// BlindedPathCfg holds the config values to use when constructing the
+	// blinded payment paths of BOLT 12 invoices.
+	BlindedPathCfg *BlindedPathConfig
+
+	// NodeKeyECDH is the ECDH capable wrapper of the node's identity key.
+	// It is used to derive the key that authenticates our offers.
+	NodeKeyECDH keychain.SingleKeyECDH
+
+	// NodeKeyLoc is the key locator of the node's identity key.
+	NodeKeyLoc keychain.KeyLocator
+
+	// KeyRing is used to create the schnorr signatures of BOLT 12
+	// invoices with the node's identity key.
+	KeyRing keychain.MessageSignerRing
 }
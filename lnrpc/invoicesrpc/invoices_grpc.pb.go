@@ -44,6 +44,16 @@ type InvoicesClient interface {
 	// server will send HTLCs of invoices to the client and the client can modify
 	// some aspects of the HTLC in order to pass the invoice acceptance tests.
 	HtlcModifier(ctx context.Context, opts ...grpc.CallOption) (Invoices_HtlcModifierClient, error)
+	// CreateOffer creates a new BOLT 12 offer that is signed for by the node's
+	// identity key. The offer isn't stored by the node: its metadata
+	// authenticates all other fields, so that invoice requests for it can be
+	// answered statelessly.
+	CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResp, error)
+	// RespondInvoiceRequest answers a BOLT 12 invoice request for one of the
+	// offers created by this node. A new invoice with blinded payment paths to
+	// this node is added to the invoice database and returned in its BOLT 12
+	// encoding, signed by the node's identity key.
+	RespondInvoiceRequest(ctx context.Context, in *RespondInvoiceRequestMsg, opts ...grpc.CallOption) (*RespondInvoiceRequestResp, error)
 }
 
 type invoicesClient struct {
@@ -153,6 +163,24 @@ func (x *invoicesHtlcModifierClient) Recv() (*HtlcModifyRequest, error) {
 	return m, nil
 }
 
+func (c *invoicesClient) CreateOffer(ctx context.Context, in *CreateOfferRequest, opts ...grpc.CallOption) (*CreateOfferResp, error) {
+	out := new(CreateOfferResp)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/CreateOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoicesClient) RespondInvoiceRequest(ctx context.Context, in *RespondInvoiceRequestMsg, opts ...grpc.CallOption) (*RespondInvoiceRequestResp, error) {
+	out := new(RespondInvoiceRequestResp)
+	err := c.cc.Invoke(ctx, "/invoicesrpc.Invoices/RespondInvoiceRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoicesServer is the server API for Invoices service.
 // All implementations must embed UnimplementedInvoicesServer
 // for forward compatibility
@@ -182,6 +210,16 @@ type InvoicesServer interface {
 	// server will send HTLCs of invoices to the client and the client can modify
 	// some aspects of the HTLC in order to pass the invoice acceptance tests.
 	HtlcModifier(Invoices_HtlcModifierServer) error
+	// CreateOffer creates a new BOLT 12 offer that is signed for by the node's
+	// identity key. The offer isn't stored by the node: its metadata
+	// authenticates all other fields, so that invoice requests for it can be
+	// answered statelessly.
+	CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResp, error)
+	// RespondInvoiceRequest answers a BOLT 12 invoice request for one of the
+	// offers created by this node. A new invoice with blinded payment paths to
+	// this node is added to the invoice database and returned in its BOLT 12
+	// encoding, signed by the node's identity key.
+	RespondInvoiceRequest(context.Context, *RespondInvoiceRequestMsg) (*RespondInvoiceRequestResp, error)
 	mustEmbedUnimplementedInvoicesServer()
 }
 
@@ -207,6 +245,12 @@ func (UnimplementedInvoicesServer) LookupInvoiceV2(context.Context, *LookupInvoi
 func (UnimplementedInvoicesServer) HtlcModifier(Invoices_HtlcModifierServer) error {
 	return status.Errorf(codes.Unimplemented, "method HtlcModifier not implemented")
 }
+func (UnimplementedInvoicesServer) CreateOffer(context.Context, *CreateOfferRequest) (*CreateOfferResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOffer not implemented")
+}
+func (UnimplementedInvoicesServer) RespondInvoiceRequest(context.Context, *RespondInvoiceRequestMsg) (*RespondInvoiceRequestResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondInvoiceRequest not implemented")
+}
 func (UnimplementedInvoicesServer) mustEmbedUnimplementedInvoicesServer() {}
 
 // UnsafeInvoicesServer may be embedded to opt out of forward compatibility for this service.
@@ -339,6 +383,42 @@ func (x *invoicesHtlcModifierServer) Recv() (*HtlcModifyResponse, error) {
 	return m, nil
 }
 
+func _Invoices_CreateOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).CreateOffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/CreateOffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).CreateOffer(ctx, req.(*CreateOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invoices_RespondInvoiceRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondInvoiceRequestMsg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoicesServer).RespondInvoiceRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/invoicesrpc.Invoices/RespondInvoiceRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoicesServer).RespondInvoiceRequest(ctx, req.(*RespondInvoiceRequestMsg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Invoices_ServiceDesc is the grpc.ServiceDesc for Invoices service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -362,6 +442,14 @@ var Invoices_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LookupInvoiceV2",
 			Handler:    _Invoices_LookupInvoiceV2_Handler,
 		},
+		{
+			MethodName: "CreateOffer",
+			Handler:    _Invoices_CreateOffer_Handler,
+		},
+		{
+			MethodName: "RespondInvoiceRequest",
+			Handler:    _Invoices_RespondInvoiceRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -9,12 +9,20 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/invoices"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/zpay32"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -72,6 +80,14 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		}},
+		"/invoicesrpc.Invoices/CreateOffer": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
+		"/invoicesrpc.Invoices/RespondInvoiceRequest": {{
+			Entity: "invoices",
+			Action: "write",
+		}},
 	}
 
 	// DefaultInvoicesMacFilename is the default name of the invoices
@@ -97,6 +113,10 @@ type Server struct {
 	quit chan struct{}
 
 	cfg *Config
+
+	// offerAuth authenticates the offers created by this node. It is nil
+	// if the node key isn't available to the sub-server.
+	offerAuth *OfferAuthenticator
 }
 
 // A compile time check to ensure that Server fully implements the
@@ -150,6 +170,14 @@ func New(cfg *Config) (*Server, lnrpc.MacaroonPerms, error) {
 		quit: make(chan struct{}, 1),
 	}
 
+	if cfg.NodeKeyECDH != nil {
+		offerAuth, err := NewOfferAuthenticator(cfg.NodeKeyECDH)
+		if err != nil {
+			return nil, nil, err
+		}
+		server.offerAuth = offerAuth
+	}
+
 	return server, macPermissions, nil
 }
 
@@ -507,3 +535,223 @@ func (s *Server) HtlcModifier(
 		}
 	}
 }
+
+// CreateOffer creates a new BOLT 12 offer that is signed for by the node's
+// identity key. The offer isn't stored: its metadata authenticates all other
+// fields, so that invoice requests for it can be answered statelessly.
+func (s *Server) CreateOffer(_ context.Context,
+	req *CreateOfferRequest) (*CreateOfferResp, error) {
+
+	if s.offerAuth == nil {
+		return nil, errors.New("offers not supported by this node")
+	}
+
+	offer := &bolt12.Offer{
+		IssuerID: s.cfg.NodeKeyECDH.PubKey(),
+	}
+
+	// Offers are only valid on mainnet unless they list other chains.
+	if *s.cfg.ChainParams.GenesisHash !=
+		*chaincfg.MainNetParams.GenesisHash {
+
+		offer.Chains = []chainhash.Hash{*s.cfg.ChainParams.GenesisHash}
+	}
+
+	if req.Description != "" {
+		offer.Description = fn.Some(req.Description)
+	}
+	if req.AmountMsat != 0 {
+		offer.Amount = fn.Some(req.AmountMsat)
+	}
+	if req.Issuer != "" {
+		offer.Issuer = fn.Some(req.Issuer)
+	}
+	if req.QuantityMax != nil {
+		offer.QuantityMax = fn.Some(*req.QuantityMax)
+	}
+	if req.AbsoluteExpiry != 0 {
+		offer.AbsoluteExpiry = fn.Some(
+			time.Unix(int64(req.AbsoluteExpiry), 0),
+		)
+	}
+
+	if err := offer.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.offerAuth.AddMetadata(offer); err != nil {
+		return nil, err
+	}
+
+	encoded, err := offer.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	offerID, err := offer.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Created offer %v", offerID)
+
+	return &CreateOfferResp{
+		Offer:   encoded,
+		OfferId: offerID[:],
+	}, nil
+}
+
+// verifyInvoiceRequest checks that the given invoice request refers to a
+// valid, unexpired offer that was created by this node and returns the amount
+// to invoice.
+func (s *Server) verifyInvoiceRequest(
+	invReq *bolt12.InvoiceRequest) (lnwire.MilliSatoshi, error) {
+
+	offer := invReq.Offer
+	if offer.IssuerID == nil ||
+		!offer.IssuerID.IsEqual(s.cfg.NodeKeyECDH.PubKey()) {
+
+		return 0, ErrUnknownOffer
+	}
+
+	if err := s.offerAuth.Verify(offer); err != nil {
+		return 0, err
+	}
+
+	if offer.IsExpired(time.Now()) {
+		return 0, fmt.Errorf("offer %w", bolt12.ErrExpired)
+	}
+
+	if invReq.ChainHash() != *s.cfg.ChainParams.GenesisHash {
+		return 0, fmt.Errorf("invoice request for chain %v, but "+
+			"node runs on %v", invReq.ChainHash(),
+			s.cfg.ChainParams.GenesisHash)
+	}
+
+	// We never create offers with an amount in a currency, so we don't
+	// have to convert the amount.
+	if offer.Currency.IsSome() {
+		return 0, errors.New("offers with a currency are not " +
+			"supported")
+	}
+
+	amt, err := invReq.AmountMsat()
+	if err != nil {
+		return 0, err
+	}
+
+	return lnwire.MilliSatoshi(amt), nil
+}
+
+// RespondInvoiceRequest answers a BOLT 12 invoice request for one of the
+// offers created by this node. A new invoice with blinded payment paths to this
+// node is added to the invoice database and returned in its BOLT 12 encoding.
+func (s *Server) RespondInvoiceRequest(ctx context.Context,
+	req *RespondInvoiceRequestMsg) (*RespondInvoiceRequestResp, error) {
+
+	if s.offerAuth == nil || s.cfg.BlindedPathCfg == nil {
+		return nil, errors.New("offers not supported by this node")
+	}
+
+	// Decoding the request also validates it and verifies the signature
+	// of the payer.
+	invReq, err := bolt12.DecodeInvoiceRequest(req.InvoiceRequest)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"invoice request: %v", err)
+	}
+
+	amt, err := s.verifyInvoiceRequest(invReq)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	relativeExpiry := bolt12.DefaultRelativeExpiry
+	if req.RelativeExpiry != 0 {
+		relativeExpiry = time.Duration(req.RelativeExpiry) *
+			time.Second
+	}
+
+	addInvoiceCfg := &AddInvoiceConfig{
+		AddInvoice:        s.cfg.InvoiceRegistry.AddInvoice,
+		IsChannelActive:   s.cfg.IsChannelActive,
+		ChainParams:       s.cfg.ChainParams,
+		NodeSigner:        s.cfg.NodeSigner,
+		DefaultCLTVExpiry: s.cfg.DefaultCLTVExpiry,
+		ChanDB:            s.cfg.ChanStateDB,
+		Graph:             s.cfg.Graph,
+		GenInvoiceFeatures: func() *lnwire.FeatureVector {
+			// The payment address is carried as path ID in the
+			// encrypted data of the blinded paths, so it isn't
+			// required.
+			v := s.cfg.GenInvoiceFeatures()
+			v.Unset(lnwire.PaymentAddrRequired)
+			v.Set(lnwire.PaymentAddrOptional)
+
+			return v
+		},
+		GenAmpInvoiceFeatures: s.cfg.GenAmpInvoiceFeatures,
+		GetAlias:              s.cfg.GetAlias,
+		BestHeight:            s.cfg.BestHeight,
+		QueryBlindedRoutes:    s.cfg.QueryBlindedRoutes,
+	}
+
+	addInvoiceData := &AddInvoiceData{
+		Memo:           invReq.Offer.Description.UnwrapOr(""),
+		Value:          amt,
+		Expiry:         int64(relativeExpiry.Seconds()),
+		BlindedPathCfg: s.cfg.BlindedPathCfg,
+	}
+
+	hash, dbInvoice, err := AddInvoice(ctx, addInvoiceCfg, addInvoiceData)
+	if err != nil {
+		return nil, err
+	}
+
+	// The blinded paths were encoded in the BOLT 11 payment request, from
+	// which we convert them to the BOLT 12 format.
+	payReq, err := zpay32.Decode(
+		string(dbInvoice.PaymentRequest), s.cfg.ChainParams,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := bolt12PaymentPaths(payReq.BlindedPaymentPaths)
+	if err != nil {
+		return nil, err
+	}
+
+	invoice := bolt12.NewInvoice(
+		invReq, paths, dbInvoice.CreationDate, *hash, amt,
+		s.cfg.NodeKeyECDH.PubKey(),
+	)
+	invoice.Features = lnwire.NewRawFeatureVector(lnwire.MPPOptional)
+	if relativeExpiry != bolt12.DefaultRelativeExpiry {
+		invoice.RelativeExpiry = fn.Some(relativeExpiry)
+	}
+
+	err = invoice.Sign(func(tag []byte,
+		root chainhash.Hash) (*schnorr.Signature, error) {
+
+		return s.cfg.KeyRing.SignMessageSchnorr(
+			s.cfg.NodeKeyLoc, root[:], false, nil, tag,
+		)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to sign invoice: %w", err)
+	}
+
+	encoded, err := invoice.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Infof("Responded to invoice request with invoice %v", hash)
+
+	return &RespondInvoiceRequestResp{
+		Invoice:  encoded,
+		RHash:    hash[:],
+		AddIndex: dbInvoice.AddIndex,
+	}, nil
+}
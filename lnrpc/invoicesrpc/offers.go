@@ -0,0 +1,155 @@
+package invoicesrpc
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// offerNonceSize is the size of the random nonce that is placed in
+	// the metadata of every offer we create.
+	offerNonceSize = 16
+
+	// offerMetadataSize is the total size of the metadata of offers we
+	// create: the nonce followed by the HMAC over the offer.
+	offerMetadataSize = offerNonceSize + sha256.Size
+)
+
+var (
+	// offerMetadataTag is used for domain separation of the key that
+	// authenticates the metadata of our offers.
+	offerMetadataTag = []byte("lnd-bolt12-offer-metadata")
+
+	// ErrUnknownOffer is returned when an invoice request refers to an
+	// offer that wasn't created by us.
+	ErrUnknownOffer = errors.New("offer not created by this node")
+)
+
+// OfferAuthenticator creates and verifies the metadata of the offers we
+// issue. This allows us to answer invoice requests without storing the offers
+// we've created: the metadata of an offer contains an HMAC over all of its
+// other fields, keyed with a secret only known to our node.
+type OfferAuthenticator struct {
+	key [32]byte
+}
+
+// NewOfferAuthenticator derives the authentication key from the given node
+// key and returns a new OfferAuthenticator.
+func NewOfferAuthenticator(
+	nodeKey keychain.SingleKeyECDH) (*OfferAuthenticator, error) {
+
+	secret, err := nodeKey.ECDH(nodeKey.PubKey())
+	if err != nil {
+		return nil, fmt.Errorf("unable to derive offer key: %w", err)
+	}
+
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write(offerMetadataTag)
+
+	auth := &OfferAuthenticator{}
+	copy(auth.key[:], mac.Sum(nil))
+
+	return auth, nil
+}
+
+// offerMAC returns the HMAC over the given nonce and all fields of the offer
+// except for its metadata.
+func (a *OfferAuthenticator) offerMAC(offer *bolt12.Offer,
+	nonce []byte) ([]byte, error) {
+
+	unauthenticated := *offer
+	unauthenticated.Metadata = nil
+
+	data, err := unauthenticated.Serialize()
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, a.key[:])
+	mac.Write(nonce)
+	mac.Write(data)
+
+	return mac.Sum(nil), nil
+}
+
+// AddMetadata sets the metadata of the given offer to a fresh nonce followed
+// by the HMAC over the offer. All other fields of the offer must be final
+// when this method is called.
+func (a *OfferAuthenticator) AddMetadata(offer *bolt12.Offer) error {
+	nonce := make([]byte, offerNonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	mac, err := a.offerMAC(offer, nonce)
+	if err != nil {
+		return err
+	}
+
+	offer.Metadata = append(nonce, mac...)
+
+	return nil
+}
+
+// Verify checks that the metadata of the given offer was created by us for
+// exactly this offer.
+func (a *OfferAuthenticator) Verify(offer *bolt12.Offer) error {
+	if len(offer.Metadata) != offerMetadataSize {
+		return ErrUnknownOffer
+	}
+
+	nonce := offer.Metadata[:offerNonceSize]
+	mac, err := a.offerMAC(offer, nonce)
+	if err != nil {
+		return err
+	}
+
+	if !hmac.Equal(mac, offer.Metadata[offerNonceSize:]) {
+		return ErrUnknownOffer
+	}
+
+	return nil
+}
+
+// bolt12PaymentPaths converts the blinded payment paths of a BOLT 11 invoice
+// into their BOLT 12 representation. As constructed by the blindedpath
+// package, the first hop of each path carries the real public key of the
+// introduction node.
+func bolt12PaymentPaths(
+	paths []*zpay32.BlindedPaymentPath) ([]*bolt12.BlindedPaymentPath,
+	error) {
+
+	bolt12Paths := make([]*bolt12.BlindedPaymentPath, 0, len(paths))
+	for _, path := range paths {
+		if len(path.Hops) == 0 {
+			return nil, fmt.Errorf("blinded path without hops")
+		}
+
+		bolt12Paths = append(bolt12Paths, &bolt12.BlindedPaymentPath{
+			Path: &sphinx.BlindedPath{
+				IntroductionPoint: path.Hops[0].BlindedNodePub,
+				BlindingPoint: path.
+					FirstEphemeralBlindingPoint,
+				BlindedHops: path.Hops,
+			},
+			PayInfo: bolt12.BlindedPayInfo{
+				FeeBaseMsat:     path.FeeBaseMsat,
+				FeeRate:         path.FeeRate,
+				CltvExpiryDelta: path.CltvExpiryDelta,
+				HTLCMinMsat:     path.HTLCMinMsat,
+				HTLCMaxMsat:     path.HTLCMaxMsat,
+				Features:        path.Features,
+			},
+		})
+	}
+
+	return bolt12Paths, nil
+}
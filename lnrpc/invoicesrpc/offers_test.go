@@ -0,0 +1,132 @@
+package invoicesrpc
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOfferAuthenticator makes sure that only offers created by the same node
+// key pass the verification, and that any modification of an offer is
+// detected.
+func TestOfferAuthenticator(t *testing.T) {
+	t.Parallel()
+
+	nodeKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	auth, err := NewOfferAuthenticator(&keychain.PrivKeyECDH{
+		PrivKey: nodeKey,
+	})
+	require.NoError(t, err)
+
+	offer := &bolt12.Offer{
+		Amount:      fn.Some[uint64](1000),
+		Description: fn.Some("coffee"),
+		IssuerID:    nodeKey.PubKey(),
+	}
+	require.NoError(t, auth.AddMetadata(offer))
+	require.Len(t, offer.Metadata, offerMetadataSize)
+	require.NoError(t, auth.Verify(offer))
+
+	// Every offer gets a fresh nonce.
+	other := &bolt12.Offer{
+		Amount:      fn.Some[uint64](1000),
+		Description: fn.Some("coffee"),
+		IssuerID:    nodeKey.PubKey(),
+	}
+	require.NoError(t, auth.AddMetadata(other))
+	require.NotEqual(t, offer.Metadata, other.Metadata)
+
+	// The offer must survive an encoding round trip.
+	encoded, err := offer.Encode()
+	require.NoError(t, err)
+	decoded, err := bolt12.DecodeOffer(encoded)
+	require.NoError(t, err)
+	require.NoError(t, auth.Verify(decoded))
+
+	// Modifying any field invalidates the metadata.
+	decoded.Amount = fn.Some[uint64](1)
+	require.ErrorIs(t, auth.Verify(decoded), ErrUnknownOffer)
+
+	// Offers without or with truncated metadata are rejected.
+	decoded.Amount = offer.Amount
+	decoded.Metadata = decoded.Metadata[:offerNonceSize]
+	require.ErrorIs(t, auth.Verify(decoded), ErrUnknownOffer)
+
+	// A different node key doesn't accept our offers.
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherAuth, err := NewOfferAuthenticator(&keychain.PrivKeyECDH{
+		PrivKey: otherKey,
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, otherAuth.Verify(offer), ErrUnknownOffer)
+}
+
+// TestBolt12PaymentPaths tests the conversion of BOLT 11 blinded payment paths
+// to their BOLT 12 representation.
+func TestBolt12PaymentPaths(t *testing.T) {
+	t.Parallel()
+
+	randKey := func() *btcec.PublicKey {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return key.PubKey()
+	}
+
+	features := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(), lnwire.Features,
+	)
+	path := &zpay32.BlindedPaymentPath{
+		FeeBaseMsat:                 1,
+		FeeRate:                     2,
+		CltvExpiryDelta:             3,
+		HTLCMinMsat:                 4,
+		HTLCMaxMsat:                 5,
+		Features:                    features,
+		FirstEphemeralBlindingPoint: randKey(),
+		Hops: []*sphinx.BlindedHopInfo{
+			{
+				BlindedNodePub: randKey(),
+				CipherText:     []byte{1},
+			},
+			{
+				BlindedNodePub: randKey(),
+				CipherText:     []byte{2},
+			},
+		},
+	}
+
+	paths, err := bolt12PaymentPaths([]*zpay32.BlindedPaymentPath{path})
+	require.NoError(t, err)
+	require.Len(t, paths, 1)
+
+	require.Equal(t, &bolt12.BlindedPaymentPath{
+		Path: &sphinx.BlindedPath{
+			IntroductionPoint: path.Hops[0].BlindedNodePub,
+			BlindingPoint:     path.FirstEphemeralBlindingPoint,
+			BlindedHops:       path.Hops,
+		},
+		PayInfo: bolt12.BlindedPayInfo{
+			FeeBaseMsat:     1,
+			FeeRate:         2,
+			CltvExpiryDelta: 3,
+			HTLCMinMsat:     4,
+			HTLCMaxMsat:     5,
+			Features:        features,
+		},
+	}, paths[0])
+
+	path.Hops = nil
+	_, err = bolt12PaymentPaths([]*zpay32.BlindedPaymentPath{path})
+	require.Error(t, err)
+}
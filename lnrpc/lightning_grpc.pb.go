@@ -185,6 +185,46 @@ type LightningClient interface {
 	// never broadcast. Only available for non-externally funded channels in dev
 	// build.
 	AbandonChannel(ctx context.Context, in *AbandonChannelRequest, opts ...grpc.CallOption) (*AbandonChannelResponse, error)
+	// lncli: `splicein`
+	// SpliceIn adds funds of the wallet to an existing channel by splicing them
+	// into its funding output. The channel can only be used again once the
+	// splice transaction confirmed. Only private channels with peers that
+	// support splicing can be spliced.
+	SpliceIn(ctx context.Context, in *SpliceInRequest, opts ...grpc.CallOption) (*SpliceResponse, error)
+	// lncli: `spliceout`
+	// SpliceOut removes funds from an existing channel by splicing them out of
+	// its funding output to an on-chain address. The fees of the splice
+	// transaction are paid from our channel balance. The channel can only be used
+	// again once the splice transaction confirmed. Only private channels with
+	// peers that support splicing can be spliced.
+	SpliceOut(ctx context.Context, in *SpliceOutRequest, opts ...grpc.CallOption) (*SpliceResponse, error)
+	// lncli: `modifychanparams`
+	// ModifyChannelParams changes the parameters of an existing channel through
+	// a dynamic commitment with its peer. The parameters that constrain the
+	// remote party, like its dust limit or the CSV delay of its funds, as well
+	// as the channel type can be changed. If the channel is upgraded to a simple
+	// taproot channel or its CSV delay changes, its funds are moved to a new
+	// funding output by a kickoff transaction, and the channel can only be used
+	// again once the kickoff transaction confirmed.
+	ModifyChannelParams(ctx context.Context, in *ModifyChannelParamsRequest, opts ...grpc.CallOption) (*ModifyChannelParamsResponse, error)
+	// lncli: `bumpchannelopenfee`
+	// BumpChannelOpenFee replaces the unconfirmed funding transaction of a
+	// pending dual-funded channel with one that pays a higher fee rate. The
+	// replacement is negotiated with the peer, which must be online. Both
+	// parties keep their contributions to the channel.
+	BumpChannelOpenFee(ctx context.Context, in *BumpChannelOpenFeeRequest, opts ...grpc.CallOption) (*BumpChannelOpenFeeResponse, error)
+	// lncli: `listleaseoffers`
+	// ListLeaseOffers returns the nodes of the graph that advertise lease rates
+	// for inbound liquidity in their node announcement, along with the lease
+	// fee they charge for the given amount.
+	ListLeaseOffers(ctx context.Context, in *ListLeaseOffersRequest, opts ...grpc.CallOption) (*ListLeaseOffersResponse, error)
+	// lncli: `buyliquidity`
+	// BuyLiquidity opens a dual-funded channel with a node that advertises lease
+	// rates and leases inbound liquidity from it. The channel uses the script
+	// enforced lease commitment type, which locks the funds of the seller in
+	// the channel until the lease expires. The call returns once the funding
+	// transaction is published.
+	BuyLiquidity(ctx context.Context, in *BuyLiquidityRequest, opts ...grpc.CallOption) (*BuyLiquidityResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	// Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -413,6 +453,15 @@ type LightningClient interface {
 	// needs to be compiled with  the `dev` build tag, and the message type to
 	// override should be specified in lnd's experimental protocol configuration.
 	SubscribeCustomMessages(ctx context.Context, in *SubscribeCustomMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeCustomMessagesClient, error)
+	// lncli: `sendonion`
+	// SendOnionMessage sends an onion message along a blinded path. The
+	// introduction node of the path must be a connected peer that supports onion
+	// messages, or this node itself.
+	SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonion`
+	// SubscribeOnionMessages subscribes to a stream of incoming onion messages
+	// for which this node is the final hop.
+	SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error)
 	// lncli: `listaliases`
 	// ListAliases returns the set of all aliases that have ever existed with
 	// their confirmed SCID (if it exists) and/or the base SCID (in the case of
@@ -830,6 +879,60 @@ func (c *lightningClient) AbandonChannel(ctx context.Context, in *AbandonChannel
 	return out, nil
 }
 
+func (c *lightningClient) SpliceIn(ctx context.Context, in *SpliceInRequest, opts ...grpc.CallOption) (*SpliceResponse, error) {
+	out := new(SpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SpliceOut(ctx context.Context, in *SpliceOutRequest, opts ...grpc.CallOption) (*SpliceResponse, error) {
+	out := new(SpliceResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SpliceOut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ModifyChannelParams(ctx context.Context, in *ModifyChannelParamsRequest, opts ...grpc.CallOption) (*ModifyChannelParamsResponse, error) {
+	out := new(ModifyChannelParamsResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ModifyChannelParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BumpChannelOpenFee(ctx context.Context, in *BumpChannelOpenFeeRequest, opts ...grpc.CallOption) (*BumpChannelOpenFeeResponse, error) {
+	out := new(BumpChannelOpenFeeResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BumpChannelOpenFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListLeaseOffers(ctx context.Context, in *ListLeaseOffersRequest, opts ...grpc.CallOption) (*ListLeaseOffersResponse, error) {
+	out := new(ListLeaseOffersResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListLeaseOffers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BuyLiquidity(ctx context.Context, in *BuyLiquidityRequest, opts ...grpc.CallOption) (*BuyLiquidityResponse, error) {
+	out := new(BuyLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/BuyLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[6], "/lnrpc.Lightning/SendPayment", opts...)
@@ -1325,6 +1428,47 @@ func (x *lightningSubscribeCustomMessagesClient) Recv() (*CustomMessage, error)
 	return m, nil
 }
 
+func (c *lightningClient) SendOnionMessage(ctx context.Context, in *SendOnionMessageRequest, opts ...grpc.CallOption) (*SendOnionMessageResponse, error) {
+	out := new(SendOnionMessageResponse)
+	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/SendOnionMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SubscribeOnionMessages(ctx context.Context, in *SubscribeOnionMessagesRequest, opts ...grpc.CallOption) (Lightning_SubscribeOnionMessagesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Lightning_ServiceDesc.Streams[13], "/lnrpc.Lightning/SubscribeOnionMessages", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeOnionMessagesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeOnionMessagesClient interface {
+	Recv() (*OnionMessageUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeOnionMessagesClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeOnionMessagesClient) Recv() (*OnionMessageUpdate, error) {
+	m := new(OnionMessageUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *lightningClient) ListAliases(ctx context.Context, in *ListAliasesRequest, opts ...grpc.CallOption) (*ListAliasesResponse, error) {
 	out := new(ListAliasesResponse)
 	err := c.cc.Invoke(ctx, "/lnrpc.Lightning/ListAliases", in, out, opts...)
@@ -1514,6 +1658,46 @@ type LightningServer interface {
 	// never broadcast. Only available for non-externally funded channels in dev
 	// build.
 	AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error)
+	// lncli: `splicein`
+	// SpliceIn adds funds of the wallet to an existing channel by splicing them
+	// into its funding output. The channel can only be used again once the
+	// splice transaction confirmed. Only private channels with peers that
+	// support splicing can be spliced.
+	SpliceIn(context.Context, *SpliceInRequest) (*SpliceResponse, error)
+	// lncli: `spliceout`
+	// SpliceOut removes funds from an existing channel by splicing them out of
+	// its funding output to an on-chain address. The fees of the splice
+	// transaction are paid from our channel balance. The channel can only be used
+	// again once the splice transaction confirmed. Only private channels with
+	// peers that support splicing can be spliced.
+	SpliceOut(context.Context, *SpliceOutRequest) (*SpliceResponse, error)
+	// lncli: `modifychanparams`
+	// ModifyChannelParams changes the parameters of an existing channel through
+	// a dynamic commitment with its peer. The parameters that constrain the
+	// remote party, like its dust limit or the CSV delay of its funds, as well
+	// as the channel type can be changed. If the channel is upgraded to a simple
+	// taproot channel or its CSV delay changes, its funds are moved to a new
+	// funding output by a kickoff transaction, and the channel can only be used
+	// again once the kickoff transaction confirmed.
+	ModifyChannelParams(context.Context, *ModifyChannelParamsRequest) (*ModifyChannelParamsResponse, error)
+	// lncli: `bumpchannelopenfee`
+	// BumpChannelOpenFee replaces the unconfirmed funding transaction of a
+	// pending dual-funded channel with one that pays a higher fee rate. The
+	// replacement is negotiated with the peer, which must be online. Both
+	// parties keep their contributions to the channel.
+	BumpChannelOpenFee(context.Context, *BumpChannelOpenFeeRequest) (*BumpChannelOpenFeeResponse, error)
+	// lncli: `listleaseoffers`
+	// ListLeaseOffers returns the nodes of the graph that advertise lease rates
+	// for inbound liquidity in their node announcement, along with the lease
+	// fee they charge for the given amount.
+	ListLeaseOffers(context.Context, *ListLeaseOffersRequest) (*ListLeaseOffersResponse, error)
+	// lncli: `buyliquidity`
+	// BuyLiquidity opens a dual-funded channel with a node that advertises lease
+	// rates and leases inbound liquidity from it. The channel uses the script
+	// enforced lease commitment type, which locks the funds of the seller in
+	// the channel until the lease expires. The call returns once the funding
+	// transaction is published.
+	BuyLiquidity(context.Context, *BuyLiquidityRequest) (*BuyLiquidityResponse, error)
 	// Deprecated: Do not use.
 	// lncli: `sendpayment`
 	// Deprecated, use routerrpc.SendPaymentV2. SendPayment dispatches a
@@ -1742,6 +1926,15 @@ type LightningServer interface {
 	// needs to be compiled with  the `dev` build tag, and the message type to
 	// override should be specified in lnd's experimental protocol configuration.
 	SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error
+	// lncli: `sendonion`
+	// SendOnionMessage sends an onion message along a blinded path. The
+	// introduction node of the path must be a connected peer that supports onion
+	// messages, or this node itself.
+	SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error)
+	// lncli: `subscribeonion`
+	// SubscribeOnionMessages subscribes to a stream of incoming onion messages
+	// for which this node is the final hop.
+	SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error
 	// lncli: `listaliases`
 	// ListAliases returns the set of all aliases that have ever existed with
 	// their confirmed SCID (if it exists) and/or the base SCID (in the case of
@@ -1845,6 +2038,24 @@ func (UnimplementedLightningServer) CloseChannel(*CloseChannelRequest, Lightning
 func (UnimplementedLightningServer) AbandonChannel(context.Context, *AbandonChannelRequest) (*AbandonChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AbandonChannel not implemented")
 }
+func (UnimplementedLightningServer) SpliceIn(context.Context, *SpliceInRequest) (*SpliceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceIn not implemented")
+}
+func (UnimplementedLightningServer) SpliceOut(context.Context, *SpliceOutRequest) (*SpliceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpliceOut not implemented")
+}
+func (UnimplementedLightningServer) ModifyChannelParams(context.Context, *ModifyChannelParamsRequest) (*ModifyChannelParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyChannelParams not implemented")
+}
+func (UnimplementedLightningServer) BumpChannelOpenFee(context.Context, *BumpChannelOpenFeeRequest) (*BumpChannelOpenFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpChannelOpenFee not implemented")
+}
+func (UnimplementedLightningServer) ListLeaseOffers(context.Context, *ListLeaseOffersRequest) (*ListLeaseOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaseOffers not implemented")
+}
+func (UnimplementedLightningServer) BuyLiquidity(context.Context, *BuyLiquidityRequest) (*BuyLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyLiquidity not implemented")
+}
 func (UnimplementedLightningServer) SendPayment(Lightning_SendPaymentServer) error {
 	return status.Errorf(codes.Unimplemented, "method SendPayment not implemented")
 }
@@ -1956,6 +2167,12 @@ func (UnimplementedLightningServer) SendCustomMessage(context.Context, *SendCust
 func (UnimplementedLightningServer) SubscribeCustomMessages(*SubscribeCustomMessagesRequest, Lightning_SubscribeCustomMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeCustomMessages not implemented")
 }
+func (UnimplementedLightningServer) SendOnionMessage(context.Context, *SendOnionMessageRequest) (*SendOnionMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendOnionMessage not implemented")
+}
+func (UnimplementedLightningServer) SubscribeOnionMessages(*SubscribeOnionMessagesRequest, Lightning_SubscribeOnionMessagesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeOnionMessages not implemented")
+}
 func (UnimplementedLightningServer) ListAliases(context.Context, *ListAliasesRequest) (*ListAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListAliases not implemented")
 }
@@ -2520,6 +2737,114 @@ func _Lightning_AbandonChannel_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_SpliceIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceIn(ctx, req.(*SpliceInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SpliceOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpliceOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SpliceOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SpliceOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SpliceOut(ctx, req.(*SpliceOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ModifyChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyChannelParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ModifyChannelParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ModifyChannelParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ModifyChannelParams(ctx, req.(*ModifyChannelParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BumpChannelOpenFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpChannelOpenFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BumpChannelOpenFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BumpChannelOpenFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BumpChannelOpenFee(ctx, req.(*BumpChannelOpenFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListLeaseOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaseOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListLeaseOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListLeaseOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListLeaseOffers(ctx, req.(*ListLeaseOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BuyLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BuyLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BuyLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BuyLiquidity(ctx, req.(*BuyLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_SendPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(LightningServer).SendPayment(&lightningSendPaymentServer{stream})
 }
@@ -3222,6 +3547,45 @@ func (x *lightningSubscribeCustomMessagesServer) Send(m *CustomMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_SendOnionMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendOnionMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SendOnionMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendOnionMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendOnionMessage(ctx, req.(*SendOnionMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SubscribeOnionMessages_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeOnionMessagesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeOnionMessages(m, &lightningSubscribeOnionMessagesServer{stream})
+}
+
+type Lightning_SubscribeOnionMessagesServer interface {
+	Send(*OnionMessageUpdate) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeOnionMessagesServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeOnionMessagesServer) Send(m *OnionMessageUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_ListAliases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListAliasesRequest)
 	if err := dec(in); err != nil {
@@ -3357,6 +3721,30 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AbandonChannel",
 			Handler:    _Lightning_AbandonChannel_Handler,
 		},
+		{
+			MethodName: "SpliceIn",
+			Handler:    _Lightning_SpliceIn_Handler,
+		},
+		{
+			MethodName: "SpliceOut",
+			Handler:    _Lightning_SpliceOut_Handler,
+		},
+		{
+			MethodName: "ModifyChannelParams",
+			Handler:    _Lightning_ModifyChannelParams_Handler,
+		},
+		{
+			MethodName: "BumpChannelOpenFee",
+			Handler:    _Lightning_BumpChannelOpenFee_Handler,
+		},
+		{
+			MethodName: "ListLeaseOffers",
+			Handler:    _Lightning_ListLeaseOffers_Handler,
+		},
+		{
+			MethodName: "BuyLiquidity",
+			Handler:    _Lightning_BuyLiquidity_Handler,
+		},
 		{
 			MethodName: "SendPaymentSync",
 			Handler:    _Lightning_SendPaymentSync_Handler,
@@ -3477,6 +3865,10 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendCustomMessage",
 			Handler:    _Lightning_SendCustomMessage_Handler,
 		},
+		{
+			MethodName: "SendOnionMessage",
+			Handler:    _Lightning_SendOnionMessage_Handler,
+		},
 		{
 			MethodName: "ListAliases",
 			Handler:    _Lightning_ListAliases_Handler,
@@ -3556,6 +3948,11 @@ var Lightning_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeCustomMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeOnionMessages",
+			Handler:       _Lightning_SubscribeOnionMessages_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "lightning.proto",
 }
@@ -74,6 +74,34 @@ func DefaultConfig() *Config {
 			NodeWeight: routing.DefaultBimodalNodeWeight,
 			DecayTime:  routing.DefaultBimodalDecayTime,
 		},
+		MinCostFlowConfig: &MinCostFlowConfig{
+			MaxHops: routing.DefaultMinCostFlowMaxHops,
+			Units:   routing.DefaultMinCostFlowUnits,
+		},
+		ProberConfig: &ProberConfig{
+			Interval:    routing.DefaultProbeInterval,
+			Amount:      routing.DefaultProbeAmount.ToSatoshis(),
+			MaxInFlight: routing.DefaultProbeMaxInFlight.ToSatoshis(),
+
+			AutoDestinations: routing.DefaultProbeAutoDestinations,
+			HistorySize:      routing.DefaultProbeHistorySize,
+		},
+		RebalancerConfig: &RebalancerConfig{
+			Interval:  routing.DefaultRebalanceInterval,
+			MaxFeePPM: routing.DefaultRebalanceMaxFeePPM,
+			MaxAmount: routing.DefaultRebalanceMaxAmount.
+				ToSatoshis(),
+
+			MinLocalRatio: routing.DefaultRebalanceMinLocalRatio,
+			MaxLocalRatio: routing.DefaultRebalanceMaxLocalRatio,
+		},
+		TrampolineConfig: &TrampolineConfig{
+			FeeBase:        uint64(routing.DefaultTrampolineFeeBase),
+			FeeRate:        uint64(routing.DefaultTrampolineFeeRate),
+			CltvDelta:      routing.DefaultTrampolineCltvDelta,
+			MaxParts:       routing.DefaultTrampolineMaxParts,
+			PaymentTimeout: routing.DefaultTrampolinePaymentTimeout,
+		},
 		FeeEstimationTimeout: routing.DefaultFeeEstimationTimeout,
 	}
 
@@ -102,6 +130,36 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 			NodeWeight: cfg.BimodalConfig.NodeWeight,
 			DecayTime:  cfg.BimodalConfig.DecayTime,
 		},
+		MinCostFlowConfig: &MinCostFlowConfig{
+			Active:  cfg.MinCostFlowConfig.Active,
+			MaxHops: cfg.MinCostFlowConfig.MaxHops,
+			Units:   cfg.MinCostFlowConfig.Units,
+		},
+		ProberConfig: &ProberConfig{
+			Active:           cfg.ProberConfig.Active,
+			Interval:         cfg.ProberConfig.Interval,
+			Amount:           cfg.ProberConfig.Amount,
+			MaxInFlight:      cfg.ProberConfig.MaxInFlight,
+			Destinations:     cfg.ProberConfig.Destinations,
+			AutoDestinations: cfg.ProberConfig.AutoDestinations,
+			HistorySize:      cfg.ProberConfig.HistorySize,
+		},
+		RebalancerConfig: &RebalancerConfig{
+			Active:        cfg.RebalancerConfig.Active,
+			Interval:      cfg.RebalancerConfig.Interval,
+			MaxAmount:     cfg.RebalancerConfig.MaxAmount,
+			MaxFeePPM:     cfg.RebalancerConfig.MaxFeePPM,
+			MinLocalRatio: cfg.RebalancerConfig.MinLocalRatio,
+			MaxLocalRatio: cfg.RebalancerConfig.MaxLocalRatio,
+		},
+		TrampolineConfig: &TrampolineConfig{
+			Active:         cfg.TrampolineConfig.Active,
+			FeeBase:        cfg.TrampolineConfig.FeeBase,
+			FeeRate:        cfg.TrampolineConfig.FeeRate,
+			CltvDelta:      cfg.TrampolineConfig.CltvDelta,
+			MaxParts:       cfg.TrampolineConfig.MaxParts,
+			PaymentTimeout: cfg.TrampolineConfig.PaymentTimeout,
+		},
 		FeeEstimationTimeout: cfg.FeeEstimationTimeout,
 	}
 }
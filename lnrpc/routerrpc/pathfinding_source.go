@@ -0,0 +1,192 @@
+package routerrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
+	"github.com/lightningnetwork/lnd/lnutils"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// pathFindingSourceTimeout is the time a path finding source has to
+	// answer a request before the payment attempt fails.
+	pathFindingSourceTimeout = time.Minute
+)
+
+var (
+	// errPathFindingSourceClosed is returned when a payment requests paths
+	// from a source whose stream has ended.
+	errPathFindingSourceClosed = errors.New("path finding source " +
+		"disconnected")
+)
+
+// streamPathFindingSource is a path finding source that forwards the path
+// finding requests of payments to a client connected through the
+// RegisterPathFindingSource stream.
+type streamPathFindingSource struct {
+	stream Router_RegisterPathFindingSourceServer
+
+	// timeout is the time the client has to answer a request.
+	timeout time.Duration
+
+	// sendMtx serializes the requests sent to the stream, as payments
+	// request paths concurrently.
+	sendMtx sync.Mutex
+
+	// mu protects the fields below.
+	mu sync.Mutex
+
+	// nextID is the id of the next request.
+	nextID uint64
+
+	// pending holds the channels the results of the outstanding requests
+	// are delivered on, by request id.
+	pending map[uint64]chan *PathFindingResult
+
+	// quit is closed when the stream ends.
+	quit chan struct{}
+}
+
+// A compile-time check to ensure streamPathFindingSource implements the
+// routing.PathFindingSource interface.
+var _ routing.PathFindingSource = (*streamPathFindingSource)(nil)
+
+// newStreamPathFindingSource creates a path finding source backed by the
+// given stream.
+func newStreamPathFindingSource(stream Router_RegisterPathFindingSourceServer,
+	timeout time.Duration) *streamPathFindingSource {
+
+	return &streamPathFindingSource{
+		stream:  stream,
+		timeout: timeout,
+		pending: make(map[uint64]chan *PathFindingResult),
+		quit:    make(chan struct{}),
+	}
+}
+
+// run receives the results of the client until the stream ends, and delivers
+// them to the payments waiting for them.
+func (s *streamPathFindingSource) run() error {
+	defer close(s.quit)
+
+	for {
+		resp, err := s.stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		log.Tracef("Received path finding result from stream: %v",
+			lnutils.SpewLogClosure(resp))
+
+		result := resp.GetResult()
+		if result == nil {
+			return status.Errorf(codes.InvalidArgument,
+				"expected path finding result")
+		}
+
+		s.mu.Lock()
+		resultChan, ok := s.pending[result.RequestId]
+		delete(s.pending, result.RequestId)
+		s.mu.Unlock()
+
+		// The payment may have stopped waiting for the result
+		// already.
+		if !ok {
+			log.Debugf("Ignoring path finding result for unknown "+
+				"request %v", result.RequestId)
+
+			continue
+		}
+
+		// The channel is buffered, so this never blocks.
+		resultChan <- result
+	}
+}
+
+// FindPaths sends the request to the client and waits for the candidate paths
+// it returns.
+//
+// NOTE: This is part of the routing.PathFindingSource interface.
+func (s *streamPathFindingSource) FindPaths(ctx context.Context,
+	req *routing.PathFindingRequest) ([][]route.Vertex, error) {
+
+	resultChan := make(chan *PathFindingResult, 1)
+
+	s.mu.Lock()
+	id := s.nextID
+	s.nextID++
+	s.pending[id] = resultChan
+	s.mu.Unlock()
+
+	defer func() {
+		s.mu.Lock()
+		delete(s.pending, id)
+		s.mu.Unlock()
+	}()
+
+	rpcReq := &PathFindingSourceRequest{
+		RequestId:       id,
+		Source:          req.Source[:],
+		Dest:            req.Target[:],
+		AmtMsat:         uint64(req.Amount),
+		FeeLimitMsat:    uint64(req.FeeLimit),
+		CltvLimit:       req.CltvLimit,
+		OutgoingChanIds: req.OutgoingChannelIDs,
+	}
+	if len(req.RouteHints) > 0 {
+		rpcReq.RouteHints = invoicesrpc.CreateRPCRouteHints(
+			req.RouteHints,
+		)
+	}
+	if req.LastHop != nil {
+		rpcReq.LastHopPubkey = req.LastHop[:]
+	}
+
+	s.sendMtx.Lock()
+	err := s.stream.Send(rpcReq)
+	s.sendMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	var result *PathFindingResult
+	select {
+	case result = <-resultChan:
+
+	case <-time.After(s.timeout):
+		return nil, fmt.Errorf("path finding request %v timed out", id)
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-s.quit:
+		return nil, errPathFindingSourceClosed
+	}
+
+	if result.Error != "" {
+		return nil, errors.New(result.Error)
+	}
+
+	paths := make([][]route.Vertex, 0, len(result.Paths))
+	for _, rpcPath := range result.Paths {
+		path := make([]route.Vertex, len(rpcPath.HopPubkeys))
+		for i, pubKey := range rpcPath.HopPubkeys {
+			path[i], err = route.NewVertexFromBytes(pubKey)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
@@ -0,0 +1,173 @@
+package routerrpc
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntest/wait"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// pathFindingSourceStreamMock is a mock of the server side of the
+// RegisterPathFindingSource stream.
+type pathFindingSourceStreamMock struct {
+	grpc.ServerStream
+
+	// requests receives the requests the server sends to the client.
+	requests chan *PathFindingSourceRequest
+
+	// responses delivers the responses of the client to the server. The
+	// stream ends when it's closed.
+	responses chan *PathFindingSourceResponse
+}
+
+func newPathFindingSourceStreamMock() *pathFindingSourceStreamMock {
+	return &pathFindingSourceStreamMock{
+		requests:  make(chan *PathFindingSourceRequest, 10),
+		responses: make(chan *PathFindingSourceResponse, 10),
+	}
+}
+
+func (m *pathFindingSourceStreamMock) Send(
+	req *PathFindingSourceRequest) error {
+
+	m.requests <- req
+	return nil
+}
+
+func (m *pathFindingSourceStreamMock) Recv() (*PathFindingSourceResponse,
+	error) {
+
+	resp, ok := <-m.responses
+	if !ok {
+		return nil, io.EOF
+	}
+
+	return resp, nil
+}
+
+// TestRegisterPathFindingSource tests that a client registered through the
+// RegisterPathFindingSource stream answers the path finding requests of
+// payments.
+func TestRegisterPathFindingSource(t *testing.T) {
+	t.Parallel()
+
+	registry := routing.NewPathFindingSourceRegistry()
+	server := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				PathFindingSources: registry,
+			},
+		},
+	}
+
+	stream := newPathFindingSourceStreamMock()
+	stream.responses <- &PathFindingSourceResponse{
+		Response: &PathFindingSourceResponse_Register{
+			Register: &PathFindingSourceRegister{Name: "mcf"},
+		},
+	}
+
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- server.RegisterPathFindingSource(stream)
+	}()
+
+	var source routing.PathFindingSource
+	err := wait.NoError(func() error {
+		var err error
+		source, err = registry.Lookup("mcf")
+
+		return err
+	}, time.Second)
+	require.NoError(t, err)
+
+	self := route.Vertex{1}
+	hop := route.Vertex{2}
+	target := route.Vertex{3}
+
+	// Answer the first request with a path, and the second one with an
+	// error.
+	go func() {
+		req := <-stream.requests
+		stream.responses <- &PathFindingSourceResponse{
+			Response: &PathFindingSourceResponse_Result{
+				Result: &PathFindingResult{
+					RequestId: req.RequestId,
+					Paths: []*CandidatePath{{
+						HopPubkeys: [][]byte{
+							hop[:], req.Dest,
+						},
+					}},
+				},
+			},
+		}
+
+		req = <-stream.requests
+		stream.responses <- &PathFindingSourceResponse{
+			Response: &PathFindingSourceResponse_Result{
+				Result: &PathFindingResult{
+					RequestId: req.RequestId,
+					Error:     "no liquidity",
+				},
+			},
+		}
+	}()
+
+	req := &routing.PathFindingRequest{
+		Source: self,
+		Target: target,
+		Amount: lnwire.MilliSatoshi(1000),
+	}
+	paths, err := source.FindPaths(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, [][]route.Vertex{{hop, target}}, paths)
+
+	_, err = source.FindPaths(context.Background(), req)
+	require.ErrorContains(t, err, "no liquidity")
+
+	// Another client can't take the name of the registered source.
+	otherStream := newPathFindingSourceStreamMock()
+	otherStream.responses <- &PathFindingSourceResponse{
+		Response: &PathFindingSourceResponse_Register{
+			Register: &PathFindingSourceRegister{Name: "mcf"},
+		},
+	}
+	err = server.RegisterPathFindingSource(otherStream)
+	require.ErrorContains(t, err, "already registered")
+
+	// When the stream ends, the source is unregistered and pending
+	// requests fail.
+	close(stream.responses)
+	require.ErrorIs(t, <-errChan, io.EOF)
+
+	_, err = registry.Lookup("mcf")
+	require.ErrorIs(t, err, routing.ErrUnknownPathFindingSource)
+
+	_, err = source.FindPaths(context.Background(), req)
+	require.ErrorIs(t, err, errPathFindingSourceClosed)
+}
+
+// TestPathFindingSourceTimeout tests that a path finding request fails if the
+// client doesn't answer in time.
+func TestPathFindingSourceTimeout(t *testing.T) {
+	t.Parallel()
+
+	stream := newPathFindingSourceStreamMock()
+	source := newStreamPathFindingSource(stream, 10*time.Millisecond)
+
+	_, err := source.FindPaths(
+		context.Background(), &routing.PathFindingRequest{},
+	)
+	require.ErrorContains(t, err, "timed out")
+
+	source.mu.Lock()
+	require.Empty(t, source.pending)
+	source.mu.Unlock()
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/clock"
 	"github.com/lightningnetwork/lnd/feature"
@@ -38,9 +39,17 @@ const (
 	// attempts for given amount.
 	DefaultMaxParts = 16
 
+	// defaultSimulatedRoutes is the default number of candidate routes a
+	// route simulation returns.
+	defaultSimulatedRoutes = 5
+
 	// MaxPartsUpperLimit defines the maximum allowable number of splits
 	// for MPP/AMP when the user is attempting to send a payment.
 	MaxPartsUpperLimit = 1000
+
+	// DefaultTrampolineCltvDelta is the default time lock delta that is
+	// left to a trampoline node the payment is sent through.
+	DefaultTrampolineCltvDelta = 576
 )
 
 // RouterBackend contains the backend implementation of the router rpc sub
@@ -65,10 +74,31 @@ type RouterBackend struct {
 
 	// FindRoute is a closure that abstracts away how we locate/query for
 	// routes.
-	FindRoute func(*routing.RouteRequest) (*route.Route, float64, error)
+	FindRoute func(context.Context, *routing.RouteRequest) (*route.Route,
+		float64, error)
+
+	// SimulateRoutes is a closure that finds ranked candidate routes under
+	// the mission control state behind the probability source of the
+	// request's restrictions.
+	SimulateRoutes func(context.Context, *routing.RouteRequest,
+		uint32) ([]*routing.SimulatedRoute, error)
 
 	MissionControl MissionControl
 
+	// NewMissionControlSandbox returns a copy of mission control that
+	// doesn't affect the live state, with the given snapshot imported. If
+	// fresh is set, the copy only knows the results of the snapshot.
+	NewMissionControlSandbox func(
+		override *routing.MissionControlSnapshot,
+		fresh bool) MissionControl
+
+	// Prober is the background prober. It is nil if probing is disabled.
+	Prober Prober
+
+	// Rebalancer moves liquidity between our channels with circular
+	// payments.
+	Rebalancer Rebalancer
+
 	// ActiveNetParams are the network parameters of the primary network
 	// that the route is operating on. This is necessary so we can ensure
 	// that we receive payment requests that send to destinations on our
@@ -120,6 +150,10 @@ type RouterBackend struct {
 	// experimental endorsement bit should be set.
 	ShouldSetExpEndorsement func() bool
 
+	// PathFindingSources holds the external path finding sources payments
+	// can select.
+	PathFindingSources *routing.PathFindingSourceRegistry
+
 	// Clock is the clock used to validate payment requests expiry.
 	// It is useful for testing.
 	Clock clock.Clock
@@ -158,6 +192,28 @@ type MissionControl interface {
 	SetConfig(cfg *routing.MissionControlConfig) error
 }
 
+// Prober defines the dependencies of routerrpc on the background prober.
+type Prober interface {
+	// History returns the probes in the history, oldest first.
+	History() []*routing.ProbeResult
+
+	// InFlight returns the total amount of the probes that are in flight.
+	InFlight() lnwire.MilliSatoshi
+}
+
+// Rebalancer defines the dependencies of routerrpc on the rebalancer.
+type Rebalancer interface {
+	// Rebalance performs the requested rebalance and blocks until the
+	// circular payment is resolved.
+	Rebalance(ctx context.Context,
+		req *routing.RebalanceRequest) (*routing.RebalanceResult, error)
+
+	// SubscribeRebalances returns a client that receives an update of type
+	// *routing.RebalanceResult whenever a rebalance is started or
+	// resolved.
+	SubscribeRebalances() (*subscribe.Client, error)
+}
+
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
 // route to a target destination capable of carrying a specific amount of
 // satoshis within the route's flow. The returned route contains the full
@@ -178,7 +234,7 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	// Query the channel router for a possible path to the destination that
 	// can carry `in.Amt` satoshis _including_ the total fee required on
 	// the route
-	route, successProb, err := r.FindRoute(routeReq)
+	route, successProb, err := r.FindRoute(ctx, routeReq)
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +254,315 @@ func (r *RouterBackend) QueryRoutes(ctx context.Context,
 	return routeResp, nil
 }
 
+// SimulateRoute finds the ranked candidate routes to a destination under a
+// hypothetical mission control state, which is evaluated in a sandbox so that
+// the live state isn't changed.
+func (r *RouterBackend) SimulateRoute(ctx context.Context,
+	in *SimulateRouteRequest) (*SimulateRouteResponse, error) {
+
+	dest, err := route.NewVertexFromBytes(in.Dest)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.AmtMsat <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+	amt := lnwire.MilliSatoshi(in.AmtMsat)
+
+	if in.FeeLimitMsat < 0 {
+		return nil, errors.New("fee limit must not be negative")
+	}
+	feeLimit := lnwire.MaxMilliSatoshi
+	if in.FeeLimitMsat > 0 {
+		feeLimit = lnwire.MilliSatoshi(in.FeeLimitMsat)
+	}
+
+	numRoutes := in.NumRoutes
+	if numRoutes == 0 {
+		numRoutes = defaultSimulatedRoutes
+	}
+
+	finalCLTVDelta := r.DefaultFinalCltvDelta
+	if in.FinalCltvDelta != 0 {
+		finalCLTVDelta = uint16(in.FinalCltvDelta)
+	}
+
+	cltvLimit := r.MaxTotalTimelock
+	err = routing.ValidateCLTVLimit(cltvLimit, finalCLTVDelta, false)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &routing.MissionControlSnapshot{
+		Pairs: make([]routing.MissionControlPairSnapshot, len(in.Pairs)),
+	}
+	for i, pairResult := range in.Pairs {
+		pairSnapshot, err := toPairSnapshot(pairResult)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Pairs[i] = *pairSnapshot
+	}
+
+	excludedPairs := make(map[routing.DirectedNodePair]struct{})
+	for _, edge := range in.ExcludedEdges {
+		pair, err := r.rpcEdgeToPair(edge)
+		if err != nil {
+			return nil, fmt.Errorf("excluded channel %v: %w",
+				edge.ChannelId, err)
+		}
+		excludedPairs[pair] = struct{}{}
+	}
+
+	sandbox := r.NewMissionControlSandbox(
+		snapshot, in.IgnoreLiveHistory,
+	)
+
+	restrictions := &routing.RestrictParams{
+		FeeLimit: feeLimit,
+		ProbabilitySource: func(fromNode, toNode route.Vertex,
+			amt lnwire.MilliSatoshi,
+			capacity btcutil.Amount) float64 {
+
+			pair := routing.NewDirectedNodePair(fromNode, toNode)
+			if _, ok := excludedPairs[pair]; ok {
+				return 0
+			}
+
+			return sandbox.GetProbability(
+				fromNode, toNode, amt, capacity,
+			)
+		},
+		CltvLimit: cltvLimit - uint32(finalCLTVDelta),
+	}
+
+	routeReq, err := routing.NewRouteRequest(
+		r.SelfNode, &dest, amt, 0, restrictions, nil, nil, nil,
+		finalCLTVDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	routes, err := r.SimulateRoutes(ctx, routeReq, numRoutes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &SimulateRouteResponse{
+		Routes: make([]*SimulatedRoute, len(routes)),
+	}
+	for i, simulated := range routes {
+		rpcRoute, err := r.MarshallRoute(simulated.Route)
+		if err != nil {
+			return nil, err
+		}
+
+		hops := make([]*SimulatedHop, len(simulated.Hops))
+		for j, hop := range simulated.Hops {
+			routeHop := simulated.Route.Hops[j]
+			hops[j] = &SimulatedHop{
+				ChanId:      routeHop.ChannelID,
+				PubKey:      routeHop.PubKeyBytes[:],
+				AmtMsat:     int64(hop.Amount),
+				FeeMsat:     int64(hop.Fee),
+				SuccessProb: hop.Probability,
+			}
+		}
+
+		resp.Routes[i] = &SimulatedRoute{
+			Route:       rpcRoute,
+			SuccessProb: simulated.Probability,
+			Hops:        hops,
+			Cost:        simulated.Cost,
+		}
+	}
+
+	return resp, nil
+}
+
+// ListProbes returns the history of the background prober, optionally
+// filtered by destination.
+func (r *RouterBackend) ListProbes(
+	in *ListProbesRequest) (*ListProbesResponse, error) {
+
+	if r.Prober == nil {
+		return nil, errors.New("prober not active, enable it with " +
+			"routerrpc.prober.active")
+	}
+
+	var dest *route.Vertex
+	if len(in.Dest) > 0 {
+		vertex, err := route.NewVertexFromBytes(in.Dest)
+		if err != nil {
+			return nil, err
+		}
+		dest = &vertex
+	}
+
+	resp := &ListProbesResponse{
+		InFlightMsat: int64(r.Prober.InFlight()),
+	}
+	for _, probe := range r.Prober.History() {
+		if dest != nil && probe.Destination != *dest {
+			continue
+		}
+
+		rpcProbe, err := r.marshallProbe(probe)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Probes = append(resp.Probes, rpcProbe)
+	}
+
+	return resp, nil
+}
+
+// marshallProbe translates a probe of the prober into its rpc counterpart.
+func (r *RouterBackend) marshallProbe(probe *routing.ProbeResult) (*Probe,
+	error) {
+
+	rpcProbe := &Probe{
+		Id:          probe.ID,
+		Dest:        probe.Destination[:],
+		AmtMsat:     int64(probe.Amount),
+		FailureCode: uint32(probe.FailureCode),
+		SendTimeNs:  probe.SendTime.UnixNano(),
+	}
+
+	if probe.Route != nil {
+		rpcRoute, err := r.MarshallRoute(probe.Route)
+		if err != nil {
+			return nil, err
+		}
+		rpcProbe.Route = rpcRoute
+	}
+
+	if probe.FailureSourceIdx != nil {
+		rpcProbe.FailureSourceIndex = uint32(*probe.FailureSourceIdx)
+	}
+
+	switch {
+	case probe.InFlight():
+		rpcProbe.Status = ProbeStatus_PROBE_IN_FLIGHT
+		return rpcProbe, nil
+
+	case probe.Err != nil:
+		rpcProbe.Status = ProbeStatus_PROBE_ERROR
+		rpcProbe.Error = probe.Err.Error()
+
+	case probe.Reached:
+		rpcProbe.Status = ProbeStatus_PROBE_REACHED
+
+	default:
+		rpcProbe.Status = ProbeStatus_PROBE_FAILED
+	}
+
+	rpcProbe.ResolveTimeNs = probe.ResolveTime.UnixNano()
+
+	return rpcProbe, nil
+}
+
+// Rebalance performs the requested rebalance with the rebalancer.
+func (r *RouterBackend) Rebalance(ctx context.Context,
+	in *RebalanceRequest) (*RebalanceResponse, error) {
+
+	if r.Rebalancer == nil {
+		return nil, errors.New("rebalancer not available")
+	}
+
+	if in.AmtMsat < 0 {
+		return nil, errors.New("amount must not be negative")
+	}
+
+	req := &routing.RebalanceRequest{
+		Amount:    lnwire.MilliSatoshi(in.AmtMsat),
+		MaxFeePPM: in.MaxFeePpm,
+		DryRun:    in.DryRun,
+	}
+	if in.OutgoingChanId != 0 {
+		req.OutgoingChanID = fn.Some(
+			lnwire.NewShortChanIDFromInt(in.OutgoingChanId),
+		)
+	}
+	if in.IncomingChanId != 0 {
+		req.IncomingChanID = fn.Some(
+			lnwire.NewShortChanIDFromInt(in.IncomingChanId),
+		)
+	}
+
+	result, err := r.Rebalancer.Rebalance(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcRebalance, err := r.MarshallRebalance(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RebalanceResponse{
+		Rebalance: rpcRebalance,
+	}, nil
+}
+
+// MarshallRebalance translates a rebalance of the rebalancer into its rpc
+// counterpart.
+func (r *RouterBackend) MarshallRebalance(
+	result *routing.RebalanceResult) (*RebalanceUpdate, error) {
+
+	rpcRebalance := &RebalanceUpdate{
+		Id:             result.ID,
+		OutgoingChanId: result.OutgoingChanID.ToUint64(),
+		IncomingChanId: result.IncomingChanID.ToUint64(),
+		AmtMsat:        int64(result.Amount),
+		DryRun:         result.DryRun,
+		FeeMsat:        int64(result.Fee()),
+		StartTimeNs:    result.StartTime.UnixNano(),
+	}
+
+	if result.Route != nil {
+		rpcRoute, err := r.MarshallRoute(result.Route)
+		if err != nil {
+			return nil, err
+		}
+		rpcRebalance.Route = rpcRoute
+	}
+
+	if result.PaymentHash != (lntypes.Hash{}) {
+		rpcRebalance.PaymentHash = result.PaymentHash[:]
+	}
+
+	switch result.Status {
+	case routing.RebalanceStatusPlanned:
+		rpcRebalance.Status = RebalanceStatus_REBALANCE_PLANNED
+
+	case routing.RebalanceStatusInFlight:
+		rpcRebalance.Status = RebalanceStatus_REBALANCE_IN_FLIGHT
+		return rpcRebalance, nil
+
+	case routing.RebalanceStatusSucceeded:
+		rpcRebalance.Status = RebalanceStatus_REBALANCE_SUCCEEDED
+
+	case routing.RebalanceStatusFailed:
+		rpcRebalance.Status = RebalanceStatus_REBALANCE_FAILED
+		if result.Err != nil {
+			rpcRebalance.Error = result.Err.Error()
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown rebalance status: %v",
+			result.Status)
+	}
+
+	rpcRebalance.ResolveTimeNs = result.ResolveTime.UnixNano()
+
+	return rpcRebalance, nil
+}
+
 func parsePubKey(key string) (route.Vertex, error) {
 	pubKeyBytes, err := hex.DecodeString(key)
 	if err != nil {
@@ -951,6 +1316,28 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	payIntent.PayAttemptTimeout = time.Second *
 		time.Duration(rpcPayReq.TimeoutSeconds)
 
+	// If an external path finding source is selected, it proposes the
+	// routes of the payment.
+	if rpcPayReq.PathFindingSource != "" {
+		if rpcPayReq.Bolt12Invoice != "" {
+			return nil, errors.New("path_finding_source and " +
+				"bolt12_invoice cannot appear together")
+		}
+
+		if r.PathFindingSources == nil {
+			return nil, errors.New("path finding sources are not " +
+				"supported")
+		}
+
+		source, err := r.PathFindingSources.Lookup(
+			rpcPayReq.PathFindingSource,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payIntent.PathFindingSource = source
+	}
+
 	// Route hints.
 	routeHints, err := unmarshallRouteHints(
 		rpcPayReq.RouteHints,
@@ -968,10 +1355,17 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		return nil, err
 	}
 
-	// If the payment request field isn't blank, then the details of the
-	// invoice are encoded entirely within the encoded payReq.  So we'll
-	// attempt to decode it, populating the payment accordingly.
-	if rpcPayReq.PaymentRequest != "" {
+	// If a BOLT 12 invoice is given, all details of the payment are taken
+	// from it. Otherwise, if the payment request field isn't blank, then
+	// the details of the invoice are encoded entirely within the encoded
+	// payReq.  So we'll attempt to decode it, populating the payment
+	// accordingly.
+	if rpcPayReq.Bolt12Invoice != "" {
+		err := r.applyBolt12Invoice(payIntent, rpcPayReq, reqAmt)
+		if err != nil {
+			return nil, err
+		}
+	} else if rpcPayReq.PaymentRequest != "" {
 		switch {
 
 		case len(rpcPayReq.Dest) > 0:
@@ -1258,6 +1652,40 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		}
 	}
 
+	// If a trampoline node is chosen, the payment is sent to it and the
+	// details of the destination are passed on as its instructions.
+	if len(rpcPayReq.TrampolineNode) > 0 {
+		if rpcPayReq.Bolt12Invoice != "" {
+			return nil, errors.New("trampoline_node and " +
+				"bolt12_invoice cannot appear together")
+		}
+
+		trampolineNode, err := route.NewVertexFromBytes(
+			rpcPayReq.TrampolineNode,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		cltvDelta := rpcPayReq.TrampolineCltvDelta
+		if cltvDelta == 0 {
+			cltvDelta = DefaultTrampolineCltvDelta
+		}
+		if cltvDelta > math.MaxUint16 {
+			return nil, fmt.Errorf("trampoline_cltv_delta %v "+
+				"exceeds maximum", cltvDelta)
+		}
+
+		err = payIntent.RouteViaTrampoline(
+			trampolineNode,
+			lnwire.MilliSatoshi(rpcPayReq.TrampolineFeeMsat),
+			uint16(cltvDelta),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Do bounds checking with the block padding so the router isn't
 	// left with a zombie payment in case the user messes up.
 	err = routing.ValidateCLTVLimit(
@@ -1275,6 +1703,112 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// applyBolt12Invoice populates the payment intent from the BOLT 12 invoice of
+// the given send request. The invoice is validated and its signature is
+// verified.
+func (r *RouterBackend) applyBolt12Invoice(payIntent *routing.LightningPayment,
+	rpcPayReq *SendPaymentRequest, reqAmt lnwire.MilliSatoshi) error {
+
+	switch {
+	case rpcPayReq.PaymentRequest != "":
+		return errors.New("bolt12_invoice and payment_request " +
+			"cannot appear together")
+
+	case len(rpcPayReq.Dest) > 0:
+		return errors.New("dest and bolt12_invoice cannot appear " +
+			"together")
+
+	case len(rpcPayReq.PaymentHash) > 0:
+		return errors.New("payment_hash and bolt12_invoice cannot " +
+			"appear together")
+
+	case rpcPayReq.FinalCltvDelta != 0:
+		return errors.New("final_cltv_delta and bolt12_invoice " +
+			"cannot appear together")
+
+	case reqAmt != 0:
+		return errors.New("amount must not be specified when paying " +
+			"a BOLT 12 invoice")
+
+	case rpcPayReq.Amp:
+		return errors.New("AMP is not supported for BOLT 12 invoices")
+	}
+
+	invoice, err := bolt12.DecodeInvoice(rpcPayReq.Bolt12Invoice)
+	if err != nil {
+		return err
+	}
+
+	if invoice.IsExpired(r.Clock.Now()) {
+		return fmt.Errorf("invoice %w", bolt12.ErrExpired)
+	}
+
+	if invoice.Request.ChainHash() != *r.ActiveNetParams.GenesisHash {
+		return fmt.Errorf("invoice is for chain %v",
+			invoice.Request.ChainHash())
+	}
+
+	pathSet, err := BuildBolt12BlindedPathSet(invoice.Paths)
+	if err != nil {
+		return err
+	}
+	payIntent.BlindedPathSet = pathSet
+
+	err = payIntent.SetPaymentHash(invoice.PaymentHash)
+	if err != nil {
+		return err
+	}
+
+	payIntent.Amount = invoice.Amount
+	payIntent.FinalCLTVDelta = pathSet.FinalCLTVDelta()
+	payIntent.PaymentRequest = []byte(rpcPayReq.Bolt12Invoice)
+	copy(payIntent.Target[:], pathSet.TargetPubKey().SerializeCompressed())
+
+	// The final hop is hidden in the blinded paths, so the features of
+	// the paths are the only ones that apply to the route.
+	payIntent.DestFeatures = lnwire.EmptyFeatureVector()
+	if pathFeatures := pathSet.Features(); !pathFeatures.IsEmpty() {
+		payIntent.DestFeatures = pathFeatures.Clone()
+	}
+
+	mpp := invoice.Features != nil &&
+		(invoice.Features.IsSet(lnwire.MPPOptional) ||
+			invoice.Features.IsSet(lnwire.MPPRequired))
+	if !mpp {
+		payIntent.MaxParts = 1
+	}
+
+	return nil
+}
+
+// BuildBolt12BlindedPathSet converts the blinded payment paths of a BOLT 12
+// invoice into a routing.BlindedPaymentPathSet.
+func BuildBolt12BlindedPathSet(paths []*bolt12.BlindedPaymentPath) (
+	*routing.BlindedPaymentPathSet, error) {
+
+	marshalledPaths := make([]*routing.BlindedPayment, len(paths))
+	for i, path := range paths {
+		paymentPath := &routing.BlindedPayment{
+			BlindedPath:         path.Path,
+			BaseFee:             path.PayInfo.FeeBaseMsat,
+			ProportionalFeeRate: path.PayInfo.FeeRate,
+			CltvExpiryDelta:     path.PayInfo.CltvExpiryDelta,
+			HtlcMinimum:         path.PayInfo.HTLCMinMsat,
+			HtlcMaximum:         path.PayInfo.HTLCMaxMsat,
+			Features:            path.PayInfo.Features,
+		}
+
+		err := paymentPath.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		marshalledPaths[i] = paymentPath
+	}
+
+	return routing.NewBlindedPaymentPathSet(marshalledPaths)
+}
+
 // BuildBlindedPathSet marshals a set of zpay32.BlindedPaymentPath and uses
 // the result to build a new routing.BlindedPaymentPathSet.
 func BuildBlindedPathSet(paths []*zpay32.BlindedPaymentPath) (
@@ -4,13 +4,20 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"testing"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/lnmock"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing"
@@ -150,8 +157,8 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 		request.OutgoingChanIds = outgoingChanIds
 	}
 
-	findRoute := func(req *routing.RouteRequest) (*route.Route, float64,
-		error) {
+	findRoute := func(_ context.Context,
+		req *routing.RouteRequest) (*route.Route, float64, error) {
 
 		if int64(req.Amount) != amtSat*1000 {
 			t.Fatal("unexpected amount")
@@ -288,6 +295,262 @@ func testQueryRoutes(t *testing.T, useMissionControl bool, useMsat bool,
 	}
 }
 
+// TestSimulateRoute tests that a route simulation evaluates routes against a
+// sandbox with the given mission control state and excluded edges.
+func TestSimulateRoute(t *testing.T) {
+	t.Parallel()
+
+	self := route.Vertex{1}
+	dest := route.Vertex{3}
+	hop := route.Vertex{2}
+
+	override := &PairHistory{
+		NodeFrom: hop[:],
+		NodeTo:   dest[:],
+		History: &PairData{
+			FailTime:    1000,
+			FailAmtMsat: 5000,
+		},
+	}
+
+	var (
+		sandboxOverride *routing.MissionControlSnapshot
+		sandboxFresh    bool
+	)
+	simulateRoutes := func(_ context.Context, req *routing.RouteRequest,
+		numRoutes uint32) ([]*routing.SimulatedRoute, error) {
+
+		require.Equal(t, self, req.Source)
+		require.Equal(t, dest, req.Target)
+		require.Equal(t, lnwire.MilliSatoshi(10000), req.Amount)
+		require.EqualValues(t, defaultSimulatedRoutes, numRoutes)
+
+		restrictions := req.Restrictions
+		require.Equal(t, lnwire.MaxMilliSatoshi, restrictions.FeeLimit)
+		require.EqualValues(t, 1000-40, restrictions.CltvLimit)
+
+		// The excluded edge is ruled out, while other pairs are
+		// evaluated by the sandbox.
+		require.Zero(t, restrictions.ProbabilitySource(
+			hop, self, 0, 0,
+		))
+		require.Equal(
+			t, testMissionControlProb,
+			restrictions.ProbabilitySource(self, hop, 0, 0),
+		)
+
+		rt, err := route.NewRouteFromHops(
+			req.Amount+100, 144, req.Source, []*route.Hop{{
+				ChannelID:    555,
+				PubKeyBytes:  hop,
+				AmtToForward: req.Amount,
+			}, {
+				ChannelID:    556,
+				PubKeyBytes:  dest,
+				AmtToForward: req.Amount,
+			}},
+		)
+		require.NoError(t, err)
+
+		return []*routing.SimulatedRoute{{
+			Route:       rt,
+			Probability: 0.25,
+			Hops: []routing.SimulatedHop{{
+				Amount:      req.Amount + 100,
+				Fee:         100,
+				Probability: 0.5,
+			}, {
+				Amount:      req.Amount,
+				Probability: 0.5,
+			}},
+			Cost: 500,
+		}}, nil
+	}
+
+	backend := &RouterBackend{
+		SelfNode:              self,
+		MaxTotalTimelock:      1000,
+		DefaultFinalCltvDelta: 40,
+		SimulateRoutes:        simulateRoutes,
+		FetchChannelCapacity: func(chanID uint64) (
+			btcutil.Amount, error) {
+
+			return 1, nil
+		},
+		FetchChannelEndpoints: func(chanID uint64) (route.Vertex,
+			route.Vertex, error) {
+
+			return self, hop, nil
+		},
+		NewMissionControlSandbox: func(
+			snapshot *routing.MissionControlSnapshot,
+			fresh bool) MissionControl {
+
+			sandboxOverride = snapshot
+			sandboxFresh = fresh
+
+			return &mockMissionControl{}
+		},
+	}
+
+	resp, err := backend.SimulateRoute(
+		context.Background(), &SimulateRouteRequest{
+			Dest:              dest[:],
+			AmtMsat:           10000,
+			Pairs:             []*PairHistory{override},
+			IgnoreLiveHistory: true,
+			ExcludedEdges: []*lnrpc.EdgeLocator{{
+				ChannelId:        555,
+				DirectionReverse: true,
+			}},
+		},
+	)
+	require.NoError(t, err)
+
+	require.True(t, sandboxFresh)
+	require.Len(t, sandboxOverride.Pairs, 1)
+	require.Equal(
+		t, routing.NewDirectedNodePair(hop, dest),
+		sandboxOverride.Pairs[0].Pair,
+	)
+	require.Equal(
+		t, lnwire.MilliSatoshi(5000), sandboxOverride.Pairs[0].FailAmt,
+	)
+
+	require.Len(t, resp.Routes, 1)
+	rpcRoute := resp.Routes[0]
+	require.Equal(t, 0.25, rpcRoute.SuccessProb)
+	require.EqualValues(t, 500, rpcRoute.Cost)
+	require.Len(t, rpcRoute.Route.Hops, 2)
+	require.Equal(t, []*SimulatedHop{{
+		ChanId:      555,
+		PubKey:      hop[:],
+		AmtMsat:     10100,
+		FeeMsat:     100,
+		SuccessProb: 0.5,
+	}, {
+		ChanId:      556,
+		PubKey:      dest[:],
+		AmtMsat:     10000,
+		SuccessProb: 0.5,
+	}}, rpcRoute.Hops)
+
+	// A positive amount is required.
+	_, err = backend.SimulateRoute(
+		context.Background(), &SimulateRouteRequest{Dest: dest[:]},
+	)
+	require.ErrorContains(t, err, "amount must be positive")
+}
+
+// TestListProbes tests that the probe history is marshalled and filtered by
+// destination.
+func TestListProbes(t *testing.T) {
+	t.Parallel()
+
+	const failureCode = lnwire.CodeTemporaryChannelFailure
+
+	a, b := route.Vertex{2}, route.Vertex{3}
+	srcIdx := 1
+	sendTime := time.Unix(10, 0)
+	resolveTime := time.Unix(20, 0)
+
+	prober := &mockProber{
+		inFlight: 1000,
+		history: []*routing.ProbeResult{
+			{
+				ID:          1,
+				Destination: a,
+				Amount:      1000,
+				Route: &route.Route{
+					TotalAmount: 1000,
+					Hops: []*route.Hop{{
+						PubKeyBytes:  a,
+						AmtToForward: 1000,
+					}},
+				},
+				Reached:     true,
+				SendTime:    sendTime,
+				ResolveTime: resolveTime,
+			},
+			{
+				ID:               2,
+				Destination:      b,
+				Amount:           1000,
+				FailureSourceIdx: &srcIdx,
+				FailureCode:      failureCode,
+				SendTime:         sendTime,
+				ResolveTime:      resolveTime,
+			},
+			{
+				ID:          3,
+				Destination: b,
+				Amount:      1000,
+				Err:         errors.New("no route"),
+				SendTime:    sendTime,
+				ResolveTime: resolveTime,
+			},
+			{
+				ID:          4,
+				Destination: a,
+				Amount:      1000,
+				SendTime:    sendTime,
+			},
+		},
+	}
+
+	backend := &RouterBackend{
+		FetchChannelCapacity: func(chanID uint64) (
+			btcutil.Amount, error) {
+
+			return 1, nil
+		},
+	}
+	_, err := backend.ListProbes(&ListProbesRequest{})
+	require.Error(t, err)
+
+	backend.Prober = prober
+	resp, err := backend.ListProbes(&ListProbesRequest{})
+	require.NoError(t, err)
+	require.EqualValues(t, 1000, resp.InFlightMsat)
+	require.Len(t, resp.Probes, 4)
+
+	statuses := make([]ProbeStatus, len(resp.Probes))
+	for i, probe := range resp.Probes {
+		statuses[i] = probe.Status
+	}
+	require.Equal(t, []ProbeStatus{
+		ProbeStatus_PROBE_REACHED, ProbeStatus_PROBE_FAILED,
+		ProbeStatus_PROBE_ERROR, ProbeStatus_PROBE_IN_FLIGHT,
+	}, statuses)
+
+	require.Len(t, resp.Probes[0].Route.Hops, 1)
+	require.Equal(t, resolveTime.UnixNano(), resp.Probes[0].ResolveTimeNs)
+	require.EqualValues(t, 1, resp.Probes[1].FailureSourceIndex)
+	require.EqualValues(t, failureCode, resp.Probes[1].FailureCode)
+	require.Equal(t, "no route", resp.Probes[2].Error)
+	require.Zero(t, resp.Probes[3].ResolveTimeNs)
+
+	// Only the probes to the given destination are returned.
+	resp, err = backend.ListProbes(&ListProbesRequest{Dest: b[:]})
+	require.NoError(t, err)
+	require.Len(t, resp.Probes, 2)
+	require.EqualValues(t, 2, resp.Probes[0].Id)
+	require.EqualValues(t, 3, resp.Probes[1].Id)
+}
+
+type mockProber struct {
+	history  []*routing.ProbeResult
+	inFlight lnwire.MilliSatoshi
+}
+
+func (m *mockProber) History() []*routing.ProbeResult {
+	return m.history
+}
+
+func (m *mockProber) InFlight() lnwire.MilliSatoshi {
+	return m.inFlight
+}
+
 type mockMissionControl struct {
 	MissionControl
 }
@@ -675,6 +938,37 @@ func TestExtractIntentFromSendRequest(t *testing.T) {
 			valid:            false,
 			expectedErrorMsg: "custom records entry with TLV type",
 		},
+		{
+			name: "Unknown path finding source",
+			backend: &RouterBackend{
+				ShouldSetExpEndorsement: func() bool {
+					return false
+				},
+				PathFindingSources: routing.
+					NewPathFindingSourceRegistry(),
+			},
+			sendReq: &SendPaymentRequest{
+				PathFindingSource: "mcf",
+			},
+			valid:            false,
+			expectedErrorMsg: "unknown path finding source",
+		},
+		{
+			name: "Both path_finding_source and bolt12_invoice " +
+				"provided",
+			backend: &RouterBackend{
+				ShouldSetExpEndorsement: func() bool {
+					return false
+				},
+			},
+			sendReq: &SendPaymentRequest{
+				PathFindingSource: "mcf",
+				Bolt12Invoice:     "test",
+			},
+			valid: false,
+			expectedErrorMsg: "path_finding_source and " +
+				"bolt12_invoice cannot appear together",
+		},
 		{
 			name: "Amount conflict, both sat and msat specified",
 			backend: &RouterBackend{
@@ -917,3 +1211,195 @@ func TestExtractIntentFromSendRequest(t *testing.T) {
 		})
 	}
 }
+
+// TestExtractIntentTrampoline verifies that a payment through a trampoline
+// node is retargeted to it and carries the details of the destination as
+// trampoline instructions.
+func TestExtractIntentTrampoline(t *testing.T) {
+	t.Parallel()
+
+	destNodeBytes, err := hex.DecodeString(destKey)
+	require.NoError(t, err)
+
+	trampolineKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	trampolineNode := route.NewVertex(trampolineKey.PubKey())
+
+	backend := &RouterBackend{
+		MaxTotalTimelock: 2000,
+		ShouldSetExpEndorsement: func() bool {
+			return false
+		},
+	}
+
+	payAddr := [32]byte{1, 2, 3}
+	payIntent, err := backend.extractIntentFromSendRequest(
+		&SendPaymentRequest{
+			Dest:              destNodeBytes,
+			AmtMsat:           100_000,
+			PaymentHash:       make([]byte, 32),
+			PaymentAddr:       payAddr[:],
+			FinalCltvDelta:    40,
+			TrampolineNode:    trampolineNode[:],
+			TrampolineFeeMsat: 2_000,
+		},
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, trampolineNode, payIntent.Target)
+	require.Equal(t, lnwire.MilliSatoshi(102_000), payIntent.Amount)
+	require.EqualValues(t, 40+DefaultTrampolineCltvDelta,
+		payIntent.FinalCLTVDelta)
+	require.True(t, payIntent.PaymentAddr.IsNone())
+	require.EqualValues(t, 1, payIntent.MaxParts)
+
+	trampoline := payIntent.Trampoline
+	require.NotNil(t, trampoline)
+	require.EqualValues(
+		t, DefaultTrampolineCltvDelta, trampoline.CltvDelta,
+	)
+
+	instructions := trampoline.Instructions
+	require.Equal(t, destNodeBytes, instructions.NextNode[:])
+	require.EqualValues(t, 100_000, instructions.AmtToForward)
+	require.Equal(t, payAddr, instructions.MPP.PaymentAddr())
+
+	// Trampoline payments can't be sent as AMP payments.
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		Dest:           destNodeBytes,
+		AmtMsat:        100_000,
+		Amp:            true,
+		TrampolineNode: trampolineNode[:],
+	})
+	require.ErrorContains(t, err, "AMP payments can't be sent")
+}
+
+// TestExtractIntentFromBolt12Invoice verifies that a payment intent is
+// correctly populated from a BOLT 12 invoice.
+func TestExtractIntentFromBolt12Invoice(t *testing.T) {
+	t.Parallel()
+
+	randKey := func() *btcec.PrivateKey {
+		key, err := btcec.NewPrivateKey()
+		require.NoError(t, err)
+
+		return key
+	}
+
+	issuerKey, payerKey := randKey(), randKey()
+	regtest := *chaincfg.RegressionNetParams.GenesisHash
+
+	offer := &bolt12.Offer{
+		Chains:      []chainhash.Hash{regtest},
+		Amount:      fn.Some[uint64](50_000),
+		Description: fn.Some("coffee"),
+		IssuerID:    issuerKey.PubKey(),
+	}
+	invReq := bolt12.NewInvoiceRequest(
+		offer, []byte{1, 2, 3}, payerKey.PubKey(),
+	)
+	invReq.Chain = fn.Some(regtest)
+	require.NoError(t, invReq.Sign(bolt12.PrivKeySigner(payerKey)))
+
+	path := &bolt12.BlindedPaymentPath{
+		Path: &sphinx.BlindedPath{
+			IntroductionPoint: randKey().PubKey(),
+			BlindingPoint:     randKey().PubKey(),
+			BlindedHops: []*sphinx.BlindedHopInfo{
+				{
+					BlindedNodePub: randKey().PubKey(),
+					CipherText:     []byte{1},
+				},
+				{
+					BlindedNodePub: randKey().PubKey(),
+					CipherText:     []byte{2},
+				},
+			},
+		},
+		PayInfo: bolt12.BlindedPayInfo{
+			FeeBaseMsat:     1000,
+			FeeRate:         10,
+			CltvExpiryDelta: 80,
+			HTLCMinMsat:     1,
+			HTLCMaxMsat:     1_000_000,
+		},
+	}
+
+	createdAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	invoice := bolt12.NewInvoice(
+		invReq, []*bolt12.BlindedPaymentPath{path}, createdAt,
+		lntypes.Hash{1, 2, 3}, 50_000, issuerKey.PubKey(),
+	)
+	invoice.Features = lnwire.NewRawFeatureVector(lnwire.MPPOptional)
+	require.NoError(t, invoice.Sign(bolt12.PrivKeySigner(issuerKey)))
+
+	encoded, err := invoice.Encode()
+	require.NoError(t, err)
+
+	newBackend := func(now time.Time,
+		params *chaincfg.Params) *RouterBackend {
+
+		clock := &lnmock.MockClock{}
+		clock.On("Now").Return(now)
+
+		return &RouterBackend{
+			MaxTotalTimelock: 1000,
+			ActiveNetParams:  params,
+			Clock:            clock,
+			ShouldSetExpEndorsement: func() bool {
+				return false
+			},
+		}
+	}
+
+	backend := newBackend(
+		createdAt.Add(time.Minute), &chaincfg.RegressionNetParams,
+	)
+	payIntent, err := backend.extractIntentFromSendRequest(
+		&SendPaymentRequest{
+			Bolt12Invoice: encoded,
+		},
+	)
+	require.NoError(t, err)
+
+	require.EqualValues(t, 50_000, payIntent.Amount)
+	require.EqualValues(t, lntypes.Hash{1, 2, 3}, payIntent.Identifier())
+	require.NotNil(t, payIntent.BlindedPathSet)
+	require.Equal(
+		t, route.NewVertex(payIntent.BlindedPathSet.TargetPubKey()),
+		payIntent.Target,
+	)
+	require.EqualValues(t, DefaultMaxParts, payIntent.MaxParts)
+	require.Equal(t, []byte(encoded), payIntent.PaymentRequest)
+
+	// Expired invoices are rejected.
+	backend = newBackend(
+		createdAt.Add(3*time.Hour), &chaincfg.RegressionNetParams,
+	)
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		Bolt12Invoice: encoded,
+	})
+	require.ErrorIs(t, err, bolt12.ErrExpired)
+
+	// So are invoices for other chains.
+	backend = newBackend(
+		createdAt.Add(time.Minute), &chaincfg.TestNet3Params,
+	)
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		Bolt12Invoice: encoded,
+	})
+	require.ErrorContains(t, err, "invoice is for chain")
+
+	// The amount and payment hash are taken from the invoice.
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		Bolt12Invoice: encoded,
+		AmtMsat:       1,
+	})
+	require.ErrorContains(t, err, "amount must not be specified")
+
+	_, err = backend.extractIntentFromSendRequest(&SendPaymentRequest{
+		Bolt12Invoice: encoded,
+		PaymentHash:   make([]byte, 32),
+	})
+	require.ErrorContains(t, err, "payment_hash and bolt12_invoice")
+}
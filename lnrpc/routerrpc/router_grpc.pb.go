@@ -80,6 +80,27 @@ type RouterClient interface {
 	// probability if no channel is available or if the amount violates min/max
 	// HTLC constraints.
 	QueryProbability(ctx context.Context, in *QueryProbabilityRequest, opts ...grpc.CallOption) (*QueryProbabilityResponse, error)
+	// SimulateRoute finds the candidate routes to a destination under a
+	// hypothetical mission control state, ranked the way path finding ranks
+	// them. The simulation runs against a sandbox that starts out with a copy of
+	// the live mission control state, onto which the given pair history is
+	// imported, so the live state isn't changed.
+	SimulateRoute(ctx context.Context, in *SimulateRouteRequest, opts ...grpc.CallOption) (*SimulateRouteResponse, error)
+	// ListProbes returns the history of the probes the background prober sent,
+	// oldest first. The results of these probes are recorded in a dedicated
+	// mission control namespace.
+	ListProbes(ctx context.Context, in *ListProbesRequest, opts ...grpc.CallOption) (*ListProbesResponse, error)
+	// lncli: `rebalance`
+	// Rebalance moves liquidity from one of our channels to another with a
+	// circular payment. Channels that aren't given are chosen by their local
+	// balance ratio. The call blocks until the circular payment is resolved.
+	// Failures to find a route or to pay are reported in the returned rebalance
+	// rather than as an error.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// SubscribeRebalances returns a stream of updates of the rebalances, both
+	// requested ones and the ones started by the automatic rebalancer. An update
+	// is sent whenever a rebalance is started or resolved.
+	SubscribeRebalances(ctx context.Context, in *SubscribeRebalancesRequest, opts ...grpc.CallOption) (Router_SubscribeRebalancesClient, error)
 	// lncli: `buildroute`
 	// BuildRoute builds a fully specified route based on a list of hop public
 	// keys. It retrieves the relevant channel policies from the graph in order to
@@ -128,6 +149,20 @@ type RouterClient interface {
 	// operation is returned. The deletion will not be communicated to the channel
 	// peer via any message.
 	XDeleteLocalChanAliases(ctx context.Context, in *DeleteAliasesRequest, opts ...grpc.CallOption) (*DeleteAliasesResponse, error)
+	// BuildInvoiceRequest creates a BOLT 12 invoice request for the given offer,
+	// signed with a fresh payer key. The issuer of the offer answers the request
+	// with a BOLT 12 invoice, which can then be paid by setting the
+	// bolt12_invoice field of SendPaymentV2.
+	BuildInvoiceRequest(ctx context.Context, in *BuildInvoiceRequestRequest, opts ...grpc.CallOption) (*BuildInvoiceRequestResponse, error)
+	// RegisterPathFindingSource dispatches a bi-directional streaming RPC that
+	// registers the client as an external path finding source. The client first
+	// sends its name, after which payments that select the source by setting the
+	// path_finding_source field of SendPaymentV2 are routed along the candidate
+	// paths the client returns. LND sends a request for each route it needs and
+	// validates the candidate paths against the bandwidth of its channels, the
+	// payment's restrictions and mission control before using one of them. The
+	// source is unregistered when the stream ends.
+	RegisterPathFindingSource(ctx context.Context, opts ...grpc.CallOption) (Router_RegisterPathFindingSourceClient, error)
 }
 
 type routerClient struct {
@@ -316,6 +351,65 @@ func (c *routerClient) QueryProbability(ctx context.Context, in *QueryProbabilit
 	return out, nil
 }
 
+func (c *routerClient) SimulateRoute(ctx context.Context, in *SimulateRouteRequest, opts ...grpc.CallOption) (*SimulateRouteResponse, error) {
+	out := new(SimulateRouteResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/SimulateRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) ListProbes(ctx context.Context, in *ListProbesRequest, opts ...grpc.CallOption) (*ListProbesResponse, error) {
+	out := new(ListProbesResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/ListProbes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/Rebalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) SubscribeRebalances(ctx context.Context, in *SubscribeRebalancesRequest, opts ...grpc.CallOption) (Router_SubscribeRebalancesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/SubscribeRebalances", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerSubscribeRebalancesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Router_SubscribeRebalancesClient interface {
+	Recv() (*RebalanceUpdate, error)
+	grpc.ClientStream
+}
+
+type routerSubscribeRebalancesClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerSubscribeRebalancesClient) Recv() (*RebalanceUpdate, error) {
+	m := new(RebalanceUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, opts ...grpc.CallOption) (*BuildRouteResponse, error) {
 	out := new(BuildRouteResponse)
 	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildRoute", in, out, opts...)
@@ -326,7 +420,7 @@ func (c *routerClient) BuildRoute(ctx context.Context, in *BuildRouteRequest, op
 }
 
 func (c *routerClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Router_SubscribeHtlcEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[3], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/SubscribeHtlcEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +453,7 @@ func (x *routerSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) SendPayment(ctx context.Context, in *SendPaymentRequest, opts ...grpc.CallOption) (Router_SendPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[4], "/routerrpc.Router/SendPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -392,7 +486,7 @@ func (x *routerSendPaymentClient) Recv() (*PaymentStatus, error) {
 
 // Deprecated: Do not use.
 func (c *routerClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Router_TrackPaymentClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[5], "/routerrpc.Router/TrackPayment", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/TrackPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -424,7 +518,7 @@ func (x *routerTrackPaymentClient) Recv() (*PaymentStatus, error) {
 }
 
 func (c *routerClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Router_HtlcInterceptorClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[6], "/routerrpc.Router/HtlcInterceptor", opts...)
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[7], "/routerrpc.Router/HtlcInterceptor", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -481,6 +575,46 @@ func (c *routerClient) XDeleteLocalChanAliases(ctx context.Context, in *DeleteAl
 	return out, nil
 }
 
+func (c *routerClient) BuildInvoiceRequest(ctx context.Context, in *BuildInvoiceRequestRequest, opts ...grpc.CallOption) (*BuildInvoiceRequestResponse, error) {
+	out := new(BuildInvoiceRequestResponse)
+	err := c.cc.Invoke(ctx, "/routerrpc.Router/BuildInvoiceRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routerClient) RegisterPathFindingSource(ctx context.Context, opts ...grpc.CallOption) (Router_RegisterPathFindingSourceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Router_ServiceDesc.Streams[8], "/routerrpc.Router/RegisterPathFindingSource", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &routerRegisterPathFindingSourceClient{stream}
+	return x, nil
+}
+
+type Router_RegisterPathFindingSourceClient interface {
+	Send(*PathFindingSourceResponse) error
+	Recv() (*PathFindingSourceRequest, error)
+	grpc.ClientStream
+}
+
+type routerRegisterPathFindingSourceClient struct {
+	grpc.ClientStream
+}
+
+func (x *routerRegisterPathFindingSourceClient) Send(m *PathFindingSourceResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *routerRegisterPathFindingSourceClient) Recv() (*PathFindingSourceRequest, error) {
+	m := new(PathFindingSourceRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // RouterServer is the server API for Router service.
 // All implementations must embed UnimplementedRouterServer
 // for forward compatibility
@@ -546,6 +680,27 @@ type RouterServer interface {
 	// probability if no channel is available or if the amount violates min/max
 	// HTLC constraints.
 	QueryProbability(context.Context, *QueryProbabilityRequest) (*QueryProbabilityResponse, error)
+	// SimulateRoute finds the candidate routes to a destination under a
+	// hypothetical mission control state, ranked the way path finding ranks
+	// them. The simulation runs against a sandbox that starts out with a copy of
+	// the live mission control state, onto which the given pair history is
+	// imported, so the live state isn't changed.
+	SimulateRoute(context.Context, *SimulateRouteRequest) (*SimulateRouteResponse, error)
+	// ListProbes returns the history of the probes the background prober sent,
+	// oldest first. The results of these probes are recorded in a dedicated
+	// mission control namespace.
+	ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error)
+	// lncli: `rebalance`
+	// Rebalance moves liquidity from one of our channels to another with a
+	// circular payment. Channels that aren't given are chosen by their local
+	// balance ratio. The call blocks until the circular payment is resolved.
+	// Failures to find a route or to pay are reported in the returned rebalance
+	// rather than as an error.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// SubscribeRebalances returns a stream of updates of the rebalances, both
+	// requested ones and the ones started by the automatic rebalancer. An update
+	// is sent whenever a rebalance is started or resolved.
+	SubscribeRebalances(*SubscribeRebalancesRequest, Router_SubscribeRebalancesServer) error
 	// lncli: `buildroute`
 	// BuildRoute builds a fully specified route based on a list of hop public
 	// keys. It retrieves the relevant channel policies from the graph in order to
@@ -594,6 +749,20 @@ type RouterServer interface {
 	// operation is returned. The deletion will not be communicated to the channel
 	// peer via any message.
 	XDeleteLocalChanAliases(context.Context, *DeleteAliasesRequest) (*DeleteAliasesResponse, error)
+	// BuildInvoiceRequest creates a BOLT 12 invoice request for the given offer,
+	// signed with a fresh payer key. The issuer of the offer answers the request
+	// with a BOLT 12 invoice, which can then be paid by setting the
+	// bolt12_invoice field of SendPaymentV2.
+	BuildInvoiceRequest(context.Context, *BuildInvoiceRequestRequest) (*BuildInvoiceRequestResponse, error)
+	// RegisterPathFindingSource dispatches a bi-directional streaming RPC that
+	// registers the client as an external path finding source. The client first
+	// sends its name, after which payments that select the source by setting the
+	// path_finding_source field of SendPaymentV2 are routed along the candidate
+	// paths the client returns. LND sends a request for each route it needs and
+	// validates the candidate paths against the bandwidth of its channels, the
+	// payment's restrictions and mission control before using one of them. The
+	// source is unregistered when the stream ends.
+	RegisterPathFindingSource(Router_RegisterPathFindingSourceServer) error
 	mustEmbedUnimplementedRouterServer()
 }
 
@@ -637,6 +806,18 @@ func (UnimplementedRouterServer) SetMissionControlConfig(context.Context, *SetMi
 func (UnimplementedRouterServer) QueryProbability(context.Context, *QueryProbabilityRequest) (*QueryProbabilityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryProbability not implemented")
 }
+func (UnimplementedRouterServer) SimulateRoute(context.Context, *SimulateRouteRequest) (*SimulateRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateRoute not implemented")
+}
+func (UnimplementedRouterServer) ListProbes(context.Context, *ListProbesRequest) (*ListProbesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProbes not implemented")
+}
+func (UnimplementedRouterServer) Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rebalance not implemented")
+}
+func (UnimplementedRouterServer) SubscribeRebalances(*SubscribeRebalancesRequest, Router_SubscribeRebalancesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRebalances not implemented")
+}
 func (UnimplementedRouterServer) BuildRoute(context.Context, *BuildRouteRequest) (*BuildRouteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuildRoute not implemented")
 }
@@ -661,6 +842,12 @@ func (UnimplementedRouterServer) XAddLocalChanAliases(context.Context, *AddAlias
 func (UnimplementedRouterServer) XDeleteLocalChanAliases(context.Context, *DeleteAliasesRequest) (*DeleteAliasesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method XDeleteLocalChanAliases not implemented")
 }
+func (UnimplementedRouterServer) BuildInvoiceRequest(context.Context, *BuildInvoiceRequestRequest) (*BuildInvoiceRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuildInvoiceRequest not implemented")
+}
+func (UnimplementedRouterServer) RegisterPathFindingSource(Router_RegisterPathFindingSourceServer) error {
+	return status.Errorf(codes.Unimplemented, "method RegisterPathFindingSource not implemented")
+}
 func (UnimplementedRouterServer) mustEmbedUnimplementedRouterServer() {}
 
 // UnsafeRouterServer may be embedded to opt out of forward compatibility for this service.
@@ -899,6 +1086,81 @@ func _Router_QueryProbability_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_SimulateRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).SimulateRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/SimulateRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).SimulateRoute(ctx, req.(*SimulateRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_ListProbes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProbesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).ListProbes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/ListProbes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).ListProbes(ctx, req.(*ListProbesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_SubscribeRebalances_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRebalancesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouterServer).SubscribeRebalances(m, &routerSubscribeRebalancesServer{stream})
+}
+
+type Router_SubscribeRebalancesServer interface {
+	Send(*RebalanceUpdate) error
+	grpc.ServerStream
+}
+
+type routerSubscribeRebalancesServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerSubscribeRebalancesServer) Send(m *RebalanceUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Router_BuildRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BuildRouteRequest)
 	if err := dec(in); err != nil {
@@ -1060,6 +1322,50 @@ func _Router_XDeleteLocalChanAliases_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Router_BuildInvoiceRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuildInvoiceRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouterServer).BuildInvoiceRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/routerrpc.Router/BuildInvoiceRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouterServer).BuildInvoiceRequest(ctx, req.(*BuildInvoiceRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Router_RegisterPathFindingSource_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouterServer).RegisterPathFindingSource(&routerRegisterPathFindingSourceServer{stream})
+}
+
+type Router_RegisterPathFindingSourceServer interface {
+	Send(*PathFindingSourceRequest) error
+	Recv() (*PathFindingSourceResponse, error)
+	grpc.ServerStream
+}
+
+type routerRegisterPathFindingSourceServer struct {
+	grpc.ServerStream
+}
+
+func (x *routerRegisterPathFindingSourceServer) Send(m *PathFindingSourceRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *routerRegisterPathFindingSourceServer) Recv() (*PathFindingSourceResponse, error) {
+	m := new(PathFindingSourceResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Router_ServiceDesc is the grpc.ServiceDesc for Router service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1103,6 +1409,18 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryProbability",
 			Handler:    _Router_QueryProbability_Handler,
 		},
+		{
+			MethodName: "SimulateRoute",
+			Handler:    _Router_SimulateRoute_Handler,
+		},
+		{
+			MethodName: "ListProbes",
+			Handler:    _Router_ListProbes_Handler,
+		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Router_Rebalance_Handler,
+		},
 		{
 			MethodName: "BuildRoute",
 			Handler:    _Router_BuildRoute_Handler,
@@ -1119,6 +1437,10 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "XDeleteLocalChanAliases",
 			Handler:    _Router_XDeleteLocalChanAliases_Handler,
 		},
+		{
+			MethodName: "BuildInvoiceRequest",
+			Handler:    _Router_BuildInvoiceRequest_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1136,6 +1458,11 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Router_TrackPayments_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeRebalances",
+			Handler:       _Router_SubscribeRebalances_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "SubscribeHtlcEvents",
 			Handler:       _Router_SubscribeHtlcEvents_Handler,
@@ -1157,6 +1484,12 @@ var Router_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "RegisterPathFindingSource",
+			Handler:       _Router_RegisterPathFindingSource_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "routerrpc/router.proto",
 }
@@ -11,10 +11,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/lightningnetwork/lnd/aliasmgr"
+	"github.com/lightningnetwork/lnd/bolt12"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -25,6 +28,7 @@ import (
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/zpay32"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -127,6 +131,22 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/SimulateRoute": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/ListProbes": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/SubscribeRebalances": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/routerrpc.Router/ResetMissionControl": {{
 			Entity: "offchain",
 			Action: "write",
@@ -163,6 +183,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/BuildInvoiceRequest": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/RegisterPathFindingSource": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultRouterMacFilename is the default name of the router macaroon
@@ -401,7 +429,12 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 	// timeout, we will additionally wrap the context in a deadline. If the
 	// user provided 'cancelable' and ends the stream before the timeout is
 	// reached the payment will be canceled.
-	ctx := context.Background()
+	//
+	// Either way, the span of the call is carried over, so that the spans
+	// of the payment are part of the caller's trace if tracing is enabled.
+	ctx := trace.ContextWithSpan(
+		context.Background(), trace.SpanFromContext(stream.Context()),
+	)
 	if req.Cancelable {
 		ctx = stream.Context()
 	}
@@ -440,7 +473,7 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 			return nil, errors.New("amount must be greater than 0")
 
 		default:
-			return s.probeDestination(req.Dest, req.AmtSat)
+			return s.probeDestination(ctx, req.Dest, req.AmtSat)
 		}
 
 	case isProbeInvoice:
@@ -454,8 +487,8 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 
 // probeDestination estimates fees along a route to a destination based on the
 // contents of the local graph.
-func (s *Server) probeDestination(dest []byte, amtSat int64) (*RouteFeeResponse,
-	error) {
+func (s *Server) probeDestination(ctx context.Context, dest []byte,
+	amtSat int64) (*RouteFeeResponse, error) {
 
 	destNode, err := route.NewVertexFromBytes(dest)
 	if err != nil {
@@ -483,7 +516,7 @@ func (s *Server) probeDestination(dest []byte, amtSat int64) (*RouteFeeResponse,
 		return nil, err
 	}
 
-	route, _, err := s.cfg.Router.FindRoute(routeReq)
+	route, _, err := s.cfg.Router.FindRoute(ctx, routeReq)
 	if err != nil {
 		return nil, err
 	}
@@ -1485,6 +1518,79 @@ func (s *Server) trackPaymentStream(context context.Context,
 	}
 }
 
+// SimulateRoute finds the ranked candidate routes to a destination under a
+// hypothetical mission control state, without changing the live state.
+func (s *Server) SimulateRoute(ctx context.Context,
+	req *SimulateRouteRequest) (*SimulateRouteResponse, error) {
+
+	return s.cfg.RouterBackend.SimulateRoute(ctx, req)
+}
+
+// ListProbes returns the history of the probes the background prober sent.
+func (s *Server) ListProbes(_ context.Context,
+	req *ListProbesRequest) (*ListProbesResponse, error) {
+
+	return s.cfg.RouterBackend.ListProbes(req)
+}
+
+// Rebalance moves liquidity between two of our channels with a circular
+// payment.
+func (s *Server) Rebalance(ctx context.Context,
+	req *RebalanceRequest) (*RebalanceResponse, error) {
+
+	return s.cfg.RouterBackend.Rebalance(ctx, req)
+}
+
+// SubscribeRebalances streams an update whenever a rebalance is started or
+// resolved.
+func (s *Server) SubscribeRebalances(_ *SubscribeRebalancesRequest,
+	stream Router_SubscribeRebalancesServer) error {
+
+	if s.cfg.RouterBackend.Rebalancer == nil {
+		return errors.New("rebalancer not available")
+	}
+
+	client, err := s.cfg.RouterBackend.Rebalancer.SubscribeRebalances()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			result, ok := update.(*routing.RebalanceResult)
+			if !ok {
+				return fmt.Errorf("unexpected rebalance update "+
+					"type: %T", update)
+			}
+
+			rpcRebalance, err := s.cfg.RouterBackend.
+				MarshallRebalance(result)
+			if err != nil {
+				return err
+			}
+
+			if err := stream.Send(rpcRebalance); err != nil {
+				return err
+			}
+
+		// If the stream's context is cancelled, return an error.
+		case <-stream.Context().Done():
+			log.Debugf("rebalance stream cancelled")
+			return stream.Context().Err()
+
+		// If the subscribe client terminates, exit with an error.
+		case <-client.Quit():
+			return errors.New("rebalance subscription terminated")
+
+		// If the server has been signalled to shut down, exit.
+		case <-s.quit:
+			return errServerShuttingDown
+		}
+	}
+}
+
 // BuildRoute builds a route from a list of hop addresses.
 func (s *Server) BuildRoute(_ context.Context,
 	req *BuildRouteRequest) (*BuildRouteResponse, error) {
@@ -1755,6 +1861,105 @@ func (s *Server) XDeleteLocalChanAliases(_ context.Context,
 	}, nil
 }
 
+// BuildInvoiceRequest creates a BOLT 12 invoice request for the given offer,
+// signed with a fresh payer key.
+func (s *Server) BuildInvoiceRequest(_ context.Context,
+	req *BuildInvoiceRequestRequest) (*BuildInvoiceRequestResponse,
+	error) {
+
+	offer, err := bolt12.DecodeOffer(req.Offer)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid "+
+			"offer: %v", err)
+	}
+
+	if offer.IsExpired(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "offer %v",
+			bolt12.ErrExpired)
+	}
+
+	// The metadata makes every request unique, and the payer key is only
+	// used for this request, so that requests can't be linked to each
+	// other or to our node.
+	metadata := make([]byte, 32)
+	if _, err := crand.Read(metadata); err != nil {
+		return nil, err
+	}
+
+	payerKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	invReq := bolt12.NewInvoiceRequest(offer, metadata, payerKey.PubKey())
+
+	chain := s.cfg.RouterBackend.ActiveNetParams.GenesisHash
+	if *chain != *chaincfg.MainNetParams.GenesisHash {
+		invReq.Chain = fn.Some(*chain)
+	}
+	if req.AmtMsat != 0 {
+		invReq.Amount = fn.Some(req.AmtMsat)
+	}
+	if req.Quantity != 0 {
+		invReq.Quantity = fn.Some(req.Quantity)
+	}
+	if req.PayerNote != "" {
+		invReq.PayerNote = fn.Some(req.PayerNote)
+	}
+
+	if err := invReq.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := invReq.Sign(bolt12.PrivKeySigner(payerKey)); err != nil {
+		return nil, err
+	}
+
+	encoded, err := invReq.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &BuildInvoiceRequestResponse{
+		InvoiceRequest: encoded,
+		PayerId:        payerKey.PubKey().SerializeCompressed(),
+	}, nil
+}
+
+// RegisterPathFindingSource registers the caller as an external path finding
+// source under the name it sends first. Payments that select the source are
+// routed along the candidate paths the caller returns for the requests sent on
+// the stream. The source is unregistered when the stream ends.
+func (s *Server) RegisterPathFindingSource(
+	stream Router_RegisterPathFindingSourceServer) error {
+
+	sources := s.cfg.RouterBackend.PathFindingSources
+	if sources == nil {
+		return status.Error(codes.Unimplemented, "path finding "+
+			"sources are not supported")
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	register := resp.GetRegister()
+	if register == nil || register.Name == "" {
+		return status.Error(codes.InvalidArgument, "the name of the "+
+			"path finding source must be sent first")
+	}
+
+	source := newStreamPathFindingSource(stream, pathFindingSourceTimeout)
+	err = sources.Register(register.Name, source)
+	if err != nil {
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	defer sources.Unregister(register.Name)
+
+	return source.run()
+}
+
 func extractOutPoint(req *UpdateChanStatusRequest) (*wire.OutPoint, error) {
 	chanPoint := req.GetChanPoint()
 	txid, err := lnrpc.GetChanPointFundingTxid(chanPoint)
@@ -6,7 +6,11 @@ import (
 	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/bolt12"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
 	graphdb "github.com/lightningnetwork/lnd/graph/db"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -455,3 +459,59 @@ func TestIsLsp(t *testing.T) {
 		})
 	}
 }
+
+// TestBuildInvoiceRequest tests that invoice requests are built for the
+// active chain and signed with a fresh payer key.
+func TestBuildInvoiceRequest(t *testing.T) {
+	t.Parallel()
+
+	issuerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	regtest := *chaincfg.RegressionNetParams.GenesisHash
+	offer := &bolt12.Offer{
+		Chains:      []chainhash.Hash{regtest},
+		Amount:      fn.Some[uint64](1000),
+		Description: fn.Some("coffee"),
+		QuantityMax: fn.Some[uint64](10),
+		IssuerID:    issuerKey.PubKey(),
+	}
+	encodedOffer, err := offer.Encode()
+	require.NoError(t, err)
+
+	server := &Server{
+		cfg: &Config{
+			RouterBackend: &RouterBackend{
+				ActiveNetParams: &chaincfg.RegressionNetParams,
+			},
+		},
+	}
+
+	resp, err := server.BuildInvoiceRequest(
+		context.Background(), &BuildInvoiceRequestRequest{
+			Offer:     encodedOffer,
+			Quantity:  3,
+			PayerNote: "thanks",
+		},
+	)
+	require.NoError(t, err)
+
+	invReq, err := bolt12.DecodeInvoiceRequest(resp.InvoiceRequest)
+	require.NoError(t, err)
+	require.Equal(t, regtest, invReq.ChainHash())
+	require.Equal(t, resp.PayerId, invReq.PayerID.SerializeCompressed())
+	require.Equal(t, "thanks", invReq.PayerNote.UnwrapOr(""))
+
+	amt, err := invReq.AmountMsat()
+	require.NoError(t, err)
+	require.EqualValues(t, 3000, amt)
+
+	// A quantity above the maximum of the offer is rejected.
+	_, err = server.BuildInvoiceRequest(
+		context.Background(), &BuildInvoiceRequestRequest{
+			Offer:    encodedOffer,
+			Quantity: 11,
+		},
+	)
+	require.ErrorContains(t, err, "exceeds maximum")
+}
@@ -42,6 +42,20 @@ type RoutingConfig struct {
 	// BimodalConfig defines parameters for the bimodal probability.
 	BimodalConfig *BimodalConfig `group:"bimodal" namespace:"bimodal" description:"configuration for the bimodal pathfinding probability estimator"`
 
+	// MinCostFlowConfig defines parameters for splitting multi-part
+	// payments with a min-cost flow.
+	MinCostFlowConfig *MinCostFlowConfig `group:"mcf" namespace:"mcf" description:"configuration for splitting multi-part payments with a min-cost flow"`
+
+	// ProberConfig defines parameters for the background prober.
+	ProberConfig *ProberConfig `group:"prober" namespace:"prober" description:"configuration for the background prober that sends scheduled probe payments"`
+
+	// RebalancerConfig defines parameters for the circular rebalancer.
+	RebalancerConfig *RebalancerConfig `group:"rebalancer" namespace:"rebalancer" description:"configuration for the rebalancer that moves liquidity between channels with circular payments"`
+
+	// TrampolineConfig defines parameters for forwarding payments as
+	// trampoline node.
+	TrampolineConfig *TrampolineConfig `group:"trampoline" namespace:"trampoline" description:"configuration for forwarding payments as trampoline node"`
+
 	// FeeEstimationTimeout is the maximum time to wait for routing fees to be estimated.
 	FeeEstimationTimeout time.Duration `long:"fee-estimation-timeout" description:"the maximum time to wait for routing fees to be estimated by payment probes"`
 }
@@ -89,3 +103,107 @@ type BimodalConfig struct {
 	// time for previous successes or failures.
 	DecayTime time.Duration `long:"decaytime" description:"Describes the information decay of knowledge about previous successes and failures in channels."`
 }
+
+// MinCostFlowConfig defines parameters for splitting multi-part payments with
+// a min-cost flow.
+//
+//nolint:ll
+type MinCostFlowConfig struct {
+	// Active enables splitting multi-part payments with a min-cost flow
+	// over the liquidity bounds of the bimodal estimator instead of
+	// repeatedly halving the amount.
+	Active bool `long:"active" description:"Split multi-part payments with a min-cost flow over the liquidity bounds of the bimodal estimator."`
+
+	// MaxHops is the maximum number of hops of the paths the flow is
+	// solved over.
+	MaxHops uint32 `long:"maxhops" description:"The maximum number of hops of the paths a payment is split across."`
+
+	// Units is the number of units the payment amount is divided into to
+	// solve the flow. More units give a finer split at a higher
+	// computational cost.
+	Units uint32 `long:"units" description:"The number of units the payment amount is divided into to solve the flow. Valid values are in [1, 1000]."`
+}
+
+// ProberConfig defines parameters for the background prober.
+//
+//nolint:ll
+type ProberConfig struct {
+	// Active enables the background prober.
+	Active bool `long:"active" description:"Periodically send probe payments to learn about the liquidity in the network. The results are recorded in a dedicated mission control namespace."`
+
+	// Interval is the time between two probes.
+	Interval time.Duration `long:"interval" description:"The time between two probes, which bounds the probe rate."`
+
+	// Amount is the amount that is probed.
+	Amount btcutil.Amount `long:"amt" description:"The amount in sats that is probed."`
+
+	// MaxInFlight is the maximum total amount of the probes that are in
+	// flight at the same time.
+	MaxInFlight btcutil.Amount `long:"maxinflight" description:"The maximum total amount in sats, including fees, of the probes that are in flight at the same time."`
+
+	// Destinations are the public keys of the nodes that are probed.
+	Destinations []string `long:"dest" description:"The public key of a node to probe. Can be specified multiple times."`
+
+	// AutoDestinations is the number of destinations that are chosen
+	// automatically from the graph.
+	AutoDestinations int `long:"autodest" description:"The number of destinations that are chosen automatically from the best connected nodes of the graph, in addition to the configured ones."`
+
+	// HistorySize is the number of probes that are kept in the history.
+	HistorySize int `long:"historysize" description:"The number of probes that are kept in the probe history."`
+}
+
+// RebalancerConfig defines parameters for the circular rebalancer.
+//
+//nolint:ll
+type RebalancerConfig struct {
+	// Active enables the automatic rebalances.
+	Active bool `long:"active" description:"Periodically rebalance the channel with the highest local balance ratio above maxlocalratio into the one with the lowest ratio below minlocalratio. Rebalances can always be requested through the Rebalance RPC."`
+
+	// Interval is the time between two automatic rebalances.
+	Interval time.Duration `long:"interval" description:"The time between two automatic rebalances."`
+
+	// MaxAmount is the maximum amount that is moved by a rebalance whose
+	// amount isn't given.
+	MaxAmount btcutil.Amount `long:"maxamt" description:"The maximum amount in sats that is moved by a rebalance whose amount isn't given."`
+
+	// MaxFeePPM is the default maximum fee of a rebalance in parts per
+	// million of the amount.
+	MaxFeePPM uint64 `long:"maxfeeppm" description:"The maximum fee of a rebalance in parts per million of the amount, unless the request sets its own."`
+
+	// MinLocalRatio is the local balance ratio below which a channel is a
+	// rebalance destination.
+	MinLocalRatio float64 `long:"minlocalratio" description:"The local balance ratio below which a channel is chosen as rebalance destination. Valid values are in [0, 1)."`
+
+	// MaxLocalRatio is the local balance ratio above which a channel is a
+	// rebalance source.
+	MaxLocalRatio float64 `long:"maxlocalratio" description:"The local balance ratio above which a channel is chosen as rebalance source. Must be greater than minlocalratio and at most 1."`
+}
+
+// TrampolineConfig defines parameters for forwarding payments as trampoline
+// node.
+//
+//nolint:ll
+type TrampolineConfig struct {
+	// Active enables forwarding payments as trampoline node.
+	Active bool `long:"active" description:"EXPERIMENTAL: Forward payments that carry trampoline instructions in their onion by finding a route to the next node ourselves."`
+
+	// FeeBase is the base fee in msat that is charged for forwarding a
+	// payment as trampoline node.
+	FeeBase uint64 `long:"feebase" description:"The base fee in msat that is charged for forwarding a payment as trampoline node."`
+
+	// FeeRate is the proportional fee in parts per million that is charged
+	// for forwarding a payment as trampoline node.
+	FeeRate uint64 `long:"feerate" description:"The proportional fee in parts per million that is charged for forwarding a payment as trampoline node."`
+
+	// CltvDelta is the time lock delta that is kept on top of the route
+	// to the next node.
+	CltvDelta uint16 `long:"cltvdelta" description:"The time lock delta in blocks that is kept on top of the route to the next node."`
+
+	// MaxParts is the maximum number of parts a forwarded payment is split
+	// into.
+	MaxParts uint32 `long:"maxparts" description:"The maximum number of parts a forwarded payment is split into if the next node supports multi-part payments."`
+
+	// PaymentTimeout is the time after which no new attempts are made to
+	// forward a payment.
+	PaymentTimeout time.Duration `long:"paymenttimeout" description:"The time after which no new attempts are made to forward a payment."`
+}
@@ -607,9 +607,11 @@ func (c *ChanCloser) ReceiveShutdown(msg lnwire.Shutdown) (
 		// wants to close), we'll check if this is a frozen channel or
 		// not. If the channel is frozen and we were not also the
 		// initiator of the channel opening, then we'll deny their close
-		// attempt.
+		// attempt. If we sold the lease of the channel as its
+		// responder, then it's the initiator that can't close it.
 		chanInitiator := c.cfg.Channel.IsInitiator()
-		if !chanInitiator {
+		acceptorLease := c.cfg.Channel.ChanType().HasAcceptorLease()
+		if chanInitiator == acceptorLease {
 			absoluteThawHeight, err :=
 				c.cfg.Channel.AbsoluteThawHeight()
 			if err != nil {
@@ -0,0 +1,154 @@
+package chanfunding
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+)
+
+// InteractiveIntent is an intent created by the InteractiveAssembler. The
+// funding transaction of a dual-funded channel is constructed interactively by
+// both parties after they exchanged their channel parameters, so the channel
+// point is only bound once the construction is complete.
+type InteractiveIntent struct {
+	// ShimIntent is the wrapped basic intent that holds the funding
+	// amounts, the multi-sig keys and the channel point.
+	ShimIntent
+
+	// leasedInputs are the wallet inputs we add to the funding
+	// transaction, which are released if the intent is canceled.
+	leasedInputs []wire.OutPoint
+
+	// coinLeaser is used to release the leased inputs.
+	coinLeaser OutputLeaser
+}
+
+// BindKeys sets both the local and remote multi-sig keys, which are needed to
+// create the funding output.
+func (i *InteractiveIntent) BindKeys(localKey *keychain.KeyDescriptor,
+	remoteKey *btcec.PublicKey) {
+
+	i.localKey = localKey
+	i.remoteKey = remoteKey
+}
+
+// BindTapscriptRoot takes an optional tapscript root and binds it to the
+// underlying funding intent.
+func (i *InteractiveIntent) BindTapscriptRoot(root fn.Option[chainhash.Hash]) {
+	i.tapscriptRoot = root
+}
+
+// BindChanPoint sets the channel point once the funding transaction was
+// constructed.
+func (i *InteractiveIntent) BindChanPoint(chanPoint wire.OutPoint) {
+	i.chanPoint = &chanPoint
+}
+
+// SetRemoteFundingAmt sets the amount the remote party contributes to the
+// funding output, which is only known once it accepted the channel.
+func (i *InteractiveIntent) SetRemoteFundingAmt(amt btcutil.Amount) {
+	i.remoteFundingAmt = amt
+}
+
+// Cancel releases the wallet inputs we leased to contribute to the funding
+// transaction.
+//
+// NOTE: This method satisfies the chanfunding.Intent interface.
+func (i *InteractiveIntent) Cancel() {
+	for _, op := range i.leasedInputs {
+		err := i.coinLeaser.ReleaseOutput(LndInternalLockID, op)
+		if err != nil {
+			log.Warnf("Failed to release UTXO %s (%v))", op, err)
+		}
+	}
+
+	i.ShimIntent.Cancel()
+}
+
+// A compile-time check to ensure InteractiveIntent adheres to the Intent
+// interface.
+var _ Intent = (*InteractiveIntent)(nil)
+
+// InteractiveAssembler is a type of chanfunding.Assembler for dual-funded
+// channels. The wallet inputs of both parties are added to the funding
+// transaction during its interactive construction, which is driven outside of
+// the wallet, so the assembler only keeps track of the funding amounts.
+type InteractiveAssembler struct {
+	// initiator indicates if we're the initiator of the channel or not.
+	initiator bool
+
+	// thawHeight is the height at which the liquidity lease of the
+	// channel expires, or zero if no lease was negotiated.
+	thawHeight uint32
+
+	// leasedInputs are the wallet inputs that were leased to fund our
+	// contribution to the channel.
+	leasedInputs []wire.OutPoint
+
+	// coinLeaser is used to release the leased inputs if the funding flow
+	// is canceled.
+	coinLeaser OutputLeaser
+}
+
+// NewInteractiveAssembler creates a new InteractiveAssembler. The leased
+// inputs fund our contribution to the channel and are released by the coin
+// leaser if the funding flow is canceled before the channel was persisted. A
+// non-zero thaw height marks the channel as a liquidity lease that expires at
+// that height.
+func NewInteractiveAssembler(initiator bool, thawHeight uint32,
+	leasedInputs []wire.OutPoint,
+	coinLeaser OutputLeaser) *InteractiveAssembler {
+
+	return &InteractiveAssembler{
+		initiator:    initiator,
+		thawHeight:   thawHeight,
+		leasedInputs: leasedInputs,
+		coinLeaser:   coinLeaser,
+	}
+}
+
+// IsInitiator returns true if we're the initiator of the channel.
+func (i *InteractiveAssembler) IsInitiator() bool {
+	return i.initiator
+}
+
+// ProvisionChannel creates a new InteractiveIntent given the passed funding
+// Request. The channel point of the intent is only known once the funding
+// transaction was constructed with the remote party.
+//
+// NOTE: This method satisfies the chanfunding.Assembler interface.
+func (i *InteractiveAssembler) ProvisionChannel(req *Request) (Intent, error) {
+	switch {
+	case req.SubtractFees:
+		return nil, fmt.Errorf("SubtractFees not supported for " +
+			"dual-funded channels")
+
+	case req.FundUpToMaxAmt != 0 || req.MinFundAmt != 0:
+		return nil, fmt.Errorf("FundUpToMaxAmt and MinFundAmt not " +
+			"supported for dual-funded channels")
+
+	case req.PushAmt != 0:
+		return nil, fmt.Errorf("push amount not supported for " +
+			"dual-funded channels")
+	}
+
+	return &InteractiveIntent{
+		ShimIntent: ShimIntent{
+			localFundingAmt:  req.LocalAmt,
+			remoteFundingAmt: req.RemoteAmt,
+			musig2:           req.Musig2,
+			thawHeight:       i.thawHeight,
+		},
+		leasedInputs: i.leasedInputs,
+		coinLeaser:   i.coinLeaser,
+	}, nil
+}
+
+// A compile-time assertion to ensure InteractiveAssembler meets the Assembler
+// interface.
+var _ Assembler = (*InteractiveAssembler)(nil)
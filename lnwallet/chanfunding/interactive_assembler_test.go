@@ -0,0 +1,75 @@
+package chanfunding
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInteractiveIntent tests that the interactive intent only provides the
+// funding output once the keys are bound and the channel point once the
+// funding transaction was constructed.
+func TestInteractiveIntent(t *testing.T) {
+	t.Parallel()
+
+	const thawHeight = 1_000
+	a := NewInteractiveAssembler(true, thawHeight, nil, nil)
+	require.True(t, a.IsInitiator())
+
+	// A push amount isn't supported for dual-funded channels.
+	_, err := a.ProvisionChannel(&Request{
+		LocalAmt: chanCapacity,
+		PushAmt:  1000,
+	})
+	require.Error(t, err)
+
+	intent, err := a.ProvisionChannel(&Request{LocalAmt: chanCapacity})
+	require.NoError(t, err)
+
+	interactiveIntent, ok := intent.(*InteractiveIntent)
+	require.True(t, ok)
+	require.EqualValues(t, thawHeight, interactiveIntent.ThawHeight())
+
+	_, _, err = intent.FundingOutput()
+	require.Error(t, err)
+
+	// The remote party's contribution is added to the funding output.
+	const remoteAmt = 100_000
+	interactiveIntent.SetRemoteFundingAmt(remoteAmt)
+	require.EqualValues(t, remoteAmt, intent.RemoteFundingAmt())
+
+	localPub, _ := btcec.PrivKeyFromBytes(localPrivkey)
+	remotePub, _ := btcec.PrivKeyFromBytes(remotePrivkey)
+	interactiveIntent.BindKeys(
+		&keychain.KeyDescriptor{PubKey: localPub.PubKey()},
+		remotePub.PubKey(),
+	)
+
+	_, txOut, err := intent.FundingOutput()
+	require.NoError(t, err)
+	require.EqualValues(t, chanCapacity+remoteAmt, txOut.Value)
+
+	_, expectedOut, err := input.GenFundingPkScript(
+		localPub.PubKey().SerializeCompressed(),
+		remotePub.PubKey().SerializeCompressed(),
+		int64(chanCapacity+remoteAmt),
+	)
+	require.NoError(t, err)
+	require.Equal(t, expectedOut, txOut)
+
+	// The channel point is only known once it was bound.
+	_, err = intent.ChanPoint()
+	require.Error(t, err)
+
+	chanPoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 2}
+	interactiveIntent.BindChanPoint(chanPoint)
+
+	boundPoint, err := intent.ChanPoint()
+	require.NoError(t, err)
+	require.Equal(t, chanPoint, *boundPoint)
+}
@@ -2908,9 +2908,11 @@ func (lc *LightningChannel) fetchCommitmentView(
 
 // fundingTxIn returns the funding output as a transaction input. The input
 // returned by this function uses a max sequence number, so it isn't able to be
-// used with RBF by default.
+// used with RBF by default. If the channel was spliced, the input spends the
+// funding output of the splice transaction.
 func fundingTxIn(chanState *channeldb.OpenChannel) wire.TxIn {
-	return *wire.NewTxIn(&chanState.FundingOutpoint, nil, nil)
+	fundingOutpoint := chanState.CurrentFundingOutpoint()
+	return *wire.NewTxIn(&fundingOutpoint, nil, nil)
 }
 
 // evaluateHTLCView processes all update entries in both HTLC update logs,
@@ -5428,6 +5430,13 @@ func (lc *LightningChannel) IsChannelClean() bool {
 	lc.RLock()
 	defer lc.RUnlock()
 
+	return lc.isChannelClean()
+}
+
+// isChannelClean is the unlocked version of IsChannelClean.
+//
+// NOTE: This method requires the channel lock to be held.
+func (lc *LightningChannel) isChannelClean() bool {
 	// Check whether we have a pending commitment for our local state.
 	if lc.commitChains.Local.hasUnackedCommitment() {
 		return false
@@ -240,10 +240,10 @@ func CommitScriptToSelf(chanType channeldb.ChannelType, initiator bool,
 			csvDelay, selfKey, revokeKey, auxLeaf,
 		)
 
-	// If we are the initiator of a leased channel, then we have an
+	// If we sold the lease of a leased channel, then we have an
 	// additional CLTV requirement in addition to the usual CSV
 	// requirement.
-	case initiator && chanType.HasLeaseExpiration():
+	case chanType.IsLeaseSeller(initiator):
 		toLocalRedeemScript, err := input.LeaseCommitScriptToSelf(
 			selfKey, revokeKey, csvDelay, leaseExpiry,
 		)
@@ -295,10 +295,10 @@ func CommitScriptToRemote(chanType channeldb.ChannelType, initiator bool,
 	auxLeaf input.AuxTapLeaf) (input.ScriptDescriptor, uint32, error) {
 
 	switch {
-	// If we are not the initiator of a leased channel, then the remote
-	// party has an additional CLTV requirement in addition to the 1 block
-	// CSV requirement.
-	case chanType.HasLeaseExpiration() && !initiator:
+	// If the remote party sold the lease of a leased channel, then it has
+	// an additional CLTV requirement in addition to the 1 block CSV
+	// requirement.
+	case chanType.IsLeaseSeller(!initiator):
 		script, err := input.LeaseCommitScriptToRemoteConfirmed(
 			remoteKey, leaseExpiry,
 		)
@@ -430,10 +430,10 @@ func SecondLevelHtlcScript(chanType channeldb.ChannelType, initiator bool,
 			revocationKey, delayKey, csvDelay, auxLeaf,
 		)
 
-	// If we are the initiator of a leased channel, then we have an
+	// If we sold the lease of a leased channel, then we have an
 	// additional CLTV requirement in addition to the usual CSV
 	// requirement.
-	case initiator && chanType.HasLeaseExpiration():
+	case chanType.IsLeaseSeller(initiator):
 		witnessScript, err := input.LeaseSecondLevelHtlcScript(
 			revocationKey, delayKey, csvDelay, leaseExpiry,
 		)
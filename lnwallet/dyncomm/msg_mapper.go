@@ -0,0 +1,101 @@
+package dyncomm
+
+import (
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+)
+
+// MsgMapper is a struct that implements the MsgMapper interface for the
+// dynamic commitment state machine. This enables the state machine to be used
+// with protofsm.
+type MsgMapper struct {
+	// chanID is the channel ID of the channel whose parameters are
+	// changed.
+	chanID lnwire.ChannelID
+
+	// peerPub is the public key of the peer we change the channel
+	// parameters with.
+	peerPub btcec.PublicKey
+}
+
+// NewMsgMapper creates a new MsgMapper instance for the given channel.
+func NewMsgMapper(chanID lnwire.ChannelID,
+	peerPub btcec.PublicKey) *MsgMapper {
+
+	return &MsgMapper{
+		chanID:  chanID,
+		peerPub: peerPub,
+	}
+}
+
+// someEvent returns the target type as a protocol event option.
+func someEvent[T ProtocolEvent](m T) fn.Option[ProtocolEvent] {
+	return fn.Some(ProtocolEvent(m))
+}
+
+// isForUs returns true if the channel ID + pubkey of the message matches the
+// bound instance.
+func (m *MsgMapper) isForUs(chanID lnwire.ChannelID,
+	fromPub btcec.PublicKey) bool {
+
+	return m.chanID == chanID && m.peerPub.IsEqual(&fromPub)
+}
+
+// MapMsg maps a wire message into a FSM event. If the message is not mappable,
+// then None is returned.
+func (m *MsgMapper) MapMsg(wireMsg msgmux.PeerMsg) fn.Option[ProtocolEvent] {
+	switch msg := wireMsg.Message.(type) {
+	case *lnwire.DynPropose:
+		if !m.isForUs(msg.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&ProposeReceived{Propose: *msg})
+
+	case *lnwire.DynAck:
+		if !m.isForUs(msg.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&AckReceived{Ack: *msg})
+
+	case *lnwire.DynReject:
+		if !m.isForUs(msg.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&RejectReceived{Reject: *msg})
+
+	case *lnwire.DynCommit:
+		if !m.isForUs(msg.DynPropose.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&CommitReceived{Commit: *msg})
+
+	case *lnwire.CommitSig:
+		if !m.isForUs(msg.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&CommitSigReceived{CommitSig: *msg})
+
+	case *lnwire.KickoffSig:
+		if !m.isForUs(msg.ChanID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&KickoffSigReceived{KickoffSig: *msg})
+
+	case *lnwire.TxAbort:
+		if !m.isForUs(msg.ChannelID, wireMsg.PeerPub) {
+			return fn.None[ProtocolEvent]()
+		}
+
+		return someEvent(&AbortReceived{Abort: *msg})
+	}
+
+	return fn.None[ProtocolEvent]()
+}
@@ -0,0 +1,539 @@
+package dyncomm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrEmptyProposal is returned when a proposal doesn't change any
+	// channel parameter.
+	ErrEmptyProposal = errors.New("no channel parameters to change")
+
+	// ErrUnsupportedChanType is returned when a proposal changes the
+	// channel type to a type the channel can't be upgraded to.
+	ErrUnsupportedChanType = errors.New("unsupported channel type " +
+		"upgrade")
+
+	// ErrTaprootNotNegotiated is returned when a channel should be
+	// upgraded to a taproot channel, but not both parties support them.
+	ErrTaprootNotNegotiated = errors.New("simple taproot channels not " +
+		"negotiated")
+
+	// ErrPublicChannel is returned when the parameters of a public channel
+	// would require it to be reanchored. We don't announce the new funding
+	// output of a reanchored channel yet, so only private channels can be
+	// reanchored.
+	ErrPublicChannel = errors.New("changing the channel type, dust limit " +
+		"or csv delay of public channels is not supported")
+)
+
+// paramNames maps the TLV types of a dyn_propose to the names of the channel
+// parameters they change.
+var paramNames = []struct {
+	tlvType tlv.Type
+	name    string
+}{
+	{tlvType: 0, name: "dust_limit"},
+	{tlvType: 2, name: "max_value_in_flight"},
+	{tlvType: 4, name: "htlc_minimum"},
+	{tlvType: 6, name: "channel_reserve"},
+	{tlvType: 8, name: "csv_delay"},
+	{tlvType: 10, name: "max_accepted_htlcs"},
+	{tlvType: 12, name: "channel_type"},
+}
+
+// RejectedParams returns the names of the channel parameters whose TLV types
+// are set in the rejections of a dyn_reject.
+func RejectedParams(rejections *lnwire.RawFeatureVector) string {
+	var names []string
+	for _, param := range paramNames {
+		if rejections.IsSet(lnwire.FeatureBit(param.tlvType)) {
+			names = append(names, param.name)
+		}
+	}
+
+	if len(names) == 0 {
+		return "unknown parameters"
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// Request holds the channel parameters we propose to change. Parameters that
+// are None are left untouched.
+type Request struct {
+	// DustLimit is the new dust limit of our commitment.
+	DustLimit fn.Option[btcutil.Amount]
+
+	// MaxValueInFlight is the new maximum value of the HTLCs the remote
+	// party may have in flight towards us.
+	MaxValueInFlight fn.Option[lnwire.MilliSatoshi]
+
+	// HtlcMinimum is the new minimum value of the HTLCs the remote party
+	// may offer us.
+	HtlcMinimum fn.Option[lnwire.MilliSatoshi]
+
+	// ChannelReserve is the new reserve the remote party must keep.
+	ChannelReserve fn.Option[btcutil.Amount]
+
+	// CsvDelay is the new CSV delay of the funds of the remote party on
+	// its commitment.
+	CsvDelay fn.Option[uint16]
+
+	// MaxAcceptedHTLCs is the new maximum number of HTLCs the remote party
+	// may offer us.
+	MaxAcceptedHTLCs fn.Option[uint16]
+
+	// UpgradeToTaproot is true if the channel should be upgraded to a
+	// simple taproot channel.
+	UpgradeToTaproot bool
+}
+
+// NewProposal creates the dyn_propose for the given request, along with the
+// parameters of the channel once it is accepted. An error is returned if we
+// consider the parameters invalid ourselves.
+func NewProposal(env *Environment, req Request) (*lnwire.DynPropose,
+	channeldb.ChannelParams, error) {
+
+	state := env.Channel.State()
+
+	msg := &lnwire.DynPropose{
+		ChanID: env.ChanID,
+	}
+	req.DustLimit.WhenSome(func(dustLimit btcutil.Amount) {
+		msg.DustLimit = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType0](
+				tlv.NewBigSizeT(dustLimit),
+			),
+		)
+	})
+	req.MaxValueInFlight.WhenSome(func(maxValue lnwire.MilliSatoshi) {
+		msg.MaxValueInFlight = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType2](maxValue),
+		)
+	})
+	req.HtlcMinimum.WhenSome(func(htlcMin lnwire.MilliSatoshi) {
+		msg.HtlcMinimum = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType4](htlcMin),
+		)
+	})
+	req.ChannelReserve.WhenSome(func(reserve btcutil.Amount) {
+		msg.ChannelReserve = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType6](tlv.NewBigSizeT(reserve)),
+		)
+	})
+	req.CsvDelay.WhenSome(func(csvDelay uint16) {
+		msg.CsvDelay = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType8](csvDelay),
+		)
+	})
+	req.MaxAcceptedHTLCs.WhenSome(func(maxHtlcs uint16) {
+		msg.MaxAcceptedHTLCs = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType10](maxHtlcs),
+		)
+	})
+	if req.UpgradeToTaproot {
+		msg.ChannelType = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType12](
+				taprootChanType(state.ChanType),
+			),
+		)
+	}
+
+	if len(splitProposal(msg)) == 0 {
+		return nil, channeldb.ChannelParams{}, ErrEmptyProposal
+	}
+
+	params, err := proposalParams(state.Params(), msg, lntypes.Local)
+	if err != nil {
+		return nil, channeldb.ChannelParams{}, err
+	}
+
+	err = checkParams(env, state, params, lntypes.Local)
+	if err != nil {
+		return nil, channeldb.ChannelParams{}, err
+	}
+
+	return msg, params, nil
+}
+
+// proposalParams returns the parameters of the channel once the given proposal
+// of the sender is applied to the current parameters. All parameters are from
+// our point of view.
+func proposalParams(cur channeldb.ChannelParams, msg *lnwire.DynPropose,
+	sender lntypes.ChannelParty) (channeldb.ChannelParams, error) {
+
+	// A proposal changes the dust limit of the sender's commitment, and
+	// the constraints the sender imposes on the recipient. We apply it
+	// from the point of view of the sender.
+	params := cur
+	if sender.IsRemote() {
+		params = cur.Swapped()
+	}
+
+	msg.DustLimit.WhenSomeV(func(dustLimit tlv.BigSizeT[btcutil.Amount]) {
+		params.LocalCommitParams.DustLimit = dustLimit.Int()
+	})
+	msg.MaxValueInFlight.WhenSomeV(func(maxValue lnwire.MilliSatoshi) {
+		params.RemoteBounds.MaxPendingAmount = maxValue
+	})
+	msg.HtlcMinimum.WhenSomeV(func(htlcMin lnwire.MilliSatoshi) {
+		params.RemoteBounds.MinHTLC = htlcMin
+	})
+	msg.ChannelReserve.WhenSomeV(
+		func(reserve tlv.BigSizeT[btcutil.Amount]) {
+			params.RemoteBounds.ChanReserve = reserve.Int()
+		},
+	)
+	msg.CsvDelay.WhenSomeV(func(csvDelay uint16) {
+		params.RemoteCommitParams.CsvDelay = csvDelay
+	})
+	msg.MaxAcceptedHTLCs.WhenSomeV(func(maxHtlcs uint16) {
+		params.RemoteBounds.MaxAcceptedHtlcs = maxHtlcs
+	})
+
+	var err error
+	msg.ChannelType.WhenSomeV(func(chanType lnwire.ChannelType) {
+		params.ChanType, err = upgradedChanType(cur.ChanType, chanType)
+	})
+	if err != nil {
+		return channeldb.ChannelParams{}, err
+	}
+
+	if sender.IsRemote() {
+		params = params.Swapped()
+	}
+
+	return params, nil
+}
+
+// checkParams returns an error if the channel can't switch to the given
+// parameters, or if the recipient of the proposal isn't expected to accept the
+// constraints imposed on it.
+func checkParams(env *Environment, state *channeldb.OpenChannel,
+	params channeldb.ChannelParams, proposer lntypes.ChannelParty) error {
+
+	cur := state.Params()
+	if params.RequiresReanchor(cur) {
+		if state.ChannelFlags&lnwire.FFAnnounceChannel != 0 {
+			return ErrPublicChannel
+		}
+
+		if cur.ChanType.IsTaproot() ||
+			cur.ChanType.HasLeaseExpiration() {
+
+			return lnwallet.ErrReanchorUnsupported
+		}
+
+		if params.ChanType.IsTaproot() && !env.TaprootAllowed {
+			return ErrTaprootNotNegotiated
+		}
+	}
+
+	// We only know the maximum CSV delay we accept ourselves, so there's
+	// no limit if the remote party is the recipient.
+	prev, recipient := cur, params
+	maxCSVDelay := env.MaxLocalCSVDelay
+	if proposer.IsLocal() {
+		prev, recipient = cur.Swapped(), params.Swapped()
+		maxCSVDelay = math.MaxUint16
+	}
+
+	return checkConstraints(prev, recipient, maxCSVDelay, state.Capacity)
+}
+
+// checkConstraints performs the checks of lnwallet.VerifyConstraints on the
+// constraints the recipient of a proposal would be subject to, given the
+// parameters from its point of view. The constraints in place were accepted
+// when the channel was opened, possibly with an older policy, so only the
+// ones that change are checked.
+func checkConstraints(prev, params channeldb.ChannelParams,
+	maxCSVDelay uint16, capacity btcutil.Amount) error {
+
+	prevBounds, bounds := prev.LocalBounds, params.LocalBounds
+	prevDust := prev.RemoteCommitParams.DustLimit
+	dustLimit := params.RemoteCommitParams.DustLimit
+	csvDelay := params.LocalCommitParams.CsvDelay
+
+	if csvDelay != prev.LocalCommitParams.CsvDelay &&
+		csvDelay > maxCSVDelay {
+
+		return lnwallet.ErrCsvDelayTooLarge(csvDelay, maxCSVDelay)
+	}
+
+	dustChanged := dustLimit != prevDust
+	reserveChanged := bounds.ChanReserve != prevBounds.ChanReserve
+	if (dustChanged || reserveChanged) && dustLimit > bounds.ChanReserve {
+		return lnwallet.ErrChanReserveTooSmall(
+			bounds.ChanReserve, dustLimit,
+		)
+	}
+
+	maxWitnessLimit := lnwallet.DustLimitForSize(input.UnknownWitnessSize)
+	if dustChanged && (dustLimit < maxWitnessLimit ||
+		dustLimit > 3*maxWitnessLimit) {
+
+		return lnwallet.ErrInvalidDustLimit(dustLimit)
+	}
+
+	maxChanReserve := capacity / 5
+	if reserveChanged && bounds.ChanReserve > maxChanReserve {
+		return lnwallet.ErrChanReserveTooLarge(
+			bounds.ChanReserve, maxChanReserve,
+		)
+	}
+
+	htlcRangeChanged := bounds.MinHTLC != prevBounds.MinHTLC ||
+		bounds.MaxPendingAmount != prevBounds.MaxPendingAmount
+	if htlcRangeChanged && bounds.MinHTLC > bounds.MaxPendingAmount {
+		return lnwallet.ErrMinHtlcTooLarge(
+			bounds.MinHTLC, bounds.MaxPendingAmount,
+		)
+	}
+
+	const minNumHtlc = 5
+	maxHtlcs := bounds.MaxAcceptedHtlcs
+	if maxHtlcs != prevBounds.MaxAcceptedHtlcs {
+		if maxHtlcs > uint16(input.MaxHTLCNumber/2) {
+			return lnwallet.ErrMaxHtlcNumTooLarge(
+				maxHtlcs, uint16(input.MaxHTLCNumber/2),
+			)
+		}
+
+		if maxHtlcs < minNumHtlc {
+			return lnwallet.ErrMaxHtlcNumTooSmall(
+				maxHtlcs, minNumHtlc,
+			)
+		}
+	}
+
+	if htlcRangeChanged &&
+		bounds.MaxPendingAmount < minNumHtlc*bounds.MinHTLC {
+
+		return lnwallet.ErrMaxValueInFlightTooSmall(
+			bounds.MaxPendingAmount, minNumHtlc*bounds.MinHTLC,
+		)
+	}
+
+	return nil
+}
+
+// evaluateProposal returns the parameters of the channel once the given
+// proposal of the remote party is applied. If we don't accept the proposal,
+// the TLV types of the parameters we reject are returned instead.
+func evaluateProposal(env *Environment,
+	msg *lnwire.DynPropose) fn.Result[channeldb.ChannelParams] {
+
+	state := env.Channel.State()
+
+	check := func(msg *lnwire.DynPropose) (channeldb.ChannelParams,
+		error) {
+
+		params, err := proposalParams(
+			state.Params(), msg, lntypes.Remote,
+		)
+		if err != nil {
+			return params, err
+		}
+
+		return params, checkParams(env, state, params, lntypes.Remote)
+	}
+
+	params, err := check(msg)
+	if err == nil {
+		return fn.Ok(params)
+	}
+
+	// We check each parameter on its own to find the ones we reject. If
+	// the parameters are only invalid in combination, all of them are
+	// rejected.
+	singleParams := splitProposal(msg)
+	rejections := lnwire.NewRawFeatureVector()
+	for tlvType, single := range singleParams {
+		if _, err := check(single); err != nil {
+			log.Debugf("ChannelPoint(%v): rejecting proposed "+
+				"parameter with type %v: %v", env.ChanPoint,
+				tlvType, err)
+
+			rejections.Set(lnwire.FeatureBit(tlvType))
+		}
+	}
+	if rejections.IsEmpty() {
+		for tlvType := range singleParams {
+			rejections.Set(lnwire.FeatureBit(tlvType))
+		}
+	}
+
+	return fn.Err[channeldb.ChannelParams](&rejectionErr{
+		rejections: rejections,
+		err:        err,
+	})
+}
+
+// rejectionErr is the error of a proposal we reject.
+type rejectionErr struct {
+	// rejections holds the TLV types of the parameters we reject.
+	rejections *lnwire.RawFeatureVector
+
+	// err is the reason of the rejection.
+	err error
+}
+
+// Error returns the reason of the rejection.
+func (r *rejectionErr) Error() string {
+	return fmt.Sprintf("rejecting %v: %v", RejectedParams(r.rejections),
+		r.err)
+}
+
+// splitProposal returns a proposal for each of the parameters changed by the
+// given proposal, keyed by the TLV type of the parameter.
+func splitProposal(msg *lnwire.DynPropose) map[tlv.Type]*lnwire.DynPropose {
+	proposals := make(map[tlv.Type]*lnwire.DynPropose)
+	single := func(tlvType tlv.Type) *lnwire.DynPropose {
+		p := &lnwire.DynPropose{
+			ChanID: msg.ChanID,
+		}
+		proposals[tlvType] = p
+
+		return p
+	}
+
+	if msg.DustLimit.IsSome() {
+		p := single(msg.DustLimit.TlvType())
+		p.DustLimit = msg.DustLimit
+	}
+	if msg.MaxValueInFlight.IsSome() {
+		p := single(msg.MaxValueInFlight.TlvType())
+		p.MaxValueInFlight = msg.MaxValueInFlight
+	}
+	if msg.HtlcMinimum.IsSome() {
+		p := single(msg.HtlcMinimum.TlvType())
+		p.HtlcMinimum = msg.HtlcMinimum
+	}
+	if msg.ChannelReserve.IsSome() {
+		p := single(msg.ChannelReserve.TlvType())
+		p.ChannelReserve = msg.ChannelReserve
+	}
+	if msg.CsvDelay.IsSome() {
+		p := single(msg.CsvDelay.TlvType())
+		p.CsvDelay = msg.CsvDelay
+	}
+	if msg.MaxAcceptedHTLCs.IsSome() {
+		p := single(msg.MaxAcceptedHTLCs.TlvType())
+		p.MaxAcceptedHTLCs = msg.MaxAcceptedHTLCs
+	}
+	if msg.ChannelType.IsSome() {
+		p := single(msg.ChannelType.TlvType())
+		p.ChannelType = msg.ChannelType
+	}
+
+	return proposals
+}
+
+// taprootChanType returns the wire channel type of a simple taproot channel
+// that keeps the scid alias and zero conf properties of the given type.
+func taprootChanType(chanType channeldb.ChannelType) lnwire.ChannelType {
+	bits := []lnwire.FeatureBit{
+		lnwire.SimpleTaprootChannelsRequiredStaging,
+	}
+	if chanType.HasScidAliasChan() {
+		bits = append(bits, lnwire.ScidAliasRequired)
+	}
+	if chanType.HasZeroConf() {
+		bits = append(bits, lnwire.ZeroConfRequired)
+	}
+
+	return lnwire.ChannelType(*lnwire.NewRawFeatureVector(bits...))
+}
+
+// upgradedChanType returns the type of a channel of the given type once it is
+// upgraded to the given wire channel type. Only anchor channels with zero-fee
+// HTLC transactions can be upgraded, to simple taproot channels.
+func upgradedChanType(cur channeldb.ChannelType,
+	chanType lnwire.ChannelType) (channeldb.ChannelType, error) {
+
+	if !cur.HasAnchors() || !cur.ZeroHtlcTxFee() || cur.IsTaproot() ||
+		cur.HasLeaseExpiration() {
+
+		return 0, ErrUnsupportedChanType
+	}
+
+	want := taprootChanType(cur)
+	if !lnwire.RawFeatureVector(chanType).Equals(
+		(*lnwire.RawFeatureVector)(&want),
+	) {
+
+		return 0, ErrUnsupportedChanType
+	}
+
+	return cur | channeldb.SimpleTaprootFeatureBit, nil
+}
+
+// proposalDigest returns the serialized proposal that is signed by the
+// dyn_ack of the responder. Records unknown to us are not part of it.
+func proposalDigest(msg *lnwire.DynPropose) ([]byte, error) {
+	propose := *msg
+	propose.ExtraData = nil
+
+	var b bytes.Buffer
+	if err := propose.Encode(&b, 0); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// signProposal signs the given proposal with our funding key.
+func signProposal(env *Environment, msg *lnwire.DynPropose) (lnwire.Sig,
+	error) {
+
+	digest, err := proposalDigest(msg)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	keyLoc := env.Channel.State().LocalChanCfg.MultiSigKey.KeyLocator
+	sig, err := env.MsgSigner.SignMessage(keyLoc, digest, false)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromSignature(sig)
+}
+
+// verifyProposalSig returns an error if the signature doesn't sign the given
+// proposal with the given funding key.
+func verifyProposalSig(msg *lnwire.DynPropose, wireSig lnwire.Sig,
+	key *btcec.PublicKey) error {
+
+	digest, err := proposalDigest(msg)
+	if err != nil {
+		return err
+	}
+
+	sig, err := wireSig.ToSignature()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidAckSig, err)
+	}
+
+	if !sig.Verify(chainhash.HashB(digest), key) {
+		return ErrInvalidAckSig
+	}
+
+	return nil
+}
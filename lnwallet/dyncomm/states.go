@@ -0,0 +1,553 @@
+package dyncomm
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/protofsm"
+)
+
+var (
+	// ErrInvalidStateTransition is returned when we receive an unexpected
+	// event for a given state.
+	ErrInvalidStateTransition = errors.New("invalid state transition")
+
+	// ErrInvalidAckSig is returned when the signature of a dyn_ack doesn't
+	// sign the proposal it acknowledges.
+	ErrInvalidAckSig = errors.New("invalid dyn_ack signature")
+
+	// ErrProposalMismatch is returned when a dyn_commit doesn't commit to
+	// the proposal we acknowledged.
+	ErrProposalMismatch = errors.New("dyn_commit doesn't match the " +
+		"acknowledged proposal")
+
+	// ErrNoNonce is returned when the remote party doesn't send its nonce
+	// for the reanchor of a channel to a taproot channel.
+	ErrNoNonce = errors.New("no musig2 nonce received")
+
+	// ErrProposalRejected is returned when the remote party rejects our
+	// proposal.
+	ErrProposalRejected = errors.New("proposal rejected by remote party")
+
+	// ErrAborted is returned when the remote party aborts the negotiation.
+	ErrAborted = errors.New("negotiation aborted by remote party")
+)
+
+// ProtocolEvent is a special interface used to create the equivalent of a
+// sum-type, but using a "sealed" interface. Protocol events can be used as
+// input to trigger a state transition, and also as output to trigger a new set
+// of events into the very same state machine.
+type ProtocolEvent interface {
+	protocolSealed()
+}
+
+// ProtocolEvents is a special type constraint that enumerates all the possible
+// protocol events. This is used mainly as type-level documentation.
+type ProtocolEvents interface {
+	SendPropose | ProposeReceived | AckReceived | RejectReceived |
+		CommitReceived | CommitSigReceived | KickoffSigReceived |
+		KickoffConfirmed | AbortReceived | Resume
+}
+
+// SendPropose is sent by the user to propose new parameters for the channel.
+// The channel must be quiescent, with us as the initiator.
+type SendPropose struct {
+	// Request holds the parameters we want to change.
+	Request Request
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (s *SendPropose) protocolSealed() {}
+
+// ProposeReceived indicates that we received a dyn_propose from the remote
+// party, which quiesced the channel.
+type ProposeReceived struct {
+	// Propose is the proposal of the remote party.
+	Propose lnwire.DynPropose
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (p *ProposeReceived) protocolSealed() {}
+
+// AckReceived indicates that the remote party accepted our proposal.
+type AckReceived struct {
+	// Ack is the dyn_ack of the remote party.
+	Ack lnwire.DynAck
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (a *AckReceived) protocolSealed() {}
+
+// RejectReceived indicates that the remote party rejected our proposal.
+type RejectReceived struct {
+	// Reject is the dyn_reject of the remote party.
+	Reject lnwire.DynReject
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (r *RejectReceived) protocolSealed() {}
+
+// CommitReceived indicates that the remote party committed to the proposal we
+// accepted.
+type CommitReceived struct {
+	// Commit is the dyn_commit of the remote party.
+	Commit lnwire.DynCommit
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (c *CommitReceived) protocolSealed() {}
+
+// CommitSigReceived indicates that we received the remote party's signature
+// for our commitment spending the new funding output of a reanchored channel.
+type CommitSigReceived struct {
+	// CommitSig is the signature for our new commitment.
+	CommitSig lnwire.CommitSig
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (c *CommitSigReceived) protocolSealed() {}
+
+// KickoffSigReceived indicates that we received the remote party's signature
+// for the kickoff transaction of a reanchored channel.
+type KickoffSigReceived struct {
+	// KickoffSig is the signature of the remote party.
+	KickoffSig lnwire.KickoffSig
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (k *KickoffSigReceived) protocolSealed() {}
+
+// KickoffConfirmed indicates that the kickoff transaction of a reanchored
+// channel confirmed.
+type KickoffConfirmed struct {
+	// BlockHeight is the height the kickoff transaction confirmed at.
+	BlockHeight uint32
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (k *KickoffConfirmed) protocolSealed() {}
+
+// AbortReceived indicates that the remote party aborted the negotiation with a
+// tx_abort.
+type AbortReceived struct {
+	// Abort is the tx_abort of the remote party.
+	Abort lnwire.TxAbort
+}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (a *AbortReceived) protocolSealed() {}
+
+// Resume is sent once a state machine was restored after a restart, to redo
+// the actions of the state it was restored in.
+type Resume struct{}
+
+// protocolSealed indicates that this struct is a ProtocolEvent instance.
+func (r *Resume) protocolSealed() {}
+
+// ConfMapper maps the confirmation of the kickoff transaction to the
+// KickoffConfirmed event.
+func ConfMapper(conf *chainntnfs.TxConfirmation) ProtocolEvent {
+	return &KickoffConfirmed{
+		BlockHeight: conf.BlockHeight,
+	}
+}
+
+// Channel is the channel whose parameters are changed.
+type Channel interface {
+	// State returns the persistent state of the channel.
+	State() *channeldb.OpenChannel
+
+	// NewReanchor creates the kickoff transaction that reanchors the
+	// channel with the given parameters, along with the commitments of
+	// both parties spending it.
+	NewReanchor(params channeldb.ChannelParams,
+		payer lntypes.ChannelParty) (*lnwallet.Reanchor, error)
+
+	// ReanchorNonce returns our verification nonce for the commitment of
+	// a reanchor to a taproot channel.
+	ReanchorNonce() (lnwire.Musig2Nonce, error)
+
+	// SignReanchorCommitment signs the remote party's commitment of the
+	// reanchor.
+	SignReanchorCommitment(r *lnwallet.Reanchor,
+		remoteNonce fn.Option[lnwire.Musig2Nonce]) (*lnwire.CommitSig,
+		error)
+
+	// ReceiveReanchorCommitment verifies the remote party's signature
+	// for our commitment of the reanchor.
+	ReceiveReanchorCommitment(r *lnwallet.Reanchor,
+		msg *lnwire.CommitSig) error
+
+	// SignFundingInput signs the input of the given transaction that
+	// spends the current funding output of the channel.
+	SignFundingInput(tx *wire.MsgTx, inputIndex int) (lnwire.Sig, error)
+
+	// KickoffWitness returns the witness of the kickoff transaction given
+	// the signatures of both parties.
+	KickoffWitness(kickoffTx *wire.MsgTx, localSig,
+		remoteSig lnwire.Sig) (wire.TxWitness, error)
+}
+
+// A compile-time check to ensure that LightningChannel satisfies the Channel
+// interface.
+var _ Channel = (*lnwallet.LightningChannel)(nil)
+
+// Environment is a set of dependencies that a state machine may need to carry
+// out the logic for a given state transition. All fields are to be considered
+// immutable, and will be fixed for the lifetime of the state machine.
+type Environment struct {
+	// ChanPeer is the peer we change the channel parameters with.
+	ChanPeer btcec.PublicKey
+
+	// ChanPoint is the channel point of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the channel ID of the channel.
+	ChanID lnwire.ChannelID
+
+	// Scid is the short channel ID of the channel, which is used to label
+	// the kickoff transaction.
+	Scid lnwire.ShortChannelID
+
+	// BlockHeight is the current block height, which is used as the
+	// height hint of the kickoff transaction.
+	BlockHeight uint32
+
+	// MaxLocalCSVDelay is the maximum CSV delay we accept for our own
+	// funds.
+	MaxLocalCSVDelay uint16
+
+	// TaprootAllowed is true if both parties support simple taproot
+	// channels, so a channel can be upgraded to one.
+	TaprootAllowed bool
+
+	// Channel is the channel whose parameters are changed.
+	Channel Channel
+
+	// MsgSigner signs the proposals we acknowledge with the funding key of
+	// the channel.
+	MsgSigner keychain.MessageSignerRing
+}
+
+// Name returns the name of the environment. This is used to uniquely identify
+// the environment of related state machines. For this state machine, the name
+// is based on the channel point.
+func (e *Environment) Name() string {
+	return fmt.Sprintf("dyn_commit(%v)", e.ChanPoint)
+}
+
+// DynStateTransition is the StateTransition type specific to the dynamic
+// commitment state machine.
+//
+//nolint:ll
+type DynStateTransition = protofsm.StateTransition[ProtocolEvent, *Environment]
+
+// ProtocolState is our sum-type ish interface that represents the current
+// protocol state.
+type ProtocolState interface {
+	// protocolStateSealed is a special method that is used to seal the
+	// interface (only types in this package can implement it).
+	protocolStateSealed()
+
+	// IsTerminal returns true if the target state is a terminal state.
+	IsTerminal() bool
+
+	// ProcessEvent takes a protocol event, and implements a state
+	// transition for the state.
+	ProcessEvent(ProtocolEvent, *Environment) (*DynStateTransition, error)
+
+	// String returns the name of the state.
+	String() string
+}
+
+// ProtocolStates is a special type constraint that enumerates all the possible
+// protocol states.
+type ProtocolStates interface {
+	Idle | AwaitingAck | AwaitingCommit | AwaitingCommitSig |
+		AwaitingKickoffSig | AwaitingKickoffConf | Committed |
+		Rejected | Aborted
+}
+
+// Idle is the initial state of a quiescent channel. Either we send a proposal,
+// or we receive one from the remote party.
+//
+// transition:
+//   - fromState: None
+//   - toState: AwaitingAck, AwaitingCommit or Rejected
+//
+// input events:
+//   - SendPropose
+//   - ProposeReceived
+type Idle struct{}
+
+// String returns the name of the state.
+func (i *Idle) String() string {
+	return "Idle"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (i *Idle) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (i *Idle) protocolStateSealed() {}
+
+// AwaitingAck is the state of the initiator after it sent its proposal.
+//
+// transition:
+//   - fromState: Idle
+//   - toState: AwaitingCommitSig, Committed, Rejected or Aborted
+//
+// input events:
+//   - AckReceived
+//   - RejectReceived
+//   - AbortReceived
+type AwaitingAck struct {
+	// Propose is the proposal we sent.
+	Propose lnwire.DynPropose
+
+	// Params are the channel parameters once the proposal is accepted.
+	Params channeldb.ChannelParams
+}
+
+// String returns the name of the state.
+func (a *AwaitingAck) String() string {
+	return "AwaitingAck"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *AwaitingAck) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *AwaitingAck) protocolStateSealed() {}
+
+// AwaitingCommit is the state of the responder after it accepted the proposal
+// of the initiator.
+//
+// transition:
+//   - fromState: Idle
+//   - toState: AwaitingCommitSig, Committed or Aborted
+//
+// input events:
+//   - CommitReceived
+//   - AbortReceived
+type AwaitingCommit struct {
+	// Propose is the proposal we accepted.
+	Propose lnwire.DynPropose
+
+	// Params are the channel parameters once the proposal is committed.
+	Params channeldb.ChannelParams
+}
+
+// String returns the name of the state.
+func (a *AwaitingCommit) String() string {
+	return "AwaitingCommit"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *AwaitingCommit) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *AwaitingCommit) protocolStateSealed() {}
+
+// AwaitingCommitSig is the state of both parties once they committed to
+// parameters that require the channel to be reanchored. Both parties signed
+// the new commitment of the remote party, and wait for the signature of their
+// own.
+//
+// transition:
+//   - fromState: AwaitingAck or AwaitingCommit
+//   - toState: AwaitingKickoffSig or Aborted
+//
+// input events:
+//   - CommitSigReceived
+//   - AbortReceived
+type AwaitingCommitSig struct {
+	// Reanchor holds the kickoff transaction and the new commitments.
+	Reanchor *lnwallet.Reanchor
+}
+
+// String returns the name of the state.
+func (a *AwaitingCommitSig) String() string {
+	return "AwaitingCommitSig"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *AwaitingCommitSig) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *AwaitingCommitSig) protocolStateSealed() {}
+
+// AwaitingKickoffSig is the state once both new commitments are signed and
+// persisted along with the kickoff transaction, and we sent our signature for
+// the kickoff transaction. From here on, the negotiation can't be aborted
+// anymore.
+//
+// transition:
+//   - fromState: AwaitingCommitSig
+//   - toState: AwaitingKickoffConf or Committed
+//
+// input events:
+//   - KickoffSigReceived
+//   - KickoffConfirmed
+//   - Resume
+type AwaitingKickoffSig struct {
+	// KickoffTx is the unsigned kickoff transaction.
+	KickoffTx *wire.MsgTx
+}
+
+// String returns the name of the state.
+func (a *AwaitingKickoffSig) String() string {
+	return "AwaitingKickoffSig"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *AwaitingKickoffSig) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *AwaitingKickoffSig) protocolStateSealed() {}
+
+// AwaitingKickoffConf is the state once the kickoff transaction was signed by
+// both parties and broadcast.
+//
+// transition:
+//   - fromState: AwaitingKickoffSig
+//   - toState: Committed
+//
+// input events:
+//   - KickoffConfirmed
+//   - KickoffSigReceived
+//   - Resume
+type AwaitingKickoffConf struct {
+	// KickoffTx is the fully signed kickoff transaction.
+	KickoffTx *wire.MsgTx
+}
+
+// String returns the name of the state.
+func (a *AwaitingKickoffConf) String() string {
+	return "AwaitingKickoffConf"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *AwaitingKickoffConf) IsTerminal() bool {
+	return false
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *AwaitingKickoffConf) protocolStateSealed() {}
+
+// Committed is the terminal state once the new channel parameters are in
+// effect.
+type Committed struct {
+	// Params are the new parameters of the channel.
+	Params channeldb.ChannelParams
+
+	// KickoffTxid is the txid of the kickoff transaction if the channel
+	// was reanchored.
+	KickoffTxid fn.Option[chainhash.Hash]
+}
+
+// String returns the name of the state.
+func (c *Committed) String() string {
+	return "Committed"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (c *Committed) IsTerminal() bool {
+	return true
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (c *Committed) protocolStateSealed() {}
+
+// Rejected is the terminal state once a proposal was rejected, either by us or
+// by the remote party.
+type Rejected struct {
+	// Rejections holds the TLV types of the rejected parameters.
+	Rejections lnwire.RawFeatureVector
+
+	// RejectedBy is the party that rejected the proposal.
+	RejectedBy lntypes.ChannelParty
+}
+
+// String returns the name of the state.
+func (r *Rejected) String() string {
+	return "Rejected"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (r *Rejected) IsTerminal() bool {
+	return true
+}
+
+// Err returns the error that describes the rejection.
+func (r *Rejected) Err() error {
+	return fmt.Errorf("%w: %v", ErrProposalRejected,
+		RejectedParams(&r.Rejections))
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (r *Rejected) protocolStateSealed() {}
+
+// Aborted is the terminal state once the remote party aborted the negotiation
+// before the channel was reanchored.
+type Aborted struct {
+	// Reason is the reason the remote party gave.
+	Reason string
+}
+
+// String returns the name of the state.
+func (a *Aborted) String() string {
+	return "Aborted"
+}
+
+// IsTerminal returns true if the target state is a terminal state.
+func (a *Aborted) IsTerminal() bool {
+	return true
+}
+
+// Err returns the error that describes the abort.
+func (a *Aborted) Err() error {
+	return fmt.Errorf("%w: %v", ErrAborted, a.Reason)
+}
+
+// protocolStateSealed indicates that this struct is a ProtocolState instance.
+func (a *Aborted) protocolStateSealed() {}
+
+// DynCommitter is a state machine that changes the parameters of a channel
+// with a dynamic commitment.
+type DynCommitter = protofsm.StateMachine[ProtocolEvent, *Environment]
+
+// DynCommitterCfg is a configuration struct that is used to initialize a new
+// DynCommitter.
+type DynCommitterCfg = protofsm.StateMachineCfg[ProtocolEvent, *Environment]
+
+// DynMsgMapperT is a type used to map incoming wire messages to protocol
+// events.
+type DynMsgMapperT = protofsm.MsgMapper[ProtocolEvent]
+
+// DynState is a type alias for the state of the DynCommitter.
+type DynState = protofsm.State[ProtocolEvent, *Environment]
+
+// DynStateSub is a type alias for the state subscription type of the
+// DynCommitter.
+type DynStateSub = protofsm.StateSubscriber[ProtocolEvent, *Environment]
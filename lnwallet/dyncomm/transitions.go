@@ -0,0 +1,605 @@
+package dyncomm
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/protofsm"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// sendMsgs returns the daemon event that sends the given messages to the
+// remote party.
+func sendMsgs(env *Environment,
+	msgs ...lnwire.Message) protofsm.DaemonEventSet {
+
+	return protofsm.DaemonEventSet{&protofsm.SendMsgEvent[ProtocolEvent]{
+		TargetPeer: env.ChanPeer,
+		Msgs:       msgs,
+	}}
+}
+
+// withEvents returns a transition to the given state that emits the given
+// daemon events.
+func withEvents(state ProtocolState,
+	events protofsm.DaemonEventSet) *DynStateTransition {
+
+	return &DynStateTransition{
+		NextState: state,
+		NewEvents: fn.Some(protofsm.EmittedEvent[ProtocolEvent]{
+			ExternalEvents: events,
+		}),
+	}
+}
+
+// abortedTransition returns the transition to the Aborted state once the
+// remote party aborted the negotiation. Just like the remote party, we send a
+// tx_abort to acknowledge it.
+func abortedTransition(env *Environment,
+	msg *lnwire.TxAbort) *DynStateTransition {
+
+	log.Infof("ChannelPoint(%v): remote party aborted dynamic commitment: "+
+		"%s", env.ChanPoint, msg.Data)
+
+	abort := &lnwire.TxAbort{
+		ChannelID: env.ChanID,
+		Data:      []byte("dynamic commitment aborted"),
+	}
+
+	return withEvents(
+		&Aborted{Reason: string(msg.Data)}, sendMsgs(env, abort),
+	)
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. From this state, we either send our proposal, or we respond to
+// the proposal of the remote party.
+func (i *Idle) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *SendPropose:
+		propose, params, err := NewProposal(env, msg.Request)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("ChannelPoint(%v): proposing new channel parameters",
+			env.ChanPoint)
+
+		return withEvents(&AwaitingAck{
+			Propose: *propose,
+			Params:  params,
+		}, sendMsgs(env, propose)), nil
+
+	case *ProposeReceived:
+		propose := &msg.Propose
+
+		params, err := evaluateProposal(env, propose).Unpack()
+		var rejectErr *rejectionErr
+		if errors.As(err, &rejectErr) {
+			log.Infof("ChannelPoint(%v): %v", env.ChanPoint, err)
+
+			reject := &lnwire.DynReject{
+				ChanID:           env.ChanID,
+				UpdateRejections: *rejectErr.rejections,
+			}
+
+			return withEvents(&Rejected{
+				Rejections: *rejectErr.rejections,
+				RejectedBy: lntypes.Local,
+			}, sendMsgs(env, reject)), nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := signProposal(env, propose)
+		if err != nil {
+			return nil, err
+		}
+
+		ack := &lnwire.DynAck{
+			ChanID: env.ChanID,
+			Sig:    sig,
+		}
+
+		// If the channel is upgraded to a taproot channel, the
+		// initiator needs our nonce to sign our new commitment.
+		state := env.Channel.State()
+		if params.RequiresReanchor(state.Params()) &&
+			params.ChanType.IsTaproot() {
+
+			nonce, err := env.Channel.ReanchorNonce()
+			if err != nil {
+				return nil, err
+			}
+
+			ack.LocalNonce = tlv.SomeRecordT(
+				tlv.NewRecordT[tlv.TlvType14](nonce),
+			)
+		}
+
+		log.Infof("ChannelPoint(%v): accepting new channel parameters",
+			env.ChanPoint)
+
+		return withEvents(&AwaitingCommit{
+			Propose: *propose,
+			Params:  params,
+		}, sendMsgs(env, ack)), nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in Idle",
+			ErrInvalidStateTransition, msg)
+	}
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. Once the remote party accepted our proposal, we commit to it. If
+// the channel needs to be reanchored, we sign the new commitment of the remote
+// party along with it.
+func (a *AwaitingAck) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *AckReceived:
+		state := env.Channel.State()
+		err := verifyProposalSig(
+			&a.Propose, msg.Ack.Sig,
+			state.RemoteChanCfg.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		commit := &lnwire.DynCommit{
+			DynPropose: a.Propose,
+			DynAck: lnwire.DynAck{
+				ChanID: env.ChanID,
+				Sig:    msg.Ack.Sig,
+			},
+		}
+
+		if !a.Params.RequiresReanchor(state.Params()) {
+			err := updateBounds(env, a.Params)
+			if err != nil {
+				return nil, err
+			}
+
+			return withEvents(&Committed{
+				Params: a.Params,
+			}, sendMsgs(env, commit)), nil
+		}
+
+		reanchor, err := env.Channel.NewReanchor(
+			a.Params, lntypes.Local,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteNonce := fn.None[lnwire.Musig2Nonce]()
+		if a.Params.ChanType.IsTaproot() {
+			nonce, err := msg.Ack.LocalNonce.UnwrapOrErrV(
+				ErrNoNonce,
+			)
+			if err != nil {
+				return nil, err
+			}
+			remoteNonce = fn.Some(nonce)
+
+			localNonce, err := env.Channel.ReanchorNonce()
+			if err != nil {
+				return nil, err
+			}
+			commit.LocalNonce = tlv.SomeRecordT(
+				tlv.NewRecordT[tlv.TlvType14](localNonce),
+			)
+		}
+
+		commitSig, err := env.Channel.SignReanchorCommitment(
+			reanchor, remoteNonce,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("ChannelPoint(%v): reanchoring channel with kickoff "+
+			"tx %v", env.ChanPoint, reanchor.KickoffTx.TxHash())
+
+		return withEvents(&AwaitingCommitSig{
+			Reanchor: reanchor,
+		}, sendMsgs(env, commit, commitSig)), nil
+
+	case *RejectReceived:
+		rejections := msg.Reject.UpdateRejections
+
+		log.Infof("ChannelPoint(%v): remote party rejected %v",
+			env.ChanPoint, RejectedParams(&rejections))
+
+		return &DynStateTransition{
+			NextState: &Rejected{
+				Rejections: rejections,
+				RejectedBy: lntypes.Remote,
+			},
+		}, nil
+
+	case *AbortReceived:
+		return abortedTransition(env, &msg.Abort), nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in AwaitingAck",
+			ErrInvalidStateTransition, msg)
+	}
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. Once the initiator committed to the proposal we accepted, the new
+// parameters take effect, or we sign the new commitment of the initiator if
+// the channel needs to be reanchored.
+func (a *AwaitingCommit) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *CommitReceived:
+		commit := &msg.Commit
+
+		// The dyn_commit must carry our signature for the proposal
+		// we accepted.
+		acked, err := proposalDigest(&a.Propose)
+		if err != nil {
+			return nil, err
+		}
+		committed, err := proposalDigest(&commit.DynPropose)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(acked, committed) {
+			return nil, ErrProposalMismatch
+		}
+
+		state := env.Channel.State()
+		err = verifyProposalSig(
+			&commit.DynPropose, commit.Sig,
+			state.LocalChanCfg.MultiSigKey.PubKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if !a.Params.RequiresReanchor(state.Params()) {
+			if err := updateBounds(env, a.Params); err != nil {
+				return nil, err
+			}
+
+			return &DynStateTransition{
+				NextState: &Committed{
+					Params: a.Params,
+				},
+			}, nil
+		}
+
+		reanchor, err := env.Channel.NewReanchor(
+			a.Params, lntypes.Remote,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		remoteNonce := fn.None[lnwire.Musig2Nonce]()
+		if a.Params.ChanType.IsTaproot() {
+			nonce, err := commit.LocalNonce.UnwrapOrErrV(ErrNoNonce)
+			if err != nil {
+				return nil, err
+			}
+			remoteNonce = fn.Some(nonce)
+		}
+
+		commitSig, err := env.Channel.SignReanchorCommitment(
+			reanchor, remoteNonce,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Infof("ChannelPoint(%v): reanchoring channel with kickoff "+
+			"tx %v", env.ChanPoint, reanchor.KickoffTx.TxHash())
+
+		return withEvents(&AwaitingCommitSig{
+			Reanchor: reanchor,
+		}, sendMsgs(env, commitSig)), nil
+
+	case *AbortReceived:
+		return abortedTransition(env, &msg.Abort), nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in "+
+			"AwaitingCommit", ErrInvalidStateTransition, msg)
+	}
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. Once we have the signature for our new commitment, we persist the
+// reanchor and sign the kickoff transaction.
+func (a *AwaitingCommitSig) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *CommitSigReceived:
+		err := env.Channel.ReceiveReanchorCommitment(
+			a.Reanchor, &msg.CommitSig,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// We persist the new commitments before we sign the kickoff
+		// transaction, so we're able to close the channel once it
+		// confirms.
+		kickoffTx := a.Reanchor.KickoffTx
+		pending := a.Reanchor.Commitments.PendingSplice(
+			kickoffTx, env.BlockHeight,
+		)
+		err = env.Channel.State().StorePendingSplice(pending)
+		if err != nil {
+			return nil, err
+		}
+
+		events, err := kickoffSigEvents(env, kickoffTx)
+		if err != nil {
+			return nil, err
+		}
+
+		return withEvents(&AwaitingKickoffSig{
+			KickoffTx: kickoffTx,
+		}, events), nil
+
+	case *AbortReceived:
+		return abortedTransition(env, &msg.Abort), nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in "+
+			"AwaitingCommitSig", ErrInvalidStateTransition, msg)
+	}
+}
+
+// kickoffSigEvents returns the daemon events that send our signature for the
+// kickoff transaction, and register for its confirmation. The remote party
+// may broadcast the kickoff transaction without handing us its signature, so
+// we watch for it from here on.
+func kickoffSigEvents(env *Environment,
+	kickoffTx *wire.MsgTx) (protofsm.DaemonEventSet, error) {
+
+	sig, err := env.Channel.SignFundingInput(kickoffTx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	events := sendMsgs(env, &lnwire.KickoffSig{
+		ChanID:    env.ChanID,
+		Signature: sig,
+	})
+
+	return append(events, registerKickoffConf(env, kickoffTx)), nil
+}
+
+// registerKickoffConf returns the daemon event that registers for the
+// confirmation of the kickoff transaction.
+func registerKickoffConf(env *Environment,
+	kickoffTx *wire.MsgTx) protofsm.DaemonEvent {
+
+	numConfs := max(uint32(env.Channel.State().NumConfsRequired), 1)
+
+	return &protofsm.RegisterConf[ProtocolEvent]{
+		Txid:       kickoffTx.TxHash(),
+		PkScript:   kickoffTx.TxOut[0].PkScript,
+		HeightHint: env.BlockHeight,
+		NumConfs:   fn.Some(numConfs),
+		PostConfMapper: fn.Some[protofsm.ConfMapper[ProtocolEvent]](
+			ConfMapper,
+		),
+	}
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. Once we have the signature of the remote party, we broadcast the
+// kickoff transaction.
+func (a *AwaitingKickoffSig) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *KickoffSigReceived:
+		localSig, err := env.Channel.SignFundingInput(a.KickoffTx, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		witness, err := env.Channel.KickoffWitness(
+			a.KickoffTx, localSig, msg.KickoffSig.Signature,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		kickoffTx := a.KickoffTx.Copy()
+		kickoffTx.TxIn[0].Witness = witness
+
+		// We store the signed kickoff transaction, so we're able to
+		// rebroadcast it after a restart.
+		state := env.Channel.State()
+		pending, err := state.PendingSplice()
+		if err != nil {
+			return nil, err
+		}
+		pending.SpliceTx = kickoffTx
+		if err := state.StorePendingSplice(pending); err != nil {
+			return nil, err
+		}
+
+		events := protofsm.DaemonEventSet{
+			broadcastKickoff(env, kickoffTx),
+		}
+
+		return withEvents(&AwaitingKickoffConf{
+			KickoffTx: kickoffTx,
+		}, events), nil
+
+	case *KickoffConfirmed:
+		return confirmedTransition(env, a.KickoffTx)
+
+	// After a restart, we send our signature again, as the remote party
+	// may not have received it.
+	case *Resume:
+		events, err := kickoffSigEvents(env, a.KickoffTx)
+		if err != nil {
+			return nil, err
+		}
+
+		return withEvents(a, events), nil
+
+	// The reanchor can't be aborted anymore, as the remote party may
+	// broadcast the kickoff transaction at any time.
+	case *AbortReceived:
+		log.Warnf("ChannelPoint(%v): ignoring tx_abort of signed "+
+			"reanchor", env.ChanPoint)
+
+		return &DynStateTransition{NextState: a}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in "+
+			"AwaitingKickoffSig", ErrInvalidStateTransition, msg)
+	}
+}
+
+// broadcastKickoff returns the daemon event that broadcasts the kickoff
+// transaction.
+func broadcastKickoff(env *Environment,
+	kickoffTx *wire.MsgTx) protofsm.DaemonEvent {
+
+	log.Infof("ChannelPoint(%v): broadcasting kickoff tx %v",
+		env.ChanPoint, kickoffTx.TxHash())
+
+	return &protofsm.BroadcastTxn{
+		Tx: kickoffTx,
+		Label: labels.MakeLabel(
+			labels.LabelTypeKickoffTransaction, &env.Scid,
+		),
+	}
+}
+
+// confirmedTransition applies the new parameters of the channel once the
+// kickoff transaction confirmed.
+func confirmedTransition(env *Environment,
+	kickoffTx *wire.MsgTx) (*DynStateTransition, error) {
+
+	// The chain watcher may have applied the reanchor already, in which
+	// case this is a no-op.
+	state := env.Channel.State()
+	if err := state.ApplySplice(); err != nil {
+		return nil, err
+	}
+
+	log.Infof("ChannelPoint(%v): kickoff tx %v confirmed, new channel "+
+		"parameters are in effect", env.ChanPoint, kickoffTx.TxHash())
+
+	return &DynStateTransition{
+		NextState: &Committed{
+			Params:      state.Params(),
+			KickoffTxid: fn.Some(kickoffTx.TxHash()),
+		},
+	}, nil
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. We wait for the kickoff transaction to confirm, after which the
+// new parameters take effect.
+func (a *AwaitingKickoffConf) ProcessEvent(event ProtocolEvent,
+	env *Environment) (*DynStateTransition, error) {
+
+	switch msg := event.(type) {
+	case *KickoffConfirmed:
+		return confirmedTransition(env, a.KickoffTx)
+
+	// After a restart, we broadcast the kickoff transaction again.
+	case *Resume:
+		return withEvents(a, protofsm.DaemonEventSet{
+			broadcastKickoff(env, a.KickoffTx),
+			registerKickoffConf(env, a.KickoffTx),
+		}), nil
+
+	// The remote party sends its signature again after a restart. We
+	// broadcast the kickoff transaction already, so there's nothing left
+	// to do.
+	case *KickoffSigReceived, *AbortReceived:
+		return &DynStateTransition{NextState: a}, nil
+
+	default:
+		return nil, fmt.Errorf("%w: received %T while in "+
+			"AwaitingKickoffConf", ErrInvalidStateTransition, msg)
+	}
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. This is a terminal state, so no events are expected.
+func (c *Committed) ProcessEvent(event ProtocolEvent,
+	_ *Environment) (*DynStateTransition, error) {
+
+	return nil, fmt.Errorf("%w: received %T while in Committed",
+		ErrInvalidStateTransition, event)
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. This is a terminal state, so no events are expected.
+func (r *Rejected) ProcessEvent(event ProtocolEvent,
+	_ *Environment) (*DynStateTransition, error) {
+
+	return nil, fmt.Errorf("%w: received %T while in Rejected",
+		ErrInvalidStateTransition, event)
+}
+
+// ProcessEvent takes a protocol event, and implements a state transition for
+// the state. This is a terminal state, so no events are expected.
+func (a *Aborted) ProcessEvent(event ProtocolEvent,
+	_ *Environment) (*DynStateTransition, error) {
+
+	return nil, fmt.Errorf("%w: received %T while in Aborted",
+		ErrInvalidStateTransition, event)
+}
+
+// updateBounds persists the new bounds of the channel, which don't require it
+// to be reanchored.
+func updateBounds(env *Environment, params channeldb.ChannelParams) error {
+	log.Infof("ChannelPoint(%v): updating channel bounds", env.ChanPoint)
+
+	return env.Channel.State().UpdateChanStateBounds(
+		params.LocalBounds, params.RemoteBounds,
+	)
+}
+
+// ResumeState returns the state of a reanchor that was persisted before a
+// restart, given the pending splice that holds its kickoff transaction. The
+// Resume event must be sent once the state machine is started.
+func ResumeState(pending *channeldb.PendingSplice) (DynState, error) {
+	if pending.Params.IsNone() {
+		return nil, errors.New("pending splice isn't a reanchor")
+	}
+
+	kickoffTx := pending.SpliceTx
+	if len(kickoffTx.TxIn) != 1 || len(kickoffTx.TxOut) != 1 {
+		return nil, fmt.Errorf("invalid kickoff tx %v",
+			kickoffTx.TxHash())
+	}
+
+	if len(kickoffTx.TxIn[0].Witness) == 0 {
+		return &AwaitingKickoffSig{KickoffTx: kickoffTx}, nil
+	}
+
+	return &AwaitingKickoffConf{KickoffTx: kickoffTx}, nil
+}
@@ -0,0 +1,506 @@
+package dyncomm
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntest/mock"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/lightningnetwork/lnd/protofsm"
+	"github.com/stretchr/testify/require"
+)
+
+// testParty is one of the two parties changing the parameters of a channel.
+// The state transitions of the party are driven directly, without a state
+// machine executing the daemon events.
+type testParty struct {
+	t *testing.T
+
+	env   *Environment
+	state DynState
+
+	// outbox holds the messages sent to the other party.
+	outbox []lnwire.Message
+
+	// broadcasts holds the transactions broadcast by the party.
+	broadcasts []*wire.MsgTx
+
+	// confRegistrations holds the txids the party registered for the
+	// confirmation of.
+	confRegistrations []chainhash.Hash
+}
+
+// newTestParty creates a test party for the given channel.
+func newTestParty(t *testing.T, channel *lnwallet.LightningChannel,
+	taproot bool) *testParty {
+
+	state := channel.State()
+
+	// The mock signer of the test channel holds the private key of the
+	// funding key, which the proposals are signed with.
+	signer, ok := channel.Signer.(*input.MockSigner)
+	require.True(t, ok)
+
+	multiSigKey := state.LocalChanCfg.MultiSigKey.PubKey
+	keyRing := &mock.SecretKeyRing{}
+	for _, privKey := range signer.Privkeys {
+		if privKey.PubKey().IsEqual(multiSigKey) {
+			keyRing.RootKey = privKey
+		}
+	}
+	require.NotNil(t, keyRing.RootKey)
+
+	chanPoint := state.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	return &testParty{
+		t: t,
+		env: &Environment{
+			ChanPeer:         *state.IdentityPub,
+			ChanPoint:        chanPoint,
+			ChanID:           chanID,
+			Scid:             state.ShortChannelID,
+			BlockHeight:      100,
+			MaxLocalCSVDelay: 1_000,
+			TaprootAllowed:   taproot,
+			Channel:          channel,
+			MsgSigner:        keyRing,
+		},
+		state: &Idle{},
+	}
+}
+
+// process processes the given event, and records the daemon events emitted by
+// the transition.
+func (p *testParty) process(event ProtocolEvent) error {
+	transition, err := p.state.ProcessEvent(event, p.env)
+	if err != nil {
+		return err
+	}
+
+	p.state = transition.NextState
+
+	transition.NewEvents.WhenSome(
+		func(events protofsm.EmittedEvent[ProtocolEvent]) {
+			for _, event := range events.ExternalEvents {
+				p.recordEvent(event)
+			}
+		},
+	)
+
+	return nil
+}
+
+// recordEvent records the given daemon event.
+func (p *testParty) recordEvent(event protofsm.DaemonEvent) {
+	switch e := event.(type) {
+	case *protofsm.SendMsgEvent[ProtocolEvent]:
+		p.outbox = append(p.outbox, e.Msgs...)
+
+	case *protofsm.BroadcastTxn:
+		p.broadcasts = append(p.broadcasts, e.Tx)
+
+	case *protofsm.RegisterConf[ProtocolEvent]:
+		p.confRegistrations = append(p.confRegistrations, e.Txid)
+
+	default:
+		p.t.Fatalf("unexpected daemon event %T", e)
+	}
+}
+
+// deliverOne delivers the next message of the sender to the receiver.
+func deliverOne(t *testing.T, sender, receiver *testParty) {
+	t.Helper()
+
+	msg := sender.outbox[0]
+	sender.outbox = sender.outbox[1:]
+
+	mapper := NewMsgMapper(receiver.env.ChanID, receiver.env.ChanPeer)
+	event := mapper.MapMsg(msgmux.PeerMsg{
+		PeerPub: receiver.env.ChanPeer,
+		Message: msg,
+	})
+	require.Truef(t, event.IsSome(), "unmapped message %T", msg)
+
+	require.NoError(t, receiver.process(event.UnsafeFromSome()))
+}
+
+// deliver delivers the messages of both parties to each other, until neither
+// party has any messages left to send.
+func deliver(t *testing.T, alice, bob *testParty) {
+	t.Helper()
+
+	for len(alice.outbox) > 0 || len(bob.outbox) > 0 {
+		for len(alice.outbox) > 0 {
+			deliverOne(t, alice, bob)
+		}
+		for len(bob.outbox) > 0 {
+			deliverOne(t, bob, alice)
+		}
+	}
+}
+
+// newTestParties creates the test parties of an anchor channel, where Bob
+// proposes the new parameters.
+func newTestParties(t *testing.T, taproot bool) (*testParty, *testParty) {
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, chanType,
+	)
+	require.NoError(t, err)
+
+	return newTestParty(t, aliceChannel, taproot),
+		newTestParty(t, bobChannel, taproot)
+}
+
+// assertState asserts that the party is in the given state type.
+func assertState[T DynState](t *testing.T, p *testParty) T {
+	t.Helper()
+
+	state, ok := p.state.(T)
+	require.Truef(t, ok, "expected %T, got %T", *new(T), p.state)
+
+	return state
+}
+
+// assertParams asserts that both parties agree on the persisted parameters
+// of the channel.
+func assertParams(t *testing.T, alice, bob *testParty,
+	params channeldb.ChannelParams) {
+
+	t.Helper()
+
+	for _, p := range []*testParty{alice, bob} {
+		state := p.env.Channel.State()
+		dbChannel, err := state.Db.FetchChannel(state.FundingOutpoint)
+		require.NoError(t, err)
+
+		expected := params
+		if p == alice {
+			expected = params.Swapped()
+		}
+		require.Equal(t, expected, state.Params())
+		require.Equal(t, expected, dbChannel.Params())
+	}
+}
+
+// TestDynCommitBounds tests that parameters that don't affect the commitment
+// scripts are changed in place.
+func TestDynCommitBounds(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestParties(t, false)
+
+	req := Request{
+		MaxValueInFlight: fn.Some(lnwire.MilliSatoshi(100_000_000)),
+		HtlcMinimum:      fn.Some(lnwire.MilliSatoshi(1_000)),
+		MaxAcceptedHTLCs: fn.Some(uint16(100)),
+	}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+	awaitingAck := assertState[*AwaitingAck](t, bob)
+
+	deliver(t, bob, alice)
+
+	bobState := assertState[*Committed](t, bob)
+	aliceState := assertState[*Committed](t, alice)
+	require.True(t, bobState.KickoffTxid.IsNone())
+	require.Equal(t, bobState.Params, aliceState.Params.Swapped())
+	require.Equal(t, awaitingAck.Params, bobState.Params)
+
+	// The new limits apply to the HTLCs offered by Alice.
+	bounds := bobState.Params.RemoteBounds
+	require.EqualValues(t, 100_000_000, bounds.MaxPendingAmount)
+	require.EqualValues(t, 1_000, bounds.MinHTLC)
+	require.EqualValues(t, 100, bounds.MaxAcceptedHtlcs)
+
+	assertParams(t, alice, bob, bobState.Params)
+}
+
+// TestDynCommitReanchor tests that the channel is reanchored to change
+// parameters that affect the commitment scripts, including an upgrade to a
+// taproot channel.
+func TestDynCommitReanchor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		taproot bool
+	}{
+		{
+			name: "csv delay",
+		},
+		{
+			name:    "taproot upgrade",
+			taproot: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testDynCommitReanchor(t, tc.taproot)
+		})
+	}
+}
+
+func testDynCommitReanchor(t *testing.T, taproot bool) {
+	alice, bob := newTestParties(t, taproot)
+	prevParams := bob.env.Channel.State().Params()
+
+	req := Request{
+		CsvDelay:         fn.Some(uint16(500)),
+		DustLimit:        fn.Some(btcutil.Amount(1_000)),
+		UpgradeToTaproot: taproot,
+	}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+	params := assertState[*AwaitingAck](t, bob).Params
+	require.True(t, params.RequiresReanchor(prevParams))
+	require.Equal(t, taproot, params.ChanType.IsTaproot())
+
+	// Once the commitments are exchanged, both parties persisted the
+	// reanchor, and sent their signature for the kickoff transaction.
+	// Delivering it leads both parties to broadcast the kickoff tx.
+	deliver(t, bob, alice)
+
+	aliceConf := assertState[*AwaitingKickoffConf](t, alice)
+	bobConf := assertState[*AwaitingKickoffConf](t, bob)
+	kickoffTx := bobConf.KickoffTx
+	require.Equal(t, kickoffTx.TxHash(), aliceConf.KickoffTx.TxHash())
+	require.NotEmpty(t, kickoffTx.TxIn[0].Witness)
+	require.Len(t, alice.broadcasts, 1)
+	require.Len(t, bob.broadcasts, 1)
+	require.Len(t, alice.confRegistrations, 1)
+	require.Len(t, bob.confRegistrations, 1)
+
+	require.Equal(t, []chainhash.Hash{kickoffTx.TxHash()},
+		alice.confRegistrations)
+	require.Equal(t, []chainhash.Hash{kickoffTx.TxHash()},
+		bob.confRegistrations)
+
+	// The signed kickoff transaction is persisted along with the new
+	// parameters, so it's rebroadcast after a restart.
+	for _, p := range []*testParty{alice, bob} {
+		pending, err := p.env.Channel.State().PendingSplice()
+		require.NoError(t, err)
+		require.Equal(t, kickoffTx.TxHash(), pending.SpliceTx.TxHash())
+		require.Len(t, pending.SpliceTx.TxIn[0].Witness, 4)
+
+		expected := params
+		if p == alice {
+			expected = params.Swapped()
+		}
+		require.Equal(t, fn.Some(expected), pending.Params)
+
+		resumed, err := ResumeState(pending)
+		require.NoError(t, err)
+		require.IsType(t, &AwaitingKickoffConf{}, resumed)
+
+		require.NoError(t, p.process(&Resume{}))
+		require.Len(t, p.broadcasts, 2)
+		require.Len(t, p.confRegistrations, 2)
+	}
+
+	// Once the kickoff transaction confirms, the new parameters are in
+	// effect.
+	for _, p := range []*testParty{alice, bob} {
+		err := p.process(&KickoffConfirmed{BlockHeight: 101})
+		require.NoError(t, err)
+
+		committed := assertState[*Committed](t, p)
+		require.Equal(t, fn.Some(kickoffTx.TxHash()),
+			committed.KickoffTxid)
+	}
+
+	assertParams(t, alice, bob, params)
+	require.EqualValues(t, 500, params.RemoteCommitParams.CsvDelay)
+	require.EqualValues(t, 1_000, params.LocalCommitParams.DustLimit)
+}
+
+// TestDynCommitReanchorResume tests that a party that hasn't received the
+// kickoff signature of the remote party sends its own signature again after a
+// restart.
+func TestDynCommitReanchorResume(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestParties(t, false)
+
+	req := Request{CsvDelay: fn.Some(uint16(500))}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+
+	// Bob sends his proposal, which Alice acknowledges.
+	deliverOne(t, bob, alice)
+	deliverOne(t, alice, bob)
+
+	// Bob sends his dyn_commit and the signature of Alice's commitment,
+	// after which Alice sends the signature of Bob's commitment.
+	deliverOne(t, bob, alice)
+	deliverOne(t, bob, alice)
+	deliverOne(t, alice, bob)
+
+	// Both parties persisted the reanchor, but the connection is lost
+	// before their kickoff signatures are delivered.
+	assertState[*AwaitingKickoffSig](t, alice)
+	assertState[*AwaitingKickoffSig](t, bob)
+	require.Len(t, alice.outbox, 1)
+	require.Len(t, bob.outbox, 1)
+	alice.outbox = nil
+	bob.outbox = nil
+
+	for _, p := range []*testParty{alice, bob} {
+		pending, err := p.env.Channel.State().PendingSplice()
+		require.NoError(t, err)
+
+		resumed, err := ResumeState(pending)
+		require.NoError(t, err)
+		require.IsType(t, &AwaitingKickoffSig{}, resumed)
+
+		p.state = resumed
+		require.NoError(t, p.process(&Resume{}))
+	}
+
+	// Once the signatures are exchanged, both parties broadcast the kickoff
+	// transaction. The signature of the remote party received after
+	// broadcasting it is ignored.
+	deliver(t, alice, bob)
+	assertState[*AwaitingKickoffConf](t, alice)
+	assertState[*AwaitingKickoffConf](t, bob)
+	require.Len(t, alice.broadcasts, 1)
+	require.Len(t, bob.broadcasts, 1)
+
+	sig := &lnwire.KickoffSig{ChanID: alice.env.ChanID}
+	require.NoError(t, alice.process(&KickoffSigReceived{
+		KickoffSig: *sig,
+	}))
+	assertState[*AwaitingKickoffConf](t, alice)
+}
+
+// TestDynCommitKickoffConfirmedEarly tests that the new parameters take effect
+// if the kickoff transaction broadcast by the remote party confirms before we
+// received its signature.
+func TestDynCommitKickoffConfirmedEarly(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestParties(t, false)
+
+	req := Request{CsvDelay: fn.Some(uint16(500))}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+	params := assertState[*AwaitingAck](t, bob).Params
+
+	deliverOne(t, bob, alice)
+	deliverOne(t, alice, bob)
+	deliverOne(t, bob, alice)
+	deliverOne(t, bob, alice)
+	deliverOne(t, alice, bob)
+
+	// Alice receives Bob's kickoff signature and broadcasts the kickoff
+	// transaction, while Bob never receives hers.
+	deliverOne(t, bob, alice)
+	kickoffTx := assertState[*AwaitingKickoffConf](t, alice).KickoffTx
+
+	for _, p := range []*testParty{alice, bob} {
+		err := p.process(&KickoffConfirmed{BlockHeight: 101})
+		require.NoError(t, err)
+
+		committed := assertState[*Committed](t, p)
+		require.Equal(
+			t, fn.Some(kickoffTx.TxHash()), committed.KickoffTxid,
+		)
+	}
+
+	assertParams(t, alice, bob, params)
+}
+
+// TestDynCommitReject tests that the parameters we don't accept are rejected.
+func TestDynCommitReject(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestParties(t, false)
+	prevParams := bob.env.Channel.State().Params()
+
+	// Alice doesn't wait for her funds that long, while she accepts the
+	// new number of HTLCs.
+	req := Request{
+		CsvDelay:         fn.Some(uint16(2_000)),
+		MaxAcceptedHTLCs: fn.Some(uint16(100)),
+	}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+
+	deliver(t, bob, alice)
+
+	aliceState := assertState[*Rejected](t, alice)
+	bobState := assertState[*Rejected](t, bob)
+	require.Equal(t, lntypes.Local, aliceState.RejectedBy)
+	require.Equal(t, lntypes.Remote, bobState.RejectedBy)
+
+	expected := lnwire.NewRawFeatureVector(lnwire.FeatureBit(8))
+	require.Equal(t, *expected, bobState.Rejections)
+	require.ErrorIs(t, bobState.Err(), ErrProposalRejected)
+	require.ErrorContains(t, bobState.Err(), "csv_delay")
+
+	// The parameters of the channel are unchanged.
+	assertParams(t, alice, bob, prevParams)
+}
+
+// TestDynCommitInvalidProposal tests that we don't send proposals we consider
+// invalid ourselves.
+func TestDynCommitInvalidProposal(t *testing.T) {
+	t.Parallel()
+
+	_, bob := newTestParties(t, false)
+
+	testCases := []struct {
+		name string
+		req  Request
+		err  error
+	}{
+		{
+			name: "empty proposal",
+			err:  ErrEmptyProposal,
+		},
+		{
+			name: "taproot not negotiated",
+			req:  Request{UpgradeToTaproot: true},
+			err:  ErrTaprootNotNegotiated,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := NewProposal(bob.env, tc.req)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+// TestDynCommitInvalidAck tests that an acknowledgement that isn't signed by
+// the remote party is rejected.
+func TestDynCommitInvalidAck(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestParties(t, false)
+
+	req := Request{MaxAcceptedHTLCs: fn.Some(uint16(100))}
+	require.NoError(t, bob.process(&SendPropose{Request: req}))
+	propose := assertState[*AwaitingAck](t, bob).Propose
+
+	// Bob signs his own proposal instead of Alice.
+	sig, err := signProposal(bob.env, &propose)
+	require.NoError(t, err)
+
+	err = bob.process(&AckReceived{Ack: lnwire.DynAck{
+		ChanID: alice.env.ChanID,
+		Sig:    sig,
+	}})
+	require.ErrorIs(t, err, ErrInvalidAckSig)
+}
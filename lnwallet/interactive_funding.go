@@ -0,0 +1,243 @@
+package lnwallet
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+)
+
+// InteractiveFunding holds the wallet inputs and the optional change output
+// that add funds to an interactively constructed transaction, such as the
+// funding transaction of a dual-funded channel or a splice transaction.
+type InteractiveFunding struct {
+	// Inputs are the leased wallet inputs that fund the contribution.
+	Inputs []*interactivetx.Input
+
+	// Change is the change output that pays back to the wallet, if any.
+	Change fn.Option[wire.TxOut]
+}
+
+// FundInteractiveTx selects and leases wallet coins that add the given amount
+// to an interactively constructed transaction. The coins also pay for our
+// share of the fees of the transaction, whose weight without the wallet inputs
+// and change output is given by the weight estimator.
+func (l *LightningWallet) FundInteractiveTx(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight,
+	weight input.TxWeightEstimator) (*InteractiveFunding, error) {
+
+	var funding InteractiveFunding
+	err := l.WithCoinSelectLock(func() error {
+		coins, err := NewCoinSource(l, nil).ListCoins(1, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+
+		dustLimit := DustLimitForSize(input.P2TRSize)
+		selected, changeAmt, err := chanfunding.CoinSelect(
+			feeRate, amt, dustLimit, coins,
+			l.Cfg.CoinSelectionStrategy, weight,
+			chanfunding.P2TRChangeAddress,
+			chanfunding.DefaultMaxFeeRatio,
+		)
+		if err != nil {
+			return err
+		}
+
+		if changeAmt != 0 {
+			changeAddr, err := l.NewAddress(
+				TaprootPubkey, true, DefaultAccountName,
+			)
+			if err != nil {
+				return err
+			}
+			changeScript, err := txscript.PayToAddrScript(
+				changeAddr,
+			)
+			if err != nil {
+				return err
+			}
+
+			funding.Change = fn.Some(wire.TxOut{
+				Value:    int64(changeAmt),
+				PkScript: changeScript,
+			})
+		}
+
+		for _, coin := range selected {
+			prevTx, err := l.FetchTx(coin.OutPoint.Hash)
+			if err != nil {
+				return err
+			}
+
+			_, err = l.LeaseOutput(
+				chanfunding.LndInternalLockID, coin.OutPoint,
+				chanfunding.DefaultLockDuration,
+			)
+			if err != nil {
+				return err
+			}
+
+			prevOut := coin.TxOut
+			funding.Inputs = append(
+				funding.Inputs, &interactivetx.Input{
+					OutPoint: coin.OutPoint,
+					PrevOut:  &prevOut,
+					PrevTx:   prevTx,
+					Sequence: interactivetx.MaxSequence - 1,
+				},
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		l.ReleaseInteractiveInputs(funding.Inputs)
+
+		return nil, err
+	}
+
+	return &funding, nil
+}
+
+// ReplacementFunding returns the funding of a transaction that replaces the
+// one the inputs were added to. The replacement spends the same inputs, which
+// makes both transactions conflict, and pays the higher fee rate from the
+// change output. If the change drops below the dust limit, it's added to the
+// fees instead.
+func (f *InteractiveFunding) ReplacementFunding(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight,
+	weight input.TxWeightEstimator) (*InteractiveFunding, error) {
+
+	var total btcutil.Amount
+	for _, in := range f.Inputs {
+		total += btcutil.Amount(in.PrevOut.Value)
+
+		switch {
+		case txscript.IsPayToTaproot(in.PrevOut.PkScript):
+			weight.AddTaprootKeySpendInput(txscript.SigHashDefault)
+
+		case txscript.IsPayToWitnessPubKeyHash(in.PrevOut.PkScript):
+			weight.AddP2WKHInput()
+
+		default:
+			return nil, fmt.Errorf("unsupported script of input %v",
+				in.OutPoint)
+		}
+	}
+
+	fee := feeRate.FeeForWeight(weight.Weight())
+	if total < amt+fee {
+		return nil, fmt.Errorf("inputs of %v can't fund %v at fee "+
+			"rate %v", total, amt, feeRate)
+	}
+
+	replacement := &InteractiveFunding{
+		Inputs: f.Inputs,
+	}
+	f.Change.WhenSome(func(change wire.TxOut) {
+		weight.AddOutput(change.PkScript)
+		changeAmt := total - amt - feeRate.FeeForWeight(weight.Weight())
+		if changeAmt < DustLimitForSize(len(change.PkScript)) {
+			return
+		}
+
+		change.Value = int64(changeAmt)
+		replacement.Change = fn.Some(change)
+	})
+
+	return replacement, nil
+}
+
+// ReleaseInteractiveInputs releases the wallet inputs that were leased for an
+// interactively constructed transaction that didn't complete.
+func (l *LightningWallet) ReleaseInteractiveInputs(
+	inputs []*interactivetx.Input) {
+
+	for _, in := range inputs {
+		err := l.ReleaseOutput(
+			chanfunding.LndInternalLockID, in.OutPoint,
+		)
+		if err != nil {
+			walletLog.Warnf("Failed to release UTXO %v: %v",
+				in.OutPoint, err)
+		}
+	}
+}
+
+// SignInteractiveInputs signs the inputs of an interactively constructed
+// transaction at the given indexes, which must spend outputs of the wallet.
+// The witnesses are returned in the order of the indexes.
+func (l *LightningWallet) SignInteractiveInputs(tx *wire.MsgTx,
+	prevOutFetcher txscript.PrevOutputFetcher,
+	indexes []int) ([]wire.TxWitness, error) {
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+
+	witnesses := make([]wire.TxWitness, 0, len(indexes))
+	for _, idx := range indexes {
+		prevOut := prevOutFetcher.FetchPrevOutput(
+			tx.TxIn[idx].PreviousOutPoint,
+		)
+		if prevOut == nil {
+			return nil, fmt.Errorf("unknown output spent by input "+
+				"%d", idx)
+		}
+
+		signDesc := input.SignDescriptor{
+			Output:            prevOut,
+			HashType:          txscript.SigHashAll,
+			SigHashes:         sigHashes,
+			PrevOutputFetcher: prevOutFetcher,
+			InputIndex:        idx,
+		}
+		if txscript.IsPayToTaproot(prevOut.PkScript) {
+			signDesc.HashType = txscript.SigHashDefault
+		}
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			tx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		// Only native segwit inputs are allowed in interactively
+		// constructed transactions, so there's no sigScript.
+		witnesses = append(witnesses, inputScript.Witness)
+	}
+
+	return witnesses, nil
+}
+
+// NewRbfCommitments creates the commitments of both parties of a pending
+// dual-funded channel that spend the funding output of a replacement funding
+// transaction. The funding output itself stays the same, so the commitments
+// only differ from the current ones in the funding outpoint they spend.
+func (lc *LightningChannel) NewRbfCommitments(
+	fundingOutpoint wire.OutPoint) (*SpliceCommitments, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	if !chanState.IsPending || !chanState.ChanType.IsDualFunder() {
+		return nil, fmt.Errorf("only the funding transaction of " +
+			"pending dual-funded channels can be replaced")
+	}
+
+	if chanState.ChanType.IsTaproot() {
+		return nil, ErrSpliceUnsupported
+	}
+
+	return lc.newSpliceCommitments(
+		fundingOutpoint, lc.fundingOutput, 0, 0, chanState.Params(),
+	)
+}
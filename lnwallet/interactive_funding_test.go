@@ -0,0 +1,136 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRbfCommitments tests that both parties of a pending dual-funded channel
+// create matching commitments for a replacement funding transaction and are
+// able to verify each other's signatures.
+func TestRbfCommitments(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit|channeldb.AnchorOutputsBit,
+	)
+	require.NoError(t, err)
+
+	fundingOutpoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+
+	// The funding transaction of a single funder channel can't be
+	// replaced.
+	_, err = aliceChannel.NewRbfCommitments(fundingOutpoint)
+	require.Error(t, err)
+
+	// The test channels are turned into pending dual-funded channels.
+	for _, channel := range []*LightningChannel{aliceChannel, bobChannel} {
+		channel.State().ChanType |= channeldb.DualFunderBit
+		channel.State().IsPending = true
+	}
+
+	aliceRbf, err := aliceChannel.NewRbfCommitments(fundingOutpoint)
+	require.NoError(t, err)
+	bobRbf, err := bobChannel.NewRbfCommitments(fundingOutpoint)
+	require.NoError(t, err)
+
+	// Both parties agree on the commitment transactions, which only differ
+	// from the current ones in the funding outpoint.
+	require.Equal(
+		t, aliceRbf.LocalCommitment.CommitTx.TxHash(),
+		bobRbf.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceRbf.RemoteCommitment.CommitTx.TxHash(),
+		bobRbf.LocalCommitment.CommitTx.TxHash(),
+	)
+
+	commitTx := aliceRbf.LocalCommitment.CommitTx
+	require.Equal(t, fundingOutpoint, commitTx.TxIn[0].PreviousOutPoint)
+	require.Equal(
+		t, aliceChannel.State().LocalCommitment.LocalBalance,
+		aliceRbf.LocalCommitment.LocalBalance,
+	)
+	require.Equal(t, aliceChannel.State().Capacity, aliceRbf.Capacity())
+
+	// The signatures are exchanged and verified.
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceRbf)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobRbf)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.ReceiveSpliceCommitment(
+		aliceRbf, bobSig,
+	))
+	require.NoError(t, bobChannel.ReceiveSpliceCommitment(
+		bobRbf, aliceSig,
+	))
+}
+
+// TestReplacementFunding tests that the funding of a replacement transaction
+// spends the same inputs and pays the higher fee rate from the change output.
+func TestReplacementFunding(t *testing.T) {
+	t.Parallel()
+
+	p2trScript := append([]byte{txscript.OP_1, txscript.OP_DATA_32},
+		make([]byte, 32)...)
+
+	funding := &InteractiveFunding{
+		Inputs: []*interactivetx.Input{{
+			OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}},
+			PrevOut: &wire.TxOut{
+				Value:    100_000,
+				PkScript: p2trScript,
+			},
+		}},
+		Change: fn.Some(wire.TxOut{
+			Value:    40_000,
+			PkScript: p2trScript,
+		}),
+	}
+
+	var weight input.TxWeightEstimator
+	weight.AddP2WSHOutput()
+
+	replacement, err := funding.ReplacementFunding(
+		50_000, chainfee.SatPerKWeight(2_000), weight,
+	)
+	require.NoError(t, err)
+	require.Equal(t, funding.Inputs, replacement.Inputs)
+
+	weight.AddTaprootKeySpendInput(txscript.SigHashDefault)
+	weight.AddOutput(p2trScript)
+	fee := chainfee.SatPerKWeight(2_000).FeeForWeight(weight.Weight())
+
+	change := replacement.Change.UnwrapOrFail(t)
+	require.EqualValues(t, 100_000-50_000-fee, change.Value)
+	require.Equal(t, p2trScript, change.PkScript)
+
+	// The replaced funding is left untouched.
+	require.EqualValues(
+		t, 40_000, funding.Change.UnwrapOrFail(t).Value,
+	)
+
+	// If the change drops below the dust limit, it's added to the fees.
+	replacement, err = funding.ReplacementFunding(
+		99_500, chainfee.SatPerKWeight(1_000), input.TxWeightEstimator{},
+	)
+	require.NoError(t, err)
+	require.True(t, replacement.Change.IsNone())
+
+	// The inputs must be able to pay for the fees at the new fee rate.
+	_, err = funding.ReplacementFunding(
+		99_900, chainfee.SatPerKWeight(10_000),
+		input.TxWeightEstimator{},
+	)
+	require.Error(t, err)
+}
@@ -0,0 +1,682 @@
+package interactivetx
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/wallet/txrules"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// MaxInputsOutputsReceived is the maximum number of tx_add_input and
+	// tx_add_output messages each that we accept from the remote party
+	// during a single construction session.
+	MaxInputsOutputsReceived = 4096
+
+	// MaxInputsOutputs is the maximum number of inputs and outputs each
+	// that the constructed transaction may have.
+	MaxInputsOutputs = 252
+
+	// MaxSequence is the exclusive upper bound of the sequence number of
+	// the inputs, which ensures that the constructed transaction signals
+	// replaceability.
+	MaxSequence = wire.MaxTxInSequenceNum - 1
+
+	// txVersion is the version of the constructed transaction.
+	txVersion = 2
+)
+
+var (
+	// ErrWrongParity is returned when the remote party uses a serial ID
+	// with the parity of the local party.
+	ErrWrongParity = errors.New("serial ID has wrong parity")
+
+	// ErrDuplicateSerialID is returned when a serial ID is used twice.
+	ErrDuplicateSerialID = errors.New("duplicate serial ID")
+
+	// ErrUnknownSerialID is returned when the remote party removes an
+	// input or output that doesn't exist.
+	ErrUnknownSerialID = errors.New("unknown serial ID")
+
+	// ErrNotPeersTurn is returned when a message is received while the
+	// remote party is waiting for us.
+	ErrNotPeersTurn = errors.New("message received out of turn")
+
+	// ErrSessionComplete is returned when a message is received after the
+	// construction of the transaction was completed.
+	ErrSessionComplete = errors.New("transaction construction already " +
+		"complete")
+
+	// ErrTooManyMessages is returned when the remote party exceeds the
+	// number of inputs or outputs it may add.
+	ErrTooManyMessages = errors.New("too many inputs or outputs received")
+
+	// ErrInvalidInput is returned when an input added by the remote party
+	// is invalid.
+	ErrInvalidInput = errors.New("invalid input")
+
+	// ErrInvalidOutput is returned when an output added by the remote
+	// party is invalid.
+	ErrInvalidOutput = errors.New("invalid output")
+
+	// ErrInvalidTx is returned when the transaction that was constructed
+	// is invalid.
+	ErrInvalidTx = errors.New("invalid transaction")
+)
+
+// Input is an input of an interactively constructed transaction.
+type Input struct {
+	// SerialID is the serial ID of the input.
+	SerialID uint64
+
+	// OutPoint is the outpoint that is spent by the input.
+	OutPoint wire.OutPoint
+
+	// PrevOut is the output that is spent by the input.
+	PrevOut *wire.TxOut
+
+	// PrevTx is the transaction that contains the spent output. It is nil
+	// for the shared input.
+	PrevTx *wire.MsgTx
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// Shared is true if this is the shared input that is spent by both
+	// parties, such as the current funding output of a channel.
+	Shared bool
+
+	// Party is the party that added the input.
+	Party lntypes.ChannelParty
+}
+
+// Output is an output of an interactively constructed transaction.
+type Output struct {
+	// SerialID is the serial ID of the output.
+	SerialID uint64
+
+	// TxOut is the output.
+	TxOut *wire.TxOut
+
+	// Party is the party that added the output.
+	Party lntypes.ChannelParty
+}
+
+// SharedInput describes the input that is owned by both parties, such as the
+// funding output of a channel that is spliced.
+type SharedInput struct {
+	// OutPoint is the outpoint of the shared input.
+	OutPoint wire.OutPoint
+
+	// PrevOut is the output that is spent by the shared input.
+	PrevOut *wire.TxOut
+}
+
+// Config holds the parameters of a construction session.
+type Config struct {
+	// ChanID is the ID of the channel the transaction is constructed for.
+	ChanID lnwire.ChannelID
+
+	// Initiator is true if the local party is the initiator of the
+	// session. The initiator sends the first message, adds the shared
+	// input and uses even serial IDs.
+	Initiator bool
+
+	// LockTime is the locktime of the constructed transaction.
+	LockTime uint32
+
+	// SharedInput is the input that is owned by both parties, if any.
+	SharedInput fn.Option[SharedInput]
+
+	// LocalInputs are the inputs the local party contributes. Their
+	// serial IDs are assigned by the session.
+	LocalInputs []*Input
+
+	// LocalOutputs are the outputs the local party contributes. Their
+	// serial IDs are assigned by the session.
+	LocalOutputs []*Output
+}
+
+// Session drives the interactive construction of a transaction with a remote
+// party. Both parties take turns in sending exactly one message until they
+// sent tx_complete consecutively.
+type Session struct {
+	cfg Config
+
+	// nextSerialID is the serial ID that is assigned to the next local
+	// input or output.
+	nextSerialID uint64
+
+	// pending are the messages that still need to be sent to add our
+	// contribution.
+	pending []lnwire.Message
+
+	inputs  map[uint64]*Input
+	outputs map[uint64]*Output
+
+	// spent tracks the outpoints of all inputs to prevent the same output
+	// from being spent twice.
+	spent map[wire.OutPoint]struct{}
+
+	numInputsReceived  int
+	numOutputsReceived int
+
+	ourTurn      bool
+	sentComplete bool
+	recvComplete bool
+	complete     bool
+	started      bool
+}
+
+// NewSession creates a new construction session with the given config.
+func NewSession(cfg Config) *Session {
+	s := &Session{
+		cfg:     cfg,
+		inputs:  make(map[uint64]*Input),
+		outputs: make(map[uint64]*Output),
+		spent:   make(map[wire.OutPoint]struct{}),
+		ourTurn: cfg.Initiator,
+	}
+
+	// The initiator uses even serial IDs, the acceptor odd ones.
+	if !cfg.Initiator {
+		s.nextSerialID = 1
+	}
+
+	// The initiator is responsible for adding the shared input.
+	if cfg.Initiator {
+		cfg.SharedInput.WhenSome(func(shared SharedInput) {
+			s.queueInput(&Input{
+				OutPoint: shared.OutPoint,
+				PrevOut:  shared.PrevOut,
+				Sequence: MaxSequence - 1,
+				Shared:   true,
+			})
+		})
+	}
+
+	for _, input := range cfg.LocalInputs {
+		s.queueInput(input)
+	}
+	for _, output := range cfg.LocalOutputs {
+		s.queueOutput(output)
+	}
+
+	return s
+}
+
+// queueInput assigns a serial ID to the local input and queues the message
+// that adds it.
+func (s *Session) queueInput(input *Input) {
+	input.SerialID = s.nextSerialID
+	input.Party = lntypes.Local
+	s.nextSerialID += 2
+
+	msg := &lnwire.TxAddInput{
+		ChannelID: s.cfg.ChanID,
+		SerialID:  input.SerialID,
+		PrevTxOut: input.OutPoint.Index,
+		Sequence:  input.Sequence,
+	}
+	if input.Shared {
+		msg.SharedInputTxid = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0, [32]byte](
+				input.OutPoint.Hash,
+			),
+		)
+	} else {
+		var b bytes.Buffer
+		_ = input.PrevTx.Serialize(&b)
+		msg.PrevTx = b.Bytes()
+	}
+
+	s.inputs[input.SerialID] = input
+	s.spent[input.OutPoint] = struct{}{}
+	s.pending = append(s.pending, msg)
+}
+
+// queueOutput assigns a serial ID to the local output and queues the message
+// that adds it.
+func (s *Session) queueOutput(output *Output) {
+	output.SerialID = s.nextSerialID
+	output.Party = lntypes.Local
+	s.nextSerialID += 2
+
+	s.outputs[output.SerialID] = output
+	s.pending = append(s.pending, &lnwire.TxAddOutput{
+		ChannelID: s.cfg.ChanID,
+		SerialID:  output.SerialID,
+		Amount:    btcutil.Amount(output.TxOut.Value),
+		PkScript:  output.TxOut.PkScript,
+	})
+}
+
+// Start returns the first message of the session. It must only be called by
+// the initiator.
+func (s *Session) Start() (lnwire.Message, error) {
+	if !s.cfg.Initiator || s.started {
+		return nil, fmt.Errorf("session can only be started once by " +
+			"the initiator")
+	}
+
+	return s.nextMessage()
+}
+
+// Complete returns true once both parties sent tx_complete consecutively.
+func (s *Session) Complete() bool {
+	return s.complete
+}
+
+// nextMessage returns the next message we send, which is either the next
+// part of our contribution or tx_complete.
+func (s *Session) nextMessage() (lnwire.Message, error) {
+	s.started = true
+	s.ourTurn = false
+
+	if len(s.pending) > 0 {
+		msg := s.pending[0]
+		s.pending = s.pending[1:]
+		s.sentComplete = false
+
+		return msg, nil
+	}
+
+	s.sentComplete = true
+	if s.recvComplete {
+		if err := s.finish(); err != nil {
+			return nil, err
+		}
+	}
+
+	return &lnwire.TxComplete{ChannelID: s.cfg.ChanID}, nil
+}
+
+// ReceiveMessage processes a construction message of the remote party. It
+// returns the message that we reply with, or None if the construction of the
+// transaction is complete and no reply is due.
+func (s *Session) ReceiveMessage(
+	msg lnwire.Message) (fn.Option[lnwire.Message], error) {
+
+	none := fn.None[lnwire.Message]()
+
+	switch {
+	case s.complete:
+		return none, ErrSessionComplete
+
+	case s.ourTurn:
+		return none, ErrNotPeersTurn
+	}
+
+	s.started = true
+
+	var err error
+	switch msg := msg.(type) {
+	case *lnwire.TxAddInput:
+		err = s.receiveAddInput(msg)
+
+	case *lnwire.TxAddOutput:
+		err = s.receiveAddOutput(msg)
+
+	case *lnwire.TxRemoveInput:
+		err = s.receiveRemoveInput(msg.SerialID)
+
+	case *lnwire.TxRemoveOutput:
+		err = s.receiveRemoveOutput(msg.SerialID)
+
+	case *lnwire.TxComplete:
+		s.recvComplete = true
+
+		// If we sent tx_complete right before, the construction is
+		// complete and no further message is sent.
+		if s.sentComplete {
+			if err := s.finish(); err != nil {
+				return none, err
+			}
+
+			return none, nil
+		}
+
+	case *lnwire.TxAbort:
+		return none, msg
+
+	default:
+		return none, fmt.Errorf("unexpected message %v", msg.MsgType())
+	}
+	if err != nil {
+		return none, err
+	}
+
+	if _, ok := msg.(*lnwire.TxComplete); !ok {
+		s.recvComplete = false
+	}
+
+	s.ourTurn = true
+	reply, err := s.nextMessage()
+	if err != nil {
+		return none, err
+	}
+
+	return fn.Some(reply), nil
+}
+
+// checkRemoteSerialID makes sure the serial ID of a newly added remote input
+// or output is valid.
+func (s *Session) checkRemoteSerialID(serialID uint64) error {
+	// The remote party must use the parity of its own role.
+	if (serialID%2 == 0) != !s.cfg.Initiator {
+		return fmt.Errorf("%w: %d", ErrWrongParity, serialID)
+	}
+
+	_, isInput := s.inputs[serialID]
+	_, isOutput := s.outputs[serialID]
+	if isInput || isOutput {
+		return fmt.Errorf("%w: %d", ErrDuplicateSerialID, serialID)
+	}
+
+	return nil
+}
+
+// receiveAddInput validates and adds an input of the remote party.
+func (s *Session) receiveAddInput(msg *lnwire.TxAddInput) error {
+	if err := s.checkRemoteSerialID(msg.SerialID); err != nil {
+		return err
+	}
+
+	s.numInputsReceived++
+	if s.numInputsReceived > MaxInputsOutputsReceived {
+		return ErrTooManyMessages
+	}
+
+	if msg.Sequence >= MaxSequence {
+		return fmt.Errorf("%w: sequence %d doesn't signal "+
+			"replaceability", ErrInvalidInput, msg.Sequence)
+	}
+
+	input := &Input{
+		SerialID: msg.SerialID,
+		Sequence: msg.Sequence,
+		Party:    lntypes.Remote,
+	}
+
+	sharedTxid := msg.SharedInputTxid.ValOpt()
+	if sharedTxid.IsSome() {
+		// Only the initiator can add the shared input, and it must be
+		// the one we expect.
+		shared, err := s.cfg.SharedInput.UnwrapOrErr(fmt.Errorf(
+			"%w: unexpected shared input", ErrInvalidInput,
+		))
+		if err != nil {
+			return err
+		}
+
+		txid := chainhash.Hash(sharedTxid.UnwrapOr([32]byte{}))
+		outPoint := wire.OutPoint{Hash: txid, Index: msg.PrevTxOut}
+		if s.cfg.Initiator || outPoint != shared.OutPoint ||
+			len(msg.PrevTx) != 0 {
+
+			return fmt.Errorf("%w: invalid shared input %v",
+				ErrInvalidInput, outPoint)
+		}
+
+		input.OutPoint = shared.OutPoint
+		input.PrevOut = shared.PrevOut
+		input.Shared = true
+	} else {
+		prevTx := &wire.MsgTx{}
+		err := prevTx.Deserialize(bytes.NewReader(msg.PrevTx))
+		if err != nil {
+			return fmt.Errorf("%w: unable to parse previous tx: %w",
+				ErrInvalidInput, err)
+		}
+
+		if int(msg.PrevTxOut) >= len(prevTx.TxOut) {
+			return fmt.Errorf("%w: output index %d out of range",
+				ErrInvalidInput, msg.PrevTxOut)
+		}
+
+		// Only segwit inputs are allowed, as the txid of the
+		// transaction must not change once it is signed.
+		prevOut := prevTx.TxOut[msg.PrevTxOut]
+		if !txscript.IsWitnessProgram(prevOut.PkScript) {
+			return fmt.Errorf("%w: input doesn't spend a segwit "+
+				"output", ErrInvalidInput)
+		}
+
+		input.OutPoint = wire.OutPoint{
+			Hash:  prevTx.TxHash(),
+			Index: msg.PrevTxOut,
+		}
+		input.PrevOut = prevOut
+		input.PrevTx = prevTx
+	}
+
+	if _, ok := s.spent[input.OutPoint]; ok {
+		return fmt.Errorf("%w: outpoint %v already spent",
+			ErrInvalidInput, input.OutPoint)
+	}
+
+	s.inputs[input.SerialID] = input
+	s.spent[input.OutPoint] = struct{}{}
+
+	return nil
+}
+
+// receiveAddOutput validates and adds an output of the remote party.
+func (s *Session) receiveAddOutput(msg *lnwire.TxAddOutput) error {
+	if err := s.checkRemoteSerialID(msg.SerialID); err != nil {
+		return err
+	}
+
+	s.numOutputsReceived++
+	if s.numOutputsReceived > MaxInputsOutputsReceived {
+		return ErrTooManyMessages
+	}
+
+	txOut := wire.NewTxOut(int64(msg.Amount), msg.PkScript)
+	err := txrules.CheckOutput(txOut, txrules.DefaultRelayFeePerKb)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidOutput, err)
+	}
+
+	class := txscript.GetScriptClass(msg.PkScript)
+	if class == txscript.NonStandardTy {
+		return fmt.Errorf("%w: non-standard script", ErrInvalidOutput)
+	}
+
+	s.outputs[msg.SerialID] = &Output{
+		SerialID: msg.SerialID,
+		TxOut:    txOut,
+		Party:    lntypes.Remote,
+	}
+
+	return nil
+}
+
+// receiveRemoveInput removes an input that was added by the remote party.
+func (s *Session) receiveRemoveInput(serialID uint64) error {
+	input, ok := s.inputs[serialID]
+	if !ok || input.Party != lntypes.Remote {
+		return fmt.Errorf("%w: %d", ErrUnknownSerialID, serialID)
+	}
+
+	delete(s.inputs, serialID)
+	delete(s.spent, input.OutPoint)
+
+	return nil
+}
+
+// receiveRemoveOutput removes an output that was added by the remote party.
+func (s *Session) receiveRemoveOutput(serialID uint64) error {
+	output, ok := s.outputs[serialID]
+	if !ok || output.Party != lntypes.Remote {
+		return fmt.Errorf("%w: %d", ErrUnknownSerialID, serialID)
+	}
+
+	delete(s.outputs, serialID)
+
+	return nil
+}
+
+// finish marks the session as complete after validating the constructed
+// transaction.
+func (s *Session) finish() error {
+	if len(s.inputs) > MaxInputsOutputs ||
+		len(s.outputs) > MaxInputsOutputs {
+
+		return fmt.Errorf("%w: too many inputs or outputs",
+			ErrInvalidTx)
+	}
+
+	if len(s.inputs) == 0 || len(s.outputs) == 0 {
+		return fmt.Errorf("%w: no inputs or outputs", ErrInvalidTx)
+	}
+
+	var hasShared bool
+	for _, input := range s.inputs {
+		hasShared = hasShared || input.Shared
+	}
+	if s.cfg.SharedInput.IsSome() && !hasShared {
+		return fmt.Errorf("%w: shared input missing", ErrInvalidTx)
+	}
+
+	var totalIn, totalOut int64
+	for _, input := range s.inputs {
+		totalIn += input.PrevOut.Value
+	}
+	for _, output := range s.outputs {
+		totalOut += output.TxOut.Value
+	}
+	if totalIn < totalOut {
+		return fmt.Errorf("%w: outputs exceed inputs", ErrInvalidTx)
+	}
+
+	s.complete = true
+
+	return nil
+}
+
+// ConstructedTx is the result of a completed construction session.
+type ConstructedTx struct {
+	// Tx is the unsigned transaction.
+	Tx *wire.MsgTx
+
+	// Inputs are the inputs of Tx, in the same order.
+	Inputs []*Input
+
+	// Outputs are the outputs of Tx, in the same order.
+	Outputs []*Output
+}
+
+// Tx returns the transaction that was constructed. The inputs and outputs are
+// ordered by their serial IDs.
+func (s *Session) Tx() (*ConstructedTx, error) {
+	if !s.complete {
+		return nil, fmt.Errorf("transaction construction not complete")
+	}
+
+	constructed := &ConstructedTx{
+		Tx: wire.NewMsgTx(txVersion),
+	}
+	constructed.Tx.LockTime = s.cfg.LockTime
+
+	for _, input := range s.inputs {
+		constructed.Inputs = append(constructed.Inputs, input)
+	}
+	sort.Slice(constructed.Inputs, func(i, j int) bool {
+		return constructed.Inputs[i].SerialID <
+			constructed.Inputs[j].SerialID
+	})
+	for _, input := range constructed.Inputs {
+		txIn := wire.NewTxIn(&input.OutPoint, nil, nil)
+		txIn.Sequence = input.Sequence
+		constructed.Tx.AddTxIn(txIn)
+	}
+
+	for _, output := range s.outputs {
+		constructed.Outputs = append(constructed.Outputs, output)
+	}
+	sort.Slice(constructed.Outputs, func(i, j int) bool {
+		return constructed.Outputs[i].SerialID <
+			constructed.Outputs[j].SerialID
+	})
+	for _, output := range constructed.Outputs {
+		constructed.Tx.AddTxOut(output.TxOut)
+	}
+
+	return constructed, nil
+}
+
+// PrevOutputFetcher returns a fetcher for the outputs that are spent by the
+// constructed transaction, which is needed to sign it.
+func (c *ConstructedTx) PrevOutputFetcher() txscript.PrevOutputFetcher {
+	fetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for _, input := range c.Inputs {
+		fetcher.AddPrevOut(input.OutPoint, input.PrevOut)
+	}
+
+	return fetcher
+}
+
+// PartyInputs returns the inputs that were added by the given party, ordered
+// by serial ID, along with their index in the transaction. The shared input
+// is excluded.
+func (c *ConstructedTx) PartyInputs(party lntypes.ChannelParty) ([]*Input,
+	[]int) {
+
+	var (
+		inputs  []*Input
+		indexes []int
+	)
+	for i, input := range c.Inputs {
+		if input.Party != party || input.Shared {
+			continue
+		}
+
+		inputs = append(inputs, input)
+		indexes = append(indexes, i)
+	}
+
+	return inputs, indexes
+}
+
+// SharedInputIndex returns the index of the shared input in the transaction,
+// if there is one.
+func (c *ConstructedTx) SharedInputIndex() fn.Option[int] {
+	for i, input := range c.Inputs {
+		if input.Shared {
+			return fn.Some(i)
+		}
+	}
+
+	return fn.None[int]()
+}
+
+// PartyAmounts returns the total value of the inputs and outputs that were
+// added by the given party, excluding the shared input.
+func (c *ConstructedTx) PartyAmounts(
+	party lntypes.ChannelParty) (btcutil.Amount, btcutil.Amount) {
+
+	var in, out btcutil.Amount
+	for _, input := range c.Inputs {
+		if input.Party == party && !input.Shared {
+			in += btcutil.Amount(input.PrevOut.Value)
+		}
+	}
+	for _, output := range c.Outputs {
+		if output.Party == party {
+			out += btcutil.Amount(output.TxOut.Value)
+		}
+	}
+
+	return in, out
+}
@@ -0,0 +1,267 @@
+package interactivetx
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	// p2wkhScript is a P2WKH output script.
+	p2wkhScript = append([]byte{0x00, 0x14}, bytes.Repeat([]byte{1}, 20)...)
+
+	// p2wshScript is a P2WSH output script.
+	p2wshScript = append([]byte{0x00, 0x20}, bytes.Repeat([]byte{2}, 32)...)
+)
+
+// walletInput creates an input that spends a new P2WKH output of the given
+// value.
+func walletInput(value int64) *Input {
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(&wire.TxIn{})
+	prevTx.AddTxOut(wire.NewTxOut(value, p2wkhScript))
+
+	return &Input{
+		OutPoint: wire.OutPoint{Hash: prevTx.TxHash()},
+		PrevOut:  prevTx.TxOut[0],
+		PrevTx:   prevTx,
+		Sequence: MaxSequence - 1,
+	}
+}
+
+// runSessions exchanges the messages of the two sessions until both are
+// complete.
+func runSessions(t *testing.T, initiator, acceptor *Session) {
+	t.Helper()
+
+	msg, err := initiator.Start()
+	require.NoError(t, err)
+
+	sender, receiver := initiator, acceptor
+	for i := 0; i < 100; i++ {
+		reply, err := receiver.ReceiveMessage(msg)
+		require.NoError(t, err)
+
+		if reply.IsNone() {
+			require.True(t, sender.Complete())
+			require.True(t, receiver.Complete())
+
+			return
+		}
+
+		msg = reply.UnsafeFromSome()
+		sender, receiver = receiver, sender
+	}
+
+	t.Fatalf("sessions didn't complete")
+}
+
+// TestSessionConstruction tests that two parties construct the same
+// transaction, which includes a shared input and the contributions of both
+// parties.
+func TestSessionConstruction(t *testing.T) {
+	t.Parallel()
+
+	shared := SharedInput{
+		OutPoint: wire.OutPoint{Hash: chainhash.Hash{1}, Index: 3},
+		PrevOut:  wire.NewTxOut(1_000_000, p2wshScript),
+	}
+
+	initiator := NewSession(Config{
+		Initiator:   true,
+		LockTime:    100,
+		SharedInput: fn.Some(shared),
+		LocalInputs: []*Input{walletInput(500_000)},
+		LocalOutputs: []*Output{
+			{TxOut: wire.NewTxOut(1_400_000, p2wshScript)},
+		},
+	})
+	acceptor := NewSession(Config{
+		LockTime:    100,
+		SharedInput: fn.Some(shared),
+		LocalInputs: []*Input{walletInput(200_000)},
+		LocalOutputs: []*Output{
+			{TxOut: wire.NewTxOut(199_000, p2wkhScript)},
+		},
+	})
+
+	runSessions(t, initiator, acceptor)
+
+	initiatorTx, err := initiator.Tx()
+	require.NoError(t, err)
+	acceptorTx, err := acceptor.Tx()
+	require.NoError(t, err)
+
+	require.Equal(t, initiatorTx.Tx.TxHash(), acceptorTx.Tx.TxHash())
+	require.Len(t, initiatorTx.Tx.TxIn, 3)
+	require.Len(t, initiatorTx.Tx.TxOut, 2)
+	require.EqualValues(t, 100, initiatorTx.Tx.LockTime)
+
+	// The shared input was added first by the initiator.
+	require.Equal(t, fn.Some(0), initiatorTx.SharedInputIndex())
+	require.Equal(t, fn.Some(0), acceptorTx.SharedInputIndex())
+	require.Equal(t, shared.OutPoint, initiatorTx.Tx.TxIn[0].PreviousOutPoint)
+
+	// Both parties agree on who contributed what.
+	_, localIdx := initiatorTx.PartyInputs(lntypes.Local)
+	_, remoteIdx := acceptorTx.PartyInputs(lntypes.Remote)
+	require.Equal(t, localIdx, remoteIdx)
+
+	in, out := acceptorTx.PartyAmounts(lntypes.Local)
+	require.EqualValues(t, 200_000, in)
+	require.EqualValues(t, 199_000, out)
+
+	// No further messages are accepted.
+	_, err = acceptor.ReceiveMessage(&lnwire.TxComplete{})
+	require.ErrorIs(t, err, ErrSessionComplete)
+}
+
+// TestSessionInvalidMessages tests that invalid messages of the remote party
+// are rejected.
+func TestSessionInvalidMessages(t *testing.T) {
+	t.Parallel()
+
+	validInput := func() *lnwire.TxAddInput {
+		input := walletInput(100_000)
+
+		var b bytes.Buffer
+		require.NoError(t, input.PrevTx.Serialize(&b))
+
+		return &lnwire.TxAddInput{
+			SerialID: 2,
+			PrevTx:   b.Bytes(),
+			Sequence: MaxSequence - 1,
+		}
+	}
+
+	testCases := []struct {
+		name string
+		msg  func() lnwire.Message
+		err  error
+	}{{
+		name: "wrong parity",
+		msg: func() lnwire.Message {
+			msg := validInput()
+			msg.SerialID = 1
+
+			return msg
+		},
+		err: ErrWrongParity,
+	}, {
+		name: "non-segwit input",
+		msg: func() lnwire.Message {
+			prevTx := wire.NewMsgTx(2)
+			prevTx.AddTxOut(wire.NewTxOut(1000, []byte{0x51}))
+
+			var b bytes.Buffer
+			require.NoError(t, prevTx.Serialize(&b))
+
+			msg := validInput()
+			msg.PrevTx = b.Bytes()
+
+			return msg
+		},
+		err: ErrInvalidInput,
+	}, {
+		name: "final sequence",
+		msg: func() lnwire.Message {
+			msg := validInput()
+			msg.Sequence = wire.MaxTxInSequenceNum
+
+			return msg
+		},
+		err: ErrInvalidInput,
+	}, {
+		name: "unexpected shared input",
+		msg: func() lnwire.Message {
+			msg := validInput()
+			msg.PrevTx = nil
+			msg.SharedInputTxid = tlv.SomeRecordT(
+				tlv.NewPrimitiveRecord[tlv.TlvType0, [32]byte](
+					[32]byte{1},
+				),
+			)
+
+			return msg
+		},
+		err: ErrInvalidInput,
+	}, {
+		name: "dust output",
+		msg: func() lnwire.Message {
+			return &lnwire.TxAddOutput{
+				SerialID: 2,
+				Amount:   1,
+				PkScript: p2wkhScript,
+			}
+		},
+		err: ErrInvalidOutput,
+	}, {
+		name: "unknown removal",
+		msg: func() lnwire.Message {
+			return &lnwire.TxRemoveInput{SerialID: 2}
+		},
+		err: ErrUnknownSerialID,
+	}}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			acceptor := NewSession(Config{})
+
+			_, err := acceptor.ReceiveMessage(tc.msg())
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+// TestSessionRemoveAndDuplicate tests that the remote party can remove its
+// own inputs, but can't reuse serial IDs or spend the same output twice.
+func TestSessionRemoveAndDuplicate(t *testing.T) {
+	t.Parallel()
+
+	input := walletInput(100_000)
+	var b bytes.Buffer
+	require.NoError(t, input.PrevTx.Serialize(&b))
+
+	addInput := &lnwire.TxAddInput{
+		SerialID: 2,
+		PrevTx:   b.Bytes(),
+		Sequence: MaxSequence - 1,
+	}
+
+	acceptor := NewSession(Config{})
+
+	// The acceptor has nothing to contribute, so it replies with
+	// tx_complete.
+	reply, err := acceptor.ReceiveMessage(addInput)
+	require.NoError(t, err)
+	require.IsType(t, &lnwire.TxComplete{}, reply.UnsafeFromSome())
+
+	// The same serial ID can't be added twice.
+	_, err = acceptor.ReceiveMessage(addInput)
+	require.ErrorIs(t, err, ErrDuplicateSerialID)
+
+	// Neither can the same outpoint be spent twice.
+	dup := *addInput
+	dup.SerialID = 4
+	_, err = acceptor.ReceiveMessage(&dup)
+	require.ErrorIs(t, err, ErrInvalidInput)
+
+	// Once removed, the outpoint can be added again.
+	_, err = acceptor.ReceiveMessage(&lnwire.TxRemoveInput{SerialID: 2})
+	require.NoError(t, err)
+	_, err = acceptor.ReceiveMessage(&dup)
+	require.NoError(t, err)
+
+	// A transaction without outputs is invalid.
+	_, err = acceptor.ReceiveMessage(&lnwire.TxComplete{})
+	require.ErrorIs(t, err, ErrInvalidTx)
+}
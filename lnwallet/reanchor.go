@@ -0,0 +1,351 @@
+package lnwallet
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrReanchorUnsupported is returned when a channel can't be
+	// reanchored to new channel parameters.
+	ErrReanchorUnsupported = errors.New("reanchoring is not supported " +
+		"for this channel type")
+)
+
+// Reanchor holds the kickoff transaction that moves the funds of a channel to
+// a new funding output, along with the commitments of both parties spending
+// it. A channel is reanchored to change parameters that affect the scripts of
+// its commitments, as the outputs of all prior commitments must remain
+// resolvable with the parameters they were created with.
+type Reanchor struct {
+	// KickoffTx spends the current funding output of the channel to the
+	// new funding output.
+	KickoffTx *wire.MsgTx
+
+	// Commitments are the commitments of both parties that spend the new
+	// funding output with the new channel parameters.
+	Commitments *SpliceCommitments
+}
+
+// kickoffWeight returns the weight of a kickoff transaction that creates a
+// funding output for the given channel type.
+func kickoffWeight(chanType channeldb.ChannelType) lntypes.WeightUnit {
+	var weight input.TxWeightEstimator
+	weight.AddWitnessInput(input.MultiSigWitnessSize)
+
+	if chanType.IsTaproot() {
+		weight.AddP2TROutput()
+	} else {
+		weight.AddP2WSHOutput()
+	}
+
+	return weight.Weight()
+}
+
+// NewReanchor creates the kickoff transaction that reanchors the channel to a
+// funding output for the given channel parameters, and the commitments of
+// both parties spending it. The fees of the kickoff transaction are paid by
+// the given party at the fee rate of the current commitments, so both parties
+// arrive at the same transaction. The channel must not have any HTLCs or
+// pending updates, which the caller ensures by quiescing the channel first.
+func (lc *LightningChannel) NewReanchor(params channeldb.ChannelParams,
+	payer lntypes.ChannelParty) (*Reanchor, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	if chanState.ChanType.IsTaproot() ||
+		chanState.ChanType.HasLeaseExpiration() ||
+		params.ChanType.HasTapscriptRoot() {
+
+		return nil, ErrReanchorUnsupported
+	}
+
+	if !lc.isChannelClean() {
+		return nil, ErrSpliceChannelNotClean
+	}
+
+	localCommit := chanState.LocalCommitment
+	feeRate := chainfee.SatPerKWeight(localCommit.FeePerKw)
+	fee := feeRate.FeeForWeight(kickoffWeight(params.ChanType))
+
+	var (
+		localDelta, remoteDelta btcutil.Amount
+		payerBalance            btcutil.Amount
+		payerReserve            btcutil.Amount
+	)
+	if payer.IsLocal() {
+		localDelta = -fee
+		payerBalance = localCommit.LocalBalance.ToSatoshis()
+		payerReserve = chanState.LocalChanCfg.ChanReserve
+	} else {
+		remoteDelta = -fee
+		payerBalance = localCommit.RemoteBalance.ToSatoshis()
+		payerReserve = chanState.RemoteChanCfg.ChanReserve
+	}
+
+	if payerBalance-fee < payerReserve {
+		return nil, fmt.Errorf("%w: %v balance %v can't pay kickoff "+
+			"fee %v", ErrBelowChanReserve, payer, payerBalance, fee)
+	}
+
+	capacity := chanState.Capacity - fee
+	fundingOutput, err := reanchorFundingOutput(
+		chanState, params.ChanType, capacity,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	currentOutpoint := chanState.CurrentFundingOutpoint()
+	kickoffTx := wire.NewMsgTx(2)
+	kickoffTx.AddTxIn(wire.NewTxIn(&currentOutpoint, nil, nil))
+	kickoffTx.AddTxOut(fundingOutput)
+
+	fundingOutpoint := wire.OutPoint{
+		Hash:  kickoffTx.TxHash(),
+		Index: 0,
+	}
+	commitments, err := lc.newSpliceCommitments(
+		fundingOutpoint, *fundingOutput, localDelta, remoteDelta,
+		params,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reanchor{
+		KickoffTx:   kickoffTx,
+		Commitments: commitments,
+	}, nil
+}
+
+// reanchorFundingOutput returns the funding output of the channel for the
+// given channel type and capacity. The funding keys of both parties stay the
+// same.
+func reanchorFundingOutput(chanState *channeldb.OpenChannel,
+	chanType channeldb.ChannelType,
+	capacity btcutil.Amount) (*wire.TxOut, error) {
+
+	localKey := chanState.LocalChanCfg.MultiSigKey.PubKey
+	remoteKey := chanState.RemoteChanCfg.MultiSigKey.PubKey
+
+	if chanType.IsTaproot() {
+		_, txOut, err := input.GenTaprootFundingScript(
+			localKey, remoteKey, int64(capacity),
+			fn.None[chainhash.Hash](),
+		)
+
+		return txOut, err
+	}
+
+	_, txOut, err := input.GenFundingPkScript(
+		localKey.SerializeCompressed(), remoteKey.SerializeCompressed(),
+		int64(capacity),
+	)
+
+	return txOut, err
+}
+
+// ReanchorNonce returns our verification nonce for the commitment at our
+// current height. It's handed to the remote party to sign our commitment of a
+// reanchor to a taproot channel. As the nonce is derived from the current
+// height, it's the one we re-derive to broadcast that commitment.
+func (lc *LightningChannel) ReanchorNonce() (lnwire.Musig2Nonce, error) {
+	lc.RLock()
+	defer lc.RUnlock()
+
+	nonce, err := lc.reanchorNonce()
+	if err != nil {
+		return lnwire.Musig2Nonce{}, err
+	}
+
+	return nonce.PubNonce, nil
+}
+
+// reanchorNonce is the unlocked version of ReanchorNonce.
+//
+// NOTE: This method requires the channel lock to be held.
+func (lc *LightningChannel) reanchorNonce() (*musig2.Nonces, error) {
+	chanState := lc.channelState
+
+	return channeldb.NewMusigVerificationNonce(
+		chanState.LocalChanCfg.MultiSigKey.PubKey,
+		chanState.LocalCommitment.CommitHeight,
+		lc.taprootNonceProducer,
+	)
+}
+
+// SignReanchorCommitment signs the remote party's commitment of the reanchor.
+// If the channel is reanchored to a taproot channel, the verification nonce
+// of the remote party for its commitment must be given.
+func (lc *LightningChannel) SignReanchorCommitment(r *Reanchor,
+	remoteNonce fn.Option[lnwire.Musig2Nonce]) (*lnwire.CommitSig, error) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(lc.ChannelPoint())
+	if !reanchorsToTaproot(r) {
+		sig, err := lc.SignSpliceCommitment(r.Commitments)
+		if err != nil {
+			return nil, err
+		}
+
+		return &lnwire.CommitSig{
+			ChanID:    chanID,
+			CommitSig: sig,
+		}, nil
+	}
+
+	nonce, err := remoteNonce.UnwrapOrErr(
+		errors.New("remote nonce required for taproot commitment"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	chanState := lc.channelState
+	session := NewPartialMusigSession(
+		musig2.Nonces{PubNonce: nonce},
+		chanState.LocalChanCfg.MultiSigKey,
+		chanState.RemoteChanCfg.MultiSigKey, lc.Signer,
+		&r.Commitments.FundingOutput, RemoteMusigCommit,
+		fn.None[input.MuSig2Tweaks](),
+	)
+
+	commitTx := r.Commitments.RemoteCommitment.CommitTx
+	partialSig, err := session.SignCommit(commitTx)
+	if err != nil {
+		return nil, err
+	}
+
+	r.Commitments.RemoteCommitment.CommitSig = partialSig.Serialize()
+
+	return &lnwire.CommitSig{
+		ChanID: chanID,
+		PartialSig: lnwire.MaybePartialSigWithNonce(
+			partialSig.ToWireSig(),
+		),
+	}, nil
+}
+
+// ReceiveReanchorCommitment verifies the remote party's signature for our
+// commitment of the reanchor.
+func (lc *LightningChannel) ReceiveReanchorCommitment(r *Reanchor,
+	msg *lnwire.CommitSig) error {
+
+	if !reanchorsToTaproot(r) {
+		return lc.ReceiveSpliceCommitment(r.Commitments, msg.CommitSig)
+	}
+
+	partialSig, err := msg.PartialSig.UnwrapOrErrV(errNoPartialSig)
+	if err != nil {
+		return err
+	}
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	localNonce, err := lc.reanchorNonce()
+	if err != nil {
+		return err
+	}
+
+	chanState := lc.channelState
+	session := NewPartialMusigSession(
+		*localNonce, chanState.LocalChanCfg.MultiSigKey,
+		chanState.RemoteChanCfg.MultiSigKey, lc.Signer,
+		&r.Commitments.FundingOutput, LocalMusigCommit,
+		fn.None[input.MuSig2Tweaks](),
+	)
+
+	commitTx := r.Commitments.LocalCommitment.CommitTx
+	_, err = session.VerifyCommitSig(commitTx, &partialSig)
+	if err != nil {
+		return fmt.Errorf("invalid reanchor commitment signature for "+
+			"ChannelPoint(%v): %w", chanState.FundingOutpoint, err)
+	}
+
+	// Just like for any other commitment of a taproot channel, we store
+	// the partial signature along with the remote party's nonce, which
+	// allows us to complete the signature if we need to broadcast the
+	// commitment.
+	var b bytes.Buffer
+	if err := partialSig.Encode(&b); err != nil {
+		return err
+	}
+	r.Commitments.LocalCommitment.CommitSig = b.Bytes()
+
+	return nil
+}
+
+// reanchorsToTaproot returns true if the commitments of the reanchor belong to
+// a taproot channel.
+func reanchorsToTaproot(r *Reanchor) bool {
+	return fn.MapOptionZ(
+		r.Commitments.Params, func(p channeldb.ChannelParams) bool {
+			return p.ChanType.IsTaproot()
+		},
+	)
+}
+
+// KickoffWitness returns the witness of the given kickoff transaction given
+// the signatures of both parties for the funding input.
+func (lc *LightningChannel) KickoffWitness(kickoffTx *wire.MsgTx, localSig,
+	remoteSig lnwire.Sig) (wire.TxWitness, error) {
+
+	witness, err := lc.FundingInputWitness(localSig, remoteSig)
+	if err != nil {
+		return nil, err
+	}
+
+	// We verify the witness before handing it out, so we never broadcast
+	// a kickoff transaction with an invalid remote signature.
+	tx := kickoffTx.Copy()
+	tx.TxIn[0].Witness = witness
+
+	lc.RLock()
+	fundingOutput := lc.fundingOutput
+	lc.RUnlock()
+
+	err = verifyFundingSpend(tx, &fundingOutput)
+	if err != nil {
+		return nil, fmt.Errorf("invalid kickoff signature: %w", err)
+	}
+
+	return witness, nil
+}
+
+// verifyFundingSpend verifies that the first input of the given transaction
+// validly spends the given funding output.
+func verifyFundingSpend(tx *wire.MsgTx, fundingOutput *wire.TxOut) error {
+	prevOuts := txscript.NewCannedPrevOutputFetcher(
+		fundingOutput.PkScript, fundingOutput.Value,
+	)
+
+	vm, err := txscript.NewEngine(
+		fundingOutput.PkScript, tx, 0, txscript.StandardVerifyFlags,
+		nil, txscript.NewTxSigHashes(tx, prevOuts),
+		fundingOutput.Value, prevOuts,
+	)
+	if err != nil {
+		return err
+	}
+
+	return vm.Execute()
+}
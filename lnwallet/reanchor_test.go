@@ -0,0 +1,194 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestReanchor tests that both parties create the same kickoff transaction and
+// commitments when reanchoring a channel, and that the commitments and the
+// kickoff transaction can be fully signed.
+func TestReanchor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		taproot bool
+	}{
+		{
+			name: "csv delay",
+		},
+		{
+			name:    "taproot upgrade",
+			taproot: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			testReanchor(t, tc.taproot)
+		})
+	}
+}
+
+func testReanchor(t *testing.T, taproot bool) {
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit | channeldb.ZeroHtlcTxFeeBit
+
+	aliceChannel, bobChannel, err := CreateTestChannels(t, chanType)
+	require.NoError(t, err)
+
+	// Alice wants Bob to wait longer for his funds, and optionally
+	// upgrades the channel to a taproot channel.
+	params := aliceChannel.State().Params()
+	params.RemoteCommitParams.CsvDelay += 10
+	if taproot {
+		params.ChanType |= channeldb.SimpleTaprootFeatureBit
+	}
+
+	aliceReanchor, err := aliceChannel.NewReanchor(params, lntypes.Local)
+	require.NoError(t, err)
+	bobReanchor, err := bobChannel.NewReanchor(
+		params.Swapped(), lntypes.Remote,
+	)
+	require.NoError(t, err)
+
+	// Both parties agree on the kickoff transaction, which spends the
+	// current funding output, and on the commitments spending its output.
+	require.Equal(
+		t, aliceReanchor.KickoffTx.TxHash(),
+		bobReanchor.KickoffTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceChannel.ChannelPoint(),
+		aliceReanchor.KickoffTx.TxIn[0].PreviousOutPoint,
+	)
+
+	aliceCommits := aliceReanchor.Commitments
+	bobCommits := bobReanchor.Commitments
+	require.Equal(
+		t, aliceCommits.LocalCommitment.CommitTx.TxHash(),
+		bobCommits.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceCommits.RemoteCommitment.CommitTx.TxHash(),
+		bobCommits.LocalCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(t, fn.Some(params), aliceCommits.Params)
+
+	// Alice pays for the kickoff transaction.
+	fee := aliceChannel.State().Capacity - aliceCommits.Capacity()
+	require.Positive(t, fee)
+	require.Equal(
+		t, bobChannel.State().LocalCommitment.LocalBalance,
+		bobCommits.LocalCommitment.LocalBalance,
+	)
+
+	// The commitments are signed with the nonces both parties derive for
+	// their current commitment height.
+	nonce := func(lc *LightningChannel) fn.Option[lnwire.Musig2Nonce] {
+		if !taproot {
+			return fn.None[lnwire.Musig2Nonce]()
+		}
+
+		nonce, err := lc.ReanchorNonce()
+		require.NoError(t, err)
+
+		return fn.Some(nonce)
+	}
+
+	aliceSig, err := aliceChannel.SignReanchorCommitment(
+		aliceReanchor, nonce(bobChannel),
+	)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignReanchorCommitment(
+		bobReanchor, nonce(aliceChannel),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.ReceiveReanchorCommitment(
+		aliceReanchor, bobSig,
+	))
+	require.NoError(t, bobChannel.ReceiveReanchorCommitment(
+		bobReanchor, aliceSig,
+	))
+
+	// A signature for the wrong commitment is rejected.
+	require.Error(t, aliceChannel.ReceiveReanchorCommitment(
+		aliceReanchor, aliceSig,
+	))
+
+	// Alice is able to broadcast her commitment spending the new funding
+	// output.
+	aliceState := aliceChannel.State()
+	signDesc := *aliceChannel.signDesc
+	signDesc.Output = &aliceCommits.FundingOutput
+
+	signedInputs := SignedCommitTxInputs{
+		CommitTx:  aliceCommits.LocalCommitment.CommitTx,
+		CommitSig: aliceCommits.LocalCommitment.CommitSig,
+		OurKey:    aliceState.LocalChanCfg.MultiSigKey,
+		TheirKey:  aliceState.RemoteChanCfg.MultiSigKey,
+		SignDesc:  &signDesc,
+	}
+	if taproot {
+		signedInputs.Taproot = fn.Some(TaprootSignedCommitTxInputs{
+			CommitHeight: aliceState.LocalCommitment.CommitHeight,
+			TaprootNonceProducer: aliceChannel.
+				taprootNonceProducer,
+			TapscriptRoot: fn.None[chainhash.Hash](),
+		})
+	}
+
+	commitTx, err := GetSignedCommitTx(signedInputs, aliceChannel.Signer)
+	require.NoError(t, err)
+	require.NoError(t, verifyFundingSpend(
+		commitTx, &aliceCommits.FundingOutput,
+	))
+
+	// Finally, the kickoff transaction is signed by both parties.
+	aliceKickoffSig, err := aliceChannel.SignFundingInput(
+		aliceReanchor.KickoffTx, 0,
+	)
+	require.NoError(t, err)
+	bobKickoffSig, err := bobChannel.SignFundingInput(
+		bobReanchor.KickoffTx, 0,
+	)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.KickoffWitness(
+		aliceReanchor.KickoffTx, aliceKickoffSig, bobKickoffSig,
+	)
+	require.NoError(t, err)
+
+	// A kickoff signature of the wrong party is rejected.
+	_, err = aliceChannel.KickoffWitness(
+		aliceReanchor.KickoffTx, aliceKickoffSig, aliceKickoffSig,
+	)
+	require.Error(t, err)
+}
+
+// TestReanchorTaprootUnsupported tests that taproot channels can't be
+// reanchored.
+func TestReanchorTaprootUnsupported(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := CreateTestChannels(
+		t, channeldb.SimpleTaprootFeatureBit,
+	)
+	require.NoError(t, err)
+
+	params := aliceChannel.State().Params()
+	params.LocalCommitParams.CsvDelay++
+
+	_, err = aliceChannel.NewReanchor(params, lntypes.Local)
+	require.ErrorIs(t, err, ErrReanchorUnsupported)
+}
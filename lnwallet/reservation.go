@@ -307,10 +307,39 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 	// Used to cut down on verbosity.
 	defaultDust := DustLimitUnknownWitness()
 
+	// The funding transaction of a dual-funded channel is constructed
+	// interactively, which is signaled by the interactive assembler. Both
+	// parties may contribute to the channel, and the opener pays the
+	// commitment fee from its contribution.
+	interactive, isInteractive :=
+		req.ChanFunder.(*chanfunding.InteractiveAssembler)
+
 	// If we're the responder to a single-funder reservation, then we have
 	// no initial balance in the channel unless the remote party is pushing
 	// some funds to us within the first commitment state.
-	if localFundingAmt == 0 {
+	if isInteractive {
+		remoteFundingMSat := capacityMSat - localFundingMSat
+		initiator = interactive.IsInitiator()
+
+		funderMSat := remoteFundingMSat
+		if initiator {
+			funderMSat = localFundingMSat
+		}
+		if funderMSat < feeMSat {
+			return nil, ErrFunderBalanceDust(
+				int64(commitFee), int64(funderMSat.ToSatoshis()),
+				int64(2*defaultDust),
+			)
+		}
+
+		ourBalance = localFundingMSat
+		theirBalance = remoteFundingMSat
+		if initiator {
+			ourBalance -= feeMSat
+		} else {
+			theirBalance -= feeMSat
+		}
+	} else if localFundingAmt == 0 {
 		ourBalance = req.PushMSat
 		theirBalance = capacityMSat - feeMSat - req.PushMSat
 		initiator = false
@@ -383,8 +412,15 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 
 	// If either of the balances are zero at this point, or we have a
 	// non-zero push amt (there's no pushing for dual funder), then this is
-	// a single-funder channel.
-	if ourBalance == 0 || theirBalance == 0 || req.PushMSat != 0 {
+	// a single-funder channel. A channel established with the interactive
+	// protocol is a dual-funded channel, even if only the opener ends up
+	// contributing to it.
+	if isInteractive {
+		chanType |= channeldb.DualFunderBit
+		if req.CommitType.HasStaticRemoteKey() {
+			chanType |= channeldb.SingleFunderTweaklessBit
+		}
+	} else if ourBalance == 0 || theirBalance == 0 || req.PushMSat != 0 {
 		// Both the tweakless type and the anchor type is tweakless,
 		// hence set the bit.
 		if req.CommitType.HasStaticRemoteKey() {
@@ -435,6 +471,12 @@ func NewChannelReservation(capacity, localFundingAmt btcutil.Amount,
 				"for script enforced lease commitment type")
 		}
 		chanType |= channeldb.LeaseExpirationBit
+
+		// The lease of a dual-funded channel is sold by its acceptor
+		// through liquidity ads.
+		if isInteractive {
+			chanType |= channeldb.AcceptorLeaseBit
+		}
 	} else if thawHeight > 0 {
 		chanType |= channeldb.FrozenBit
 	}
@@ -691,6 +733,131 @@ func (r *ChannelReservation) ProcessPsbt(
 	return <-errChan
 }
 
+// IsInteractive returns true if the funding transaction of the reservation is
+// constructed interactively with the remote party.
+func (r *ChannelReservation) IsInteractive() bool {
+	_, ok := r.fundingIntent.(*chanfunding.InteractiveIntent)
+	return ok
+}
+
+// AddRemoteFunding adds the amount the remote party contributes to a
+// dual-funded channel we opened, which is only known once the remote party
+// accepted the channel. It must be called before the remote party's
+// contribution is processed.
+func (r *ChannelReservation) AddRemoteFunding(amt btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	intent, ok := r.fundingIntent.(*chanfunding.InteractiveIntent)
+	if !ok {
+		return fmt.Errorf("remote funding can only be added to " +
+			"dual-funded channels")
+	}
+
+	amtMSat := lnwire.NewMSatFromSatoshis(amt)
+	state := r.partialState
+	state.Capacity += amt
+	state.LocalCommitment.RemoteBalance += amtMSat
+	state.RemoteCommitment.RemoteBalance += amtMSat
+	state.InitialRemoteBalance += amtMSat
+	r.theirContribution.FundingAmount += amt
+
+	intent.SetRemoteFundingAmt(amt)
+
+	return nil
+}
+
+// AddLeaseFee moves the fee of a liquidity lease from the initiator of a
+// dual-funded channel, who bought the lease, to the acceptor, who sold it. The
+// contributions to the funding output are unchanged, only the initial
+// balances of the channel are shifted. It must be called after the
+// contributions of both parties are known, and before the commitments are
+// signed.
+func (r *ChannelReservation) AddLeaseFee(fee btcutil.Amount) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if _, ok := r.fundingIntent.(*chanfunding.InteractiveIntent); !ok {
+		return fmt.Errorf("a lease fee can only be added to " +
+			"dual-funded channels")
+	}
+
+	state := r.partialState
+	if !state.ChanType.HasAcceptorLease() {
+		return fmt.Errorf("a lease fee can only be added to leased " +
+			"channels")
+	}
+
+	// The fee is paid by the initiator, so we pay it if we opened the
+	// channel, and receive it otherwise.
+	feeMSat := lnwire.NewMSatFromSatoshis(fee)
+	buyerBalance := state.LocalCommitment.RemoteBalance
+	if state.IsInitiator {
+		buyerBalance = state.LocalCommitment.LocalBalance
+	}
+	if buyerBalance < feeMSat {
+		return fmt.Errorf("lease fee of %v exceeds the balance of %v "+
+			"of the initiator", fee, buyerBalance.ToSatoshis())
+	}
+
+	commits := []*channeldb.ChannelCommitment{
+		&state.LocalCommitment, &state.RemoteCommitment,
+	}
+	if state.IsInitiator {
+		for _, commit := range commits {
+			commit.LocalBalance -= feeMSat
+			commit.RemoteBalance += feeMSat
+		}
+		state.InitialLocalBalance -= feeMSat
+		state.InitialRemoteBalance += feeMSat
+	} else {
+		for _, commit := range commits {
+			commit.LocalBalance += feeMSat
+			commit.RemoteBalance -= feeMSat
+		}
+		state.InitialLocalBalance += feeMSat
+		state.InitialRemoteBalance -= feeMSat
+	}
+
+	return nil
+}
+
+// ProcessInteractiveTx binds the funding transaction that was constructed
+// interactively with the remote party to the reservation of a dual-funded
+// channel. If we're the initiator, our signature for the remote party's
+// commitment is available via OurSignatures once this method returns. The
+// responder continues with CompleteReservationSingle instead.
+func (r *ChannelReservation) ProcessInteractiveTx(fundingTx *wire.MsgTx,
+	chanPoint wire.OutPoint) error {
+
+	r.Lock()
+	intent, ok := r.fundingIntent.(*chanfunding.InteractiveIntent)
+	if !ok {
+		r.Unlock()
+
+		return fmt.Errorf("funding transaction can only be " +
+			"constructed interactively for dual-funded channels")
+	}
+
+	r.fundingTx = fundingTx
+	r.partialState.FundingOutpoint = chanPoint
+	intent.BindChanPoint(chanPoint)
+	initiator := r.partialState.IsInitiator
+	r.Unlock()
+
+	if !initiator {
+		return nil
+	}
+
+	errChan := make(chan error, 1)
+	r.wallet.msgChan <- &continueContributionMsg{
+		pendingFundingID: r.reservationID,
+		err:              errChan,
+	}
+
+	return <-errChan
+}
+
 // RemoteCanceled informs the PSBT funding state machine that the remote peer
 // has canceled the pending reservation, likely due to a timeout.
 func (r *ChannelReservation) RemoteCanceled() {
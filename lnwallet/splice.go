@@ -0,0 +1,448 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// ErrSpliceUnsupported is returned when a splice is attempted for a
+	// channel type that doesn't support splicing.
+	ErrSpliceUnsupported = errors.New("splicing is not supported for " +
+		"this channel type")
+
+	// ErrSpliceChannelNotClean is returned when a splice is attempted for
+	// a channel that has active HTLCs or pending updates.
+	ErrSpliceChannelNotClean = errors.New("channel must not have any " +
+		"HTLCs or pending updates to be spliced")
+)
+
+// SpliceCommitments holds the commitment transactions of both parties that
+// spend the funding output created by a splice transaction. They are created
+// at the current commitment heights and only differ from the current
+// commitments in the funding output they spend and the balances.
+type SpliceCommitments struct {
+	// FundingOutpoint is the funding outpoint created by the splice
+	// transaction.
+	FundingOutpoint wire.OutPoint
+
+	// FundingOutput is the funding output created by the splice
+	// transaction.
+	FundingOutput wire.TxOut
+
+	// LocalCommitment is our commitment spending the new funding output.
+	LocalCommitment channeldb.ChannelCommitment
+
+	// RemoteCommitment is the remote party's commitment spending the new
+	// funding output.
+	RemoteCommitment channeldb.ChannelCommitment
+
+	// Params are the new parameters of the channel the commitments were
+	// created with, if they differ from the current ones.
+	Params fn.Option[channeldb.ChannelParams]
+}
+
+// Capacity returns the capacity of the channel after the splice.
+func (s *SpliceCommitments) Capacity() btcutil.Amount {
+	return btcutil.Amount(s.FundingOutput.Value)
+}
+
+// PendingSplice returns the splice to persist once both commitments have been
+// signed.
+func (s *SpliceCommitments) PendingSplice(spliceTx *wire.MsgTx,
+	broadcastHeight uint32) *channeldb.PendingSplice {
+
+	return &channeldb.PendingSplice{
+		SpliceTx:         spliceTx,
+		FundingOutpoint:  s.FundingOutpoint,
+		Capacity:         s.Capacity(),
+		BroadcastHeight:  broadcastHeight,
+		LocalCommitment:  s.LocalCommitment,
+		RemoteCommitment: s.RemoteCommitment,
+		Params:           s.Params,
+	}
+}
+
+// SpliceFundingOutput returns the funding output of the channel if its
+// capacity was changed to the given amount. As the funding keys of both
+// parties stay the same, only the value of the output changes.
+func (lc *LightningChannel) SpliceFundingOutput(
+	capacity btcutil.Amount) (wire.TxOut, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if lc.channelState.ChanType.IsTaproot() {
+		return wire.TxOut{}, ErrSpliceUnsupported
+	}
+
+	return wire.TxOut{
+		PkScript: lc.fundingOutput.PkScript,
+		Value:    int64(capacity),
+	}, nil
+}
+
+// NewSpliceCommitments creates the commitments of both parties that spend the
+// given funding output of a splice transaction. The balances of both parties
+// are adjusted by their contribution to the splice, which is negative if funds
+// are spliced out of the channel. The channel must not have any HTLCs or
+// pending updates, which the caller ensures by quiescing the channel first.
+func (lc *LightningChannel) NewSpliceCommitments(fundingOutpoint wire.OutPoint,
+	fundingOutput wire.TxOut, localContribution,
+	remoteContribution btcutil.Amount) (*SpliceCommitments, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	chanState := lc.channelState
+	if chanState.ChanType.IsTaproot() {
+		return nil, ErrSpliceUnsupported
+	}
+
+	if !lc.isChannelClean() {
+		return nil, ErrSpliceChannelNotClean
+	}
+
+	capacity := chanState.Capacity + localContribution + remoteContribution
+	if btcutil.Amount(fundingOutput.Value) != capacity {
+		return nil, fmt.Errorf("splice funding output value %v "+
+			"doesn't match new capacity %v",
+			btcutil.Amount(fundingOutput.Value), capacity)
+	}
+
+	// Both parties must still be able to pay for their reserve after the
+	// splice.
+	localCommit := chanState.LocalCommitment
+	ourBalance := localCommit.LocalBalance.ToSatoshis() + localContribution
+	theirBalance := localCommit.RemoteBalance.ToSatoshis() +
+		remoteContribution
+
+	if ourBalance < chanState.LocalChanCfg.ChanReserve {
+		return nil, fmt.Errorf("%w: local balance %v after splice",
+			ErrBelowChanReserve, ourBalance)
+	}
+	if theirBalance < chanState.RemoteChanCfg.ChanReserve {
+		return nil, fmt.Errorf("%w: remote balance %v after splice",
+			ErrBelowChanReserve, theirBalance)
+	}
+
+	return lc.newSpliceCommitments(
+		fundingOutpoint, fundingOutput, localContribution,
+		remoteContribution, chanState.Params(),
+	)
+}
+
+// newSpliceCommitments creates the commitments of both parties that spend the
+// given funding output with the given channel parameters. The balances of both
+// parties are adjusted by the given amounts.
+//
+// NOTE: This method requires the channel lock to be held.
+func (lc *LightningChannel) newSpliceCommitments(fundingOutpoint wire.OutPoint,
+	fundingOutput wire.TxOut, localDelta, remoteDelta btcutil.Amount,
+	params channeldb.ChannelParams) (*SpliceCommitments, error) {
+
+	chanState := lc.channelState
+	localCfg, remoteCfg := paramsChanConfigs(chanState, params)
+
+	splice := &SpliceCommitments{
+		FundingOutpoint: fundingOutpoint,
+		FundingOutput:   fundingOutput,
+	}
+	if params != chanState.Params() {
+		splice.Params = fn.Some(params)
+	}
+
+	// Our commitment is created at our current height, so we re-derive
+	// the commitment point of that height.
+	localCommit := chanState.LocalCommitment
+	commitSecret, err := chanState.RevocationProducer.AtIndex(
+		localCommit.CommitHeight,
+	)
+	if err != nil {
+		return nil, err
+	}
+	localKeyRing := DeriveCommitmentKeys(
+		input.ComputeCommitmentPoint(commitSecret[:]), lntypes.Local,
+		params.ChanType, localCfg, remoteCfg,
+	)
+	splice.LocalCommitment, err = lc.spliceCommitment(
+		lntypes.Local, localCommit, fundingOutpoint, localKeyRing,
+		params, localDelta, remoteDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteKeyRing := DeriveCommitmentKeys(
+		chanState.RemoteCurrentRevocation, lntypes.Remote,
+		params.ChanType, localCfg, remoteCfg,
+	)
+	splice.RemoteCommitment, err = lc.spliceCommitment(
+		lntypes.Remote, chanState.RemoteCommitment, fundingOutpoint,
+		remoteKeyRing, params, localDelta, remoteDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return splice, nil
+}
+
+// paramsChanConfigs returns the channel configs of both parties with the given
+// channel parameters applied.
+func paramsChanConfigs(chanState *channeldb.OpenChannel,
+	params channeldb.ChannelParams) (*channeldb.ChannelConfig,
+	*channeldb.ChannelConfig) {
+
+	localCfg := chanState.LocalChanCfg
+	localCfg.ChannelStateBounds = params.LocalBounds
+	localCfg.CommitmentParams = params.LocalCommitParams
+
+	remoteCfg := chanState.RemoteChanCfg
+	remoteCfg.ChannelStateBounds = params.RemoteBounds
+	remoteCfg.CommitmentParams = params.RemoteCommitParams
+
+	return &localCfg, &remoteCfg
+}
+
+// spliceCommitment creates a copy of the given commitment that spends the new
+// funding outpoint with the given channel parameters, and the balances
+// adjusted by the given amounts.
+func (lc *LightningChannel) spliceCommitment(whoseCommit lntypes.ChannelParty,
+	commit channeldb.ChannelCommitment, fundingOutpoint wire.OutPoint,
+	keyRing *CommitmentKeyRing, params channeldb.ChannelParams,
+	localDelta, remoteDelta btcutil.Amount) (channeldb.ChannelCommitment,
+	error) {
+
+	chanState := lc.channelState
+	localCfg, remoteCfg := paramsChanConfigs(chanState, params)
+
+	// If the channel type changes, the weight of the commitment may
+	// change as well. The difference in fees is settled with the
+	// initiator of the channel, who pays for the commitment.
+	if params.ChanType != chanState.ChanType {
+		feeRate := chainfee.SatPerKWeight(commit.FeePerKw)
+		commitFee := feeRate.FeeForWeight(CommitWeight(params.ChanType))
+		feeDelta := commit.CommitFee - commitFee
+		if chanState.IsInitiator {
+			localDelta += feeDelta
+		} else {
+			remoteDelta += feeDelta
+		}
+		commit.CommitFee = commitFee
+	}
+
+	ourBalance := commit.LocalBalance.ToSatoshis() + localDelta
+	theirBalance := commit.RemoteBalance.ToSatoshis() + remoteDelta
+	if ourBalance < 0 || theirBalance < 0 {
+		return channeldb.ChannelCommitment{}, fmt.Errorf("%w: "+
+			"negative balance after splice", ErrBelowChanReserve)
+	}
+
+	var leaseExpiry uint32
+	if params.ChanType.HasLeaseExpiration() {
+		leaseExpiry = chanState.ThawHeight
+	}
+
+	fundingTxIn := *wire.NewTxIn(&fundingOutpoint, nil, nil)
+
+	var (
+		commitTx *wire.MsgTx
+		err      error
+	)
+	if whoseCommit.IsLocal() {
+		commitTx, err = CreateCommitTx(
+			params.ChanType, fundingTxIn, keyRing, localCfg,
+			remoteCfg, ourBalance, theirBalance, 0,
+			chanState.IsInitiator, leaseExpiry,
+			fn.None[CommitAuxLeaves](),
+		)
+	} else {
+		commitTx, err = CreateCommitTx(
+			params.ChanType, fundingTxIn, keyRing, remoteCfg,
+			localCfg, theirBalance, ourBalance, 0,
+			!chanState.IsInitiator, leaseExpiry,
+			fn.None[CommitAuxLeaves](),
+		)
+	}
+	if err != nil {
+		return channeldb.ChannelCommitment{}, err
+	}
+
+	err = SetStateNumHint(
+		commitTx, commit.CommitHeight, lc.commitBuilder.obfuscator,
+	)
+	if err != nil {
+		return channeldb.ChannelCommitment{}, err
+	}
+
+	// Without HTLCs, all outputs have a zero CLTV.
+	InPlaceCommitSort(commitTx, make([]uint32, len(commitTx.TxOut)))
+
+	err = blockchain.CheckTransactionSanity(btcutil.NewTx(commitTx))
+	if err != nil {
+		return channeldb.ChannelCommitment{}, err
+	}
+
+	// The balances are adjusted in msat to carry over any sub-satoshi
+	// amounts of the current commitment.
+	commit.CommitTx = commitTx
+	commit.CommitSig = nil
+	commit.LocalBalance = addContribution(commit.LocalBalance, localDelta)
+	commit.RemoteBalance = addContribution(
+		commit.RemoteBalance, remoteDelta,
+	)
+
+	return commit, nil
+}
+
+// addContribution adds the possibly negative splice contribution to the given
+// balance.
+func addContribution(balance lnwire.MilliSatoshi,
+	contribution btcutil.Amount) lnwire.MilliSatoshi {
+
+	if contribution < 0 {
+		return balance - lnwire.NewMSatFromSatoshis(-contribution)
+	}
+
+	return balance + lnwire.NewMSatFromSatoshis(contribution)
+}
+
+// SignSpliceCommitment signs the remote party's commitment of the splice.
+func (lc *LightningChannel) SignSpliceCommitment(
+	splice *SpliceCommitments) (lnwire.Sig, error) {
+
+	lc.Lock()
+	defer lc.Unlock()
+
+	commitTx := splice.RemoteCommitment.CommitTx
+	signDesc := lc.spliceSignDesc(splice, commitTx)
+
+	rawSig, err := lc.Signer.SignOutputRaw(commitTx, signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	sig, err := lnwire.NewSigFromSignature(rawSig)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	splice.RemoteCommitment.CommitSig = sig.ToSignatureBytes()
+
+	return sig, nil
+}
+
+// ReceiveSpliceCommitment verifies the remote party's signature for our
+// commitment of the splice.
+func (lc *LightningChannel) ReceiveSpliceCommitment(splice *SpliceCommitments,
+	sig lnwire.Sig) error {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	commitTx := splice.LocalCommitment.CommitTx
+	signDesc := lc.spliceSignDesc(splice, commitTx)
+
+	sigHash, err := txscript.CalcWitnessSigHash(
+		signDesc.WitnessScript, signDesc.SigHashes,
+		txscript.SigHashAll, commitTx, 0, splice.FundingOutput.Value,
+	)
+	if err != nil {
+		return err
+	}
+
+	cSig, err := sig.ToSignature()
+	if err != nil {
+		return err
+	}
+
+	verifyKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+	if !cSig.Verify(sigHash, verifyKey) {
+		return fmt.Errorf("invalid splice commitment signature for "+
+			"ChannelPoint(%v)", lc.channelState.FundingOutpoint)
+	}
+
+	splice.LocalCommitment.CommitSig = sig.ToSignatureBytes()
+
+	return nil
+}
+
+// spliceSignDesc returns the sign descriptor for a commitment transaction
+// spending the funding output of the splice.
+func (lc *LightningChannel) spliceSignDesc(splice *SpliceCommitments,
+	commitTx *wire.MsgTx) *input.SignDescriptor {
+
+	return &input.SignDescriptor{
+		KeyDesc:       lc.channelState.LocalChanCfg.MultiSigKey,
+		WitnessScript: lc.signDesc.WitnessScript,
+		Output:        &splice.FundingOutput,
+		HashType:      txscript.SigHashAll,
+		SigHashes:     input.NewTxSigHashesV0Only(commitTx),
+		InputIndex:    0,
+	}
+}
+
+// SignFundingInput signs the input of the given transaction that spends the
+// current funding output of the channel, which is the shared input of a
+// splice transaction.
+func (lc *LightningChannel) SignFundingInput(tx *wire.MsgTx,
+	inputIndex int) (lnwire.Sig, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	if lc.channelState.ChanType.IsTaproot() {
+		return lnwire.Sig{}, ErrSpliceUnsupported
+	}
+
+	// The funding output is a P2WSH output, so its sighash only commits
+	// to the value of the output itself.
+	signDesc := *lc.signDesc
+	signDesc.InputIndex = inputIndex
+	signDesc.SigHashes = input.NewTxSigHashesV0Only(tx)
+
+	rawSig, err := lc.Signer.SignOutputRaw(tx, &signDesc)
+	if err != nil {
+		return lnwire.Sig{}, err
+	}
+
+	return lnwire.NewSigFromSignature(rawSig)
+}
+
+// FundingInputWitness returns the witness that spends the current funding
+// output of the channel given the signatures of both parties.
+func (lc *LightningChannel) FundingInputWitness(localSig,
+	remoteSig lnwire.Sig) (wire.TxWitness, error) {
+
+	lc.RLock()
+	defer lc.RUnlock()
+
+	ourSig, err := localSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+	theirSig, err := remoteSig.ToSignature()
+	if err != nil {
+		return nil, err
+	}
+
+	ourKey := lc.channelState.LocalChanCfg.MultiSigKey.PubKey
+	theirKey := lc.channelState.RemoteChanCfg.MultiSigKey.PubKey
+
+	return input.SpendMultiSig(
+		lc.signDesc.WitnessScript, ourKey.SerializeCompressed(), ourSig,
+		theirKey.SerializeCompressed(), theirSig,
+	), nil
+}
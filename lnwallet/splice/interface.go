@@ -0,0 +1,82 @@
+package splice
+
+import (
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Channel abstracts the methods of a channel state machine that are needed to
+// splice a channel.
+//
+//nolint:interfacebloat
+type Channel interface {
+	// ChannelPoint returns the original funding outpoint of the channel,
+	// which identifies the channel also after it was spliced.
+	ChannelPoint() wire.OutPoint
+
+	// State returns the persistent state of the channel.
+	State() *channeldb.OpenChannel
+
+	// FundingTxOut returns the current funding output of the channel.
+	FundingTxOut() *wire.TxOut
+
+	// SpliceFundingOutput returns the funding output of the channel if
+	// its capacity was changed to the given amount.
+	SpliceFundingOutput(capacity btcutil.Amount) (wire.TxOut, error)
+
+	// NewSpliceCommitments creates the commitments of both parties that
+	// spend the given funding output of a splice transaction.
+	NewSpliceCommitments(fundingOutpoint wire.OutPoint,
+		fundingOutput wire.TxOut, localContribution,
+		remoteContribution btcutil.Amount) (
+		*lnwallet.SpliceCommitments, error)
+
+	// SignSpliceCommitment signs the remote party's commitment of the
+	// splice.
+	SignSpliceCommitment(
+		splice *lnwallet.SpliceCommitments) (lnwire.Sig, error)
+
+	// ReceiveSpliceCommitment verifies the remote party's signature for
+	// our commitment of the splice.
+	ReceiveSpliceCommitment(splice *lnwallet.SpliceCommitments,
+		sig lnwire.Sig) error
+
+	// SignFundingInput signs the input of the given transaction that
+	// spends the current funding output of the channel.
+	SignFundingInput(tx *wire.MsgTx, inputIndex int) (lnwire.Sig, error)
+
+	// FundingInputWitness returns the witness that spends the current
+	// funding output of the channel given the signatures of both parties.
+	FundingInputWitness(localSig,
+		remoteSig lnwire.Sig) (wire.TxWitness, error)
+}
+
+// Wallet abstracts the wallet methods that are needed to add funds to or
+// remove funds from a channel.
+type Wallet interface {
+	// FundInteractiveTx selects and leases wallet coins that add the given
+	// amount to a channel and pay for the fees of the splice transaction.
+	FundInteractiveTx(amt btcutil.Amount, feeRate chainfee.SatPerKWeight,
+		weight input.TxWeightEstimator) (*lnwallet.InteractiveFunding,
+		error)
+
+	// ReleaseInteractiveInputs releases the wallet inputs that were
+	// leased for a splice that didn't complete.
+	ReleaseInteractiveInputs(inputs []*interactivetx.Input)
+
+	// SignInteractiveInputs signs the wallet inputs of the splice
+	// transaction at the given indexes.
+	SignInteractiveInputs(tx *wire.MsgTx,
+		prevOutFetcher txscript.PrevOutputFetcher,
+		indexes []int) ([]wire.TxWitness, error)
+
+	// PublishTransaction broadcasts the given transaction.
+	PublishTransaction(tx *wire.MsgTx, label string) error
+}
@@ -0,0 +1,903 @@
+package splice
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/labels"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+var (
+	// ErrSpliceAborted is returned when the remote party aborted the
+	// splice.
+	ErrSpliceAborted = errors.New("splice aborted by remote party")
+
+	// ErrSpliceSigned is returned when a splice is aborted after both
+	// parties signed the commitments spending the new funding output.
+	ErrSpliceSigned = errors.New("splice can't be aborted after the " +
+		"commitments were signed")
+
+	// ErrUnexpectedMessage is returned when a message is received that
+	// isn't expected in the current state of the splice.
+	ErrUnexpectedMessage = errors.New("unexpected splice message")
+)
+
+// Request describes a splice that is initiated by the local party.
+type Request struct {
+	// Amount is the amount that is added to the channel. A negative
+	// amount splices funds out of the channel.
+	Amount btcutil.Amount
+
+	// FeeRate is the fee rate of the splice transaction. The fees are
+	// paid by the initiator of the splice.
+	FeeRate chainfee.SatPerKWeight
+
+	// DeliveryScript is the script that receives the funds spliced out
+	// of the channel.
+	DeliveryScript lnwire.DeliveryAddress
+}
+
+// Config holds the parameters of a splice negotiation.
+type Config struct {
+	// Channel is the channel that is spliced. It must be quiescent for
+	// the whole negotiation.
+	Channel Channel
+
+	// Wallet is used to fund and sign our contribution to the splice.
+	Wallet Wallet
+
+	// Request is the splice we initiate. It is None if the remote party
+	// initiates the splice.
+	Request fn.Option[Request]
+
+	// LocalNodeKey is the identity public key of our node.
+	LocalNodeKey *btcec.PublicKey
+
+	// BestHeight is the current height of the chain, which is used as
+	// the locktime of the splice transaction.
+	BestHeight uint32
+
+	// SendMessage sends a message to the remote party.
+	SendMessage func(lnwire.Message) error
+}
+
+// negotiationState is the state of a splice negotiation.
+type negotiationState uint8
+
+const (
+	// stateInit is the state before the splice was proposed.
+	stateInit negotiationState = iota
+
+	// stateAwaitingAck is the state after the initiator sent splice_init.
+	stateAwaitingAck
+
+	// stateConstructing is the state while the splice transaction is
+	// constructed interactively.
+	stateConstructing
+
+	// stateAwaitingCommitSig is the state after we signed the remote
+	// party's commitment spending the new funding output.
+	stateAwaitingCommitSig
+
+	// stateAwaitingTxSigs is the state after both commitments were
+	// signed, while we wait for the remote party's signatures for the
+	// splice transaction.
+	stateAwaitingTxSigs
+
+	// stateBroadcast is the state after the fully signed splice
+	// transaction was broadcast.
+	stateBroadcast
+
+	// stateLocked is the state after the splice transaction confirmed and
+	// we sent splice_locked.
+	stateLocked
+)
+
+// Negotiator drives the negotiation of a splice of a quiescent channel with
+// the remote party. The initiator of the splice proposes its contribution
+// with splice_init, after which both parties construct the splice transaction
+// interactively, sign the commitments spending the new funding output and
+// finally exchange their signatures for the splice transaction.
+//
+// NOTE: The Negotiator isn't safe for concurrent use.
+type Negotiator struct {
+	cfg Config
+
+	chanID lnwire.ChannelID
+	state  negotiationState
+
+	localContribution  btcutil.Amount
+	remoteContribution btcutil.Amount
+
+	// funding holds the wallet inputs that fund a splice-in we initiated.
+	funding *lnwallet.InteractiveFunding
+
+	session     *interactivetx.Session
+	constructed *interactivetx.ConstructedTx
+	commits     *lnwallet.SpliceCommitments
+
+	// pending is the persisted splice, which is set once both parties
+	// signed the commitments spending the new funding output.
+	pending *channeldb.PendingSplice
+
+	// prevOuts holds the outputs spent by the splice transaction. It is
+	// nil if the negotiation was resumed after a restart.
+	prevOuts txscript.PrevOutputFetcher
+
+	// sharedIdx, localIdx and remoteIdx are the indexes of the shared
+	// input and the inputs of both parties in the splice transaction.
+	sharedIdx int
+	localIdx  []int
+	remoteIdx []int
+
+	sentTxSigs bool
+}
+
+// NewNegotiator creates a new splice negotiation for the given channel.
+func NewNegotiator(cfg Config) *Negotiator {
+	chanPoint := cfg.Channel.ChannelPoint()
+
+	return &Negotiator{
+		cfg:    cfg,
+		chanID: lnwire.NewChanIDFromOutPoint(chanPoint),
+	}
+}
+
+// ResumeNegotiator resumes the negotiation of a splice that was signed by
+// both parties before a restart. Only the inputs we signed already carry a
+// witness in the persisted transaction, which allows us to tell our inputs
+// apart from the remote party's.
+func ResumeNegotiator(cfg Config,
+	pending *channeldb.PendingSplice) (*Negotiator, error) {
+
+	n := NewNegotiator(cfg)
+	n.pending = pending
+
+	sharedOutpoint := cfg.Channel.State().CurrentFundingOutpoint()
+
+	foundShared := false
+	for i, txIn := range pending.SpliceTx.TxIn {
+		switch {
+		case txIn.PreviousOutPoint == sharedOutpoint:
+			n.sharedIdx = i
+			foundShared = true
+
+		case len(txIn.Witness) > 0:
+			n.localIdx = append(n.localIdx, i)
+
+		default:
+			n.remoteIdx = append(n.remoteIdx, i)
+		}
+	}
+	if !foundShared {
+		return nil, fmt.Errorf("splice transaction %v doesn't spend "+
+			"funding outpoint %v", pending.SpliceTx.TxHash(),
+			sharedOutpoint)
+	}
+
+	n.state = stateAwaitingTxSigs
+	if len(pending.SpliceTx.TxIn[n.sharedIdx].Witness) > 0 {
+		n.state = stateBroadcast
+	}
+
+	return n, nil
+}
+
+// Start starts the negotiation. The initiator proposes the splice to the
+// remote party. If the negotiation was resumed, our signatures for the splice
+// transaction are sent again, or the fully signed transaction is broadcast.
+func (n *Negotiator) Start() error {
+	switch n.state {
+	case stateAwaitingTxSigs:
+		return n.sendTxSignatures()
+
+	case stateBroadcast:
+		n.publish()
+
+		return nil
+
+	case stateInit:
+
+	default:
+		return fmt.Errorf("splice negotiation already started")
+	}
+
+	req, err := n.cfg.Request.UnwrapOrErr(
+		errors.New("only the initiator can start a splice"),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := n.fundContribution(req); err != nil {
+		return err
+	}
+
+	log.Infof("ChannelPoint(%v): proposing splice with contribution "+
+		"%v at fee rate %v", n.cfg.Channel.ChannelPoint(),
+		n.localContribution, req.FeeRate)
+
+	n.state = stateAwaitingAck
+
+	return n.cfg.SendMessage(&lnwire.SpliceInit{
+		ChannelID:           n.chanID,
+		FundingContribution: n.localContribution,
+		FundingFeePerKw:     uint32(req.FeeRate),
+		LockTime:            n.cfg.BestHeight,
+		FundingPubKey:       n.localFundingKey(),
+	})
+}
+
+// fundContribution determines our contribution to the splice we initiate. A
+// splice-in is funded by wallet coins, which also pay for the fees. The fees of
+// a splice-out are paid from our channel balance.
+func (n *Negotiator) fundContribution(req Request) error {
+	// The initiator pays for the shared input and output, along with its
+	// own inputs and outputs.
+	var weight input.TxWeightEstimator
+	weight.AddWitnessInput(input.MultiSigWitnessSize)
+	weight.AddP2WSHOutput()
+
+	switch {
+	case req.Amount > 0:
+		funding, err := n.cfg.Wallet.FundInteractiveTx(
+			req.Amount, req.FeeRate, weight,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to fund splice: %w", err)
+		}
+
+		n.funding = funding
+		n.localContribution = req.Amount
+
+	case req.Amount < 0:
+		if len(req.DeliveryScript) == 0 {
+			return fmt.Errorf("delivery script required to " +
+				"splice out")
+		}
+
+		dustLimit := lnwallet.DustLimitForSize(len(req.DeliveryScript))
+		if -req.Amount < dustLimit {
+			return fmt.Errorf("splice out amount %v below dust "+
+				"limit %v", -req.Amount, dustLimit)
+		}
+
+		weight.AddOutput(req.DeliveryScript)
+		fee := req.FeeRate.FeeForWeight(weight.Weight())
+
+		n.localContribution = req.Amount - fee
+
+	default:
+		return fmt.Errorf("splice amount must not be zero")
+	}
+
+	return nil
+}
+
+// ProcessMessage processes a splice message of the remote party. If an error
+// is returned, the caller should abort the splice if possible.
+func (n *Negotiator) ProcessMessage(msg lnwire.Message) error {
+	switch msg := msg.(type) {
+	case *lnwire.SpliceInit:
+		return n.receiveSpliceInit(msg)
+
+	case *lnwire.SpliceAck:
+		return n.receiveSpliceAck(msg)
+
+	case *lnwire.TxAddInput, *lnwire.TxAddOutput, *lnwire.TxRemoveInput,
+		*lnwire.TxRemoveOutput, *lnwire.TxComplete:
+
+		return n.receiveConstructionMsg(msg)
+
+	case *lnwire.TxAbort:
+		return n.receiveTxAbort(msg)
+
+	case *lnwire.CommitSig:
+		return n.receiveCommitSig(msg)
+
+	case *lnwire.TxSignatures:
+		return n.receiveTxSignatures(msg)
+
+	case *lnwire.SpliceLocked:
+		return n.receiveSpliceLocked(msg)
+
+	default:
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+}
+
+// receiveSpliceInit handles the splice proposal of the remote party. We
+// accept the splice without contributing to it.
+func (n *Negotiator) receiveSpliceInit(msg *lnwire.SpliceInit) error {
+	if n.state != stateInit || n.cfg.Request.IsSome() {
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	if msg.FundingFeePerKw < uint32(chainfee.FeePerKwFloor) {
+		return fmt.Errorf("splice fee rate %v below minimum %v",
+			chainfee.SatPerKWeight(msg.FundingFeePerKw),
+			chainfee.FeePerKwFloor)
+	}
+
+	if err := n.checkRemoteFundingKey(msg.FundingPubKey); err != nil {
+		return err
+	}
+
+	capacity := n.cfg.Channel.State().Capacity + msg.FundingContribution
+	if capacity <= 0 {
+		return fmt.Errorf("splice contribution %v exceeds channel "+
+			"capacity", msg.FundingContribution)
+	}
+
+	log.Infof("ChannelPoint(%v): accepting splice with remote "+
+		"contribution %v", n.cfg.Channel.ChannelPoint(),
+		msg.FundingContribution)
+
+	n.remoteContribution = msg.FundingContribution
+	n.session = interactivetx.NewSession(interactivetx.Config{
+		ChanID:      n.chanID,
+		LockTime:    msg.LockTime,
+		SharedInput: fn.Some(n.sharedInput()),
+	})
+	n.state = stateConstructing
+
+	return n.cfg.SendMessage(&lnwire.SpliceAck{
+		ChannelID:     n.chanID,
+		FundingPubKey: n.localFundingKey(),
+	})
+}
+
+// receiveSpliceAck handles the remote party's acceptance of the splice we
+// proposed, and starts the construction of the splice transaction.
+func (n *Negotiator) receiveSpliceAck(msg *lnwire.SpliceAck) error {
+	if n.state != stateAwaitingAck {
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	// TODO(splice): allow the acceptor to contribute to the splice.
+	if msg.FundingContribution != 0 {
+		return fmt.Errorf("contributions of the splice acceptor are " +
+			"not supported")
+	}
+
+	if err := n.checkRemoteFundingKey(msg.FundingPubKey); err != nil {
+		return err
+	}
+
+	fundingOutput, err := n.newFundingOutput()
+	if err != nil {
+		return err
+	}
+
+	var (
+		localInputs  []*interactivetx.Input
+		localOutputs = []*interactivetx.Output{{
+			TxOut: &fundingOutput,
+		}}
+	)
+	if n.funding != nil {
+		localInputs = n.funding.Inputs
+		n.funding.Change.WhenSome(func(change wire.TxOut) {
+			localOutputs = append(
+				localOutputs, &interactivetx.Output{
+					TxOut: &change,
+				},
+			)
+		})
+	}
+
+	req := n.cfg.Request.UnsafeFromSome()
+	if req.Amount < 0 {
+		localOutputs = append(localOutputs, &interactivetx.Output{
+			TxOut: &wire.TxOut{
+				Value:    int64(-req.Amount),
+				PkScript: req.DeliveryScript,
+			},
+		})
+	}
+
+	n.session = interactivetx.NewSession(interactivetx.Config{
+		ChanID:       n.chanID,
+		Initiator:    true,
+		LockTime:     n.cfg.BestHeight,
+		SharedInput:  fn.Some(n.sharedInput()),
+		LocalInputs:  localInputs,
+		LocalOutputs: localOutputs,
+	})
+	n.state = stateConstructing
+
+	first, err := n.session.Start()
+	if err != nil {
+		return err
+	}
+
+	return n.cfg.SendMessage(first)
+}
+
+// receiveConstructionMsg hands a message that constructs the splice
+// transaction to the construction session.
+func (n *Negotiator) receiveConstructionMsg(msg lnwire.Message) error {
+	if n.state != stateConstructing {
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	reply, err := n.session.ReceiveMessage(msg)
+	if err != nil {
+		return err
+	}
+
+	if err := fn.MapOptionZ(reply, n.cfg.SendMessage); err != nil {
+		return err
+	}
+
+	if !n.session.Complete() {
+		return nil
+	}
+
+	return n.signCommitment()
+}
+
+// signCommitment creates the commitments spending the funding output of the
+// constructed splice transaction, and sends our signature for the remote
+// party's commitment.
+func (n *Negotiator) signCommitment() error {
+	constructed, err := n.session.Tx()
+	if err != nil {
+		return err
+	}
+
+	fundingOutput, err := n.newFundingOutput()
+	if err != nil {
+		return err
+	}
+
+	// Exactly one output must pay to the funding script with the value
+	// both parties agreed on.
+	fundingIdx := -1
+	for i, txOut := range constructed.Tx.TxOut {
+		if !bytes.Equal(txOut.PkScript, fundingOutput.PkScript) {
+			continue
+		}
+
+		if fundingIdx != -1 {
+			return fmt.Errorf("splice transaction has multiple " +
+				"funding outputs")
+		}
+		fundingIdx = i
+	}
+	if fundingIdx == -1 {
+		return fmt.Errorf("splice transaction has no funding output")
+	}
+
+	if constructed.Tx.TxOut[fundingIdx].Value != fundingOutput.Value {
+		return fmt.Errorf("splice funding output has value %v, "+
+			"expected %v",
+			btcutil.Amount(constructed.Tx.TxOut[fundingIdx].Value),
+			btcutil.Amount(fundingOutput.Value))
+	}
+
+	sharedIdx, err := constructed.SharedInputIndex().UnwrapOrErr(
+		errors.New("splice transaction has no shared input"),
+	)
+	if err != nil {
+		return err
+	}
+
+	fundingOutpoint := wire.OutPoint{
+		Hash:  constructed.Tx.TxHash(),
+		Index: uint32(fundingIdx),
+	}
+	commits, err := n.cfg.Channel.NewSpliceCommitments(
+		fundingOutpoint, fundingOutput, n.localContribution,
+		n.remoteContribution,
+	)
+	if err != nil {
+		return err
+	}
+
+	sig, err := n.cfg.Channel.SignSpliceCommitment(commits)
+	if err != nil {
+		return err
+	}
+
+	n.constructed = constructed
+	n.commits = commits
+	n.sharedIdx = sharedIdx
+	n.state = stateAwaitingCommitSig
+
+	return n.cfg.SendMessage(&lnwire.CommitSig{
+		ChanID:    n.chanID,
+		CommitSig: sig,
+	})
+}
+
+// receiveCommitSig verifies the remote party's signature for our commitment
+// spending the new funding output. Once it's valid, the splice is persisted
+// and the signatures for the splice transaction are exchanged.
+func (n *Negotiator) receiveCommitSig(msg *lnwire.CommitSig) error {
+	if n.state != stateAwaitingCommitSig {
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	if len(msg.HtlcSigs) != 0 {
+		return fmt.Errorf("splice commitment signed with %d HTLC "+
+			"signatures, expected none", len(msg.HtlcSigs))
+	}
+
+	err := n.cfg.Channel.ReceiveSpliceCommitment(n.commits, msg.CommitSig)
+	if err != nil {
+		return err
+	}
+
+	tx := n.constructed.Tx
+	_, n.localIdx = n.constructed.PartyInputs(lntypes.Local)
+	_, n.remoteIdx = n.constructed.PartyInputs(lntypes.Remote)
+	n.prevOuts = n.constructed.PrevOutputFetcher()
+
+	// We sign our wallet inputs right away and persist the witnesses
+	// along with the splice, so we're able to send them again after a
+	// restart.
+	witnesses, err := n.cfg.Wallet.SignInteractiveInputs(
+		tx, n.prevOuts, n.localIdx,
+	)
+	if err != nil {
+		return err
+	}
+	for i, idx := range n.localIdx {
+		tx.TxIn[idx].Witness = witnesses[i]
+	}
+
+	pending := n.commits.PendingSplice(tx, n.cfg.BestHeight)
+	err = n.cfg.Channel.State().StorePendingSplice(pending)
+	if err != nil {
+		return err
+	}
+
+	n.pending = pending
+	n.state = stateAwaitingTxSigs
+
+	log.Infof("ChannelPoint(%v): splice transaction %v signed by both "+
+		"parties", n.cfg.Channel.ChannelPoint(), tx.TxHash())
+
+	// The party that contributed the lower amount of inputs sends its
+	// signatures first. If both contributed the same amount, the party
+	// with the lower node key goes first.
+	localIn, _ := n.constructed.PartyAmounts(lntypes.Local)
+	remoteIn, _ := n.constructed.PartyAmounts(lntypes.Remote)
+
+	remoteNodeKey := n.cfg.Channel.State().IdentityPub
+	sendFirst := localIn < remoteIn || (localIn == remoteIn &&
+		bytes.Compare(
+			n.cfg.LocalNodeKey.SerializeCompressed(),
+			remoteNodeKey.SerializeCompressed(),
+		) < 0)
+	if !sendFirst {
+		return nil
+	}
+
+	return n.sendTxSignatures()
+}
+
+// sendTxSignatures sends our signatures for the splice transaction.
+func (n *Negotiator) sendTxSignatures() error {
+	tx := n.pending.SpliceTx
+
+	sig, err := n.cfg.Channel.SignFundingInput(tx, n.sharedIdx)
+	if err != nil {
+		return err
+	}
+
+	witnesses := make([]wire.TxWitness, 0, len(n.localIdx))
+	for _, idx := range n.localIdx {
+		witnesses = append(witnesses, tx.TxIn[idx].Witness)
+	}
+
+	n.sentTxSigs = true
+
+	return n.cfg.SendMessage(&lnwire.TxSignatures{
+		ChannelID: n.chanID,
+		TxID:      tx.TxHash(),
+		Witnesses: witnesses,
+		SharedInputSig: tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType0](sig),
+		),
+	})
+}
+
+// receiveTxSignatures completes the splice transaction with the remote
+// party's signatures and broadcasts it.
+func (n *Negotiator) receiveTxSignatures(msg *lnwire.TxSignatures) error {
+	switch n.state {
+	case stateAwaitingTxSigs:
+
+	// The remote party may send its signatures again after a restart,
+	// which we don't need anymore.
+	case stateBroadcast, stateLocked:
+		log.Debugf("ChannelPoint(%v): ignoring tx_signatures for "+
+			"fully signed splice", n.cfg.Channel.ChannelPoint())
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	tx := n.pending.SpliceTx
+	if msg.TxID != tx.TxHash() {
+		return fmt.Errorf("tx_signatures for unknown transaction %v",
+			msg.TxID)
+	}
+
+	if len(msg.Witnesses) != len(n.remoteIdx) {
+		return fmt.Errorf("received %d witnesses, expected %d",
+			len(msg.Witnesses), len(n.remoteIdx))
+	}
+
+	remoteSig, err := msg.SharedInputSig.UnwrapOrErr(
+		errors.New("shared input signature missing"),
+	)
+	if err != nil {
+		return err
+	}
+
+	localSig, err := n.cfg.Channel.SignFundingInput(tx, n.sharedIdx)
+	if err != nil {
+		return err
+	}
+
+	sharedWitness, err := n.cfg.Channel.FundingInputWitness(
+		localSig, remoteSig.Val,
+	)
+	if err != nil {
+		return err
+	}
+
+	signedTx := tx.Copy()
+	for i, idx := range n.remoteIdx {
+		signedTx.TxIn[idx].Witness = msg.Witnesses[i]
+	}
+	signedTx.TxIn[n.sharedIdx].Witness = sharedWitness
+
+	if err := n.verifyTx(signedTx); err != nil {
+		return err
+	}
+
+	n.pending.SpliceTx = signedTx
+	err = n.cfg.Channel.State().StorePendingSplice(n.pending)
+	if err != nil {
+		return err
+	}
+
+	n.state = stateBroadcast
+	n.publish()
+
+	if n.sentTxSigs {
+		return nil
+	}
+
+	return n.sendTxSignatures()
+}
+
+// verifyTx verifies the witnesses of the fully signed splice transaction. If
+// the negotiation was resumed, the outputs spent by the remote party's inputs
+// are unknown, so only the shared input is verified.
+func (n *Negotiator) verifyTx(tx *wire.MsgTx) error {
+	prevOuts := n.prevOuts
+	indexes := []int{n.sharedIdx}
+	if prevOuts == nil {
+		fundingOutput := n.cfg.Channel.FundingTxOut()
+		prevOuts = txscript.NewCannedPrevOutputFetcher(
+			fundingOutput.PkScript, fundingOutput.Value,
+		)
+	} else {
+		indexes = append(indexes, n.remoteIdx...)
+	}
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOuts)
+	for _, idx := range indexes {
+		prevOut := prevOuts.FetchPrevOutput(
+			tx.TxIn[idx].PreviousOutPoint,
+		)
+		if prevOut == nil {
+			return fmt.Errorf("unknown output spent by input %d",
+				idx)
+		}
+
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, tx, idx, txscript.StandardVerifyFlags,
+			nil, sigHashes, prevOut.Value, prevOuts,
+		)
+		if err != nil {
+			return err
+		}
+		if err := vm.Execute(); err != nil {
+			return fmt.Errorf("invalid witness for input %d: %w",
+				idx, err)
+		}
+	}
+
+	return nil
+}
+
+// publish broadcasts the fully signed splice transaction. A failure is only
+// logged, as the remote party may broadcast the transaction as well.
+func (n *Negotiator) publish() {
+	tx := n.pending.SpliceTx
+	scid := n.cfg.Channel.State().ShortChannelID
+	label := labels.MakeLabel(labels.LabelTypeSpliceTransaction, &scid)
+
+	log.Infof("ChannelPoint(%v): broadcasting splice transaction %v",
+		n.cfg.Channel.ChannelPoint(), tx.TxHash())
+
+	if err := n.cfg.Wallet.PublishTransaction(tx, label); err != nil {
+		log.Errorf("ChannelPoint(%v): unable to broadcast splice "+
+			"transaction %v: %v", n.cfg.Channel.ChannelPoint(),
+			tx.TxHash(), err)
+	}
+}
+
+// receiveTxAbort handles the remote party aborting the splice. As the splice
+// is over, it doesn't need to be aborted by the caller.
+func (n *Negotiator) receiveTxAbort(msg *lnwire.TxAbort) error {
+	if n.pending != nil {
+		return fmt.Errorf("%w: %v", ErrSpliceSigned, string(msg.Data))
+	}
+
+	n.releaseInputs()
+
+	return fmt.Errorf("%w: %v", ErrSpliceAborted, string(msg.Data))
+}
+
+// receiveSpliceLocked handles the remote party's confirmation of the splice
+// transaction.
+func (n *Negotiator) receiveSpliceLocked(msg *lnwire.SpliceLocked) error {
+	if n.pending == nil {
+		return fmt.Errorf("%w: %v", ErrUnexpectedMessage,
+			msg.MsgType())
+	}
+
+	if msg.SpliceTxID != n.pending.SpliceTx.TxHash() {
+		return fmt.Errorf("splice_locked for unknown transaction %v",
+			msg.SpliceTxID)
+	}
+
+	log.Infof("ChannelPoint(%v): remote party locked splice %v",
+		n.cfg.Channel.ChannelPoint(), msg.SpliceTxID)
+
+	return nil
+}
+
+// Confirmed must be called once the splice transaction reached the number of
+// confirmations required for the channel, which is announced to the remote
+// party with splice_locked.
+func (n *Negotiator) Confirmed() error {
+	if n.pending == nil || n.state == stateLocked {
+		return fmt.Errorf("splice transaction can't be locked in " +
+			"current state")
+	}
+
+	n.state = stateLocked
+
+	return n.cfg.SendMessage(&lnwire.SpliceLocked{
+		ChannelID:  n.chanID,
+		SpliceTxID: n.pending.SpliceTx.TxHash(),
+	})
+}
+
+// Abort aborts the splice and notifies the remote party. This is only
+// possible until the commitments spending the new funding output were signed
+// by both parties.
+func (n *Negotiator) Abort(reason error) error {
+	if n.pending != nil {
+		return ErrSpliceSigned
+	}
+
+	n.releaseInputs()
+
+	log.Infof("ChannelPoint(%v): aborting splice: %v",
+		n.cfg.Channel.ChannelPoint(), reason)
+
+	return n.cfg.SendMessage(&lnwire.TxAbort{
+		ChannelID: n.chanID,
+		Data:      []byte(reason.Error()),
+	})
+}
+
+// releaseInputs releases the wallet inputs leased for a splice that was
+// aborted.
+func (n *Negotiator) releaseInputs() {
+	if n.funding == nil {
+		return
+	}
+
+	n.cfg.Wallet.ReleaseInteractiveInputs(n.funding.Inputs)
+	n.funding = nil
+}
+
+// Signed returns true once both parties signed the commitments spending the
+// new funding output, after which the splice can no longer be aborted.
+func (n *Negotiator) Signed() bool {
+	return n.pending != nil
+}
+
+// Broadcast returns true once the fully signed splice transaction was
+// broadcast.
+func (n *Negotiator) Broadcast() bool {
+	return n.state >= stateBroadcast
+}
+
+// SpliceTxID returns the txid of the splice transaction once it was signed by
+// both parties.
+func (n *Negotiator) SpliceTxID() fn.Option[chainhash.Hash] {
+	if n.pending == nil {
+		return fn.None[chainhash.Hash]()
+	}
+
+	return fn.Some(n.pending.SpliceTx.TxHash())
+}
+
+// PendingSplice returns the persisted splice once it was signed by both
+// parties.
+func (n *Negotiator) PendingSplice() fn.Option[channeldb.PendingSplice] {
+	if n.pending == nil {
+		return fn.None[channeldb.PendingSplice]()
+	}
+
+	return fn.Some(*n.pending)
+}
+
+// sharedInput returns the current funding output of the channel, which is
+// spent by the splice transaction.
+func (n *Negotiator) sharedInput() interactivetx.SharedInput {
+	return interactivetx.SharedInput{
+		OutPoint: n.cfg.Channel.State().CurrentFundingOutpoint(),
+		PrevOut:  n.cfg.Channel.FundingTxOut(),
+	}
+}
+
+// newFundingOutput returns the funding output created by the splice.
+func (n *Negotiator) newFundingOutput() (wire.TxOut, error) {
+	capacity := n.cfg.Channel.State().Capacity + n.localContribution +
+		n.remoteContribution
+
+	return n.cfg.Channel.SpliceFundingOutput(capacity)
+}
+
+// localFundingKey returns our funding key, which stays the same across
+// splices.
+func (n *Negotiator) localFundingKey() *btcec.PublicKey {
+	return n.cfg.Channel.State().LocalChanCfg.MultiSigKey.PubKey
+}
+
+// checkRemoteFundingKey makes sure the remote party keeps its funding key,
+// as rotating the funding keys isn't supported.
+func (n *Negotiator) checkRemoteFundingKey(key *btcec.PublicKey) error {
+	remoteKey := n.cfg.Channel.State().RemoteChanCfg.MultiSigKey.PubKey
+	if key == nil || !key.IsEqual(remoteKey) {
+		return fmt.Errorf("rotating the funding key in a splice is " +
+			"not supported")
+	}
+
+	return nil
+}
@@ -0,0 +1,451 @@
+package splice
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/input"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/interactivetx"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// testFeeRate is the fee rate of the splice transactions.
+	testFeeRate = chainfee.SatPerKWeight(2500)
+
+	// testChange is the change the mock wallet creates for a splice-in.
+	testChange = btcutil.Amount(50_000)
+)
+
+// mockWallet is a wallet that funds splices with a single P2WKH input.
+type mockWallet struct {
+	key      *btcec.PrivateKey
+	pkScript []byte
+
+	released  []*interactivetx.Input
+	published []*wire.MsgTx
+}
+
+func newMockWallet(t *testing.T) *mockWallet {
+	key, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	pkScript, err := input.WitnessPubKeyHash(
+		key.PubKey().SerializeCompressed(),
+	)
+	require.NoError(t, err)
+
+	return &mockWallet{
+		key:      key,
+		pkScript: pkScript,
+	}
+}
+
+func (w *mockWallet) FundInteractiveTx(amt btcutil.Amount,
+	feeRate chainfee.SatPerKWeight,
+	weight input.TxWeightEstimator) (*lnwallet.InteractiveFunding, error) {
+
+	weight.AddP2WKHInput()
+	weight.AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(weight.Weight())
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, nil))
+	prevTx.AddTxOut(wire.NewTxOut(int64(amt+fee+testChange), w.pkScript))
+
+	return &lnwallet.InteractiveFunding{
+		Inputs: []*interactivetx.Input{{
+			OutPoint: wire.OutPoint{Hash: prevTx.TxHash()},
+			PrevOut:  prevTx.TxOut[0],
+			PrevTx:   prevTx,
+			Sequence: interactivetx.MaxSequence - 1,
+		}},
+		Change: fn.Some(wire.TxOut{
+			Value:    int64(testChange),
+			PkScript: w.pkScript,
+		}),
+	}, nil
+}
+
+func (w *mockWallet) ReleaseInteractiveInputs(inputs []*interactivetx.Input) {
+	w.released = append(w.released, inputs...)
+}
+
+func (w *mockWallet) SignInteractiveInputs(tx *wire.MsgTx,
+	prevOutFetcher txscript.PrevOutputFetcher,
+	indexes []int) ([]wire.TxWitness, error) {
+
+	sigHashes := txscript.NewTxSigHashes(tx, prevOutFetcher)
+
+	var witnesses []wire.TxWitness
+	for _, idx := range indexes {
+		prevOut := prevOutFetcher.FetchPrevOutput(
+			tx.TxIn[idx].PreviousOutPoint,
+		)
+
+		witness, err := txscript.WitnessSignature(
+			tx, sigHashes, idx, prevOut.Value, prevOut.PkScript,
+			txscript.SigHashAll, w.key, true,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witnesses = append(witnesses, witness)
+	}
+
+	return witnesses, nil
+}
+
+func (w *mockWallet) PublishTransaction(tx *wire.MsgTx, _ string) error {
+	w.published = append(w.published, tx)
+
+	return nil
+}
+
+// testPeer is one of the parties of a splice.
+type testPeer struct {
+	channel    *lnwallet.LightningChannel
+	wallet     *mockWallet
+	negotiator *Negotiator
+	outbox     []lnwire.Message
+}
+
+// config returns the config of a negotiator of the peer.
+func (p *testPeer) config(nodeKey *btcec.PublicKey,
+	req fn.Option[Request]) Config {
+
+	return Config{
+		Channel:      p.channel,
+		Wallet:       p.wallet,
+		Request:      req,
+		LocalNodeKey: nodeKey,
+		BestHeight:   100,
+		SendMessage: func(msg lnwire.Message) error {
+			p.outbox = append(p.outbox, msg)
+
+			return nil
+		},
+	}
+}
+
+// newTestPeers creates the negotiators of a splice that is initiated by
+// Alice.
+func newTestPeers(t *testing.T, req Request) (*testPeer, *testPeer) {
+	chanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit
+
+	aliceChannel, bobChannel, err := lnwallet.CreateTestChannels(
+		t, chanType,
+	)
+	require.NoError(t, err)
+
+	alice := &testPeer{channel: aliceChannel, wallet: newMockWallet(t)}
+	bob := &testPeer{channel: bobChannel, wallet: newMockWallet(t)}
+
+	// The identity key of a channel is the key of the remote party.
+	aliceKey := bobChannel.State().IdentityPub
+	bobKey := aliceChannel.State().IdentityPub
+
+	alice.negotiator = NewNegotiator(alice.config(aliceKey, fn.Some(req)))
+	bob.negotiator = NewNegotiator(bob.config(bobKey, fn.None[Request]()))
+
+	return alice, bob
+}
+
+// exchangeMessages delivers the messages of both parties until no message is
+// left.
+func exchangeMessages(alice, bob *testPeer) error {
+	deliver := func(from, to *testPeer) error {
+		for len(from.outbox) > 0 {
+			msg := from.outbox[0]
+			from.outbox = from.outbox[1:]
+
+			err := to.negotiator.ProcessMessage(msg)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for len(alice.outbox) > 0 || len(bob.outbox) > 0 {
+		if err := deliver(alice, bob); err != nil {
+			return err
+		}
+		if err := deliver(bob, alice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// assertSpliceTx asserts that both parties agree on the splice and that the
+// splice transaction is fully signed.
+func assertSpliceTx(t *testing.T, alice, bob *testPeer,
+	prevOuts txscript.PrevOutputFetcher) *channeldb.PendingSplice {
+
+	require.True(t, alice.negotiator.Broadcast())
+	require.True(t, bob.negotiator.Broadcast())
+
+	aliceSplice, err := alice.channel.State().PendingSplice()
+	require.NoError(t, err)
+	bobSplice, err := bob.channel.State().PendingSplice()
+	require.NoError(t, err)
+
+	spliceTx := aliceSplice.SpliceTx
+	require.Equal(
+		t, spliceTx.WitnessHash(), bobSplice.SpliceTx.WitnessHash(),
+	)
+	require.Equal(t, aliceSplice.FundingOutpoint, bobSplice.FundingOutpoint)
+	require.Equal(t, spliceTx.TxHash(), aliceSplice.FundingOutpoint.Hash)
+
+	require.Len(t, alice.wallet.published, 1)
+	require.Len(t, bob.wallet.published, 1)
+	require.Equal(
+		t, spliceTx.WitnessHash(),
+		alice.wallet.published[0].WitnessHash(),
+	)
+
+	// All inputs of the splice transaction are validly signed.
+	sigHashes := txscript.NewTxSigHashes(spliceTx, prevOuts)
+	for i, txIn := range spliceTx.TxIn {
+		prevOut := prevOuts.FetchPrevOutput(txIn.PreviousOutPoint)
+		require.NotNil(t, prevOut)
+
+		vm, err := txscript.NewEngine(
+			prevOut.PkScript, spliceTx, i,
+			txscript.StandardVerifyFlags, nil, sigHashes,
+			prevOut.Value, prevOuts,
+		)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+	}
+
+	// The splice can no longer be aborted.
+	require.ErrorIs(t, alice.negotiator.Abort(errors.New("abort")),
+		ErrSpliceSigned)
+
+	return aliceSplice
+}
+
+// fundingPrevOuts returns a fetcher for the current funding output of the
+// channel.
+func fundingPrevOuts(p *testPeer) *txscript.MultiPrevOutFetcher {
+	prevOuts := txscript.NewMultiPrevOutFetcher(nil)
+	prevOuts.AddPrevOut(
+		p.channel.State().CurrentFundingOutpoint(),
+		p.channel.FundingTxOut(),
+	)
+
+	return prevOuts
+}
+
+// TestSpliceIn tests that funds of the wallet can be spliced into a channel.
+func TestSpliceIn(t *testing.T) {
+	t.Parallel()
+
+	const amt = btcutil.Amount(200_000)
+
+	alice, bob := newTestPeers(t, Request{
+		Amount:  amt,
+		FeeRate: testFeeRate,
+	})
+	oldCapacity := alice.channel.State().Capacity
+	aliceBalance := alice.channel.State().LocalCommitment.LocalBalance
+
+	require.NoError(t, alice.negotiator.Start())
+	require.NoError(t, exchangeMessages(alice, bob))
+
+	prevOuts := fundingPrevOuts(alice)
+	walletInput := alice.negotiator.funding.Inputs[0]
+	prevOuts.AddPrevOut(walletInput.OutPoint, walletInput.PrevOut)
+
+	splice := assertSpliceTx(t, alice, bob, prevOuts)
+
+	// The channel grew by the spliced in amount, which was added to
+	// Alice's balance.
+	require.Equal(t, oldCapacity+amt, splice.Capacity)
+	require.Equal(
+		t, aliceBalance+lnwire.NewMSatFromSatoshis(amt),
+		splice.LocalCommitment.LocalBalance,
+	)
+	require.Equal(
+		t, splice.FundingOutpoint,
+		splice.LocalCommitment.CommitTx.TxIn[0].PreviousOutPoint,
+	)
+
+	// Once confirmed, both parties lock the splice.
+	require.NoError(t, alice.negotiator.Confirmed())
+	require.NoError(t, bob.negotiator.Confirmed())
+	require.NoError(t, exchangeMessages(alice, bob))
+
+	require.NoError(t, alice.channel.State().ApplySplice())
+	require.Equal(
+		t, splice.FundingOutpoint,
+		alice.channel.State().CurrentFundingOutpoint(),
+	)
+}
+
+// TestSpliceOut tests that funds of the channel can be spliced out to an
+// address.
+func TestSpliceOut(t *testing.T) {
+	t.Parallel()
+
+	const amt = btcutil.Amount(100_000)
+
+	deliveryScript, err := input.WitnessPubKeyHash(make([]byte, 33))
+	require.NoError(t, err)
+
+	alice, bob := newTestPeers(t, Request{
+		Amount:         -amt,
+		FeeRate:        testFeeRate,
+		DeliveryScript: deliveryScript,
+	})
+	oldCapacity := alice.channel.State().Capacity
+	bobBalance := bob.channel.State().LocalCommitment.LocalBalance
+
+	require.NoError(t, alice.negotiator.Start())
+	require.NoError(t, exchangeMessages(alice, bob))
+
+	splice := assertSpliceTx(t, alice, bob, fundingPrevOuts(alice))
+
+	// The spliced out amount is paid to the delivery script, while the
+	// fees are paid by Alice.
+	spliceTx := splice.SpliceTx
+	require.Len(t, spliceTx.TxOut, 2)
+
+	var (
+		delivered btcutil.Amount
+		capacity  btcutil.Amount
+	)
+	for i, txOut := range spliceTx.TxOut {
+		if i == int(splice.FundingOutpoint.Index) {
+			capacity = btcutil.Amount(txOut.Value)
+			continue
+		}
+
+		require.Equal(t, deliveryScript, []byte(txOut.PkScript))
+		delivered = btcutil.Amount(txOut.Value)
+	}
+	require.Equal(t, amt, delivered)
+	require.Equal(t, capacity, splice.Capacity)
+	require.Less(t, capacity, oldCapacity-amt)
+
+	// Bob's balance isn't affected by the splice.
+	require.Equal(t, bobBalance, splice.LocalCommitment.RemoteBalance)
+}
+
+// TestSpliceResume tests that a splice is completed after a restart if the
+// signatures for the splice transaction got lost.
+func TestSpliceResume(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestPeers(t, Request{
+		Amount:  200_000,
+		FeeRate: testFeeRate,
+	})
+
+	// Run the splice until Bob sends his signatures for the splice
+	// transaction, which get lost.
+	require.NoError(t, alice.negotiator.Start())
+	for {
+		var lost bool
+		for len(bob.outbox) > 0 {
+			msg := bob.outbox[0]
+			bob.outbox = bob.outbox[1:]
+
+			if _, ok := msg.(*lnwire.TxSignatures); ok {
+				lost = true
+				break
+			}
+			require.NoError(t, alice.negotiator.ProcessMessage(msg))
+		}
+		if lost {
+			break
+		}
+
+		for len(alice.outbox) > 0 {
+			msg := alice.outbox[0]
+			alice.outbox = alice.outbox[1:]
+			require.NoError(t, bob.negotiator.ProcessMessage(msg))
+		}
+	}
+
+	require.True(t, alice.negotiator.Signed())
+	require.True(t, bob.negotiator.Signed())
+	require.False(t, alice.negotiator.Broadcast())
+	require.Empty(t, alice.outbox)
+
+	prevOuts := fundingPrevOuts(alice)
+	walletInput := alice.negotiator.funding.Inputs[0]
+	prevOuts.AddPrevOut(walletInput.OutPoint, walletInput.PrevOut)
+
+	// After a restart, both parties resume the splice from disk and send
+	// their signatures again.
+	for _, p := range []*testPeer{alice, bob} {
+		pending, err := p.channel.State().PendingSplice()
+		require.NoError(t, err)
+
+		nodeKey := p.negotiator.cfg.LocalNodeKey
+		p.outbox = nil
+		p.negotiator, err = ResumeNegotiator(
+			p.config(nodeKey, fn.None[Request]()), pending,
+		)
+		require.NoError(t, err)
+		require.NoError(t, p.negotiator.Start())
+	}
+
+	require.NoError(t, exchangeMessages(alice, bob))
+	assertSpliceTx(t, alice, bob, prevOuts)
+}
+
+// TestSpliceAbort tests that a splice can be aborted before it was signed, in
+// which case the wallet inputs are released.
+func TestSpliceAbort(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := newTestPeers(t, Request{
+		Amount:  200_000,
+		FeeRate: testFeeRate,
+	})
+
+	require.NoError(t, alice.negotiator.Start())
+
+	// Bob aborts the splice right after accepting it.
+	require.NoError(t, bob.negotiator.ProcessMessage(alice.outbox[0]))
+	alice.outbox = nil
+	require.NoError(t, bob.negotiator.Abort(errors.New("no splice")))
+
+	var err error
+	for _, msg := range bob.outbox {
+		err = alice.negotiator.ProcessMessage(msg)
+	}
+	require.ErrorIs(t, err, ErrSpliceAborted)
+
+	require.NoError(t, alice.negotiator.Abort(err))
+	require.Nil(t, alice.negotiator.funding)
+	require.Len(t, alice.wallet.released, 1)
+	require.IsType(t, &lnwire.TxAbort{}, alice.outbox[len(alice.outbox)-1])
+
+	// A splice message of an unknown splice is rejected.
+	err = alice.negotiator.ProcessMessage(&lnwire.SpliceLocked{
+		SpliceTxID: chainhash.Hash{1},
+	})
+	require.ErrorIs(t, err, ErrUnexpectedMessage)
+
+	_, err = alice.channel.State().PendingSplice()
+	require.ErrorIs(t, err, channeldb.ErrNoPendingSplice)
+}
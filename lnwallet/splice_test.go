@@ -0,0 +1,111 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpliceCommitments tests that both parties create matching commitments
+// for a splice and are able to verify each other's signatures.
+func TestSpliceCommitments(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, bobChannel, err := CreateTestChannels(
+		t, channeldb.SingleFunderTweaklessBit|channeldb.AnchorOutputsBit,
+	)
+	require.NoError(t, err)
+
+	// Alice splices out some funds of the channel.
+	const contribution = -btcutil.Amount(100_000)
+
+	oldCapacity := aliceChannel.State().Capacity
+	newCapacity := oldCapacity + contribution
+	fundingOutpoint := wire.OutPoint{Hash: chainhash.Hash{1}, Index: 1}
+
+	aliceOutput, err := aliceChannel.SpliceFundingOutput(newCapacity)
+	require.NoError(t, err)
+	bobOutput, err := bobChannel.SpliceFundingOutput(newCapacity)
+	require.NoError(t, err)
+	require.Equal(t, aliceOutput, bobOutput)
+
+	aliceSplice, err := aliceChannel.NewSpliceCommitments(
+		fundingOutpoint, aliceOutput, contribution, 0,
+	)
+	require.NoError(t, err)
+	bobSplice, err := bobChannel.NewSpliceCommitments(
+		fundingOutpoint, bobOutput, 0, contribution,
+	)
+	require.NoError(t, err)
+
+	// Both parties agree on the commitment transactions.
+	require.Equal(
+		t, aliceSplice.LocalCommitment.CommitTx.TxHash(),
+		bobSplice.RemoteCommitment.CommitTx.TxHash(),
+	)
+	require.Equal(
+		t, aliceSplice.RemoteCommitment.CommitTx.TxHash(),
+		bobSplice.LocalCommitment.CommitTx.TxHash(),
+	)
+
+	// The commitments spend the new funding output and Alice's balance was
+	// reduced by her contribution.
+	commitTx := aliceSplice.LocalCommitment.CommitTx
+	require.Equal(t, fundingOutpoint, commitTx.TxIn[0].PreviousOutPoint)
+	require.Equal(
+		t, aliceChannel.State().LocalCommitment.LocalBalance-
+			lnwire.NewMSatFromSatoshis(-contribution),
+		aliceSplice.LocalCommitment.LocalBalance,
+	)
+	require.Equal(t, newCapacity, aliceSplice.Capacity())
+
+	// The signatures are exchanged and verified.
+	aliceSig, err := aliceChannel.SignSpliceCommitment(aliceSplice)
+	require.NoError(t, err)
+	bobSig, err := bobChannel.SignSpliceCommitment(bobSplice)
+	require.NoError(t, err)
+
+	require.NoError(t, aliceChannel.ReceiveSpliceCommitment(
+		aliceSplice, bobSig,
+	))
+	require.NoError(t, bobChannel.ReceiveSpliceCommitment(
+		bobSplice, aliceSig,
+	))
+
+	// A signature for the wrong commitment is rejected.
+	require.Error(t, aliceChannel.ReceiveSpliceCommitment(
+		aliceSplice, aliceSig,
+	))
+
+	// Splicing out the whole balance of a party is rejected, as it would
+	// dip below the channel reserve.
+	bobBalance := aliceChannel.State().LocalCommitment.RemoteBalance
+	remoteContribution := -bobBalance.ToSatoshis()
+	emptyOutput, err := aliceChannel.SpliceFundingOutput(
+		newCapacity + remoteContribution,
+	)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.NewSpliceCommitments(
+		fundingOutpoint, emptyOutput, contribution, remoteContribution,
+	)
+	require.ErrorIs(t, err, ErrBelowChanReserve)
+}
+
+// TestSpliceTaprootUnsupported tests that taproot channels can't be spliced.
+func TestSpliceTaprootUnsupported(t *testing.T) {
+	t.Parallel()
+
+	aliceChannel, _, err := CreateTestChannels(
+		t, channeldb.SimpleTaprootFeatureBit,
+	)
+	require.NoError(t, err)
+
+	_, err = aliceChannel.SpliceFundingOutput(1_000_000)
+	require.ErrorIs(t, err, ErrSpliceUnsupported)
+}
@@ -887,6 +887,10 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 	// funding because some of the following steps may add UTXOs funding
 	// the on-chain wallet.
 	// The enforcement still happens at the last step - in PsbtFundingVerify
+	//
+	// The inputs of a dual-funded channel are selected by the caller before
+	// the reservation is created, like the inputs of a splice, so the
+	// reserved value isn't enforced for them either.
 	enforceNewReservedValue := true
 
 	// If no chanFunder was provided, then we'll assume the default
@@ -910,7 +914,9 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		req.ChanFunder = chanfunding.NewWalletAssembler(cfg)
 	} else {
 		_, isPsbtFunder := req.ChanFunder.(*chanfunding.PsbtAssembler)
-		enforceNewReservedValue = !isPsbtFunder
+		_, isInteractive :=
+			req.ChanFunder.(*chanfunding.InteractiveAssembler)
+		enforceNewReservedValue = !isPsbtFunder && !isInteractive
 	}
 
 	localFundingAmt := req.LocalFundingAmt
@@ -1039,6 +1045,13 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		thawHeight = shimIntent.ThawHeight()
 	}
 
+	// The funding transaction of a dual-funded channel is constructed
+	// interactively, but its intent carries the thaw height of a
+	// negotiated liquidity lease.
+	if intent, ok := fundingIntent.(*chanfunding.InteractiveIntent); ok {
+		thawHeight = intent.ThawHeight()
+	}
+
 	// Now that we have a funding intent, we'll check whether funding a
 	// channel using it would violate our reserved value for anchor channel
 	// fee bumping.
@@ -1682,6 +1695,22 @@ func (l *LightningWallet) handleContributionMsg(req *addContributionMsg) {
 		}
 		return
 
+	// The funding transaction of a dual-funded channel is constructed
+	// interactively with the remote party, which requires the funding
+	// output that we can create now that both keys are known. The funding
+	// flow continues once the transaction was constructed.
+	case *chanfunding.InteractiveIntent:
+		fundingIntent.BindKeys(
+			&ourContribution.MultiSigKey,
+			theirContribution.MultiSigKey.PubKey,
+		)
+		fundingIntent.BindTapscriptRoot(
+			pendingReservation.partialState.TapscriptRoot,
+		)
+
+		req.err <- nil
+		return
+
 	case *chanfunding.FullIntent:
 		// Now that we know their public key, we can bind theirs as
 		// well as ours to the funding intent.
@@ -1965,9 +1994,11 @@ func (l *LightningWallet) handleChanPointReady(req *continueContributionMsg) {
 
 	// With both commitment transactions constructed, generate the state
 	// obfuscator then use it to encode the current state number within
-	// both commitment transactions.
+	// both commitment transactions. The opener of a dual-funded channel is
+	// its initiator, so the obfuscator is derived like for single funder
+	// channels.
 	var stateObfuscator [StateHintSize]byte
-	if chanState.ChanType.IsSingleFunder() {
+	if chanState.ChanType.IsSingleFunder() || chanState.IsInitiator {
 		stateObfuscator = DeriveStateHintObfuscator(
 			ourContribution.PaymentBasePoint.PubKey,
 			theirContribution.PaymentBasePoint.PubKey,
@@ -2269,9 +2300,12 @@ func (l *LightningWallet) handleFundingCounterPartySigs(msg *addCounterPartySigs
 
 	// Only if we have the final funding transaction do we need to verify
 	// the final set of inputs. Otherwise, it may be the case that the
-	// channel was funded via an external wallet.
+	// channel was funded via an external wallet. The signatures for the
+	// inputs of an interactively constructed funding transaction are only
+	// exchanged once the commitments were signed.
 	fundingTx := res.fundingTx
-	if res.partialState.ChanType.HasFundingTx() {
+	_, isInteractive := res.fundingIntent.(*chanfunding.InteractiveIntent)
+	if res.partialState.ChanType.HasFundingTx() && !isInteractive {
 		err := l.verifyFundingInputs(fundingTx, inputScripts)
 		if err != nil {
 			msg.err <- err
@@ -2530,6 +2564,10 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 
 	chanState.RevocationKeyLocator = pendingReservation.nextRevocationKeyLoc
 
+	// The funding transaction is only known to the responder if it was
+	// constructed interactively, in which case it's still unsigned.
+	chanState.FundingTxn = pendingReservation.fundingTx
+
 	err = chanState.SyncPending(pendingReservation.nodeAddr, uint32(bestHeight))
 	if err != nil {
 		req.err <- err
@@ -1,10 +1,13 @@
 package lnwire
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"math"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 )
@@ -52,6 +55,21 @@ func NewChanIDFromOutPoint(op wire.OutPoint) ChannelID {
 	return cid
 }
 
+// NewChanIDFromBasepoints derives the ChannelID of a channel that is opened
+// with the v2 channel establishment protocol. As the funding outpoint isn't
+// known before the funding transaction is constructed interactively, the
+// ChannelID is the SHA256 of the revocation basepoints of both parties, where
+// the lesser of the two serialized keys comes first.
+func NewChanIDFromBasepoints(a, b *btcec.PublicKey) ChannelID {
+	first := a.SerializeCompressed()
+	second := b.SerializeCompressed()
+	if bytes.Compare(first, second) > 0 {
+		first, second = second, first
+	}
+
+	return ChannelID(sha256.Sum256(append(first, second...)))
+}
+
 // xorTxid performs the transformation needed to transform an OutPoint into a
 // ChannelID. To do this, we expect the cid parameter to contain the txid
 // unaltered and the outputIndex to be the output index
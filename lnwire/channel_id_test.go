@@ -1,6 +1,11 @@
 package lnwire
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/stretchr/testify/require"
+)
 
 // TestChannelIDOutPointConversion ensures that the IsChanPoint always
 // recognizes its seed OutPoint for all possible values of an output index.
@@ -70,3 +75,18 @@ func TestGenPossibleOutPoints(t *testing.T) {
 		t.Fatalf("possible outpoints did not contain the root outpoint")
 	}
 }
+
+// TestChanIDFromBasepoints ensures that the ChannelID derived from the
+// revocation basepoints doesn't depend on the order of the basepoints.
+func TestChanIDFromBasepoints(t *testing.T) {
+	t.Parallel()
+
+	a, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	b, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	cid := NewChanIDFromBasepoints(a.PubKey(), b.PubKey())
+	require.Equal(t, cid, NewChanIDFromBasepoints(b.PubKey(), a.PubKey()))
+	require.NotEqual(t, cid, NewChanIDFromBasepoints(a.PubKey(), a.PubKey()))
+}
@@ -161,6 +161,18 @@ const (
 	// addresses for cooperative closure addresses.
 	ShutdownAnySegwitOptional FeatureBit = 27
 
+	// DualFundRequired is a required feature bit that signals that the
+	// sender supports the v2 channel establishment protocol, in which the
+	// funding transaction is constructed interactively and both parties
+	// may contribute funds to it.
+	DualFundRequired FeatureBit = 28
+
+	// DualFundOptional is an optional feature bit that signals that the
+	// sender supports the v2 channel establishment protocol, in which the
+	// funding transaction is constructed interactively and both parties
+	// may contribute funds to it.
+	DualFundOptional FeatureBit = 29
+
 	// AMPRequired is a required feature bit that signals that the receiver
 	// of a payment supports accepts spontaneous payments, i.e.
 	// sender-generated preimages according to BOLT XX.
@@ -181,6 +193,14 @@ const (
 	// quiescence protocol.
 	QuiescenceOptional FeatureBit = 35
 
+	// OnionMessagesRequired is a required feature bit that indicates that
+	// the node is able to forward onion messages.
+	OnionMessagesRequired FeatureBit = 38
+
+	// OnionMessagesOptional is an optional feature bit that indicates that
+	// the node is able to forward onion messages.
+	OnionMessagesOptional FeatureBit = 39
+
 	// ExplicitChannelTypeRequired is a required bit that denotes that a
 	// connection established with this node is to use explicit channel
 	// commitment types for negotiation instead of the existing implicit
@@ -249,6 +269,30 @@ const (
 	// that the new RBF-based co-op close protocol is supported.
 	RbfCoopCloseOptionalStaging = 161
 
+	// SpliceRequiredStaging is a required feature bit that signals that
+	// the node requires support for splicing funds into and out of
+	// existing channels. This is the feature bit used while the splicing
+	// protocol is still being finalized.
+	SpliceRequiredStaging = 162
+
+	// SpliceOptionalStaging is an optional feature bit that signals that
+	// the node supports splicing funds into and out of existing channels.
+	// This is the feature bit used while the splicing protocol is still
+	// being finalized.
+	SpliceOptionalStaging = 163
+
+	// DynamicCommitmentsRequiredStaging is a required feature bit that
+	// signals that the node requires support for changing the parameters
+	// and the type of existing channels with dynamic commitments. This is
+	// the feature bit used while the protocol is still being finalized.
+	DynamicCommitmentsRequiredStaging = 164
+
+	// DynamicCommitmentsOptionalStaging is an optional feature bit that
+	// signals that the node supports changing the parameters and the type
+	// of existing channels with dynamic commitments. This is the feature
+	// bit used while the protocol is still being finalized.
+	DynamicCommitmentsOptionalStaging = 165
+
 	// ScriptEnforcedLeaseRequired is a required feature bit that signals
 	// that the node requires channels having zero-fee second-level HTLC
 	// transactions, which also imply anchor commitments, along with an
@@ -363,6 +407,8 @@ var Features = map[FeatureBit]string{
 	AMPOptional:                          "amp",
 	QuiescenceRequired:                   "quiescence",
 	QuiescenceOptional:                   "quiescence",
+	OnionMessagesRequired:                "onion-messages",
+	OnionMessagesOptional:                "onion-messages",
 	PaymentMetadataOptional:              "payment-metadata",
 	PaymentMetadataRequired:              "payment-metadata",
 	ExplicitChannelTypeOptional:          "explicit-commitment-type",
@@ -379,6 +425,8 @@ var Features = map[FeatureBit]string{
 	RouteBlindingOptional:                "route-blinding",
 	ShutdownAnySegwitRequired:            "shutdown-any-segwit",
 	ShutdownAnySegwitOptional:            "shutdown-any-segwit",
+	DualFundRequired:                     "dual-funding",
+	DualFundOptional:                     "dual-funding",
 	SimpleTaprootChannelsRequiredFinal:   "simple-taproot-chans",
 	SimpleTaprootChannelsOptionalFinal:   "simple-taproot-chans",
 	SimpleTaprootChannelsRequiredStaging: "simple-taproot-chans-x",
@@ -393,6 +441,10 @@ var Features = map[FeatureBit]string{
 	RbfCoopCloseRequired:                 "rbf-coop-close",
 	RbfCoopCloseOptionalStaging:          "rbf-coop-close-x",
 	RbfCoopCloseRequiredStaging:          "rbf-coop-close-x",
+	SpliceOptionalStaging:                "splice-x",
+	SpliceRequiredStaging:                "splice-x",
+	DynamicCommitmentsOptionalStaging:    "dynamic-commitments-x",
+	DynamicCommitmentsRequiredStaging:    "dynamic-commitments-x",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
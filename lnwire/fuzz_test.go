@@ -94,6 +94,32 @@ func FuzzAcceptChannel(f *testing.F) {
 	})
 }
 
+func FuzzAcceptChannel2(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// We can't use require.Equal for UpfrontShutdownScript, since
+		// we consider the empty slice and nil to be equivalent.
+		assertEq := func(t *testing.T, x, y any) {
+			require.IsType(t, &AcceptChannel2{}, x)
+			first, _ := x.(*AcceptChannel2)
+			require.IsType(t, &AcceptChannel2{}, y)
+			second, _ := y.(*AcceptChannel2)
+
+			require.True(
+				t, bytes.Equal(
+					first.UpfrontShutdownScript,
+					second.UpfrontShutdownScript,
+				),
+			)
+			first.UpfrontShutdownScript = nil
+			second.UpfrontShutdownScript = nil
+
+			require.Equal(t, first, second)
+		}
+
+		wireMsgHarnessCustom(t, data, MsgAcceptChannel2, assertEq)
+	})
+}
+
 func FuzzAnnounceSignatures(f *testing.F) {
 	f.Fuzz(func(t *testing.T, data []byte) {
 		wireMsgHarness(t, data, MsgAnnounceSignatures)
@@ -270,6 +296,32 @@ func FuzzOpenChannel(f *testing.F) {
 	})
 }
 
+func FuzzOpenChannel2(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// We can't use require.Equal for UpfrontShutdownScript, since
+		// we consider the empty slice and nil to be equivalent.
+		assertEq := func(t *testing.T, x, y any) {
+			require.IsType(t, &OpenChannel2{}, x)
+			first, _ := x.(*OpenChannel2)
+			require.IsType(t, &OpenChannel2{}, y)
+			second, _ := y.(*OpenChannel2)
+
+			require.True(
+				t, bytes.Equal(
+					first.UpfrontShutdownScript,
+					second.UpfrontShutdownScript,
+				),
+			)
+			first.UpfrontShutdownScript = nil
+			second.UpfrontShutdownScript = nil
+
+			require.Equal(t, first, second)
+		}
+
+		wireMsgHarnessCustom(t, data, MsgOpenChannel2, assertEq)
+	})
+}
+
 func FuzzPing(f *testing.F) {
 	f.Fuzz(func(t *testing.T, data []byte) {
 		wireMsgHarness(t, data, MsgPing)
@@ -464,6 +516,84 @@ func FuzzKickoffSig(f *testing.F) {
 	})
 }
 
+func FuzzOnionMessage(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgOnionMessage)
+	})
+}
+
+func FuzzTxAddInput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxAddInput)
+	})
+}
+
+func FuzzTxAddOutput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxAddOutput)
+	})
+}
+
+func FuzzTxRemoveInput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxRemoveInput)
+	})
+}
+
+func FuzzTxRemoveOutput(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxRemoveOutput)
+	})
+}
+
+func FuzzTxComplete(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxComplete)
+	})
+}
+
+func FuzzTxSignatures(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxSignatures)
+	})
+}
+
+func FuzzTxAbort(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxAbort)
+	})
+}
+
+func FuzzTxInitRbf(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxInitRbf)
+	})
+}
+
+func FuzzTxAckRbf(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgTxAckRbf)
+	})
+}
+
+func FuzzSpliceInit(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgSpliceInit)
+	})
+}
+
+func FuzzSpliceAck(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgSpliceAck)
+	})
+}
+
+func FuzzSpliceLocked(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		wireMsgHarness(t, data, MsgSpliceLocked)
+	})
+}
+
 func FuzzCustomMessage(f *testing.F) {
 	f.Fuzz(func(t *testing.T, data []byte, customMessageType uint16) {
 		if customMessageType < uint16(CustomTypeStart) {
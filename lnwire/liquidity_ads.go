@@ -0,0 +1,329 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// LeaseRatesRecordType is the type of the node_announcement record
+	// that advertises the rates at which a node sells inbound liquidity.
+	LeaseRatesRecordType tlv.Type = 1
+
+	// RequestFundsRecordType is the type of the open_channel2 record that
+	// requests the acceptor to lease liquidity to the opener.
+	RequestFundsRecordType tlv.Type = 3
+
+	// WillFundRecordType is the type of the accept_channel2 record with
+	// which the acceptor commits to a liquidity lease.
+	WillFundRecordType tlv.Type = 3
+
+	// LeaseDuration is the number of blocks a liquidity lease lasts. The
+	// funds of the seller are locked in the channel until the lease
+	// expires.
+	LeaseDuration uint32 = 4032
+
+	// leaseRatesSize is the size of encoded lease rates.
+	leaseRatesSize = 14
+
+	// willFundSize is the size of an encoded will_fund record.
+	willFundSize = 64 + leaseRatesSize
+
+	// leaseCommitmentTag is the tag of the message the seller of a lease
+	// signs to commit to the lease.
+	leaseCommitmentTag = "option_will_fund"
+)
+
+// LeaseRates are the rates at which a node sells inbound liquidity, which it
+// advertises in its node_announcement.
+type LeaseRates struct {
+	// FundingWeight is the weight of the inputs and outputs the seller
+	// adds to the funding transaction, which the buyer pays for.
+	FundingWeight uint16
+
+	// LeaseFeeBasis is the proportional lease fee in basis points of the
+	// leased amount.
+	LeaseFeeBasis uint16
+
+	// ChannelFeeMaxProportional is the maximum proportional forwarding
+	// fee the seller charges during the lease, in thousandths of the
+	// proportional fee in parts per million.
+	ChannelFeeMaxProportional uint16
+
+	// LeaseFeeBase is the base lease fee in satoshis.
+	LeaseFeeBase uint32
+
+	// ChannelFeeMaxBase is the maximum base forwarding fee in
+	// millisatoshis the seller charges during the lease.
+	ChannelFeeMaxBase uint32
+}
+
+// LeaseFee returns the fee the buyer pays to lease the given amount, if the
+// funding transaction pays the given fee rate.
+func (l *LeaseRates) LeaseFee(amt btcutil.Amount,
+	fundingFeePerKw uint32) btcutil.Amount {
+
+	fee := btcutil.Amount(l.LeaseFeeBase)
+	fee += amt * btcutil.Amount(l.LeaseFeeBasis) / 10_000
+	fee += btcutil.Amount(l.FundingWeight) *
+		btcutil.Amount(fundingFeePerKw) / 1000
+
+	return fee
+}
+
+// MaxFeeRatePPM returns the maximum proportional forwarding fee of the seller
+// in parts per million.
+func (l *LeaseRates) MaxFeeRatePPM() uint32 {
+	return uint32(l.ChannelFeeMaxProportional) * 1000
+}
+
+// Record returns a TLV record that can be used to encode/decode the lease
+// rates of a node_announcement.
+func (l *LeaseRates) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		LeaseRatesRecordType, l, leaseRatesSize, leaseRatesEncoder,
+		leaseRatesDecoder,
+	)
+}
+
+// encodeLeaseRates writes the lease rates to the given writer.
+func encodeLeaseRates(w io.Writer, l *LeaseRates, buf *[8]byte) error {
+	if err := tlv.EUint16T(w, l.FundingWeight, buf); err != nil {
+		return err
+	}
+	if err := tlv.EUint16T(w, l.LeaseFeeBasis, buf); err != nil {
+		return err
+	}
+	err := tlv.EUint16T(w, l.ChannelFeeMaxProportional, buf)
+	if err != nil {
+		return err
+	}
+	if err := tlv.EUint32T(w, l.LeaseFeeBase, buf); err != nil {
+		return err
+	}
+
+	return tlv.EUint32T(w, l.ChannelFeeMaxBase, buf)
+}
+
+// decodeLeaseRates reads the lease rates from the given reader.
+func decodeLeaseRates(r io.Reader, l *LeaseRates, buf *[8]byte) error {
+	if err := tlv.DUint16(r, &l.FundingWeight, buf, 2); err != nil {
+		return err
+	}
+	if err := tlv.DUint16(r, &l.LeaseFeeBasis, buf, 2); err != nil {
+		return err
+	}
+	err := tlv.DUint16(r, &l.ChannelFeeMaxProportional, buf, 2)
+	if err != nil {
+		return err
+	}
+	if err := tlv.DUint32(r, &l.LeaseFeeBase, buf, 4); err != nil {
+		return err
+	}
+
+	return tlv.DUint32(r, &l.ChannelFeeMaxBase, buf, 4)
+}
+
+// leaseRatesEncoder is a custom TLV encoder for the LeaseRates record.
+func leaseRatesEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*LeaseRates); ok {
+		return encodeLeaseRates(w, v, buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.LeaseRates")
+}
+
+// leaseRatesDecoder is a custom TLV decoder for the LeaseRates record.
+func leaseRatesDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*LeaseRates); ok && l == leaseRatesSize {
+		return decodeLeaseRates(r, v, buf)
+	}
+
+	return tlv.NewTypeForDecodingErr(
+		val, "lnwire.LeaseRates", l, leaseRatesSize,
+	)
+}
+
+// ParseLeaseRates returns the lease rates advertised in the extra data of a
+// node_announcement, if there are any.
+func ParseLeaseRates(extraData ExtraOpaqueData) (fn.Option[LeaseRates],
+	error) {
+
+	var rates LeaseRates
+	typeMap, err := extraData.ExtractRecords(&rates)
+	if err != nil {
+		return fn.None[LeaseRates](), err
+	}
+
+	if val, ok := typeMap[LeaseRatesRecordType]; !ok || val != nil {
+		return fn.None[LeaseRates](), nil
+	}
+
+	return fn.Some(rates), nil
+}
+
+// SetLeaseRates returns the extra data of a node_announcement with its lease
+// rates replaced by the given ones, or removed if none are given. All other
+// records are kept.
+func SetLeaseRates(extraData ExtraOpaqueData,
+	rates fn.Option[LeaseRates]) (ExtraOpaqueData, error) {
+
+	var oldRates LeaseRates
+	_, otherData, err := ParseAndExtractExtraData(extraData, &oldRates)
+	if err != nil {
+		return nil, err
+	}
+
+	var known []tlv.RecordProducer
+	rates.WhenSome(func(r LeaseRates) {
+		known = append(known, &r)
+	})
+
+	return MergeAndEncode(known, otherData, nil)
+}
+
+// RequestFunds is the request of the opener of a dual-funded channel to lease
+// liquidity from the acceptor.
+type RequestFunds struct {
+	// RequestedAmount is the amount the acceptor is asked to contribute
+	// to the channel.
+	RequestedAmount btcutil.Amount
+
+	// BlockHeight is the height at which the lease starts. The lease
+	// expires LeaseDuration blocks later.
+	BlockHeight uint32
+}
+
+// LeaseExpiry returns the height at which the requested lease expires.
+func (r *RequestFunds) LeaseExpiry() uint32 {
+	return r.BlockHeight + LeaseDuration
+}
+
+// Record returns a TLV record that can be used to encode/decode the
+// RequestFunds type from a given TLV stream.
+func (r *RequestFunds) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		RequestFundsRecordType, r, 12, requestFundsEncoder,
+		requestFundsDecoder,
+	)
+}
+
+// requestFundsEncoder is a custom TLV encoder for the RequestFunds record.
+func requestFundsEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	v, ok := val.(*RequestFunds)
+	if !ok {
+		return tlv.NewTypeForEncodingErr(val, "lnwire.RequestFunds")
+	}
+
+	err := tlv.EUint64T(w, uint64(v.RequestedAmount), buf)
+	if err != nil {
+		return err
+	}
+
+	return tlv.EUint32T(w, v.BlockHeight, buf)
+}
+
+// requestFundsDecoder is a custom TLV decoder for the RequestFunds record.
+func requestFundsDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	v, ok := val.(*RequestFunds)
+	if !ok || l != 12 {
+		return tlv.NewTypeForDecodingErr(
+			val, "lnwire.RequestFunds", l, 12,
+		)
+	}
+
+	var amt uint64
+	if err := tlv.DUint64(r, &amt, buf, 8); err != nil {
+		return err
+	}
+	v.RequestedAmount = btcutil.Amount(amt)
+
+	return tlv.DUint32(r, &v.BlockHeight, buf, 4)
+}
+
+// WillFund is the commitment of the acceptor of a dual-funded channel to
+// lease its contribution to the opener at the given rates.
+type WillFund struct {
+	// Signature is the signature of the seller's node key over the lease
+	// commitment, see LeaseCommitment.
+	Signature Sig
+
+	// Rates are the rates of the lease, which determine the lease fee and
+	// the maximum forwarding fees of the seller during the lease.
+	Rates LeaseRates
+}
+
+// Record returns a TLV record that can be used to encode/decode the WillFund
+// type from a given TLV stream.
+func (w *WillFund) Record() tlv.Record {
+	return tlv.MakeStaticRecord(
+		WillFundRecordType, w, willFundSize, willFundEncoder,
+		willFundDecoder,
+	)
+}
+
+// willFundEncoder is a custom TLV encoder for the WillFund record.
+func willFundEncoder(w io.Writer, val interface{}, buf *[8]byte) error {
+	v, ok := val.(*WillFund)
+	if !ok {
+		return tlv.NewTypeForEncodingErr(val, "lnwire.WillFund")
+	}
+
+	if _, err := w.Write(v.Signature.RawBytes()); err != nil {
+		return err
+	}
+
+	return encodeLeaseRates(w, &v.Rates, buf)
+}
+
+// willFundDecoder is a custom TLV decoder for the WillFund record.
+func willFundDecoder(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	v, ok := val.(*WillFund)
+	if !ok || l != willFundSize {
+		return tlv.NewTypeForDecodingErr(
+			val, "lnwire.WillFund", l, willFundSize,
+		)
+	}
+
+	var sig [64]byte
+	if _, err := io.ReadFull(r, sig[:]); err != nil {
+		return err
+	}
+
+	var err error
+	v.Signature, err = NewSigFromWireECDSA(sig[:])
+	if err != nil {
+		return err
+	}
+
+	return decodeLeaseRates(r, &v.Rates, buf)
+}
+
+// LeaseCommitment returns the message the seller of a liquidity lease signs
+// with its node key to commit to the lease, which is signed and verified over
+// its SHA256 hash. It commits to the seller's funding key, the expiry of the
+// lease and the maximum forwarding fees of the seller during the lease.
+func LeaseCommitment(fundingKey *btcec.PublicKey, leaseExpiry uint32,
+	rates *LeaseRates) []byte {
+
+	var b bytes.Buffer
+	b.WriteString(leaseCommitmentTag)
+	b.Write(fundingKey.SerializeCompressed())
+	_ = binary.Write(&b, binary.BigEndian, leaseExpiry)
+	_ = binary.Write(&b, binary.BigEndian, rates.ChannelFeeMaxBase)
+	_ = binary.Write(&b, binary.BigEndian, rates.ChannelFeeMaxProportional)
+
+	return b.Bytes()
+}
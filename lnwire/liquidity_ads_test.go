@@ -0,0 +1,107 @@
+package lnwire
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLeaseFee asserts that the lease fee consists of the base fee, the
+// proportional fee and the fee for the seller's funding weight.
+func TestLeaseFee(t *testing.T) {
+	t.Parallel()
+
+	rates := LeaseRates{
+		FundingWeight:             500,
+		LeaseFeeBasis:             100,
+		ChannelFeeMaxProportional: 2,
+		LeaseFeeBase:              1_000,
+		ChannelFeeMaxBase:         5_000,
+	}
+
+	// 1000 + 1% of 1_000_000 + 500 weight at 2500 sat/kw.
+	fee := rates.LeaseFee(1_000_000, 2_500)
+	require.Equal(t, btcutil.Amount(1_000+10_000+1_250), fee)
+
+	require.EqualValues(t, 2_000, rates.MaxFeeRatePPM())
+}
+
+// TestSetLeaseRates asserts that the lease rates of a node_announcement can be
+// set, replaced and removed without touching its other records.
+func TestSetLeaseRates(t *testing.T) {
+	t.Parallel()
+
+	var otherRecord tlv.Blob = []byte{1, 2, 3}
+	extraData, err := SetLeaseRates(nil, fn.None[LeaseRates]())
+	require.NoError(t, err)
+
+	rates, err := ParseLeaseRates(extraData)
+	require.NoError(t, err)
+	require.True(t, rates.IsNone())
+
+	// Add an unrelated record which must survive all updates.
+	otherType := tlv.Type(101)
+	err = extraData.PackRecords(RecordsAsProducers([]tlv.Record{
+		tlv.MakePrimitiveRecord(otherType, &otherRecord),
+	})...)
+	require.NoError(t, err)
+
+	for _, fee := range []uint32{1_000, 2_000} {
+		newRates := LeaseRates{LeaseFeeBase: fee}
+		extraData, err = SetLeaseRates(extraData, fn.Some(newRates))
+		require.NoError(t, err)
+
+		rates, err = ParseLeaseRates(extraData)
+		require.NoError(t, err)
+		require.Equal(t, fn.Some(newRates), rates)
+
+		typeMap, err := extraData.ExtractRecords()
+		require.NoError(t, err)
+		require.Contains(t, typeMap, otherType)
+	}
+
+	extraData, err = SetLeaseRates(extraData, fn.None[LeaseRates]())
+	require.NoError(t, err)
+
+	rates, err = ParseLeaseRates(extraData)
+	require.NoError(t, err)
+	require.True(t, rates.IsNone())
+
+	typeMap, err := extraData.ExtractRecords()
+	require.NoError(t, err)
+	require.Contains(t, typeMap, otherType)
+}
+
+// TestLeaseCommitment asserts that the lease commitment commits to the
+// funding key, the lease expiry and the maximum forwarding fees, but not to
+// the lease fee.
+func TestLeaseCommitment(t *testing.T) {
+	t.Parallel()
+
+	priv, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	key := priv.PubKey()
+
+	rates := LeaseRates{
+		LeaseFeeBase:              1_000,
+		ChannelFeeMaxBase:         5_000,
+		ChannelFeeMaxProportional: 2,
+	}
+	commitment := LeaseCommitment(key, 1_000, &rates)
+
+	// A different lease fee doesn't change the commitment.
+	otherFee := rates
+	otherFee.LeaseFeeBase = 2_000
+	require.Equal(t, commitment, LeaseCommitment(key, 1_000, &otherFee))
+
+	// Anything else does.
+	require.NotEqual(t, commitment, LeaseCommitment(key, 1_001, &rates))
+
+	otherMax := rates
+	otherMax.ChannelFeeMaxBase = 5_001
+	require.NotEqual(t, commitment, LeaseCommitment(key, 1_000, &otherMax))
+}
@@ -911,3 +911,19 @@ func ReadElements(r io.Reader, elements ...interface{}) error {
 	}
 	return nil
 }
+
+// ReadVarBytes reads a byte slice that is prefixed by its length encoded as a
+// big endian uint16 from the passed io.Reader.
+func ReadVarBytes(r io.Reader) ([]byte, error) {
+	var l uint16
+	if err := ReadElement(r, &l); err != nil {
+		return nil, err
+	}
+
+	b := make([]byte, l)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
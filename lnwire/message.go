@@ -41,6 +41,20 @@ const (
 	MsgClosingSigned                       = 39
 	MsgClosingComplete                     = 40
 	MsgClosingSig                          = 41
+	MsgOpenChannel2                        = 64
+	MsgAcceptChannel2                      = 65
+	MsgTxAddInput                          = 66
+	MsgTxAddOutput                         = 67
+	MsgTxRemoveInput                       = 68
+	MsgTxRemoveOutput                      = 69
+	MsgTxComplete                          = 70
+	MsgTxSignatures                        = 71
+	MsgTxInitRbf                           = 72
+	MsgTxAckRbf                            = 73
+	MsgTxAbort                             = 74
+	MsgSpliceLocked                        = 77
+	MsgSpliceInit                          = 80
+	MsgSpliceAck                           = 81
 	MsgDynPropose                          = 111
 	MsgDynAck                              = 113
 	MsgDynReject                           = 115
@@ -65,6 +79,7 @@ const (
 	MsgGossipTimestampRange                = 265
 	MsgChannelAnnouncement2                = 267
 	MsgChannelUpdate2                      = 271
+	MsgOnionMessage                        = 513
 	MsgKickoffSig                          = 777
 
 	// MsgEnd defines the end of the official message range of the protocol.
@@ -195,6 +210,36 @@ func (t MessageType) String() string {
 		return "ChannelAnnouncement2"
 	case MsgChannelUpdate2:
 		return "ChannelUpdate2"
+	case MsgOnionMessage:
+		return "OnionMessage"
+	case MsgOpenChannel2:
+		return "OpenChannel2"
+	case MsgAcceptChannel2:
+		return "AcceptChannel2"
+	case MsgTxAddInput:
+		return "TxAddInput"
+	case MsgTxAddOutput:
+		return "TxAddOutput"
+	case MsgTxRemoveInput:
+		return "TxRemoveInput"
+	case MsgTxRemoveOutput:
+		return "TxRemoveOutput"
+	case MsgTxComplete:
+		return "TxComplete"
+	case MsgTxSignatures:
+		return "TxSignatures"
+	case MsgTxInitRbf:
+		return "TxInitRbf"
+	case MsgTxAckRbf:
+		return "TxAckRbf"
+	case MsgTxAbort:
+		return "TxAbort"
+	case MsgSpliceLocked:
+		return "SpliceLocked"
+	case MsgSpliceInit:
+		return "SpliceInit"
+	case MsgSpliceAck:
+		return "SpliceAck"
 	default:
 		return "<unknown>"
 	}
@@ -357,6 +402,36 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 		msg = &ChannelAnnouncement2{}
 	case MsgChannelUpdate2:
 		msg = &ChannelUpdate2{}
+	case MsgOnionMessage:
+		msg = &OnionMessage{}
+	case MsgOpenChannel2:
+		msg = &OpenChannel2{}
+	case MsgAcceptChannel2:
+		msg = &AcceptChannel2{}
+	case MsgTxAddInput:
+		msg = &TxAddInput{}
+	case MsgTxAddOutput:
+		msg = &TxAddOutput{}
+	case MsgTxRemoveInput:
+		msg = &TxRemoveInput{}
+	case MsgTxRemoveOutput:
+		msg = &TxRemoveOutput{}
+	case MsgTxComplete:
+		msg = &TxComplete{}
+	case MsgTxSignatures:
+		msg = &TxSignatures{}
+	case MsgTxInitRbf:
+		msg = &TxInitRbf{}
+	case MsgTxAckRbf:
+		msg = &TxAckRbf{}
+	case MsgTxAbort:
+		msg = &TxAbort{}
+	case MsgSpliceLocked:
+		msg = &SpliceLocked{}
+	case MsgSpliceInit:
+		msg = &SpliceInit{}
+	case MsgSpliceAck:
+		msg = &SpliceAck{}
 	default:
 		// If the message is not within our custom range and has not
 		// specifically been overridden, return an unknown message.
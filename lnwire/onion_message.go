@@ -0,0 +1,115 @@
+package lnwire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+)
+
+// OnionMessage is a message that carries an onion encrypted payload along a
+// blinded route of nodes, without the need for a payment or an open channel.
+// It is used to transport BOLT 12 invoice requests and invoices, but can also
+// carry arbitrary application data.
+type OnionMessage struct {
+	// PathKey is the ephemeral public key that the receiving node uses to
+	// derive the shared secret for the current hop of the blinded route.
+	PathKey *btcec.PublicKey
+
+	// OnionBlob is the serialized onion message packet.
+	OnionBlob []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// NewOnionMessage creates a new OnionMessage.
+func NewOnionMessage(pathKey *btcec.PublicKey, onion []byte) *OnionMessage {
+	return &OnionMessage{
+		PathKey:   pathKey,
+		OnionBlob: onion,
+	}
+}
+
+// A compile time check to ensure OnionMessage implements the lnwire.Message
+// interface.
+var _ Message = (*OnionMessage)(nil)
+
+// A compile time check to ensure OnionMessage implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*OnionMessage)(nil)
+
+// Encode serializes the target OnionMessage into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WritePublicKey(w, o.PathKey); err != nil {
+		return err
+	}
+
+	if len(o.OnionBlob) > math.MaxUint16 {
+		return fmt.Errorf("onion message packet too large: %d bytes",
+			len(o.OnionBlob))
+	}
+
+	if err := WriteUint16(w, uint16(len(o.OnionBlob))); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, o.OnionBlob); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// Decode deserializes a serialized OnionMessage stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElement(r, &o.PathKey); err != nil {
+		return err
+	}
+
+	var l [2]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return err
+	}
+
+	o.OnionBlob = make([]byte, binary.BigEndian.Uint16(l[:]))
+	if _, err := io.ReadFull(r, o.OnionBlob); err != nil {
+		return err
+	}
+
+	if err := ReadElement(r, &o.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(o.ExtraData) == 0 {
+		o.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying OnionMessage on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OnionMessage) MsgType() MessageType {
+	return MsgOnionMessage
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (o *OnionMessage) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(o)
+}
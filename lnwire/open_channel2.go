@@ -0,0 +1,297 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// OpenChannel2 is the message the opener of a dual-funded channel sends to
+// propose the channel. Unlike OpenChannel, the funding transaction is
+// constructed interactively, so both parties may contribute funds to it.
+type OpenChannel2 struct {
+	// ChainHash is the target chain that the opener wishes to open a
+	// channel within.
+	ChainHash chainhash.Hash
+
+	// PendingChannelID serves to uniquely identify the future channel
+	// until the channel ID derived from the revocation basepoints of both
+	// parties is known.
+	PendingChannelID [32]byte
+
+	// FundingFeePerKw is the fee rate in sat/kw the opener proposes for
+	// the funding transaction.
+	FundingFeePerKw uint32
+
+	// CommitFeePerKw is the fee rate in sat/kw the opener proposes for
+	// both commitment transactions.
+	CommitFeePerKw uint32
+
+	// FundingAmount is the amount the opener contributes to the funding
+	// output.
+	FundingAmount btcutil.Amount
+
+	// DustLimit is the specific dust limit the sender of this message
+	// would like enforced on their version of the commitment transaction.
+	DustLimit btcutil.Amount
+
+	// MaxValueInFlight represents the maximum amount of coins that can be
+	// pending within the channel at any given time.
+	MaxValueInFlight MilliSatoshi
+
+	// HtlcMinimum is the smallest HTLC that the sender of this message
+	// will accept.
+	HtlcMinimum MilliSatoshi
+
+	// CsvDelay is the number of blocks to use for the relative time lock
+	// in the pay-to-self output of the receiver's commitment transaction.
+	CsvDelay uint16
+
+	// MaxAcceptedHTLCs is the total number of incoming HTLC's that the
+	// sender of this channel will accept.
+	MaxAcceptedHTLCs uint16
+
+	// LockTime is the locktime of the funding transaction.
+	LockTime uint32
+
+	// FundingKey is the key that should be used on behalf of the sender
+	// within the 2-of-2 multi-sig output of the funding transaction.
+	FundingKey *btcec.PublicKey
+
+	// RevocationPoint is the base revocation point for the sending party.
+	RevocationPoint *btcec.PublicKey
+
+	// PaymentPoint is the base payment point for the sending party.
+	PaymentPoint *btcec.PublicKey
+
+	// DelayedPaymentPoint is the delay point for the sending party.
+	DelayedPaymentPoint *btcec.PublicKey
+
+	// HtlcPoint is the base point used to derive the set of keys for this
+	// party that will be used within the HTLC public key scripts.
+	HtlcPoint *btcec.PublicKey
+
+	// FirstCommitmentPoint is the first commitment point for the sending
+	// party.
+	FirstCommitmentPoint *btcec.PublicKey
+
+	// SecondCommitmentPoint is the second commitment point for the sending
+	// party.
+	SecondCommitmentPoint *btcec.PublicKey
+
+	// ChannelFlags is a bit-field which allows the opener of the channel
+	// to specify further behavior surrounding the channel.
+	ChannelFlags FundingFlag
+
+	// UpfrontShutdownScript is the script to which the channel funds
+	// should be paid when mutually closing the channel. This field is
+	// optional.
+	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the explicit channel type the opener wishes to open.
+	ChannelType *ChannelType
+
+	// RequestFunds optionally requests the acceptor to lease liquidity to
+	// the opener.
+	RequestFunds *RequestFunds
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure OpenChannel2 implements the lnwire.Message
+// interface.
+var _ Message = (*OpenChannel2)(nil)
+
+// A compile time check to ensure OpenChannel2 implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*OpenChannel2)(nil)
+
+// Encode serializes the target OpenChannel2 into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OpenChannel2) Encode(w *bytes.Buffer, _ uint32) error {
+	var recordProducers []tlv.RecordProducer
+	if len(o.UpfrontShutdownScript) > 0 {
+		recordProducers = append(
+			recordProducers, &o.UpfrontShutdownScript,
+		)
+	}
+	if o.ChannelType != nil {
+		recordProducers = append(recordProducers, o.ChannelType)
+	}
+	if o.RequestFunds != nil {
+		recordProducers = append(recordProducers, o.RequestFunds)
+	}
+	err := EncodeMessageExtraData(&o.ExtraData, recordProducers...)
+	if err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, o.ChainHash[:]); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, o.PendingChannelID[:]); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, o.FundingFeePerKw); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, o.CommitFeePerKw); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, o.FundingAmount); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, o.DustLimit); err != nil {
+		return err
+	}
+
+	if err := WriteMilliSatoshi(w, o.MaxValueInFlight); err != nil {
+		return err
+	}
+
+	if err := WriteMilliSatoshi(w, o.HtlcMinimum); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, o.CsvDelay); err != nil {
+		return err
+	}
+
+	if err := WriteUint16(w, o.MaxAcceptedHTLCs); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, o.LockTime); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.FundingKey); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.RevocationPoint); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.PaymentPoint); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.DelayedPaymentPoint); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.HtlcPoint); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.FirstCommitmentPoint); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, o.SecondCommitmentPoint); err != nil {
+		return err
+	}
+
+	if err := WriteFundingFlag(w, o.ChannelFlags); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, o.ExtraData)
+}
+
+// Decode deserializes a serialized OpenChannel2 stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (o *OpenChannel2) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r,
+		o.ChainHash[:],
+		o.PendingChannelID[:],
+		&o.FundingFeePerKw,
+		&o.CommitFeePerKw,
+		&o.FundingAmount,
+		&o.DustLimit,
+		&o.MaxValueInFlight,
+		&o.HtlcMinimum,
+		&o.CsvDelay,
+		&o.MaxAcceptedHTLCs,
+		&o.LockTime,
+		&o.FundingKey,
+		&o.RevocationPoint,
+		&o.PaymentPoint,
+		&o.DelayedPaymentPoint,
+		&o.HtlcPoint,
+		&o.FirstCommitmentPoint,
+		&o.SecondCommitmentPoint,
+		&o.ChannelFlags,
+	)
+	if err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	var (
+		shutdownScript DeliveryAddress
+		chanType       ChannelType
+		requestFunds   RequestFunds
+	)
+	typeMap, err := tlvRecords.ExtractRecords(
+		&shutdownScript, &chanType, &requestFunds,
+	)
+	if err != nil {
+		return err
+	}
+
+	o.UpfrontShutdownScript = nil
+	if val, ok := typeMap[DeliveryAddrType]; ok && val == nil {
+		o.UpfrontShutdownScript = shutdownScript
+	}
+	o.ChannelType = nil
+	if val, ok := typeMap[ChannelTypeRecordType]; ok && val == nil {
+		o.ChannelType = &chanType
+	}
+	o.RequestFunds = nil
+	if val, ok := typeMap[RequestFundsRecordType]; ok && val == nil {
+		o.RequestFunds = &requestFunds
+	}
+
+	o.ExtraData = nil
+	if len(tlvRecords) != 0 {
+		o.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying OpenChannel2 on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (o *OpenChannel2) MsgType() MessageType {
+	return MsgOpenChannel2
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (o *OpenChannel2) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(o)
+}
@@ -0,0 +1,91 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// SpliceAck is sent in response to a SpliceInit message to accept the
+// proposed splice.
+type SpliceAck struct {
+	// ChannelID is the ID of the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount the sender adds to the channel.
+	FundingContribution btcutil.Amount
+
+	// FundingPubKey is the sender's funding key for the new funding
+	// output.
+	FundingPubKey *btcec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceAck implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceAck)(nil)
+
+// A compile time check to ensure SpliceAck implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*SpliceAck)(nil)
+
+// Encode serializes the target SpliceAck into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingPubKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceAck stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(
+		r, &s.ChannelID, &s.FundingContribution, &s.FundingPubKey,
+		&s.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(s.ExtraData) == 0 {
+		s.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying SpliceAck on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceAck) MsgType() MessageType {
+	return MsgSpliceAck
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (s *SpliceAck) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(s)
+}
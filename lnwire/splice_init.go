@@ -0,0 +1,107 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// SpliceInit is sent by the initiator of a splice, once the channel is
+// quiescent, to propose adding funds to or removing funds from the channel.
+type SpliceInit struct {
+	// ChannelID is the ID of the channel that is spliced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount the initiator adds to the channel.
+	// A negative value removes funds from the initiator's balance.
+	FundingContribution btcutil.Amount
+
+	// FundingFeePerKw is the fee rate in sat/kw the initiator proposes for
+	// the splice transaction.
+	FundingFeePerKw uint32
+
+	// LockTime is the locktime of the splice transaction.
+	LockTime uint32
+
+	// FundingPubKey is the initiator's funding key for the new funding
+	// output.
+	FundingPubKey *btcec.PublicKey
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceInit)(nil)
+
+// A compile time check to ensure SpliceInit implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*SpliceInit)(nil)
+
+// Encode serializes the target SpliceInit into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, s.FundingContribution); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.FundingFeePerKw); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, s.LockTime); err != nil {
+		return err
+	}
+
+	if err := WritePublicKey(w, s.FundingPubKey); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceInit stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(
+		r, &s.ChannelID, &s.FundingContribution, &s.FundingFeePerKw,
+		&s.LockTime, &s.FundingPubKey, &s.ExtraData,
+	)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(s.ExtraData) == 0 {
+		s.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying SpliceInit on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceInit) MsgType() MessageType {
+	return MsgSpliceInit
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (s *SpliceInit) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(s)
+}
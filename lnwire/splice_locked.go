@@ -0,0 +1,87 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+)
+
+// SpliceLocked is sent once the sender considers a splice transaction to be
+// sufficiently confirmed. Once both parties sent SpliceLocked, the output of
+// the splice transaction becomes the new funding output of the channel.
+type SpliceLocked struct {
+	// ChannelID is the ID of the channel that was spliced.
+	ChannelID ChannelID
+
+	// SpliceTxID is the txid of the splice transaction.
+	SpliceTxID chainhash.Hash
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure SpliceLocked implements the lnwire.Message
+// interface.
+var _ Message = (*SpliceLocked)(nil)
+
+// A compile time check to ensure SpliceLocked implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*SpliceLocked)(nil)
+
+// Encode serializes the target SpliceLocked into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, s.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, s.SpliceTxID[:]); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, s.ExtraData)
+}
+
+// Decode deserializes a serialized SpliceLocked stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElement(r, &s.ChannelID); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, s.SpliceTxID[:]); err != nil {
+		return err
+	}
+
+	if err := ReadElement(r, &s.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(s.ExtraData) == 0 {
+		s.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying SpliceLocked on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (s *SpliceLocked) MsgType() MessageType {
+	return MsgSpliceLocked
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (s *SpliceLocked) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(s)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcutil"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/tlv"
 	"github.com/stretchr/testify/require"
@@ -102,6 +103,76 @@ func (a *AcceptChannel) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure AcceptChannel2 implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*AcceptChannel2)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *AcceptChannel2) RandTestMessage(t *rapid.T) Message {
+	var pendingChanID [32]byte
+	pendingChanIDBytes := rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(
+		t, "pendingChanID",
+	)
+	copy(pendingChanID[:], pendingChanIDBytes)
+
+	m := &AcceptChannel2{
+		PendingChannelID: pendingChanID,
+		FundingAmount: btcutil.Amount(
+			rapid.IntRange(0, 10000000).Draw(t, "fundingAmount"),
+		),
+		DustLimit: btcutil.Amount(
+			rapid.IntRange(100, 1000).Draw(t, "dustLimit"),
+		),
+		MaxValueInFlight: MilliSatoshi(
+			rapid.IntRange(10000, 1000000).Draw(
+				t, "maxValueInFlight",
+			),
+		),
+		HtlcMinimum: MilliSatoshi(
+			rapid.IntRange(1, 1000).Draw(t, "htlcMinimum"),
+		),
+		MinAcceptDepth: uint32(
+			rapid.IntRange(1, 10).Draw(t, "minAcceptDepth"),
+		),
+		CsvDelay: uint16(
+			rapid.IntRange(144, 1000).Draw(t, "csvDelay"),
+		),
+		MaxAcceptedHTLCs: uint16(
+			rapid.IntRange(10, 500).Draw(t, "maxAcceptedHTLCs"),
+		),
+		FundingKey:            RandPubKey(t),
+		RevocationPoint:       RandPubKey(t),
+		PaymentPoint:          RandPubKey(t),
+		DelayedPaymentPoint:   RandPubKey(t),
+		HtlcPoint:             RandPubKey(t),
+		FirstCommitmentPoint:  RandPubKey(t),
+		SecondCommitmentPoint: RandPubKey(t),
+	}
+
+	if rapid.Bool().Draw(t, "includeShutdownScript") {
+		m.UpfrontShutdownScript = RandDeliveryAddress(t)
+	}
+	if rapid.Bool().Draw(t, "includeChannelType") {
+		m.ChannelType = RandChannelType(t)
+	}
+	if rapid.Bool().Draw(t, "includeWillFund") {
+		m.WillFund = &WillFund{
+			Signature: RandSignature(t),
+			Rates:     RandLeaseRates(t),
+		}
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure AnnounceSignatures1 implements the
 // lnwire.TestMessage interface.
 var _ TestMessage = (*AnnounceSignatures1)(nil)
@@ -1220,6 +1291,32 @@ func (a *NodeAnnouncement) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure OnionMessage implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*OnionMessage)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (o *OnionMessage) RandTestMessage(t *rapid.T) Message {
+	blobLen := rapid.IntRange(1, 1400).Draw(t, "onionBlobLen")
+
+	m := &OnionMessage{
+		PathKey: RandPubKey(t),
+		OnionBlob: rapid.SliceOfN(rapid.Byte(), blobLen, blobLen).Draw(
+			t, "onionBlob",
+		),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure OpenChannel implements the TestMessage
 // interface.
 var _ TestMessage = (*OpenChannel)(nil)
@@ -1313,6 +1410,91 @@ func (o *OpenChannel) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure OpenChannel2 implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*OpenChannel2)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (o *OpenChannel2) RandTestMessage(t *rapid.T) Message {
+	var pendingChanID [32]byte
+	pendingChanIDBytes := rapid.SliceOfN(rapid.Byte(), 32, 32).Draw(
+		t, "pendingChanID",
+	)
+	copy(pendingChanID[:], pendingChanIDBytes)
+
+	var channelFlags FundingFlag
+	if rapid.Bool().Draw(t, "announceChannel") {
+		channelFlags |= FFAnnounceChannel
+	}
+
+	m := &OpenChannel2{
+		ChainHash:        RandChainHash(t),
+		PendingChannelID: pendingChanID,
+		FundingFeePerKw: uint32(
+			rapid.IntRange(250, 10000).Draw(t, "fundingFeePerKw"),
+		),
+		CommitFeePerKw: uint32(
+			rapid.IntRange(250, 10000).Draw(t, "commitFeePerKw"),
+		),
+		FundingAmount: btcutil.Amount(
+			rapid.IntRange(5000, 10000000).Draw(t, "fundingAmount"),
+		),
+		DustLimit: btcutil.Amount(
+			rapid.IntRange(100, 1000).Draw(t, "dustLimit"),
+		),
+		MaxValueInFlight: MilliSatoshi(
+			rapid.IntRange(10000, 1000000).Draw(
+				t, "maxValueInFlight",
+			),
+		),
+		HtlcMinimum: MilliSatoshi(
+			rapid.IntRange(1, 1000).Draw(t, "htlcMinimum"),
+		),
+		CsvDelay: uint16(
+			rapid.IntRange(144, 1000).Draw(t, "csvDelay"),
+		),
+		MaxAcceptedHTLCs: uint16(
+			rapid.IntRange(10, 500).Draw(t, "maxAcceptedHTLCs"),
+		),
+		LockTime:              rapid.Uint32().Draw(t, "lockTime"),
+		FundingKey:            RandPubKey(t),
+		RevocationPoint:       RandPubKey(t),
+		PaymentPoint:          RandPubKey(t),
+		DelayedPaymentPoint:   RandPubKey(t),
+		HtlcPoint:             RandPubKey(t),
+		FirstCommitmentPoint:  RandPubKey(t),
+		SecondCommitmentPoint: RandPubKey(t),
+		ChannelFlags:          channelFlags,
+	}
+
+	if rapid.Bool().Draw(t, "includeShutdownScript") {
+		m.UpfrontShutdownScript = RandDeliveryAddress(t)
+	}
+	if rapid.Bool().Draw(t, "includeChannelType") {
+		m.ChannelType = RandChannelType(t)
+	}
+	if rapid.Bool().Draw(t, "includeRequestFunds") {
+		m.RequestFunds = &RequestFunds{
+			RequestedAmount: btcutil.Amount(
+				rapid.IntRange(1, 10000000).Draw(
+					t, "requestedAmount",
+				),
+			),
+			BlockHeight: rapid.Uint32().Draw(t, "blockHeight"),
+		}
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure Ping implements the lnwire.TestMessage
 // interface.
 var _ TestMessage = (*Ping)(nil)
@@ -1616,6 +1798,80 @@ func (s *Shutdown) RandTestMessage(t *rapid.T) Message {
 	}
 }
 
+// A compile time check to ensure SpliceAck implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*SpliceAck)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (s *SpliceAck) RandTestMessage(t *rapid.T) Message {
+	m := &SpliceAck{
+		ChannelID: RandChannelID(t),
+		FundingContribution: btcutil.Amount(
+			rapid.Int64().Draw(t, "fundingContribution"),
+		),
+		FundingPubKey: RandPubKey(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure SpliceInit implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*SpliceInit)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (s *SpliceInit) RandTestMessage(t *rapid.T) Message {
+	m := &SpliceInit{
+		ChannelID: RandChannelID(t),
+		FundingContribution: btcutil.Amount(
+			rapid.Int64().Draw(t, "fundingContribution"),
+		),
+		FundingFeePerKw: rapid.Uint32().Draw(t, "fundingFeePerKw"),
+		LockTime:        rapid.Uint32().Draw(t, "lockTime"),
+		FundingPubKey:   RandPubKey(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure SpliceLocked implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*SpliceLocked)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (s *SpliceLocked) RandTestMessage(t *rapid.T) Message {
+	m := &SpliceLocked{
+		ChannelID:  RandChannelID(t),
+		SpliceTxID: RandChainHash(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure Stfu implements the lnwire.TestMessage
 // interface.
 var _ TestMessage = (*Stfu)(nil)
@@ -1638,6 +1894,267 @@ func (s *Stfu) RandTestMessage(t *rapid.T) Message {
 	return m
 }
 
+// A compile time check to ensure TxAbort implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAbort)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *TxAbort) RandTestMessage(t *rapid.T) Message {
+	m := &TxAbort{
+		ChannelID: RandChannelID(t),
+	}
+
+	data := rapid.SliceOfN(rapid.Byte(), 0, 100).Draw(t, "data")
+	if len(data) > 0 {
+		m.Data = data
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxAckRbf implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAckRbf)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *TxAckRbf) RandTestMessage(t *rapid.T) Message {
+	m := &TxAckRbf{
+		ChannelID:           RandChannelID(t),
+		FundingContribution: randFundingContribution(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxAddInput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *TxAddInput) RandTestMessage(t *rapid.T) Message {
+	m := &TxAddInput{
+		ChannelID: RandChannelID(t),
+		SerialID:  rapid.Uint64().Draw(t, "serialID"),
+		PrevTxOut: rapid.Uint32().Draw(t, "prevTxOut"),
+		Sequence:  rapid.Uint32().Draw(t, "sequence"),
+	}
+
+	// An input either carries the previous transaction, or it is the
+	// shared input that is identified by its txid.
+	if rapid.Bool().Draw(t, "sharedInput") {
+		m.SharedInputTxid = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0, [32]byte](
+				RandChainHash(t),
+			),
+		)
+	} else {
+		m.PrevTx = rapid.SliceOfN(rapid.Byte(), 60, 1000).Draw(
+			t, "prevTx",
+		)
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxAddOutput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxAddOutput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (a *TxAddOutput) RandTestMessage(t *rapid.T) Message {
+	m := &TxAddOutput{
+		ChannelID: RandChannelID(t),
+		SerialID:  rapid.Uint64().Draw(t, "serialID"),
+		Amount: btcutil.Amount(
+			rapid.Int64Range(0, 21e14).Draw(t, "amount"),
+		),
+		PkScript: rapid.SliceOfN(rapid.Byte(), 1, 100).Draw(
+			t, "pkScript",
+		),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxComplete)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (c *TxComplete) RandTestMessage(t *rapid.T) Message {
+	m := &TxComplete{
+		ChannelID: RandChannelID(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxInitRbf implements the lnwire.TestMessage
+// interface.
+var _ TestMessage = (*TxInitRbf)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (i *TxInitRbf) RandTestMessage(t *rapid.T) Message {
+	m := &TxInitRbf{
+		ChannelID:           RandChannelID(t),
+		LockTime:            rapid.Uint32().Draw(t, "lockTime"),
+		FeeRate:             rapid.Uint32().Draw(t, "feeRate"),
+		FundingContribution: randFundingContribution(t),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// randFundingContribution returns an optional funding contribution record that
+// is set at random.
+func randFundingContribution(t *rapid.T) FundingContributionTLV {
+	var contribution FundingContributionTLV
+	if rapid.Bool().Draw(t, "includeFundingContribution") {
+		amt := rapid.Uint64().Draw(t, "fundingContribution")
+		contribution = tlv.SomeRecordT(
+			tlv.NewPrimitiveRecord[tlv.TlvType0](amt),
+		)
+	}
+
+	return contribution
+}
+
+// A compile time check to ensure TxRemoveInput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxRemoveInput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (r *TxRemoveInput) RandTestMessage(t *rapid.T) Message {
+	m := &TxRemoveInput{
+		ChannelID: RandChannelID(t),
+		SerialID:  rapid.Uint64().Draw(t, "serialID"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxRemoveOutput implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxRemoveOutput)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (r *TxRemoveOutput) RandTestMessage(t *rapid.T) Message {
+	m := &TxRemoveOutput{
+		ChannelID: RandChannelID(t),
+		SerialID:  rapid.Uint64().Draw(t, "serialID"),
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
+// A compile time check to ensure TxSignatures implements the
+// lnwire.TestMessage interface.
+var _ TestMessage = (*TxSignatures)(nil)
+
+// RandTestMessage populates the message with random data suitable for testing.
+// It uses the rapid testing framework to generate random values.
+//
+// This is part of the TestMessage interface.
+func (s *TxSignatures) RandTestMessage(t *rapid.T) Message {
+	m := &TxSignatures{
+		ChannelID: RandChannelID(t),
+		TxID:      RandChainHash(t),
+	}
+
+	numWitnesses := rapid.IntRange(0, 5).Draw(t, "numWitnesses")
+	for i := 0; i < numWitnesses; i++ {
+		numItems := rapid.IntRange(0, 4).Draw(t, "numItems")
+
+		witness := make(wire.TxWitness, 0, numItems)
+		for j := 0; j < numItems; j++ {
+			witness = append(
+				witness, rapid.SliceOfN(
+					rapid.Byte(), 1, 80,
+				).Draw(t, "witnessItem"),
+			)
+		}
+
+		m.Witnesses = append(m.Witnesses, witness)
+	}
+
+	if rapid.Bool().Draw(t, "includeSharedInputSig") {
+		m.SharedInputSig = tlv.SomeRecordT(
+			tlv.NewRecordT[tlv.TlvType0](RandSignature(t)),
+		)
+	}
+
+	extraData := RandExtraOpaqueData(t, nil)
+	if len(extraData) > 0 {
+		m.ExtraData = extraData
+	}
+
+	return m
+}
+
 // A compile time check to ensure UpdateAddHTLC implements the
 // lnwire.TestMessage interface.
 var _ TestMessage = (*UpdateAddHTLC)(nil)
@@ -347,6 +347,19 @@ func RandLeaseExpiry(t *rapid.T) *LeaseExpiry {
 	return &exp
 }
 
+// RandLeaseRates generates random lease rates.
+func RandLeaseRates(t *rapid.T) LeaseRates {
+	return LeaseRates{
+		FundingWeight: rapid.Uint16().Draw(t, "fundingWeight"),
+		LeaseFeeBasis: rapid.Uint16().Draw(t, "leaseFeeBasis"),
+		ChannelFeeMaxProportional: rapid.Uint16().Draw(
+			t, "channelFeeMaxProportional",
+		),
+		LeaseFeeBase:      rapid.Uint32().Draw(t, "leaseFeeBase"),
+		ChannelFeeMaxBase: rapid.Uint32().Draw(t, "channelFeeMaxBase"),
+	}
+}
+
 // RandOutPoint generates a random transaction outpoint.
 func RandOutPoint(t *rapid.T) wire.OutPoint {
 	// Generate a random transaction ID
@@ -0,0 +1,98 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// TxAbort is sent to abort the interactive construction of a transaction, or
+// the signing of a constructed transaction that wasn't broadcast yet.
+type TxAbort struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// Data is an optional human readable reason for the abort.
+	Data []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAbort implements the lnwire.Message
+// interface.
+var _ Message = (*TxAbort)(nil)
+
+// A compile time check to ensure TxAbort implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAbort)(nil)
+
+// Error returns the abort reason as a string.
+//
+// NOTE: Satisfies the error interface.
+func (t *TxAbort) Error() string {
+	return fmt.Sprintf("interactive tx aborted: %s", t.Data)
+}
+
+// Encode serializes the target TxAbort into the passed bytes.Buffer observing
+// the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteVarBytes(w, t.Data); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAbort stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElement(r, &t.ChannelID); err != nil {
+		return err
+	}
+
+	var err error
+	t.Data, err = ReadVarBytes(r)
+	if err != nil {
+		return err
+	}
+
+	if err := ReadElement(r, &t.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.Data) == 0 {
+		t.Data = nil
+	}
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxAbort on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAbort) MsgType() MessageType {
+	return MsgTxAbort
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAbort) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
@@ -0,0 +1,78 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxAckRbf is sent by the acceptor of a dual-funded channel to agree to the
+// replacement of the pending funding transaction proposed by TxInitRbf.
+type TxAckRbf struct {
+	// ChannelID is the ID of the channel whose funding transaction is
+	// replaced.
+	ChannelID ChannelID
+
+	// FundingContribution is the amount the sender contributes to the
+	// funding output of the replacement transaction. If it isn't set, the
+	// contribution of the replaced transaction is kept.
+	FundingContribution FundingContributionTLV
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAckRbf implements the lnwire.Message
+// interface.
+var _ Message = (*TxAckRbf)(nil)
+
+// A compile time check to ensure TxAckRbf implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAckRbf)(nil)
+
+// Encode serializes the target TxAckRbf into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	err := encodeFundingContribution(&t.ExtraData, t.FundingContribution)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAckRbf stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElement(r, &t.ChannelID); err != nil {
+		return err
+	}
+
+	var err error
+	t.FundingContribution, t.ExtraData, err = decodeFundingContribution(r)
+
+	return err
+}
+
+// MsgType returns the integer uniquely identifying TxAckRbf on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAckRbf) MsgType() MessageType {
+	return MsgTxAckRbf
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAckRbf) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
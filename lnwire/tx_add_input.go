@@ -0,0 +1,153 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// SharedInputTxidTLV is the TLV record that identifies the transaction that
+// holds the shared input of an interactive transaction, such as the current
+// funding output of a channel that is being spliced.
+type SharedInputTxidTLV = tlv.OptionalRecordT[tlv.TlvType0, [32]byte]
+
+// TxAddInput is sent during the interactive construction of a transaction to
+// add an input to the transaction.
+type TxAddInput struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID is the unique ID of the input within the transaction
+	// construction session. The initiator uses even IDs, the other party
+	// uses odd IDs.
+	SerialID uint64
+
+	// PrevTx is the serialized transaction that holds the output that is
+	// spent by this input. It is empty for the shared input.
+	PrevTx []byte
+
+	// PrevTxOut is the index of the output within PrevTx that is spent.
+	PrevTxOut uint32
+
+	// Sequence is the sequence number of the input.
+	Sequence uint32
+
+	// SharedInputTxid is set if this input is the shared input of the
+	// transaction, in which case PrevTx is omitted.
+	SharedInputTxid SharedInputTxidTLV
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddInput)(nil)
+
+// A compile time check to ensure TxAddInput implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAddInput)(nil)
+
+// Encode serializes the target TxAddInput into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteVarBytes(w, t.PrevTx); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.PrevTxOut); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.Sequence); err != nil {
+		return err
+	}
+
+	var records []tlv.RecordProducer
+	t.SharedInputTxid.WhenSome(
+		func(txid tlv.RecordT[tlv.TlvType0, [32]byte]) {
+			records = append(records, &txid)
+		},
+	)
+
+	err := EncodeMessageExtraData(&t.ExtraData, records...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddInput stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElements(r, &t.ChannelID, &t.SerialID); err != nil {
+		return err
+	}
+
+	var err error
+	t.PrevTx, err = ReadVarBytes(r)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.PrevTx) == 0 {
+		t.PrevTx = nil
+	}
+
+	if err := ReadElements(r, &t.PrevTxOut, &t.Sequence); err != nil {
+		return err
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	sharedTxid := t.SharedInputTxid.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&sharedTxid)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := typeMap[sharedTxid.TlvType()]; ok && val == nil {
+		t.SharedInputTxid = tlv.SomeRecordT(sharedTxid)
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxAddInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddInput) MsgType() MessageType {
+	return MsgTxAddInput
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAddInput) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
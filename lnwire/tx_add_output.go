@@ -0,0 +1,109 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/btcsuite/btcd/btcutil"
+)
+
+// TxAddOutput is sent during the interactive construction of a transaction to
+// add an output to the transaction.
+type TxAddOutput struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID is the unique ID of the output within the transaction
+	// construction session. The initiator uses even IDs, the other party
+	// uses odd IDs.
+	SerialID uint64
+
+	// Amount is the value of the output.
+	Amount btcutil.Amount
+
+	// PkScript is the script the output pays to.
+	PkScript []byte
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxAddOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxAddOutput)(nil)
+
+// A compile time check to ensure TxAddOutput implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxAddOutput)(nil)
+
+// Encode serializes the target TxAddOutput into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	if err := WriteSatoshi(w, t.Amount); err != nil {
+		return err
+	}
+
+	if err := WriteVarBytes(w, t.PkScript); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxAddOutput stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChannelID, &t.SerialID, &t.Amount)
+	if err != nil {
+		return err
+	}
+
+	t.PkScript, err = ReadVarBytes(r)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.PkScript) == 0 {
+		t.PkScript = nil
+	}
+
+	if err := ReadElement(r, &t.ExtraData); err != nil {
+		return err
+	}
+
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxAddOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxAddOutput) MsgType() MessageType {
+	return MsgTxAddOutput
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxAddOutput) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
@@ -0,0 +1,71 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxComplete is sent during the interactive construction of a transaction to
+// signal that the sender has no further inputs or outputs to add. The
+// construction is finished once both parties sent TxComplete consecutively.
+type TxComplete struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxComplete implements the lnwire.Message
+// interface.
+var _ Message = (*TxComplete)(nil)
+
+// A compile time check to ensure TxComplete implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxComplete)(nil)
+
+// Encode serializes the target TxComplete into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxComplete stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElements(r, &t.ChannelID, &t.ExtraData); err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxComplete on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxComplete) MsgType() MessageType {
+	return MsgTxComplete
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxComplete) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
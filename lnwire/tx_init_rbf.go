@@ -0,0 +1,142 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// FundingContributionTLV is the TLV record that carries the amount the sender
+// contributes to the funding output of a replacement transaction. The value is
+// encoded as a signed 64-bit integer.
+type FundingContributionTLV = tlv.OptionalRecordT[tlv.TlvType0, uint64]
+
+// TxInitRbf is sent by the opener of a dual-funded channel to replace the
+// pending funding transaction with one that pays a higher fee rate.
+type TxInitRbf struct {
+	// ChannelID is the ID of the channel whose funding transaction is
+	// replaced.
+	ChannelID ChannelID
+
+	// LockTime is the locktime of the replacement transaction.
+	LockTime uint32
+
+	// FeeRate is the fee rate in sat/kw of the replacement transaction.
+	FeeRate uint32
+
+	// FundingContribution is the amount the sender contributes to the
+	// funding output of the replacement transaction. If it isn't set, the
+	// contribution of the replaced transaction is kept.
+	FundingContribution FundingContributionTLV
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxInitRbf implements the lnwire.Message
+// interface.
+var _ Message = (*TxInitRbf)(nil)
+
+// A compile time check to ensure TxInitRbf implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxInitRbf)(nil)
+
+// Encode serializes the target TxInitRbf into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.LockTime); err != nil {
+		return err
+	}
+
+	if err := WriteUint32(w, t.FeeRate); err != nil {
+		return err
+	}
+
+	err := encodeFundingContribution(&t.ExtraData, t.FundingContribution)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxInitRbf stored in the passed io.Reader
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChannelID, &t.LockTime, &t.FeeRate)
+	if err != nil {
+		return err
+	}
+
+	t.FundingContribution, t.ExtraData, err = decodeFundingContribution(r)
+
+	return err
+}
+
+// MsgType returns the integer uniquely identifying TxInitRbf on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxInitRbf) MsgType() MessageType {
+	return MsgTxInitRbf
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxInitRbf) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
+
+// encodeFundingContribution packs the funding contribution record, if set,
+// into the given extra data.
+func encodeFundingContribution(extraData *ExtraOpaqueData,
+	contribution FundingContributionTLV) error {
+
+	var records []tlv.RecordProducer
+	contribution.WhenSome(func(r tlv.RecordT[tlv.TlvType0, uint64]) {
+		records = append(records, &r)
+	})
+
+	return EncodeMessageExtraData(extraData, records...)
+}
+
+// decodeFundingContribution reads the TLV stream at the end of a message and
+// extracts the funding contribution record from it.
+func decodeFundingContribution(r io.Reader) (FundingContributionTLV,
+	ExtraOpaqueData, error) {
+
+	var (
+		contribution FundingContributionTLV
+		tlvRecords   ExtraOpaqueData
+	)
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return contribution, nil, err
+	}
+
+	record := contribution.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&record)
+	if err != nil {
+		return contribution, nil, err
+	}
+
+	if val, ok := typeMap[record.TlvType()]; ok && val == nil {
+		contribution = tlv.SomeRecordT(record)
+	}
+
+	if len(tlvRecords) == 0 {
+		return contribution, nil, nil
+	}
+
+	return contribution, tlvRecords, nil
+}
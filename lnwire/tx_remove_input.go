@@ -0,0 +1,78 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxRemoveInput is sent during the interactive construction of a transaction
+// to remove an input that was previously added by the sender.
+type TxRemoveInput struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID is the ID of the input that is removed.
+	SerialID uint64
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxRemoveInput implements the lnwire.Message
+// interface.
+var _ Message = (*TxRemoveInput)(nil)
+
+// A compile time check to ensure TxRemoveInput implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxRemoveInput)(nil)
+
+// Encode serializes the target TxRemoveInput into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxRemoveInput stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChannelID, &t.SerialID, &t.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxRemoveInput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveInput) MsgType() MessageType {
+	return MsgTxRemoveInput
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxRemoveInput) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
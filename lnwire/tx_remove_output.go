@@ -0,0 +1,78 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+)
+
+// TxRemoveOutput is sent during the interactive construction of a transaction
+// to remove an output that was previously added by the sender.
+type TxRemoveOutput struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// SerialID is the ID of the output that is removed.
+	SerialID uint64
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxRemoveOutput implements the lnwire.Message
+// interface.
+var _ Message = (*TxRemoveOutput)(nil)
+
+// A compile time check to ensure TxRemoveOutput implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxRemoveOutput)(nil)
+
+// Encode serializes the target TxRemoveOutput into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveOutput) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteUint64(w, t.SerialID); err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxRemoveOutput stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveOutput) Decode(r io.Reader, _ uint32) error {
+	err := ReadElements(r, &t.ChannelID, &t.SerialID, &t.ExtraData)
+	if err != nil {
+		return err
+	}
+
+	// This is required to pass the fuzz test round trip equality check.
+	if len(t.ExtraData) == 0 {
+		t.ExtraData = nil
+	}
+
+	return nil
+}
+
+// MsgType returns the integer uniquely identifying TxRemoveOutput on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxRemoveOutput) MsgType() MessageType {
+	return MsgTxRemoveOutput
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxRemoveOutput) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
@@ -0,0 +1,216 @@
+package lnwire
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// SharedInputSigTLV is the TLV record that carries the sender's signature
+// for the shared input of an interactive transaction.
+type SharedInputSigTLV = tlv.OptionalRecordT[tlv.TlvType0, Sig]
+
+// TxSignatures is sent once an interactively constructed transaction has been
+// agreed on, and carries the witnesses for the inputs the sender added to the
+// transaction.
+type TxSignatures struct {
+	// ChannelID is the ID of the channel the transaction is constructed
+	// for.
+	ChannelID ChannelID
+
+	// TxID is the txid of the transaction the witnesses are for.
+	TxID chainhash.Hash
+
+	// Witnesses are the witnesses of the inputs the sender added, ordered
+	// by the serial ID of the inputs.
+	Witnesses []wire.TxWitness
+
+	// SharedInputSig is the sender's signature for the shared input of
+	// the transaction, if there is one.
+	SharedInputSig SharedInputSigTLV
+
+	// ExtraData is the set of data that was appended to this message to
+	// fill out the full maximum transport message size. These fields can
+	// be used to specify optional data such as custom TLV fields.
+	ExtraData ExtraOpaqueData
+}
+
+// A compile time check to ensure TxSignatures implements the lnwire.Message
+// interface.
+var _ Message = (*TxSignatures)(nil)
+
+// A compile time check to ensure TxSignatures implements the
+// lnwire.SizeableMessage interface.
+var _ SizeableMessage = (*TxSignatures)(nil)
+
+// Encode serializes the target TxSignatures into the passed bytes.Buffer
+// observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Encode(w *bytes.Buffer, _ uint32) error {
+	if err := WriteChannelID(w, t.ChannelID); err != nil {
+		return err
+	}
+
+	if err := WriteBytes(w, t.TxID[:]); err != nil {
+		return err
+	}
+
+	if len(t.Witnesses) > math.MaxUint16 {
+		return fmt.Errorf("too many witnesses: %d", len(t.Witnesses))
+	}
+
+	err := WriteUint16(w, uint16(len(t.Witnesses)))
+	if err != nil {
+		return err
+	}
+
+	for _, witness := range t.Witnesses {
+		var b bytes.Buffer
+		if err := writeTxWitness(&b, witness); err != nil {
+			return err
+		}
+
+		if err := WriteVarBytes(w, b.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	var records []tlv.RecordProducer
+	t.SharedInputSig.WhenSome(func(sig tlv.RecordT[tlv.TlvType0, Sig]) {
+		records = append(records, &sig)
+	})
+
+	err = EncodeMessageExtraData(&t.ExtraData, records...)
+	if err != nil {
+		return err
+	}
+
+	return WriteBytes(w, t.ExtraData)
+}
+
+// Decode deserializes a serialized TxSignatures stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) Decode(r io.Reader, _ uint32) error {
+	if err := ReadElement(r, &t.ChannelID); err != nil {
+		return err
+	}
+
+	if _, err := io.ReadFull(r, t.TxID[:]); err != nil {
+		return err
+	}
+
+	var numWitnesses uint16
+	if err := ReadElement(r, &numWitnesses); err != nil {
+		return err
+	}
+
+	t.Witnesses = nil
+	for i := 0; i < int(numWitnesses); i++ {
+		witnessBytes, err := ReadVarBytes(r)
+		if err != nil {
+			return err
+		}
+
+		witness, err := readTxWitness(bytes.NewReader(witnessBytes))
+		if err != nil {
+			return err
+		}
+
+		t.Witnesses = append(t.Witnesses, witness)
+	}
+
+	var tlvRecords ExtraOpaqueData
+	if err := ReadElements(r, &tlvRecords); err != nil {
+		return err
+	}
+
+	sharedSig := t.SharedInputSig.Zero()
+	typeMap, err := tlvRecords.ExtractRecords(&sharedSig)
+	if err != nil {
+		return err
+	}
+
+	if val, ok := typeMap[sharedSig.TlvType()]; ok && val == nil {
+		t.SharedInputSig = tlv.SomeRecordT(sharedSig)
+	}
+
+	if len(tlvRecords) != 0 {
+		t.ExtraData = tlvRecords
+	}
+
+	return nil
+}
+
+// writeTxWitness serializes a witness stack in the same format that is used
+// for witnesses within bitcoin transactions.
+func writeTxWitness(w io.Writer, witness wire.TxWitness) error {
+	err := wire.WriteVarInt(w, 0, uint64(len(witness)))
+	if err != nil {
+		return err
+	}
+
+	for _, item := range witness {
+		if err := wire.WriteVarBytes(w, 0, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readTxWitness deserializes a witness stack that was serialized with
+// writeTxWitness. The witness must make up all of the passed reader's data.
+func readTxWitness(r *bytes.Reader) (wire.TxWitness, error) {
+	numItems, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every witness item takes up at least one byte, which bounds the
+	// number of items we allocate.
+	if numItems > uint64(r.Len()) {
+		return nil, fmt.Errorf("witness with %d items exceeds its "+
+			"length", numItems)
+	}
+
+	witness := make(wire.TxWitness, 0, numItems)
+	for i := uint64(0); i < numItems; i++ {
+		item, err := wire.ReadVarBytes(
+			r, 0, MaxSliceLength, "witness item",
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		witness = append(witness, item)
+	}
+
+	if r.Len() != 0 {
+		return nil, fmt.Errorf("%d trailing bytes after witness",
+			r.Len())
+	}
+
+	return witness, nil
+}
+
+// MsgType returns the integer uniquely identifying TxSignatures on the wire.
+//
+// This is part of the lnwire.Message interface.
+func (t *TxSignatures) MsgType() MessageType {
+	return MsgTxSignatures
+}
+
+// SerializedSize returns the serialized size of the message in bytes.
+//
+// This is part of the lnwire.SizeableMessage interface.
+func (t *TxSignatures) SerializedSize() (uint32, error) {
+	return MessageSerializedSize(t)
+}
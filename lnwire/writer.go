@@ -417,3 +417,18 @@ func writeDataWithLength(buf *bytes.Buffer, data []byte) error {
 	_, err := buf.Write(data)
 	return err
 }
+
+// WriteVarBytes appends the given bytes to the provided buffer, prefixed by
+// their length encoded as a big endian uint16.
+func WriteVarBytes(buf *bytes.Buffer, b []byte) error {
+	if len(b) > math.MaxUint16 {
+		return fmt.Errorf("byte slice of length %d exceeds max of %d",
+			len(b), math.MaxUint16)
+	}
+
+	if err := WriteUint16(buf, uint16(len(b))); err != nil {
+		return err
+	}
+
+	return WriteBytes(buf, b)
+}
@@ -0,0 +1,192 @@
+//go:build monitoring
+// +build monitoring
+
+package monitoring
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// namespace is the namespace of the metrics exported by lnd's own
+	// collectors.
+	namespace = "lnd"
+)
+
+var (
+	channelLocalBalanceDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "local_balance_sat",
+		),
+		"Our balance in a channel on our latest commitment.",
+		[]string{"chan_point"}, nil,
+	)
+
+	channelRemoteBalanceDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "channel", "remote_balance_sat",
+		),
+		"The remote balance in a channel on our latest commitment.",
+		[]string{"chan_point"}, nil,
+	)
+
+	channelPendingHtlcsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "channel", "pending_htlcs"),
+		"The number of pending htlcs in a channel on our latest "+
+			"commitment.",
+		[]string{"chan_point", "direction"}, nil,
+	)
+
+	htlcEventsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "htlcswitch", "events_total"),
+		"The number of htlc events of the switch.",
+		[]string{"event", "htlc_type"}, nil,
+	)
+
+	feeBumpAttemptsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "sweep", "fee_bump_attempts_total",
+		),
+		"The number of replacement transactions the tx publisher "+
+			"tried to create.",
+		nil, nil,
+	)
+
+	feeBumpReplacementsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "sweep", "fee_bump_replacements_total",
+		),
+		"The number of replacement transactions the tx publisher "+
+			"published.",
+		nil, nil,
+	)
+
+	gossipSyncersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "discovery", "syncers"),
+		"The number of gossip syncers of each sync type and state.",
+		[]string{"sync_type", "state"}, nil,
+	)
+
+	missionControlPairsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(
+			namespace, "routing", "mission_control_pairs",
+		),
+		"The number of node pairs with a result in mission control.",
+		[]string{"namespace"}, nil,
+	)
+)
+
+// lndCollector is a Prometheus collector that exports the internals of lnd's
+// subsystems.
+type lndCollector struct {
+	cfg *Config
+
+	// htlcEvents counts the htlc events of the switch.
+	htlcEvents *htlcEventCounter
+}
+
+// newLndCollector creates a new collector for the given subsystems.
+func newLndCollector(cfg *Config) *lndCollector {
+	return &lndCollector{
+		cfg:        cfg,
+		htlcEvents: newHtlcEventCounter(),
+	}
+}
+
+// Describe sends the descriptors of all metrics of the collector to the given
+// channel.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *lndCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- channelLocalBalanceDesc
+	ch <- channelRemoteBalanceDesc
+	ch <- channelPendingHtlcsDesc
+	ch <- htlcEventsDesc
+	ch <- feeBumpAttemptsDesc
+	ch <- feeBumpReplacementsDesc
+	ch <- gossipSyncersDesc
+	ch <- missionControlPairsDesc
+}
+
+// Collect sends the current values of all metrics of the collector to the
+// given channel.
+//
+// NOTE: This is part of the prometheus.Collector interface.
+func (c *lndCollector) Collect(ch chan<- prometheus.Metric) {
+	c.collectChannels(ch)
+
+	for key, count := range c.htlcEvents.snapshot() {
+		ch <- prometheus.MustNewConstMetric(
+			htlcEventsDesc, prometheus.CounterValue, float64(count),
+			key.event, key.htlcType,
+		)
+	}
+
+	stats := c.cfg.FeeBumpStats()
+	ch <- prometheus.MustNewConstMetric(
+		feeBumpAttemptsDesc, prometheus.CounterValue,
+		float64(stats.Attempts),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		feeBumpReplacementsDesc, prometheus.CounterValue,
+		float64(stats.Replacements),
+	)
+
+	for syncType, states := range c.cfg.SyncerStates() {
+		for state, count := range states {
+			ch <- prometheus.MustNewConstMetric(
+				gossipSyncersDesc, prometheus.GaugeValue,
+				float64(count), syncType.String(), state,
+			)
+		}
+	}
+
+	pairs, err := c.cfg.MissionControlPairs()
+	if err != nil {
+		log.Errorf("Unable to fetch mission control pairs: %v", err)
+		ch <- prometheus.NewInvalidMetric(missionControlPairsDesc, err)
+
+		return
+	}
+	for ns, count := range pairs {
+		ch <- prometheus.MustNewConstMetric(
+			missionControlPairsDesc, prometheus.GaugeValue,
+			float64(count), ns,
+		)
+	}
+}
+
+// collectChannels sends the balances and pending htlcs of all open channels
+// to the given channel.
+func (c *lndCollector) collectChannels(ch chan<- prometheus.Metric) {
+	channels, err := c.cfg.FetchChannels()
+	if err != nil {
+		log.Errorf("Unable to fetch channels: %v", err)
+		ch <- prometheus.NewInvalidMetric(channelLocalBalanceDesc, err)
+
+		return
+	}
+
+	for _, channel := range channels {
+		stats := newChannelStats(channel)
+
+		ch <- prometheus.MustNewConstMetric(
+			channelLocalBalanceDesc, prometheus.GaugeValue,
+			float64(stats.localBalance), stats.chanPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelRemoteBalanceDesc, prometheus.GaugeValue,
+			float64(stats.remoteBalance), stats.chanPoint,
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelPendingHtlcsDesc, prometheus.GaugeValue,
+			float64(stats.incomingHtlcs), stats.chanPoint,
+			"incoming",
+		)
+		ch <- prometheus.MustNewConstMetric(
+			channelPendingHtlcsDesc, prometheus.GaugeValue,
+			float64(stats.outgoingHtlcs), stats.chanPoint,
+			"outgoing",
+		)
+	}
+}
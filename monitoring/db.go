@@ -0,0 +1,78 @@
+package monitoring
+
+import (
+	"time"
+
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/kvdb"
+)
+
+// instrumentedBackend is a database backend that reports the duration of
+// each of its transactions.
+type instrumentedBackend struct {
+	kvdb.Backend
+
+	// observe is called with the kind and duration of each transaction.
+	observe func(op string, duration time.Duration)
+}
+
+// newInstrumentedBackend wraps the given backend to report the duration of
+// its transactions.
+func newInstrumentedBackend(db kvdb.Backend,
+	observe func(op string, duration time.Duration)) *instrumentedBackend {
+
+	return &instrumentedBackend{
+		Backend: db,
+		observe: observe,
+	}
+}
+
+// timeTx runs the given transaction and reports its duration, including any
+// retries by the backend.
+func (b *instrumentedBackend) timeTx(op string, tx func() error) error {
+	start := time.Now()
+	err := tx()
+	b.observe(op, time.Since(start))
+
+	return err
+}
+
+// View opens a database read transaction and executes the function f with the
+// transaction passed as a parameter.
+//
+// NOTE: This is part of the walletdb.DB interface.
+func (b *instrumentedBackend) View(f func(tx walletdb.ReadTx) error,
+	reset func()) error {
+
+	return b.timeTx("view", func() error {
+		return b.Backend.View(f, reset)
+	})
+}
+
+// Update opens a database read/write transaction and executes the function f
+// with the transaction passed as a parameter.
+//
+// NOTE: This is part of the walletdb.DB interface.
+func (b *instrumentedBackend) Update(f func(tx walletdb.ReadWriteTx) error,
+	reset func()) error {
+
+	return b.timeTx("update", func() error {
+		return b.Backend.Update(f, reset)
+	})
+}
+
+// Batch is like Update, but attempts to combine the transactions of multiple
+// goroutines if the wrapped backend supports it.
+//
+// NOTE: This is part of the walletdb.BatchDB interface.
+func (b *instrumentedBackend) Batch(
+	f func(tx walletdb.ReadWriteTx) error) error {
+
+	return b.timeTx("batch", func() error {
+		return kvdb.Batch(b.Backend, f)
+	})
+}
+
+// A compile-time check to ensure instrumentedBackend implements the
+// walletdb.BatchDB interface.
+var _ walletdb.BatchDB = (*instrumentedBackend)(nil)
@@ -0,0 +1,52 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/kvdb"
+	"github.com/stretchr/testify/require"
+)
+
+// TestInstrumentedBackend asserts that the duration of each kind of
+// transaction is reported, and that transactions are still executed.
+func TestInstrumentedBackend(t *testing.T) {
+	t.Parallel()
+
+	backend, cleanUp, err := kvdb.GetTestBackend(t.TempDir(), "db")
+	require.NoError(t, err)
+	t.Cleanup(cleanUp)
+
+	var ops []string
+	db := newInstrumentedBackend(backend, func(op string, _ time.Duration) {
+		ops = append(ops, op)
+	})
+
+	var (
+		bucketKey = []byte("bucket")
+		key       = []byte("key")
+		value     = []byte("value")
+	)
+	err = kvdb.Update(db, func(tx kvdb.RwTx) error {
+		_, err := tx.CreateTopLevelBucket(bucketKey)
+		return err
+	}, func() {})
+	require.NoError(t, err)
+
+	err = kvdb.Batch(db, func(tx kvdb.RwTx) error {
+		return tx.ReadWriteBucket(bucketKey).Put(key, value)
+	})
+	require.NoError(t, err)
+
+	var stored []byte
+	err = kvdb.View(db, func(tx kvdb.RTx) error {
+		stored = append(stored, tx.ReadBucket(bucketKey).Get(key)...)
+		return nil
+	}, func() {
+		stored = nil
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, value, stored)
+	require.Equal(t, []string{"update", "batch", "view"}, ops)
+}
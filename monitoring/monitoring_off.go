@@ -6,6 +6,7 @@ package monitoring
 import (
 	"fmt"
 
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"google.golang.org/grpc"
 )
@@ -23,3 +24,16 @@ func ExportPrometheusMetrics(_ *grpc.Server, _ lncfg.Prometheus) error {
 	return fmt.Errorf("lnd must be built with the monitoring tag to " +
 		"enable exporting Prometheus metrics")
 }
+
+// RegisterCollectors is required for lnd to compile so that the collectors of
+// lnd's internals can be hidden behind a build tag.
+func RegisterCollectors(_ *Config) error {
+	return fmt.Errorf("lnd must be built with the monitoring tag to " +
+		"enable exporting Prometheus metrics")
+}
+
+// InstrumentDB returns the given database backend unchanged, as monitoring is
+// currently disabled.
+func InstrumentDB(db kvdb.Backend, _ string) kvdb.Backend {
+	return db
+}
@@ -6,14 +6,33 @@ package monitoring
 import (
 	"net/http"
 	"sync"
+	"time"
 
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/lightningnetwork/lnd/kvdb"
 	"github.com/lightningnetwork/lnd/lncfg"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 )
 
-var started sync.Once
+var (
+	started sync.Once
+
+	// dbMetricsRegistered ensures the database metrics are only
+	// registered once, no matter how many databases are instrumented.
+	dbMetricsRegistered sync.Once
+
+	// dbTxDuration is the histogram of the duration of database
+	// transactions.
+	dbTxDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "tx_duration_seconds",
+		Help:      "The duration of database transactions.",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 2, 16),
+	}, []string{"db", "op"})
+)
 
 // GetPromInterceptors returns the set of interceptors for Prometheus
 // monitoring.
@@ -51,3 +70,32 @@ func ExportPrometheusMetrics(grpcServer *grpc.Server, cfg lncfg.Prometheus) erro
 
 	return nil
 }
+
+// RegisterCollectors registers the collectors that export the internals of
+// lnd's subsystems to Prometheus.
+func RegisterCollectors(cfg *Config) error {
+	collector := newLndCollector(cfg)
+	if err := prometheus.Register(collector); err != nil {
+		return err
+	}
+
+	client, err := cfg.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	go collector.htlcEvents.run(client)
+
+	return nil
+}
+
+// InstrumentDB wraps the given database backend to export the duration of its
+// transactions to Prometheus under the given name.
+func InstrumentDB(db kvdb.Backend, name string) kvdb.Backend {
+	dbMetricsRegistered.Do(func() {
+		prometheus.MustRegister(dbTxDuration)
+	})
+
+	return newInstrumentedBackend(db, func(op string, d time.Duration) {
+		dbTxDuration.WithLabelValues(name, op).Observe(d.Seconds())
+	})
+}
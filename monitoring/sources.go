@@ -0,0 +1,151 @@
+package monitoring
+
+import (
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/discovery"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/sweep"
+)
+
+// Config holds the subsystems of lnd whose internals are exported as
+// Prometheus metrics.
+type Config struct {
+	// FetchChannels returns the open channels of the node.
+	FetchChannels func() ([]*channeldb.OpenChannel, error)
+
+	// SubscribeHtlcEvents subscribes to the htlc events of the switch.
+	SubscribeHtlcEvents func() (*subscribe.Client, error)
+
+	// FeeBumpStats returns the number of fee bumps performed by the tx
+	// publisher of the sweeper.
+	FeeBumpStats func() sweep.FeeBumpStats
+
+	// SyncerStates returns the number of gossip syncers of each sync type
+	// that are in each state.
+	SyncerStates func() map[discovery.SyncerType]map[string]int
+
+	// MissionControlPairs returns the number of node pairs with a result
+	// in each mission control namespace.
+	MissionControlPairs func() (map[string]int, error)
+}
+
+// channelStats holds the metrics of a single channel.
+type channelStats struct {
+	// chanPoint is the funding outpoint of the channel.
+	chanPoint string
+
+	// localBalance is our balance on our commitment.
+	localBalance btcutil.Amount
+
+	// remoteBalance is the balance of the remote party on our commitment.
+	remoteBalance btcutil.Amount
+
+	// incomingHtlcs is the number of pending incoming htlcs on our
+	// commitment.
+	incomingHtlcs int
+
+	// outgoingHtlcs is the number of pending outgoing htlcs on our
+	// commitment.
+	outgoingHtlcs int
+}
+
+// newChannelStats returns the metrics of a channel based on our latest
+// commitment.
+func newChannelStats(channel *channeldb.OpenChannel) channelStats {
+	commitment := channel.LocalCommitment
+	stats := channelStats{
+		chanPoint:     channel.FundingOutpoint.String(),
+		localBalance:  commitment.LocalBalance.ToSatoshis(),
+		remoteBalance: commitment.RemoteBalance.ToSatoshis(),
+	}
+	for _, htlc := range commitment.Htlcs {
+		if htlc.Incoming {
+			stats.incomingHtlcs++
+		} else {
+			stats.outgoingHtlcs++
+		}
+	}
+
+	return stats
+}
+
+// htlcEventKey identifies the htlc events that are counted together.
+type htlcEventKey struct {
+	// event is the kind of the event, like forward or settle.
+	event string
+
+	// htlcType is whether the htlc is part of a send, receive or forward.
+	htlcType string
+}
+
+// htlcEventCounter counts the htlc events of the switch by their kind and
+// the type of the htlc.
+type htlcEventCounter struct {
+	mu     sync.Mutex
+	counts map[htlcEventKey]uint64
+}
+
+// newHtlcEventCounter creates a new counter of htlc events.
+func newHtlcEventCounter() *htlcEventCounter {
+	return &htlcEventCounter{
+		counts: make(map[htlcEventKey]uint64),
+	}
+}
+
+// count counts a single htlc event. Events of other kinds than forwards,
+// settles and failures are ignored.
+func (c *htlcEventCounter) count(event interface{}) {
+	var key htlcEventKey
+	switch e := event.(type) {
+	case *htlcswitch.ForwardingEvent:
+		key = htlcEventKey{"forward", e.HtlcEventType.String()}
+
+	case *htlcswitch.SettleEvent:
+		key = htlcEventKey{"settle", e.HtlcEventType.String()}
+
+	case *htlcswitch.ForwardingFailEvent:
+		key = htlcEventKey{"forward_fail", e.HtlcEventType.String()}
+
+	case *htlcswitch.LinkFailEvent:
+		key = htlcEventKey{"link_fail", e.HtlcEventType.String()}
+
+	default:
+		return
+	}
+
+	c.mu.Lock()
+	c.counts[key]++
+	c.mu.Unlock()
+}
+
+// snapshot returns a copy of the current counts.
+func (c *htlcEventCounter) snapshot() map[htlcEventKey]uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	counts := make(map[htlcEventKey]uint64, len(c.counts))
+	for key, count := range c.counts {
+		counts[key] = count
+	}
+
+	return counts
+}
+
+// run counts the events of the given subscription until it's canceled.
+//
+// NOTE: This must be run as a goroutine.
+func (c *htlcEventCounter) run(client *subscribe.Client) {
+	for {
+		select {
+		case event := <-client.Updates():
+			c.count(event)
+
+		case <-client.Quit():
+			return
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package monitoring
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestHtlcEventCounter asserts that htlc events are counted by their kind and
+// the type of the htlc, and that other events are ignored.
+func TestHtlcEventCounter(t *testing.T) {
+	t.Parallel()
+
+	counter := newHtlcEventCounter()
+	counter.count(&htlcswitch.ForwardingEvent{
+		HtlcEventType: htlcswitch.HtlcEventTypeForward,
+	})
+	counter.count(&htlcswitch.ForwardingEvent{
+		HtlcEventType: htlcswitch.HtlcEventTypeForward,
+	})
+	counter.count(&htlcswitch.SettleEvent{
+		HtlcEventType: htlcswitch.HtlcEventTypeSend,
+	})
+	counter.count(&htlcswitch.ForwardingFailEvent{
+		HtlcEventType: htlcswitch.HtlcEventTypeForward,
+	})
+	counter.count(&htlcswitch.LinkFailEvent{
+		HtlcEventType: htlcswitch.HtlcEventTypeReceive,
+	})
+	counter.count(&htlcswitch.FinalHtlcEvent{})
+
+	require.Equal(t, map[htlcEventKey]uint64{
+		{"forward", "forward"}:      2,
+		{"settle", "send"}:          1,
+		{"forward_fail", "forward"}: 1,
+		{"link_fail", "receive"}:    1,
+	}, counter.snapshot())
+}
+
+// TestChannelStats asserts that the metrics of a channel are taken from our
+// latest commitment.
+func TestChannelStats(t *testing.T) {
+	t.Parallel()
+
+	channel := &channeldb.OpenChannel{
+		LocalCommitment: channeldb.ChannelCommitment{
+			LocalBalance:  lnwire.NewMSatFromSatoshis(300_000),
+			RemoteBalance: lnwire.NewMSatFromSatoshis(200_000),
+			Htlcs: []channeldb.HTLC{
+				{Incoming: true},
+				{Incoming: false},
+				{Incoming: false},
+			},
+		},
+	}
+	channel.FundingOutpoint.Index = 1
+
+	require.Equal(t, channelStats{
+		chanPoint:     channel.FundingOutpoint.String(),
+		localBalance:  300_000,
+		remoteBalance: 200_000,
+		incomingHtlcs: 1,
+		outgoingHtlcs: 2,
+	}, newChannelStats(channel))
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -50,6 +51,26 @@ func NodeAnnSetFeatures(features *lnwire.RawFeatureVector) func(*lnwire.NodeAnno
 	}
 }
 
+// NodeAnnSetLeaseRates is a functional option that sets the lease rates of
+// liquidity ads advertised in the given node announcement, or removes them if
+// none are given.
+func NodeAnnSetLeaseRates(
+	rates fn.Option[lnwire.LeaseRates]) func(*lnwire.NodeAnnouncement) {
+
+	return func(nodeAnn *lnwire.NodeAnnouncement) {
+		extraData, err := lnwire.SetLeaseRates(
+			nodeAnn.ExtraOpaqueData, rates,
+		)
+		if err != nil {
+			log.Errorf("Unable to set lease rates of node "+
+				"announcement: %v", err)
+			return
+		}
+
+		nodeAnn.ExtraOpaqueData = extraData
+	}
+}
+
 // NodeAnnSetTimestamp is a functional option that sets the timestamp of the
 // announcement to the current time, or increments it if the timestamp is
 // already in the future.
@@ -0,0 +1,33 @@
+package netann
+
+import (
+	"testing"
+
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestNodeAnnSetLeaseRates asserts that the lease rates of a node
+// announcement can be set and removed.
+func TestNodeAnnSetLeaseRates(t *testing.T) {
+	t.Parallel()
+
+	rates := lnwire.LeaseRates{
+		LeaseFeeBase:      1_000,
+		ChannelFeeMaxBase: 5_000,
+	}
+
+	nodeAnn := &lnwire.NodeAnnouncement{}
+	NodeAnnSetLeaseRates(fn.Some(rates))(nodeAnn)
+
+	parsed, err := lnwire.ParseLeaseRates(nodeAnn.ExtraOpaqueData)
+	require.NoError(t, err)
+	require.Equal(t, fn.Some(rates), parsed)
+
+	NodeAnnSetLeaseRates(fn.None[lnwire.LeaseRates]())(nodeAnn)
+
+	parsed, err = lnwire.ParseLeaseRates(nodeAnn.ExtraOpaqueData)
+	require.NoError(t, err)
+	require.True(t, parsed.IsNone())
+}
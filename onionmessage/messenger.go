@@ -0,0 +1,429 @@
+package onionmessage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/tlv"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// EndpointName is the name of the message router endpoint that
+	// handles onion messages.
+	EndpointName = "onion-messenger"
+
+	// DefaultRateLimit is the default number of onion messages per second
+	// that we process from a single peer.
+	DefaultRateLimit rate.Limit = 10
+
+	// DefaultRateBurst is the default number of onion messages a single
+	// peer can send us in a burst.
+	DefaultRateBurst = 50
+
+	// incomingQueueSize is the number of onion messages that can be
+	// queued for processing before we start dropping new ones.
+	incomingQueueSize = 100
+)
+
+var (
+	// ErrMessengerShuttingDown is returned when a message is sent while
+	// the messenger is shutting down.
+	ErrMessengerShuttingDown = errors.New("onion messenger shutting down")
+)
+
+// Config holds the dependencies of the Messenger.
+type Config struct {
+	// NodeKey is the identity key of our node, which is used to peel the
+	// layers of the onion messages sent to us.
+	NodeKey keychain.SingleKeyECDH
+
+	// SendToPeer sends the onion message to the given peer. It returns an
+	// error if we're not connected to the peer or if it doesn't support
+	// onion messages.
+	SendToPeer func(peer route.Vertex, msg *lnwire.OnionMessage) error
+
+	// FetchChannelPeer returns the peer on the other side of the channel
+	// with the given short channel ID. It is used to resolve the next
+	// hop of a message whose next node is identified by a channel.
+	FetchChannelPeer func(scid lnwire.ShortChannelID) (route.Vertex,
+		error)
+
+	// RateLimit is the number of onion messages per second that we
+	// process from a single peer.
+	RateLimit rate.Limit
+
+	// RateBurst is the number of onion messages a single peer can send us
+	// in a burst.
+	RateBurst int
+}
+
+// IncomingMessage is an onion message for which we are the final hop.
+type IncomingMessage struct {
+	// Peer is the peer that delivered the message to us. This is not
+	// necessarily the sender of the message.
+	Peer route.Vertex
+
+	// PathID is the path ID that we encrypted for ourselves when creating
+	// the blinded path the message was sent along. It is empty if the
+	// path wasn't created by us.
+	PathID []byte
+
+	// ReplyPath is the optional blinded path that can be used to reply to
+	// the message.
+	ReplyPath *sphinx.BlindedPath
+
+	// FinalHopPayloads are the payloads of the message with a TLV type of
+	// at least FinalHopPayloadStart.
+	FinalHopPayloads map[uint64][]byte
+}
+
+// Messenger sends, receives and forwards onion messages. It implements the
+// msgmux.Endpoint interface so incoming onion messages can be routed to it.
+type Messenger struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// router is used to peel the layers of incoming onion messages.
+	router *sphinx.Router
+
+	// limiter rate limits the onion messages of each peer.
+	limiter *peerLimiter
+
+	// msgServer notifies subscribers about the messages addressed to us.
+	msgServer *subscribe.Server
+
+	// incoming queues the messages received from our peers for
+	// processing.
+	incoming chan msgmux.PeerMsg
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// A compile time check to ensure Messenger implements the msgmux.Endpoint
+// interface.
+var _ msgmux.Endpoint = (*Messenger)(nil)
+
+// NewMessenger creates a new onion messenger.
+func NewMessenger(cfg *Config) *Messenger {
+	// Onion messages are not protected against replays. We only ever
+	// reconstruct packets, which doesn't consult the replay log, but the
+	// router still requires one.
+	router := sphinx.NewRouter(cfg.NodeKey, sphinx.NewMemoryReplayLog())
+
+	return &Messenger{
+		cfg:       cfg,
+		router:    router,
+		limiter:   newPeerLimiter(cfg.RateLimit, cfg.RateBurst),
+		msgServer: subscribe.NewServer(),
+		incoming:  make(chan msgmux.PeerMsg, incomingQueueSize),
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start starts the messenger.
+func (m *Messenger) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Onion messenger starting")
+
+		if err = m.msgServer.Start(); err != nil {
+			return
+		}
+
+		m.wg.Add(1)
+		go m.processMessages()
+	})
+
+	return err
+}
+
+// Stop stops the messenger.
+func (m *Messenger) Stop() error {
+	var err error
+	m.stopped.Do(func() {
+		log.Info("Onion messenger shutting down...")
+		defer log.Debug("Onion messenger shutdown complete")
+
+		close(m.quit)
+		m.wg.Wait()
+
+		err = m.msgServer.Stop()
+	})
+
+	return err
+}
+
+// Name returns the name of this endpoint.
+//
+// NOTE: This is part of the msgmux.Endpoint interface.
+func (m *Messenger) Name() msgmux.EndpointName {
+	return EndpointName
+}
+
+// CanHandle returns true if the message is an onion message.
+//
+// NOTE: This is part of the msgmux.Endpoint interface.
+func (m *Messenger) CanHandle(msg msgmux.PeerMsg) bool {
+	_, ok := msg.Message.(*lnwire.OnionMessage)
+
+	return ok
+}
+
+// SendMessage queues an incoming onion message for processing. Messages that
+// exceed the rate limit of the peer, or that arrive while the processing
+// queue is full, are dropped.
+//
+// NOTE: This is part of the msgmux.Endpoint interface.
+func (m *Messenger) SendMessage(_ context.Context, msg msgmux.PeerMsg) bool {
+	if !m.CanHandle(msg) {
+		return false
+	}
+
+	peer := route.NewVertex(&msg.PeerPub)
+	if !m.limiter.allow(peer) {
+		log.Debugf("Dropping onion message from peer %v: rate limit "+
+			"exceeded", peer)
+
+		return true
+	}
+
+	select {
+	case m.incoming <- msg:
+	default:
+		log.Debugf("Dropping onion message from peer %v: queue full",
+			peer)
+	}
+
+	return true
+}
+
+// SubscribeMessages returns a client that receives an *IncomingMessage for
+// every onion message that is addressed to us.
+func (m *Messenger) SubscribeMessages() (*subscribe.Client, error) {
+	return m.msgServer.Subscribe()
+}
+
+// Send sends the given final hop payloads along the blinded path. If a reply
+// path is given, it is included in the message so the recipient can reply.
+// The introduction node of the path must be a connected peer, or our own
+// node.
+func (m *Messenger) Send(path, replyPath *sphinx.BlindedPath,
+	finalHopPayloads map[uint64][]byte) error {
+
+	msg, err := BuildOnionMessage(path, replyPath, finalHopPayloads)
+	if err != nil {
+		return err
+	}
+
+	intro := route.NewVertex(path.IntroductionPoint)
+	if intro != m.ourVertex() {
+		return m.cfg.SendToPeer(intro, msg)
+	}
+
+	// If we are the introduction node of the path, we process the message
+	// as if we had received it from a peer.
+	select {
+	case m.incoming <- msgmux.PeerMsg{
+		Message: msg,
+		PeerPub: *m.cfg.NodeKey.PubKey(),
+	}:
+		return nil
+
+	case <-m.quit:
+		return ErrMessengerShuttingDown
+	}
+}
+
+// ourVertex returns the vertex of our own node.
+func (m *Messenger) ourVertex() route.Vertex {
+	return route.NewVertex(m.cfg.NodeKey.PubKey())
+}
+
+// processMessages processes the queued incoming onion messages.
+//
+// NOTE: This method must be run as a goroutine.
+func (m *Messenger) processMessages() {
+	defer m.wg.Done()
+
+	for {
+		select {
+		case msg := <-m.incoming:
+			peer := route.NewVertex(&msg.PeerPub)
+
+			//nolint:forcetypeassert
+			onionMsg := msg.Message.(*lnwire.OnionMessage)
+
+			err := m.handleMessage(peer, onionMsg)
+			if err != nil {
+				log.Debugf("Unable to handle onion message "+
+					"from peer %v: %v", peer, err)
+			}
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// handleMessage peels one layer of the onion message. The message is either
+// delivered to our subscribers or forwarded to the next hop.
+func (m *Messenger) handleMessage(peer route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	var packet sphinx.OnionPacket
+	err := packet.Decode(bytes.NewReader(msg.OnionBlob))
+	if err != nil {
+		return fmt.Errorf("invalid onion packet: %w", err)
+	}
+
+	// Onion messages don't have any replay protection, so we use the
+	// variant of processing the packet that doesn't check the replay log.
+	processed, err := m.router.ReconstructOnionPacket(
+		&packet, nil, sphinx.WithBlindingPoint(msg.PathKey),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to process onion packet: %w", err)
+	}
+
+	payload, err := DecodePayload(processed.Payload.Payload)
+	if err != nil {
+		return err
+	}
+
+	plainText, err := m.router.DecryptBlindedHopData(
+		msg.PathKey, payload.EncryptedData,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to decrypt blinded data: %w", err)
+	}
+
+	routeData, err := record.DecodeBlindedRouteData(
+		bytes.NewReader(plainText),
+	)
+	if err != nil {
+		return fmt.Errorf("invalid blinded data: %w", err)
+	}
+
+	switch processed.Action {
+	case sphinx.ExitNode:
+		return m.deliverMessage(peer, payload, routeData)
+
+	case sphinx.MoreHops:
+		return m.forwardMessage(msg.PathKey, processed, routeData)
+
+	default:
+		return fmt.Errorf("unknown onion action: %v", processed.Action)
+	}
+}
+
+// deliverMessage notifies our subscribers about a message we are the final
+// hop of.
+func (m *Messenger) deliverMessage(peer route.Vertex, payload *Payload,
+	routeData *record.BlindedRouteData) error {
+
+	if routeData.NextNodeID.IsSome() || routeData.ShortChannelID.IsSome() {
+		return errors.New("final hop of onion message has forwarding " +
+			"instructions")
+	}
+
+	var pathID []byte
+	routeData.PathID.WhenSome(func(r tlv.RecordT[tlv.TlvType6, []byte]) {
+		pathID = r.Val
+	})
+
+	log.Debugf("Received onion message via peer %v with %d payloads",
+		peer, len(payload.FinalHopPayloads))
+
+	return m.msgServer.SendUpdate(&IncomingMessage{
+		Peer:             peer,
+		PathID:           pathID,
+		ReplyPath:        payload.ReplyPath,
+		FinalHopPayloads: payload.FinalHopPayloads,
+	})
+}
+
+// forwardMessage sends the next layer of the onion message to the next hop.
+func (m *Messenger) forwardMessage(pathKey *btcec.PublicKey,
+	processed *sphinx.ProcessedPacket,
+	routeData *record.BlindedRouteData) error {
+
+	nextHop, err := m.nextHop(routeData)
+	if err != nil {
+		return err
+	}
+
+	// The next path key is either given by the creator of the blinded
+	// path, or derived from the current one.
+	nextPathKey, err := m.router.NextEphemeral(pathKey)
+	if err != nil {
+		return err
+	}
+	routeData.NextBlindingOverride.WhenSome(
+		func(r tlv.RecordT[tlv.TlvType8, *btcec.PublicKey]) {
+			nextPathKey = r.Val
+		},
+	)
+
+	var b bytes.Buffer
+	if err := processed.NextPacket.Encode(&b); err != nil {
+		return err
+	}
+	nextMsg := lnwire.NewOnionMessage(nextPathKey, b.Bytes())
+
+	// A blinded path may lead through our own node several times, in
+	// which case we process the next layer ourselves.
+	if nextHop == m.ourVertex() {
+		return m.handleMessage(nextHop, nextMsg)
+	}
+
+	log.Tracef("Forwarding onion message to peer %v", nextHop)
+
+	return m.cfg.SendToPeer(nextHop, nextMsg)
+}
+
+// nextHop returns the node that an onion message must be forwarded to.
+func (m *Messenger) nextHop(
+	routeData *record.BlindedRouteData) (route.Vertex, error) {
+
+	var (
+		nextHop route.Vertex
+		err     error
+	)
+	switch {
+	case routeData.NextNodeID.IsSome():
+		routeData.NextNodeID.WhenSome(
+			func(r tlv.RecordT[tlv.TlvType4, *btcec.PublicKey]) {
+				nextHop = route.NewVertex(r.Val)
+			},
+		)
+
+	case routeData.ShortChannelID.IsSome():
+		routeData.ShortChannelID.WhenSome(
+			func(r tlv.RecordT[tlv.TlvType2,
+				lnwire.ShortChannelID]) {
+
+				nextHop, err = m.cfg.FetchChannelPeer(r.Val)
+			},
+		)
+
+	default:
+		err = errors.New("onion message without next hop")
+	}
+
+	return nextHop, err
+}
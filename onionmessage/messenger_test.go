@@ -0,0 +1,228 @@
+package onionmessage
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/keychain"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/stretchr/testify/require"
+)
+
+// testNetwork is a set of messengers that deliver onion messages to each
+// other directly.
+type testNetwork struct {
+	nodes map[route.Vertex]*Messenger
+}
+
+// addNode creates a new messenger that is connected to all other nodes of the
+// network.
+func (n *testNetwork) addNode(t *testing.T) (*Messenger, *btcec.PublicKey) {
+	t.Helper()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey()
+
+	m := NewMessenger(&Config{
+		NodeKey: &keychain.PrivKeyECDH{PrivKey: privKey},
+		SendToPeer: func(peer route.Vertex,
+			msg *lnwire.OnionMessage) error {
+
+			target, ok := n.nodes[peer]
+			if !ok {
+				return fmt.Errorf("peer %v not connected", peer)
+			}
+
+			ctx := context.Background()
+			target.SendMessage(ctx, msgmux.PeerMsg{
+				Message: msg,
+				PeerPub: *pubKey,
+			})
+
+			return nil
+		},
+		FetchChannelPeer: func(lnwire.ShortChannelID) (route.Vertex,
+			error) {
+
+			return route.Vertex{}, fmt.Errorf("unknown channel")
+		},
+		RateLimit: DefaultRateLimit,
+		RateBurst: DefaultRateBurst,
+	})
+	require.NoError(t, m.Start())
+	t.Cleanup(func() {
+		require.NoError(t, m.Stop())
+	})
+
+	n.nodes[route.NewVertex(pubKey)] = m
+
+	return m, pubKey
+}
+
+// nextMessage waits for the next incoming message of the subscription.
+func nextMessage(t *testing.T, sub *subscribe.Client) *IncomingMessage {
+	t.Helper()
+
+	select {
+	case update := <-sub.Updates():
+		msg, ok := update.(*IncomingMessage)
+		require.True(t, ok)
+
+		return msg
+
+	case <-time.After(5 * time.Second):
+		t.Fatalf("no onion message received")
+		return nil
+	}
+}
+
+// TestSendAndReply tests that an onion message is forwarded along a blinded
+// path to its recipient, and that the recipient can reply using the reply
+// path.
+func TestSendAndReply(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{nodes: make(map[route.Vertex]*Messenger)}
+	alice, alicePub := network.addNode(t)
+	bob, bobPub := network.addNode(t)
+	carol, carolPub := network.addNode(t)
+
+	aliceSub, err := alice.SubscribeMessages()
+	require.NoError(t, err)
+	defer aliceSub.Cancel()
+
+	bobSub, err := bob.SubscribeMessages()
+	require.NoError(t, err)
+	defer bobSub.Cancel()
+
+	carolSub, err := carol.SubscribeMessages()
+	require.NoError(t, err)
+	defer carolSub.Cancel()
+
+	// Carol creates a blinded path through Bob to herself, and Alice sends
+	// a message along it, asking for a reply to herself.
+	carolPath, err := BuildBlindedPath(
+		[]*btcec.PublicKey{bobPub, carolPub}, []byte("carol"),
+	)
+	require.NoError(t, err)
+
+	replyPath, err := BuildBlindedPath(
+		[]*btcec.PublicKey{alicePub}, []byte("alice"),
+	)
+	require.NoError(t, err)
+
+	payloads := map[uint64][]byte{
+		64: []byte("invoice request"),
+		71: {1, 2, 3},
+	}
+	require.NoError(t, alice.Send(carolPath, replyPath, payloads))
+
+	msg := nextMessage(t, carolSub)
+	require.Equal(t, route.NewVertex(bobPub), msg.Peer)
+	require.Equal(t, []byte("carol"), msg.PathID)
+	require.Equal(t, payloads, msg.FinalHopPayloads)
+	require.Equal(t, replyPath, msg.ReplyPath)
+
+	// Bob only forwarded the message.
+	select {
+	case <-bobSub.Updates():
+		t.Fatalf("intermediate hop received message")
+	default:
+	}
+
+	// Carol replies to Alice, who is the introduction node of the reply
+	// path herself.
+	reply := map[uint64][]byte{66: []byte("invoice")}
+	require.NoError(t, carol.Send(msg.ReplyPath, nil, reply))
+
+	msg = nextMessage(t, aliceSub)
+	require.Equal(t, route.NewVertex(carolPub), msg.Peer)
+	require.Equal(t, []byte("alice"), msg.PathID)
+	require.Equal(t, reply, msg.FinalHopPayloads)
+	require.Nil(t, msg.ReplyPath)
+
+	// A message along a path that starts at our own node is processed
+	// locally.
+	selfPath, err := BuildBlindedPath(
+		[]*btcec.PublicKey{alicePub}, []byte("self"),
+	)
+	require.NoError(t, err)
+	require.NoError(t, alice.Send(selfPath, nil, reply))
+
+	msg = nextMessage(t, aliceSub)
+	require.Equal(t, []byte("self"), msg.PathID)
+
+	// Messages that can't be delivered to the introduction node fail.
+	unknownPath, err := BuildBlindedPath(
+		[]*btcec.PublicKey{randPubKey(t)}, nil,
+	)
+	require.NoError(t, err)
+	require.Error(t, alice.Send(unknownPath, nil, reply))
+}
+
+// TestHandleMessageInvalid makes sure that messages that weren't encrypted
+// for us are rejected.
+func TestHandleMessageInvalid(t *testing.T) {
+	t.Parallel()
+
+	network := &testNetwork{nodes: make(map[route.Vertex]*Messenger)}
+	alice, _ := network.addNode(t)
+	_, bobPub := network.addNode(t)
+
+	// A message for Bob can't be processed by Alice.
+	path, err := BuildBlindedPath([]*btcec.PublicKey{bobPub}, nil)
+	require.NoError(t, err)
+	msg, err := BuildOnionMessage(path, nil, nil)
+	require.NoError(t, err)
+
+	err = alice.handleMessage(route.NewVertex(bobPub), msg)
+	require.Error(t, err)
+
+	// Neither can a message with a malformed onion.
+	msg.OnionBlob = msg.OnionBlob[:100]
+	err = alice.handleMessage(route.NewVertex(bobPub), msg)
+	require.ErrorContains(t, err, "invalid onion packet")
+}
+
+// TestPeerLimiter tests the per-peer rate limiting of onion messages.
+func TestPeerLimiter(t *testing.T) {
+	t.Parallel()
+
+	limiter := newPeerLimiter(1, 2)
+
+	alice := route.NewVertex(randPubKey(t))
+	bob := route.NewVertex(randPubKey(t))
+
+	// Alice can send a burst of two messages, after which she's limited.
+	require.True(t, limiter.allow(alice))
+	require.True(t, limiter.allow(alice))
+	require.False(t, limiter.allow(alice))
+
+	// Bob has his own budget.
+	require.True(t, limiter.allow(bob))
+
+	// Limiters that have replenished are pruned, the others are kept.
+	limiter.prune(time.Now().Add(time.Hour))
+	require.Empty(t, limiter.limiters)
+
+	require.True(t, limiter.allow(alice))
+	limiter.prune(time.Now())
+	require.Len(t, limiter.limiters, 1)
+}
+
+// randPubKey returns a random public key.
+func randPubKey(t *testing.T) *btcec.PublicKey {
+	t.Helper()
+
+	privKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	return privKey.PubKey()
+}
@@ -0,0 +1,127 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// BuildBlindedPath creates a blinded path for onion messages that leads
+// through the given nodes, the first of which is the introduction node. The
+// path ID is encrypted for the last node, which allows it to recognize
+// messages that were sent along a path it created.
+func BuildBlindedPath(nodes []*btcec.PublicKey,
+	pathID []byte) (*sphinx.BlindedPath, error) {
+
+	if len(nodes) == 0 {
+		return nil, errors.New("blinded path requires at least one " +
+			"node")
+	}
+
+	hops := make([]*sphinx.HopInfo, 0, len(nodes))
+	for i, node := range nodes {
+		var data record.BlindedRouteData
+		if i < len(nodes)-1 {
+			data.NextNodeID = tlv.SomeRecordT(
+				tlv.NewPrimitiveRecord[tlv.TlvType4](
+					nodes[i+1],
+				),
+			)
+		} else {
+			data.PathID = tlv.SomeRecordT(
+				tlv.NewPrimitiveRecord[tlv.TlvType6](pathID),
+			)
+		}
+
+		plainText, err := record.EncodeBlindedRouteData(&data)
+		if err != nil {
+			return nil, err
+		}
+
+		hops = append(hops, &sphinx.HopInfo{
+			NodePub:   node,
+			PlainText: plainText,
+		})
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := sphinx.BuildBlindedPath(sessionKey, hops)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.Path, nil
+}
+
+// BuildOnionMessage creates an onion message that delivers the given final
+// hop payloads and optional reply path along the blinded path. The returned
+// message must be sent to the introduction node of the path.
+func BuildOnionMessage(path *sphinx.BlindedPath, replyPath *sphinx.BlindedPath,
+	finalHopPayloads map[uint64][]byte) (*lnwire.OnionMessage, error) {
+
+	numHops := len(path.BlindedHops)
+	switch {
+	case numHops == 0:
+		return nil, errors.New("blinded path has no hops")
+
+	case numHops > sphinx.NumMaxHops:
+		return nil, fmt.Errorf("blinded path has %d hops, maximum is "+
+			"%d", numHops, sphinx.NumMaxHops)
+	}
+
+	var onionPath sphinx.PaymentPath
+	for i, hop := range path.BlindedHops {
+		payload := &Payload{
+			EncryptedData: hop.CipherText,
+		}
+		if i == numHops-1 {
+			payload.ReplyPath = replyPath
+			payload.FinalHopPayloads = finalHopPayloads
+		}
+
+		payloadBytes, err := payload.Encode()
+		if err != nil {
+			return nil, err
+		}
+
+		hopPayload, err := sphinx.NewTLVHopPayload(payloadBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		onionPath[i] = sphinx.OnionHop{
+			NodePub:    *hop.BlindedNodePub,
+			HopPayload: hopPayload,
+		}
+	}
+
+	sessionKey, err := btcec.NewPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// Onion messages don't commit to any associated data.
+	packet, err := sphinx.NewOnionPacket(
+		&onionPath, sessionKey, nil, sphinx.DeterministicPacketFiller,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create onion packet: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := packet.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return lnwire.NewOnionMessage(path.BlindingPoint, b.Bytes()), nil
+}
@@ -0,0 +1,143 @@
+package onionmessage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/bolt12"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+const (
+	// replyPathType is the TLV type of the blinded path that the final
+	// hop can use to reply to a message.
+	replyPathType tlv.Type = 2
+
+	// encryptedDataType is the TLV type of the data that the creator of
+	// the blinded path encrypted for the processing hop.
+	encryptedDataType tlv.Type = 4
+
+	// FinalHopPayloadStart is the first TLV type that is reserved for the
+	// payloads that are delivered to the final hop, such as BOLT 12
+	// invoice requests and invoices.
+	FinalHopPayloadStart uint64 = 64
+)
+
+var (
+	// ErrUnknownRequiredType is returned when a payload contains an even
+	// TLV type that we don't understand.
+	ErrUnknownRequiredType = errors.New("unknown required type in onion " +
+		"message payload")
+
+	// ErrMissingEncryptedData is returned when a payload doesn't contain
+	// the encrypted data of the blinded path.
+	ErrMissingEncryptedData = errors.New("onion message payload without " +
+		"encrypted data")
+)
+
+// Payload is the content of one hop of an onion message.
+type Payload struct {
+	// ReplyPath is an optional blinded path that the final hop can use to
+	// reply to the message.
+	ReplyPath *sphinx.BlindedPath
+
+	// EncryptedData is the data that the creator of the blinded path
+	// encrypted for this hop. It contains the forwarding instructions for
+	// intermediate hops and the path ID for the final hop.
+	EncryptedData []byte
+
+	// FinalHopPayloads are the payloads with a TLV type of at least
+	// FinalHopPayloadStart that are delivered to the final hop.
+	FinalHopPayloads map[uint64][]byte
+}
+
+// Encode serializes the payload as a TLV stream.
+func (p *Payload) Encode() ([]byte, error) {
+	records := make(map[uint64][]byte, len(p.FinalHopPayloads)+2)
+	for typ, value := range p.FinalHopPayloads {
+		if typ < FinalHopPayloadStart {
+			return nil, fmt.Errorf("final hop payload type %d "+
+				"below %d", typ, FinalHopPayloadStart)
+		}
+
+		records[typ] = value
+	}
+
+	if p.ReplyPath != nil {
+		var b bytes.Buffer
+		err := bolt12.EncodeBlindedPath(&b, p.ReplyPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode reply path: "+
+				"%w", err)
+		}
+
+		records[uint64(replyPathType)] = b.Bytes()
+	}
+
+	if len(p.EncryptedData) > 0 {
+		records[uint64(encryptedDataType)] = p.EncryptedData
+	}
+
+	stream, err := tlv.NewStream(tlv.MapToRecords(records)...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// DecodePayload parses the TLV stream of an onion message payload. Unknown
+// odd types below FinalHopPayloadStart are ignored, unknown even types are
+// rejected.
+func DecodePayload(data []byte) (*Payload, error) {
+	stream, err := tlv.NewStream()
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(
+		bytes.NewReader(data),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &Payload{
+		FinalHopPayloads: make(map[uint64][]byte),
+	}
+	for typ, value := range parsedTypes {
+		switch {
+		case typ == replyPathType:
+			payload.ReplyPath, err = bolt12.DecodeBlindedPath(
+				bytes.NewReader(value),
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid reply path: "+
+					"%w", err)
+			}
+
+		case typ == encryptedDataType:
+			payload.EncryptedData = value
+
+		case uint64(typ) >= FinalHopPayloadStart:
+			payload.FinalHopPayloads[uint64(typ)] = value
+
+		case typ%2 == 0:
+			return nil, fmt.Errorf("%w: %d", ErrUnknownRequiredType,
+				typ)
+		}
+	}
+
+	if len(payload.EncryptedData) == 0 {
+		return nil, ErrMissingEncryptedData
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,78 @@
+package onionmessage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPayloadEncodeDecode makes sure that onion message payloads survive an
+// encoding round trip.
+func TestPayloadEncodeDecode(t *testing.T) {
+	t.Parallel()
+
+	replyPath, err := BuildBlindedPath(
+		[]*btcec.PublicKey{randPubKey(t), randPubKey(t)}, []byte{1},
+	)
+	require.NoError(t, err)
+
+	payload := &Payload{
+		ReplyPath:     replyPath,
+		EncryptedData: []byte{1, 2, 3},
+		FinalHopPayloads: map[uint64][]byte{
+			64: {4, 5},
+			69: {6},
+		},
+	}
+
+	encoded, err := payload.Encode()
+	require.NoError(t, err)
+
+	decoded, err := DecodePayload(encoded)
+	require.NoError(t, err)
+	require.Equal(t, payload, decoded)
+
+	// Final hop payloads must use the reserved type range.
+	payload.FinalHopPayloads[10] = []byte{1}
+	_, err = payload.Encode()
+	require.Error(t, err)
+}
+
+// TestDecodePayloadInvalid tests that payloads with unknown even types or
+// without encrypted data are rejected.
+func TestDecodePayloadInvalid(t *testing.T) {
+	t.Parallel()
+
+	encode := func(records map[uint64][]byte) []byte {
+		stream, err := tlv.NewStream(tlv.MapToRecords(records)...)
+		require.NoError(t, err)
+
+		var b bytes.Buffer
+		require.NoError(t, stream.Encode(&b))
+
+		return b.Bytes()
+	}
+
+	// Unknown odd types are ignored.
+	payload, err := DecodePayload(encode(map[uint64][]byte{
+		4:  {1},
+		11: {2},
+	}))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1}, payload.EncryptedData)
+	require.Empty(t, payload.FinalHopPayloads)
+
+	_, err = DecodePayload(encode(map[uint64][]byte{
+		4:  {1},
+		10: {2},
+	}))
+	require.ErrorIs(t, err, ErrUnknownRequiredType)
+
+	_, err = DecodePayload(encode(map[uint64][]byte{
+		64: {1},
+	}))
+	require.ErrorIs(t, err, ErrMissingEncryptedData)
+}
@@ -0,0 +1,63 @@
+package onionmessage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+	"golang.org/x/time/rate"
+)
+
+// maxIdleLimiters is the number of per-peer limiters we track before we start
+// pruning the ones that have fully replenished.
+const maxIdleLimiters = 1000
+
+// peerLimiter enforces a separate token bucket rate limit for the onion
+// messages of each peer.
+type peerLimiter struct {
+	limit rate.Limit
+	burst int
+
+	mu       sync.Mutex
+	limiters map[route.Vertex]*rate.Limiter
+}
+
+// newPeerLimiter creates a new peerLimiter that allows each peer to send
+// messages at the given rate, with bursts of up to burst messages.
+func newPeerLimiter(limit rate.Limit, burst int) *peerLimiter {
+	return &peerLimiter{
+		limit:    limit,
+		burst:    burst,
+		limiters: make(map[route.Vertex]*rate.Limiter),
+	}
+}
+
+// allow returns true if the given peer may send another message now.
+func (p *peerLimiter) allow(peer route.Vertex) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	limiter, ok := p.limiters[peer]
+	if !ok {
+		if len(p.limiters) >= maxIdleLimiters {
+			p.prune(time.Now())
+		}
+
+		limiter = rate.NewLimiter(p.limit, p.burst)
+		p.limiters[peer] = limiter
+	}
+
+	return limiter.Allow()
+}
+
+// prune removes all limiters that have fully replenished their tokens, as
+// they behave exactly like a freshly created limiter.
+//
+// NOTE: The caller must hold the mutex.
+func (p *peerLimiter) prune(now time.Time) {
+	for peer, limiter := range p.limiters {
+		if limiter.TokensAt(now) >= float64(p.burst) {
+			delete(p.limiters, peer)
+		}
+	}
+}
@@ -310,6 +310,11 @@ type Config struct {
 	// invoice-related logic.
 	Invoices *invoices.InvoiceRegistry
 
+	// TrampolineForwarder is passed to the ChannelLink on creation and
+	// forwards the payments for which we were chosen as trampoline node.
+	// It is nil if trampoline forwarding is disabled.
+	TrampolineForwarder htlcswitch.TrampolineForwarder
+
 	// ChannelNotifier is used by the link to notify other sub-systems about
 	// channel-related events and by the Brontide to subscribe to
 	// ActiveLinkEvents.
@@ -448,11 +453,21 @@ type Config struct {
 	// This value will be passed to created links.
 	MaxFeeExposure lnwire.MilliSatoshi
 
+	// MaxLocalCSVDelay is the maximum CSV delay we accept for our own
+	// funds when the remote party changes the parameters of a channel.
+	MaxLocalCSVDelay uint16
+
 	// MsgRouter is an optional instance of the main message router that
 	// the peer will use. If None, then a new default version will be used
 	// in place.
 	MsgRouter fn.Option[msgmux.Router]
 
+	// OnionMessenger is an optional endpoint that handles the onion
+	// messages received from the peer. It is registered with the message
+	// router of the peer, unless a global message router is used, in which
+	// case it is expected to already be registered with it.
+	OnionMessenger fn.Option[msgmux.Endpoint]
+
 	// AuxChanCloser is an optional instance of an abstraction that can be
 	// used to modify the way the co-op close transaction is constructed.
 	AuxChanCloser fn.Option[chancloser.AuxChanCloser]
@@ -632,6 +647,21 @@ type Brontide struct {
 
 	startReady chan struct{}
 
+	// spliceMgr splices the channels with this peer.
+	spliceMgr *spliceManager
+
+	// dynMgr changes the parameters of the channels with this peer.
+	dynMgr *dynManager
+
+	// handedOver holds the quiescent channels whose messages are handled
+	// by an endpoint like the spliceManager instead of their link, along
+	// with the name of that endpoint.
+	handedOver *lnutils.SyncMap[lnwire.ChannelID, msgmux.EndpointName]
+
+	// replacedLinks holds the channels whose link was replaced, which
+	// tells the message stream of the channel to look the link up again.
+	replacedLinks *lnutils.SyncMap[lnwire.ChannelID, struct{}]
+
 	// cg is a helper that encapsulates a wait group and quit channel and
 	// allows contexts that either block or cancel on those depending on
 	// the use case.
@@ -686,7 +716,15 @@ func NewBrontide(cfg Config) *Brontide {
 		msgRouter:          msgRouter,
 		globalMsgRouter:    globalMsgRouter,
 		cg:                 fn.NewContextGuard(),
+		handedOver: &lnutils.SyncMap[
+			lnwire.ChannelID, msgmux.EndpointName,
+		]{},
+		replacedLinks: &lnutils.SyncMap[
+			lnwire.ChannelID, struct{},
+		]{},
 	}
+	p.spliceMgr = newSpliceManager(p)
+	p.dynMgr = newDynManager(p)
 
 	if cfg.Conn != nil && cfg.Conn.RemoteAddr() != nil {
 		remoteAddr := cfg.Conn.RemoteAddr().String()
@@ -887,6 +925,45 @@ func (p *Brontide) Start() error {
 		router.Start(context.Background())
 	})
 
+	// If we have an onion messenger, we'll register it with our local
+	// message router so the onion messages of this peer are handled.
+	if !p.globalMsgRouter {
+		err := fn.MapOptionZ(
+			p.msgRouter, func(router msgmux.Router) error {
+				return fn.MapOptionZ(
+					p.cfg.OnionMessenger,
+					router.RegisterEndpoint,
+				)
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to register onion "+
+				"messenger: %w", err)
+		}
+	}
+
+	// Register the splice manager, so the splice messages of this peer are
+	// handled by it.
+	err = fn.MapOptionZ(p.msgRouter, func(r msgmux.Router) error {
+		_ = r.UnregisterEndpoint(p.spliceMgr.Name())
+
+		return r.RegisterEndpoint(p.spliceMgr)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to register splice manager: %w", err)
+	}
+
+	// Register the dyn manager, so the messages changing the parameters of
+	// the channels of this peer are handled by it.
+	err = fn.MapOptionZ(p.msgRouter, func(r msgmux.Router) error {
+		_ = r.UnregisterEndpoint(p.dynMgr.Name())
+
+		return r.RegisterEndpoint(p.dynMgr)
+	})
+	if err != nil {
+		return fmt.Errorf("unable to register dyn manager: %w", err)
+	}
+
 	msgs, err := p.loadActiveChannels(activeChans)
 	if err != nil {
 		return fmt.Errorf("unable to load channels: %w", err)
@@ -916,11 +993,14 @@ func (p *Brontide) Start() error {
 		return fmt.Errorf("could not start ping manager %w", err)
 	}
 
-	p.cg.WgAdd(4)
+	p.cg.WgAdd(5)
 	go p.queueHandler()
 	go p.writeHandler()
 	go p.channelManager()
 	go p.readHandler()
+	go p.spliceMgr.run()
+
+	p.dynMgr.resumeAll()
 
 	// Signal to any external processes that the peer is now active.
 	close(p.activeSignal)
@@ -1098,23 +1178,8 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 			}
 		}
 
-		var chanOpts []lnwallet.ChannelOpt
-		p.cfg.AuxLeafStore.WhenSome(func(s lnwallet.AuxLeafStore) {
-			chanOpts = append(chanOpts, lnwallet.WithLeafStore(s))
-		})
-		p.cfg.AuxSigner.WhenSome(func(s lnwallet.AuxSigner) {
-			chanOpts = append(chanOpts, lnwallet.WithAuxSigner(s))
-		})
-		p.cfg.AuxResolver.WhenSome(
-			func(s lnwallet.AuxContractResolver) {
-				chanOpts = append(
-					chanOpts, lnwallet.WithAuxResolver(s),
-				)
-			},
-		)
-
 		lnChan, err := lnwallet.NewLightningChannel(
-			p.cfg.Signer, dbChan, p.cfg.SigPool, chanOpts...,
+			p.cfg.Signer, dbChan, p.cfg.SigPool, p.channelOpts()...,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to create channel "+
@@ -1182,53 +1247,11 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 		// Before we register this new link with the HTLC Switch, we'll
 		// need to fetch its current link-layer forwarding policy from
 		// the database.
-		graph := p.cfg.ChannelGraph
-		info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(
-			&chanPoint,
-		)
-		if err != nil && !errors.Is(err, graphdb.ErrEdgeNotFound) {
+		forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+		if err != nil {
 			return nil, err
 		}
 
-		// We'll filter out our policy from the directional channel
-		// edges based whom the edge connects to. If it doesn't connect
-		// to us, then we know that we were the one that advertised the
-		// policy.
-		//
-		// TODO(roasbeef): can add helper method to get policy for
-		// particular channel.
-		var selfPolicy *models.ChannelEdgePolicy
-		if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
-			p.cfg.ServerPubKey[:]) {
-
-			selfPolicy = p1
-		} else {
-			selfPolicy = p2
-		}
-
-		// If we don't yet have an advertised routing policy, then
-		// we'll use the current default, otherwise we'll translate the
-		// routing policy into a forwarding policy.
-		var forwardingPolicy *models.ForwardingPolicy
-		if selfPolicy != nil {
-			forwardingPolicy = &models.ForwardingPolicy{
-				MinHTLCOut:    selfPolicy.MinHTLC,
-				MaxHTLC:       selfPolicy.MaxHTLC,
-				BaseFee:       selfPolicy.FeeBaseMSat,
-				FeeRate:       selfPolicy.FeeProportionalMillionths,
-				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
-			}
-			selfPolicy.InboundFee.WhenSome(func(fee lnwire.Fee) {
-				inboundFee := models.NewInboundFeeFromWire(fee)
-				forwardingPolicy.InboundFee = inboundFee
-			})
-		} else {
-			p.log.Warnf("Unable to find our forwarding policy "+
-				"for channel %v, using default values",
-				chanPoint)
-			forwardingPolicy = &p.cfg.RoutingPolicy
-		}
-
 		p.log.Tracef("Using link policy of: %v",
 			spew.Sdump(forwardingPolicy))
 
@@ -1244,6 +1267,31 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 			continue
 		}
 
+		// If the channel is in the middle of a splice that has been
+		// signed already, we hand it over to the splice manager which
+		// finishes the splice before the link is added back. A signed
+		// reanchor that changes the parameters of the channel is
+		// finished by the dyn manager instead.
+		if pending, err := dbChan.PendingSplice(); err == nil {
+			p.activeChannels.Store(chanID, lnChan)
+
+			if pending.Params.IsSome() {
+				p.log.Infof("Resuming reanchor of "+
+					"ChannelPoint(%v)", chanPoint)
+
+				p.dynMgr.resumeReanchor(lnChan)
+
+				continue
+			}
+
+			p.log.Infof("Resuming splice of ChannelPoint(%v)",
+				chanPoint)
+
+			p.spliceMgr.resumeSplice(lnChan)
+
+			continue
+		}
+
 		shutdownInfo, err := lnChan.State().ShutdownInfo()
 		if err != nil && !errors.Is(err, channeldb.ErrNoShutdownInfo) {
 			return nil, err
@@ -1375,6 +1423,72 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 	return msgs, nil
 }
 
+// channelOpts returns the options used to create the state machines of our
+// channels.
+func (p *Brontide) channelOpts() []lnwallet.ChannelOpt {
+	var chanOpts []lnwallet.ChannelOpt
+	p.cfg.AuxLeafStore.WhenSome(func(s lnwallet.AuxLeafStore) {
+		chanOpts = append(chanOpts, lnwallet.WithLeafStore(s))
+	})
+	p.cfg.AuxSigner.WhenSome(func(s lnwallet.AuxSigner) {
+		chanOpts = append(chanOpts, lnwallet.WithAuxSigner(s))
+	})
+	p.cfg.AuxResolver.WhenSome(func(s lnwallet.AuxContractResolver) {
+		chanOpts = append(chanOpts, lnwallet.WithAuxResolver(s))
+	})
+
+	return chanOpts
+}
+
+// fetchForwardingPolicy fetches the link-layer forwarding policy of the given
+// channel from the graph. If we haven't advertised a policy yet, the default
+// policy is returned.
+func (p *Brontide) fetchForwardingPolicy(
+	chanPoint wire.OutPoint) (*models.ForwardingPolicy, error) {
+
+	graph := p.cfg.ChannelGraph
+	info, p1, p2, err := graph.FetchChannelEdgesByOutpoint(&chanPoint)
+	if err != nil && !errors.Is(err, graphdb.ErrEdgeNotFound) {
+		return nil, err
+	}
+
+	// We'll filter out our policy from the directional channel edges based
+	// whom the edge connects to. If it doesn't connect to us, then we know
+	// that we were the one that advertised the policy.
+	var selfPolicy *models.ChannelEdgePolicy
+	if info != nil && bytes.Equal(info.NodeKey1Bytes[:],
+		p.cfg.ServerPubKey[:]) {
+
+		selfPolicy = p1
+	} else {
+		selfPolicy = p2
+	}
+
+	// If we don't yet have an advertised routing policy, then we'll use
+	// the current default, otherwise we'll translate the routing policy
+	// into a forwarding policy.
+	if selfPolicy == nil {
+		p.log.Warnf("Unable to find our forwarding policy for channel "+
+			"%v, using default values", chanPoint)
+
+		return &p.cfg.RoutingPolicy, nil
+	}
+
+	forwardingPolicy := &models.ForwardingPolicy{
+		MinHTLCOut:    selfPolicy.MinHTLC,
+		MaxHTLC:       selfPolicy.MaxHTLC,
+		BaseFee:       selfPolicy.FeeBaseMSat,
+		FeeRate:       selfPolicy.FeeProportionalMillionths,
+		TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
+	}
+	selfPolicy.InboundFee.WhenSome(func(fee lnwire.Fee) {
+		inboundFee := models.NewInboundFeeFromWire(fee)
+		forwardingPolicy.InboundFee = inboundFee
+	})
+
+	return forwardingPolicy, nil
+}
+
 // addLink creates and adds a new ChannelLink from the specified channel.
 func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	lnChan *lnwallet.LightningChannel,
@@ -1418,6 +1532,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		FetchLastChannelUpdate: p.cfg.FetchLastChanUpdate,
 		HodlMask:               p.cfg.Hodl.Mask(),
 		Registry:               p.cfg.Invoices,
+		TrampolineForwarder:    p.cfg.TrampolineForwarder,
 		BestHeight:             p.cfg.Switch.BestHeight,
 		Circuits:               p.cfg.Switch.CircuitModifier(),
 		ForwardPackets:         p.cfg.InterceptSwitch.ForwardPackets,
@@ -1643,6 +1758,11 @@ func (p *Brontide) Disconnect(reason error) {
 		p.msgRouter.WhenSome(func(router msgmux.Router) {
 			router.Stop()
 		})
+	} else {
+		p.msgRouter.WhenSome(func(router msgmux.Router) {
+			_ = router.UnregisterEndpoint(p.spliceMgr.Name())
+			_ = router.UnregisterEndpoint(p.dynMgr.Name())
+		})
 	}
 }
 
@@ -1954,6 +2074,13 @@ func newChanMsgStream(p *Brontide, cid lnwire.ChannelID) *msgStream {
 	var chanLink htlcswitch.ChannelUpdateHandler
 
 	apply := func(msg lnwire.Message) {
+		// The link of a channel that was handed over is replaced, so we
+		// need to look it up again.
+		_, replaced := p.replacedLinks.LoadAndDelete(cid)
+		if replaced {
+			chanLink = nil
+		}
+
 		// This check is fine because if the link no longer exists, it will
 		// be removed from the activeChannels map and subsequent messages
 		// shouldn't reach the chan msg stream.
@@ -2143,7 +2270,18 @@ out:
 			*lnwire.AcceptChannel,
 			*lnwire.FundingCreated,
 			*lnwire.FundingSigned,
-			*lnwire.ChannelReady:
+			*lnwire.ChannelReady,
+			*lnwire.OpenChannel2,
+			*lnwire.AcceptChannel2,
+			*lnwire.TxAddInput,
+			*lnwire.TxAddOutput,
+			*lnwire.TxRemoveInput,
+			*lnwire.TxRemoveOutput,
+			*lnwire.TxComplete,
+			*lnwire.TxSignatures,
+			*lnwire.TxInitRbf,
+			*lnwire.TxAckRbf,
+			*lnwire.TxAbort:
 
 			p.cfg.FundingManager.ProcessFundingMsg(msg, p)
 
@@ -2195,6 +2333,17 @@ out:
 			targetChan = msg.TargetChanID()
 			isLinkUpdate = p.hasChannel(targetChan)
 
+			// The commitments of a dual-funded channel are signed
+			// by the funding manager before the channel exists.
+			isFunding := !isLinkUpdate &&
+				p.cfg.FundingManager.IsPendingChannel(
+					targetChan, p,
+				)
+			if isFunding {
+				p.cfg.FundingManager.ProcessFundingMsg(msg, p)
+				break
+			}
+
 			// Log an error if we don't have this channel. This
 			// means the peer has sent us a message with unknown
 			// channel ID.
@@ -2223,6 +2372,12 @@ out:
 				p.log.Errorf("%v", err)
 			}
 
+		// If onion messages weren't handled by the message router,
+		// then we don't support them and simply ignore them.
+		case *lnwire.OnionMessage:
+			p.log.Debugf("Ignoring onion message, onion messaging " +
+				"is disabled")
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -2408,6 +2563,17 @@ func messageSummary(msg lnwire.Message) string {
 		return fmt.Sprintf("temp_chan_id=%x, chan_point=%v",
 			msg.PendingChannelID[:], msg.FundingPoint)
 
+	case *lnwire.OpenChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, chain=%v, csv=%v, amt=%v, "+
+			"funding_feerate=%v, locktime=%v",
+			msg.PendingChannelID[:], msg.ChainHash, msg.CsvDelay,
+			msg.FundingAmount, msg.FundingFeePerKw, msg.LockTime)
+
+	case *lnwire.AcceptChannel2:
+		return fmt.Sprintf("temp_chan_id=%x, amt=%v, csv=%v, "+
+			"num_confs=%v", msg.PendingChannelID[:],
+			msg.FundingAmount, msg.CsvDelay, msg.MinAcceptDepth)
+
 	case *lnwire.FundingSigned:
 		return fmt.Sprintf("chan_id=%v", msg.ChanID)
 
@@ -3913,7 +4079,7 @@ func (p *Brontide) initRbfChanCloser(
 	}
 
 	spendEvent := protofsm.RegisterSpend[chancloser.ProtocolEvent]{
-		OutPoint:   channel.ChannelPoint(),
+		OutPoint:   channel.State().CurrentFundingOutpoint(),
 		PkScript:   channel.FundingTxOut().PkScript,
 		HeightHint: channel.DeriveHeightHint(),
 		PostSpendEvent: fn.Some[chancloser.RbfSpendMapper](
@@ -0,0 +1,688 @@
+package peer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/dyncomm"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/lightningnetwork/lnd/protofsm"
+)
+
+var (
+	// ErrDynCommitNotNegotiated is returned when the parameters of a
+	// channel are changed with a peer that didn't negotiate the dynamic
+	// commitments feature.
+	ErrDynCommitNotNegotiated = errors.New("dynamic commitments not " +
+		"negotiated with peer")
+
+	// ErrDynCommitInProgress is returned when the parameters of a channel
+	// are changed while a prior change is still in progress.
+	ErrDynCommitInProgress = errors.New("channel parameters are already " +
+		"being changed")
+)
+
+// dynCommit is a change of the parameters of a channel that is in progress.
+type dynCommit struct {
+	// channel is the state machine of the channel whose parameters are
+	// changed.
+	channel *lnwallet.LightningChannel
+
+	// fsm drives the change. It is nil while we wait for the channel to
+	// become quiescent.
+	fsm *dyncomm.DynCommitter
+
+	// result receives the txid of the kickoff transaction once it was
+	// broadcast if the channel is reanchored, or once the new parameters
+	// are in effect otherwise. It's nil if the remote party initiated the
+	// change.
+	result chan fn.Result[fn.Option[chainhash.Hash]]
+
+	resolveOnce sync.Once
+	stopOnce    sync.Once
+
+	// done is closed once the state machine was stopped.
+	done chan struct{}
+}
+
+// newDynCommit creates a change of the parameters of the given channel. If
+// the change is initiated by us, its result is delivered on the result
+// channel.
+func newDynCommit(channel *lnwallet.LightningChannel,
+	initiated bool) *dynCommit {
+
+	c := &dynCommit{
+		channel: channel,
+		done:    make(chan struct{}),
+	}
+	if initiated {
+		c.result = make(chan fn.Result[fn.Option[chainhash.Hash]], 1)
+	}
+
+	return c
+}
+
+// resolve delivers the result of the change to the caller that requested
+// it. Only the first result is delivered.
+func (c *dynCommit) resolve(result fn.Result[fn.Option[chainhash.Hash]]) {
+	if c.result == nil {
+		return
+	}
+
+	c.resolveOnce.Do(func() {
+		c.result <- result
+	})
+}
+
+// fail delivers the error that failed the change to the caller that
+// requested it.
+func (c *dynCommit) fail(err error) {
+	c.resolve(fn.Err[fn.Option[chainhash.Hash]](err))
+}
+
+// stop stops the state machine of the change.
+func (c *dynCommit) stop() {
+	c.stopOnce.Do(func() {
+		if c.fsm != nil {
+			c.fsm.Stop()
+		}
+		close(c.done)
+	})
+}
+
+// dynManager changes the parameters of the channels we have with a peer. Just
+// like for a splice, a channel is handed over from its link to the dynManager
+// once it is quiescent. The link is added back once the new parameters are in
+// effect, which for a reanchored channel is once the kickoff transaction
+// confirmed, or once the change was rejected or aborted.
+type dynManager struct {
+	p *Brontide
+
+	// resumed holds the channels with a signed reanchor that were loaded
+	// when the peer was started. They are resumed once the peer runs.
+	resumed []*lnwallet.LightningChannel
+
+	mu sync.Mutex
+
+	// committers holds the changes in progress.
+	committers map[lnwire.ChannelID]*dynCommit
+}
+
+// A compile-time check to ensure that dynManager satisfies the
+// msgmux.Endpoint interface.
+var _ msgmux.Endpoint = (*dynManager)(nil)
+
+// newDynManager creates the dynManager of the given peer.
+func newDynManager(p *Brontide) *dynManager {
+	return &dynManager{
+		p:          p,
+		committers: make(map[lnwire.ChannelID]*dynCommit),
+	}
+}
+
+// dynCommitAllowed returns true if both parties have negotiated the dynamic
+// commitments feature.
+func (p *Brontide) dynCommitAllowed() bool {
+	return p.RemoteFeatures().HasFeature(
+		lnwire.DynamicCommitmentsOptionalStaging,
+	) && p.LocalFeatures().HasFeature(
+		lnwire.DynamicCommitmentsOptionalStaging,
+	)
+}
+
+// taprootAllowed returns true if both parties have negotiated the simple
+// taproot channels feature.
+func (p *Brontide) taprootAllowed() bool {
+	return p.RemoteFeatures().HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	) && p.LocalFeatures().HasFeature(
+		lnwire.SimpleTaprootChannelsOptionalStaging,
+	)
+}
+
+// ModifyChannelParams changes the parameters of a channel with the peer. If
+// the channel needs to be reanchored, the txid of the kickoff transaction is
+// returned once it was broadcast, and the channel can only be used again once
+// the kickoff transaction confirmed. Otherwise, the new parameters are in
+// effect once this method returns.
+func (p *Brontide) ModifyChannelParams(ctx context.Context,
+	chanPoint wire.OutPoint,
+	req dyncomm.Request) (fn.Option[chainhash.Hash], error) {
+
+	none := fn.None[chainhash.Hash]()
+
+	c, err := p.dynMgr.initiate(chanPoint, req)
+	if err != nil {
+		return none, err
+	}
+
+	select {
+	case result := <-c.result:
+		return result.Unpack()
+	case <-ctx.Done():
+		return none, ctx.Err()
+	case <-p.cg.Done():
+		return none, lnpeer.ErrPeerExiting
+	}
+}
+
+// initiate starts a change of the parameters of a channel we initiate. The
+// change starts once the channel is quiescent.
+func (m *dynManager) initiate(chanPoint wire.OutPoint,
+	req dyncomm.Request) (*dynCommit, error) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	if !m.p.dynCommitAllowed() {
+		return nil, ErrDynCommitNotNegotiated
+	}
+
+	lnChan, ok := m.p.activeChannels.Load(chanID)
+	if !ok || lnChan == nil {
+		return nil, fmt.Errorf("channel %v is not active", chanPoint)
+	}
+
+	link := m.p.fetchLinkFromKeyAndCid(chanID)
+	if link == nil {
+		return nil, fmt.Errorf("link for channel %v not found",
+			chanPoint)
+	}
+
+	// We check the request before we quiesce the channel, so an invalid
+	// request doesn't interrupt it.
+	env, err := m.env(lnChan)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := dyncomm.NewProposal(env, req); err != nil {
+		return nil, err
+	}
+
+	c := newDynCommit(lnChan, true)
+
+	m.mu.Lock()
+	if _, ok := m.committers[chanID]; ok {
+		m.mu.Unlock()
+
+		return nil, ErrDynCommitInProgress
+	}
+	m.committers[chanID] = c
+	m.mu.Unlock()
+
+	quiesced := link.InitStfu()
+
+	m.p.cg.WgAdd(1)
+	go func() {
+		defer m.p.cg.WgDone()
+
+		select {
+		case initiator := <-quiesced:
+			m.handleQuiescence(chanID, c, req, initiator)
+
+		case <-m.p.cg.Done():
+		}
+	}()
+
+	return c, nil
+}
+
+// handleQuiescence sends our proposal once the channel is quiescent.
+func (m *dynManager) handleQuiescence(chanID lnwire.ChannelID, c *dynCommit,
+	req dyncomm.Request, quiesced fn.Result[lntypes.ChannelParty]) {
+
+	initiator, err := quiesced.Unpack()
+	if err == nil && initiator != lntypes.Local {
+		err = errors.New("channel was quiesced by the remote party")
+	}
+
+	m.mu.Lock()
+
+	// The change may have been replaced by one the remote party initiated
+	// in the meantime.
+	if m.committers[chanID] != c {
+		m.mu.Unlock()
+
+		return
+	}
+	if err != nil {
+		delete(m.committers, chanID)
+		m.mu.Unlock()
+
+		c.fail(fmt.Errorf("unable to quiesce channel: %w", err))
+
+		return
+	}
+	m.mu.Unlock()
+
+	m.p.handOver(chanID, m.Name())
+
+	if err := m.start(c, &dyncomm.Idle{}); err != nil {
+		m.mu.Lock()
+		delete(m.committers, chanID)
+		m.mu.Unlock()
+
+		c.fail(err)
+		m.abortQuiescence(chanID, err)
+
+		return
+	}
+
+	c.fsm.SendEvent(context.Background(), &dyncomm.SendPropose{
+		Request: req,
+	})
+}
+
+// resumeReanchor hands a channel with a reanchor that was signed before the
+// peer was started over to the dynManager.
+//
+// NOTE: This must be called before resumeAll.
+func (m *dynManager) resumeReanchor(lnChan *lnwallet.LightningChannel) {
+	m.resumed = append(m.resumed, lnChan)
+}
+
+// resumeAll resumes the reanchors of the channels that were loaded when the
+// peer was started.
+func (m *dynManager) resumeAll() {
+	for _, lnChan := range m.resumed {
+		m.resume(lnChan)
+	}
+	m.resumed = nil
+}
+
+// resume resumes a reanchor that was signed by both parties before the peer
+// was started.
+func (m *dynManager) resume(lnChan *lnwallet.LightningChannel) {
+	chanPoint := lnChan.ChannelPoint()
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	pending, err := lnChan.State().PendingSplice()
+	if err != nil {
+		m.p.log.Errorf("Unable to fetch reanchor of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+
+		return
+	}
+
+	state, err := dyncomm.ResumeState(pending)
+	if err != nil {
+		m.p.log.Errorf("Unable to resume reanchor of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+
+		return
+	}
+
+	m.p.handedOver.Store(chanID, m.Name())
+
+	c := newDynCommit(lnChan, false)
+	if err := m.start(c, state); err != nil {
+		m.p.log.Errorf("Unable to resume reanchor of "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+
+		return
+	}
+
+	c.fsm.SendEvent(context.Background(), &dyncomm.Resume{})
+}
+
+// start starts the state machine of the given change in the given state.
+func (m *dynManager) start(c *dynCommit, state dyncomm.DynState) error {
+	chanID := lnwire.NewChanIDFromOutPoint(c.channel.ChannelPoint())
+
+	env, err := m.env(c.channel)
+	if err != nil {
+		return err
+	}
+
+	peerPub := *m.p.IdentityKey()
+	daemonAdapters := NewLndDaemonAdapters(LndAdapterCfg{
+		MsgSender:     newPeerMsgSender(peerPub, m.p),
+		TxBroadcaster: m.p.cfg.Wallet,
+		ChainNotifier: m.p.cfg.ChainNotifier,
+	})
+
+	fsm := protofsm.NewStateMachine(dyncomm.DynCommitterCfg{
+		Daemon:       daemonAdapters,
+		InitialState: state,
+		Env:          env,
+		ErrorReporter: &dynErrorReporter{
+			m:      m,
+			chanID: chanID,
+			c:      c,
+		},
+		MsgMapper: fn.Some[dyncomm.DynMsgMapperT](
+			dyncomm.NewMsgMapper(chanID, peerPub),
+		),
+	})
+	stateSub := fsm.RegisterStateEvents()
+
+	m.mu.Lock()
+	c.fsm = &fsm
+	m.committers[chanID] = c
+	m.mu.Unlock()
+
+	fsm.Start(context.Background())
+
+	m.p.cg.WgAdd(1)
+	go m.watch(chanID, c, stateSub)
+
+	return nil
+}
+
+// env returns the environment of the state machine that changes the
+// parameters of the given channel.
+func (m *dynManager) env(
+	lnChan *lnwallet.LightningChannel) (*dyncomm.Environment, error) {
+
+	_, bestHeight, err := m.p.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch best block: %w", err)
+	}
+
+	chanPoint := lnChan.ChannelPoint()
+
+	return &dyncomm.Environment{
+		ChanPeer:  *m.p.IdentityKey(),
+		ChanPoint: chanPoint,
+		ChanID:    lnwire.NewChanIDFromOutPoint(chanPoint),
+		Scid: lnChan.ZeroConfRealScid().UnwrapOr(
+			lnChan.ShortChanID(),
+		),
+		BlockHeight:      uint32(bestHeight),
+		MaxLocalCSVDelay: m.p.cfg.MaxLocalCSVDelay,
+		TaprootAllowed:   m.p.taprootAllowed(),
+		Channel:          lnChan,
+		MsgSigner:        m.p.cfg.Wallet.Cfg.SecretKeyRing,
+	}, nil
+}
+
+// watch waits for the state machine of a change to reach a terminal state, or
+// for the kickoff transaction of a reanchor to be broadcast, and resolves the
+// change accordingly.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *dynManager) watch(chanID lnwire.ChannelID, c *dynCommit,
+	stateSub dyncomm.DynStateSub) {
+
+	defer m.p.cg.WgDone()
+	defer c.fsm.RemoveStateSub(stateSub)
+
+	for {
+		select {
+		case state := <-stateSub.NewItemCreated.ChanOut():
+			switch state := state.(type) {
+			case *dyncomm.AwaitingKickoffConf:
+				txid := state.KickoffTx.TxHash()
+				c.resolve(fn.Ok(fn.Some(txid)))
+
+			case *dyncomm.Committed:
+				m.p.log.Infof("New parameters of "+
+					"ChannelID(%v) are in effect", chanID)
+
+				c.resolve(fn.Ok(state.KickoffTxid))
+				m.finish(chanID, c)
+
+				return
+
+			case *dyncomm.Rejected:
+				c.fail(state.Err())
+				m.finish(chanID, c)
+
+				return
+
+			case *dyncomm.Aborted:
+				c.fail(state.Err())
+				m.finish(chanID, c)
+
+				return
+			}
+
+		case <-c.done:
+			return
+
+		case <-m.p.cg.Done():
+			c.stop()
+
+			return
+		}
+	}
+}
+
+// remove stops the state machine of a change, and removes the change.
+func (m *dynManager) remove(chanID lnwire.ChannelID, c *dynCommit) {
+	c.stop()
+
+	m.mu.Lock()
+	if m.committers[chanID] == c {
+		delete(m.committers, chanID)
+	}
+	m.mu.Unlock()
+}
+
+// finish removes a change, and hands the channel back to a new link.
+func (m *dynManager) finish(chanID lnwire.ChannelID, c *dynCommit) {
+	m.remove(chanID, c)
+
+	if err := m.p.restoreLink(chanID); err != nil {
+		m.p.log.Errorf("Unable to restore link of ChannelID(%v): %v",
+			chanID, err)
+	}
+}
+
+// abortQuiescence sends a tx_abort for a channel that was handed over before
+// its parameters were changed, and hands it back to a new link.
+func (m *dynManager) abortQuiescence(chanID lnwire.ChannelID, reason error) {
+	err := m.p.SendMessage(false, &lnwire.TxAbort{
+		ChannelID: chanID,
+		Data:      []byte(reason.Error()),
+	})
+	if err != nil {
+		m.p.log.Errorf("Unable to send tx_abort: %v", err)
+	}
+
+	if err := m.p.restoreLink(chanID); err != nil {
+		m.p.log.Errorf("Unable to restore link of ChannelID(%v): %v",
+			chanID, err)
+	}
+}
+
+// dynErrorReporter reports the errors of the state machine of a change.
+type dynErrorReporter struct {
+	m      *dynManager
+	chanID lnwire.ChannelID
+	c      *dynCommit
+}
+
+// ReportError fails the change. A change that wasn't persisted yet is aborted,
+// and the channel is handed back to a new link. Once a reanchor was persisted,
+// the kickoff transaction may confirm at any time, so it's resumed once the
+// peer reconnects.
+//
+// NOTE: Part of the protofsm.ErrorReporter interface.
+func (r *dynErrorReporter) ReportError(err error) {
+	m, c := r.m, r.c
+
+	m.p.log.Errorf("Change of parameters of ChannelID(%v) failed: %v",
+		r.chanID, err)
+
+	c.fail(err)
+
+	_, pendingErr := c.channel.State().PendingSplice()
+	reanchored := pendingErr == nil
+
+	// The state machine stops itself once this method returns, so we stop
+	// it from a new goroutine.
+	m.p.cg.WgAdd(1)
+	go func() {
+		defer m.p.cg.WgDone()
+
+		if reanchored {
+			c.stop()
+
+			return
+		}
+
+		m.remove(r.chanID, c)
+		m.abortQuiescence(r.chanID, err)
+	}()
+}
+
+// Name returns the name of the endpoint.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *dynManager) Name() msgmux.EndpointName {
+	return fmt.Sprintf("dyncomm-%x", m.p.PubKey())
+}
+
+// CanHandle returns true if the message is a dyn_propose of our peer, or a
+// message for a channel whose parameters are being changed.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *dynManager) CanHandle(msg msgmux.PeerMsg) bool {
+	if !msg.PeerPub.IsEqual(m.p.IdentityKey()) {
+		return false
+	}
+
+	chanID, ok := dynMsgChanID(msg.Message)
+	if !ok {
+		return false
+	}
+
+	owner, handedOver := m.p.handedOver.Load(chanID)
+	if handedOver {
+		return owner == m.Name()
+	}
+
+	return msg.Message.MsgType() == lnwire.MsgDynPropose
+}
+
+// SendMessage hands the message over to the state machine of the channel. A
+// dyn_propose of the remote party starts a new state machine.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *dynManager) SendMessage(ctx context.Context,
+	msg msgmux.PeerMsg) bool {
+
+	chanID, _ := dynMsgChanID(msg.Message)
+
+	if _, handedOver := m.p.handedOver.Load(chanID); !handedOver {
+		propose, ok := msg.Message.(*lnwire.DynPropose)
+		if !ok {
+			return false
+		}
+
+		return m.acceptProposal(ctx, propose)
+	}
+
+	m.mu.Lock()
+	var fsm *dyncomm.DynCommitter
+	if c, ok := m.committers[chanID]; ok {
+		fsm = c.fsm
+	}
+	m.mu.Unlock()
+
+	if fsm == nil {
+		m.p.log.Debugf("Ignoring %v for ChannelID(%v) without state "+
+			"machine", msg.Message.MsgType(), chanID)
+
+		return false
+	}
+
+	return fsm.SendMessage(ctx, msg)
+}
+
+// acceptProposal handles a proposal of the remote party after it quiesced the
+// channel.
+func (m *dynManager) acceptProposal(ctx context.Context,
+	msg *lnwire.DynPropose) bool {
+
+	chanID := msg.ChanID
+
+	lnChan, ok := m.p.activeChannels.Load(chanID)
+	if !ok || lnChan == nil {
+		m.p.log.Warnf("Received dyn_propose for unknown "+
+			"ChannelID(%v)", chanID)
+
+		return false
+	}
+
+	link := m.p.fetchLinkFromKeyAndCid(chanID)
+	if link == nil {
+		m.p.log.Warnf("Received dyn_propose for ChannelID(%v) "+
+			"without link", chanID)
+
+		return false
+	}
+
+	initiator, err := link.QuiescenceInitiator().Unpack()
+	if err != nil || initiator != lntypes.Remote {
+		m.p.log.Warnf("Received dyn_propose for ChannelID(%v) that "+
+			"wasn't quiesced by the remote party", chanID)
+
+		return false
+	}
+
+	// If we wait for the channel to become quiescent, the remote party
+	// won the race to initiate quiescence, so our request fails.
+	m.mu.Lock()
+	if c, ok := m.committers[chanID]; ok {
+		c.fail(errors.New("channel parameters are changed by the " +
+			"remote party"))
+		delete(m.committers, chanID)
+	}
+	m.mu.Unlock()
+
+	m.p.handOver(chanID, m.Name())
+
+	if !m.p.dynCommitAllowed() {
+		m.abortQuiescence(chanID, ErrDynCommitNotNegotiated)
+
+		return true
+	}
+
+	c := newDynCommit(lnChan, false)
+	if err := m.start(c, &dyncomm.Idle{}); err != nil {
+		m.p.log.Errorf("Unable to accept dyn_propose for "+
+			"ChannelID(%v): %v", chanID, err)
+
+		m.abortQuiescence(chanID, err)
+
+		return true
+	}
+
+	return c.fsm.SendMessage(ctx, msgmux.PeerMsg{
+		PeerPub: *m.p.IdentityKey(),
+		Message: msg,
+	})
+}
+
+// dynMsgChanID returns the channel of a message that takes part in a change of
+// channel parameters.
+func dynMsgChanID(msg lnwire.Message) (lnwire.ChannelID, bool) {
+	switch msg := msg.(type) {
+	case *lnwire.DynPropose:
+		return msg.ChanID, true
+	case *lnwire.DynAck:
+		return msg.ChanID, true
+	case *lnwire.DynReject:
+		return msg.ChanID, true
+	case *lnwire.DynCommit:
+		return msg.DynPropose.ChanID, true
+	case *lnwire.KickoffSig:
+		return msg.ChanID, true
+	case *lnwire.CommitSig:
+		return msg.ChanID, true
+	case *lnwire.TxAbort:
+		return msg.ChannelID, true
+	default:
+		return lnwire.ChannelID{}, false
+	}
+}
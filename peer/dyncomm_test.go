@@ -0,0 +1,80 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/stretchr/testify/require"
+)
+
+// TestDynManagerCanHandle asserts that the dyn manager only handles the
+// proposals of its peer, and the messages of channels that were handed over to
+// it.
+func TestDynManagerCanHandle(t *testing.T) {
+	t.Parallel()
+
+	peerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	p := NewBrontide(Config{
+		Addr: &lnwire.NetAddress{
+			IdentityKey: peerKey.PubKey(),
+		},
+	})
+	m := p.dynMgr
+
+	chanID := lnwire.NewChanIDFromOutPoint(wire.OutPoint{
+		Hash: chainhash.Hash{1},
+	})
+
+	canHandle := func(key *btcec.PrivateKey, msg lnwire.Message) bool {
+		return m.CanHandle(msgmux.PeerMsg{
+			Message: msg,
+			PeerPub: *key.PubKey(),
+		})
+	}
+
+	// Proposals of our peer are handled, but not the ones of other peers.
+	propose := &lnwire.DynPropose{ChanID: chanID}
+	require.True(t, canHandle(peerKey, propose))
+	require.False(t, canHandle(otherKey, propose))
+
+	// The other messages are only handled once the channel was handed
+	// over.
+	msgs := []lnwire.Message{
+		&lnwire.DynAck{ChanID: chanID},
+		&lnwire.DynReject{ChanID: chanID},
+		&lnwire.DynCommit{DynPropose: *propose},
+		&lnwire.KickoffSig{ChanID: chanID},
+		&lnwire.CommitSig{ChanID: chanID},
+		&lnwire.TxAbort{ChannelID: chanID},
+	}
+	for _, msg := range msgs {
+		require.False(t, canHandle(peerKey, msg))
+	}
+
+	p.handedOver.Store(chanID, m.Name())
+	for _, msg := range msgs {
+		require.True(t, canHandle(peerKey, msg))
+		require.False(t, canHandle(otherKey, msg))
+	}
+
+	// A channel that was handed over to the splice manager is left alone,
+	// including proposals for it.
+	p.handedOver.Store(chanID, p.spliceMgr.Name())
+	require.False(t, canHandle(peerKey, propose))
+	for _, msg := range msgs {
+		require.False(t, canHandle(peerKey, msg))
+	}
+
+	// Other messages are never handled.
+	require.False(t, canHandle(peerKey, &lnwire.UpdateAddHTLC{
+		ChanID: chanID,
+	}))
+}
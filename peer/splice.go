@@ -0,0 +1,877 @@
+package peer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
+	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
+	"github.com/lightningnetwork/lnd/lnwallet/splice"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+)
+
+var (
+	// ErrSplicingNotNegotiated is returned when a splice is requested
+	// with a peer that didn't negotiate the splicing feature.
+	ErrSplicingNotNegotiated = errors.New("splicing not negotiated with " +
+		"peer")
+
+	// ErrSpliceInProgress is returned when a splice is requested for a
+	// channel that is already being spliced.
+	ErrSpliceInProgress = errors.New("channel is already being spliced")
+)
+
+// SpliceRequest describes a splice of a channel that is initiated by us.
+type SpliceRequest struct {
+	// ChanPoint is the funding outpoint that identifies the channel.
+	ChanPoint wire.OutPoint
+
+	// Amount is the amount that is spliced into the channel. A negative
+	// amount splices funds out of the channel.
+	Amount btcutil.Amount
+
+	// FeeRate is the fee rate of the splice transaction.
+	FeeRate chainfee.SatPerKWeight
+
+	// DeliveryScript receives the funds that are spliced out of the
+	// channel.
+	DeliveryScript lnwire.DeliveryAddress
+}
+
+// spliceReq is a SpliceRequest along with the channel its result is delivered
+// on.
+type spliceReq struct {
+	SpliceRequest
+
+	// result receives the txid of the splice transaction once it was
+	// broadcast, or the error that failed the splice.
+	result chan fn.Result[chainhash.Hash]
+}
+
+// spliceQuiescence reports the result of quiescing a channel we want to
+// splice.
+type spliceQuiescence struct {
+	chanID    lnwire.ChannelID
+	initiator fn.Result[lntypes.ChannelParty]
+}
+
+// activeSplice is a splice that is in progress.
+type activeSplice struct {
+	// channel is the state machine of the spliced channel.
+	channel *lnwallet.LightningChannel
+
+	// negotiator drives the splice. It is nil while we wait for the
+	// channel to become quiescent.
+	negotiator *splice.Negotiator
+
+	// req is the request of a splice we initiated. It is nil once the
+	// request was resolved, or if the remote party initiated the splice.
+	req *spliceReq
+
+	// confirming is true once we wait for the splice transaction to
+	// confirm.
+	confirming bool
+}
+
+// resolve delivers the result of the splice to the caller that requested it.
+func (s *activeSplice) resolve(result fn.Result[chainhash.Hash]) {
+	if s.req == nil {
+		return
+	}
+
+	s.req.result <- result
+	s.req = nil
+}
+
+// spliceManager splices the channels we have with a peer. A channel is handed
+// over from its link to the spliceManager once it is quiescent. The link is
+// added back once the splice was aborted, or once the splice transaction
+// confirmed.
+type spliceManager struct {
+	p *Brontide
+
+	msgs     chan lnwire.Message
+	reqs     chan *spliceReq
+	quiesced chan *spliceQuiescence
+	confs    chan lnwire.ChannelID
+
+	// resumed holds the channels with a signed splice that were loaded
+	// when the peer was started. They are resumed once the spliceManager
+	// runs.
+	resumed []*lnwallet.LightningChannel
+
+	// splices holds the splices in progress. It's only accessed by the
+	// goroutine of the spliceManager.
+	splices map[lnwire.ChannelID]*activeSplice
+}
+
+// A compile-time check to ensure that spliceManager satisfies the
+// msgmux.Endpoint interface.
+var _ msgmux.Endpoint = (*spliceManager)(nil)
+
+// newSpliceManager creates the spliceManager of the given peer.
+func newSpliceManager(p *Brontide) *spliceManager {
+	return &spliceManager{
+		p:        p,
+		msgs:     make(chan lnwire.Message),
+		reqs:     make(chan *spliceReq),
+		quiesced: make(chan *spliceQuiescence),
+		confs:    make(chan lnwire.ChannelID),
+		splices:  make(map[lnwire.ChannelID]*activeSplice),
+	}
+}
+
+// spliceAllowed returns true if both parties have negotiated the splicing
+// feature.
+func (p *Brontide) spliceAllowed() bool {
+	return p.RemoteFeatures().HasFeature(lnwire.SpliceOptionalStaging) &&
+		p.LocalFeatures().HasFeature(lnwire.SpliceOptionalStaging)
+}
+
+// Splice splices funds into or out of a channel with the peer. The txid of
+// the splice transaction is returned once it was broadcast. The channel can
+// only be used again once the splice transaction confirmed.
+func (p *Brontide) Splice(ctx context.Context,
+	req SpliceRequest) (chainhash.Hash, error) {
+
+	spliceReq := &spliceReq{
+		SpliceRequest: req,
+		result:        make(chan fn.Result[chainhash.Hash], 1),
+	}
+
+	select {
+	case p.spliceMgr.reqs <- spliceReq:
+	case <-ctx.Done():
+		return chainhash.Hash{}, ctx.Err()
+	case <-p.cg.Done():
+		return chainhash.Hash{}, lnpeer.ErrPeerExiting
+	}
+
+	select {
+	case result := <-spliceReq.result:
+		return result.Unpack()
+	case <-ctx.Done():
+		return chainhash.Hash{}, ctx.Err()
+	case <-p.cg.Done():
+		return chainhash.Hash{}, lnpeer.ErrPeerExiting
+	}
+}
+
+// resumeSplice hands a channel with a splice that was signed before the peer
+// was started over to the spliceManager.
+//
+// NOTE: This must be called before the spliceManager runs.
+func (m *spliceManager) resumeSplice(lnChan *lnwallet.LightningChannel) {
+	m.resumed = append(m.resumed, lnChan)
+}
+
+// Name returns the name of the endpoint.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *spliceManager) Name() msgmux.EndpointName {
+	return fmt.Sprintf("splice-%x", m.p.PubKey())
+}
+
+// CanHandle returns true if the message is a splice message of our peer, or a
+// message for a channel that is being spliced.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *spliceManager) CanHandle(msg msgmux.PeerMsg) bool {
+	if !msg.PeerPub.IsEqual(m.p.IdentityKey()) {
+		return false
+	}
+
+	chanID, ok := spliceMsgChanID(msg.Message)
+	if !ok {
+		return false
+	}
+
+	owner, handedOver := m.p.handedOver.Load(chanID)
+	ours := handedOver && owner == m.Name()
+
+	switch msg.Message.(type) {
+	case *lnwire.SpliceInit, *lnwire.SpliceLocked:
+		return true
+
+	// A tx_abort also ends the quiescence of a channel that isn't handed
+	// over yet.
+	case *lnwire.TxAbort:
+		lnChan, ok := m.p.activeChannels.Load(chanID)
+
+		return ours || (!handedOver && ok && lnChan != nil)
+
+	default:
+		return ours
+	}
+}
+
+// SendMessage hands the message over to the goroutine of the spliceManager.
+//
+// NOTE: Part of the msgmux.Endpoint interface.
+func (m *spliceManager) SendMessage(ctx context.Context,
+	msg msgmux.PeerMsg) bool {
+
+	select {
+	case m.msgs <- msg.Message:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-m.p.cg.Done():
+		return false
+	}
+}
+
+// spliceMsgChanID returns the channel of a message that takes part in a
+// splice.
+func spliceMsgChanID(msg lnwire.Message) (lnwire.ChannelID, bool) {
+	switch msg := msg.(type) {
+	case *lnwire.SpliceInit:
+		return msg.ChannelID, true
+	case *lnwire.SpliceAck:
+		return msg.ChannelID, true
+	case *lnwire.SpliceLocked:
+		return msg.ChannelID, true
+	case *lnwire.TxAddInput:
+		return msg.ChannelID, true
+	case *lnwire.TxAddOutput:
+		return msg.ChannelID, true
+	case *lnwire.TxRemoveInput:
+		return msg.ChannelID, true
+	case *lnwire.TxRemoveOutput:
+		return msg.ChannelID, true
+	case *lnwire.TxComplete:
+		return msg.ChannelID, true
+	case *lnwire.TxSignatures:
+		return msg.ChannelID, true
+	case *lnwire.TxAbort:
+		return msg.ChannelID, true
+	case *lnwire.CommitSig:
+		return msg.ChanID, true
+	default:
+		return lnwire.ChannelID{}, false
+	}
+}
+
+// run is the main loop of the spliceManager.
+//
+// NOTE: This MUST be run as a goroutine.
+func (m *spliceManager) run() {
+	defer m.p.cg.WgDone()
+
+	for _, lnChan := range m.resumed {
+		m.resume(lnChan)
+	}
+	m.resumed = nil
+
+	for {
+		select {
+		case msg := <-m.msgs:
+			m.handleMsg(msg)
+
+		case req := <-m.reqs:
+			m.handleReq(req)
+
+		case quiesced := <-m.quiesced:
+			m.handleQuiescence(quiesced)
+
+		case chanID := <-m.confs:
+			m.handleConfirmation(chanID)
+
+		case <-m.p.cg.Done():
+			m.stop()
+
+			return
+		}
+	}
+}
+
+// stop aborts the splices that weren't signed yet, as the peer is exiting.
+func (m *spliceManager) stop() {
+	for chanID, s := range m.splices {
+		if s.negotiator != nil && !s.negotiator.Signed() {
+			// The peer is exiting, so we only need to release the
+			// inputs of the splice.
+			_ = s.negotiator.Abort(lnpeer.ErrPeerExiting)
+		}
+
+		s.resolve(fn.Err[chainhash.Hash](lnpeer.ErrPeerExiting))
+		delete(m.splices, chanID)
+	}
+}
+
+// resume resumes a splice that was signed by both parties before the peer was
+// started.
+func (m *spliceManager) resume(lnChan *lnwallet.LightningChannel) {
+	chanPoint := lnChan.ChannelPoint()
+	chanID := lnwire.NewChanIDFromOutPoint(chanPoint)
+
+	pending, err := lnChan.State().PendingSplice()
+	if err != nil {
+		m.p.log.Errorf("Unable to fetch splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+
+		return
+	}
+
+	cfg, err := m.negotiatorConfig(lnChan, fn.None[splice.Request]())
+	if err != nil {
+		m.p.log.Errorf("Unable to resume splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+
+		return
+	}
+
+	negotiator, err := splice.ResumeNegotiator(cfg, pending)
+	if err != nil {
+		m.p.log.Errorf("Unable to resume splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+
+		return
+	}
+
+	m.p.handedOver.Store(chanID, m.Name())
+
+	s := &activeSplice{
+		channel:    lnChan,
+		negotiator: negotiator,
+	}
+	m.splices[chanID] = s
+
+	if err := negotiator.Start(); err != nil {
+		m.p.log.Errorf("Unable to resume splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+	}
+
+	m.progress(chanID, s)
+}
+
+// handleReq handles a splice we initiate. The splice starts once the channel
+// is quiescent.
+func (m *spliceManager) handleReq(req *spliceReq) {
+	chanID := lnwire.NewChanIDFromOutPoint(req.ChanPoint)
+
+	fail := func(err error) {
+		req.result <- fn.Err[chainhash.Hash](err)
+	}
+
+	if !m.p.spliceAllowed() {
+		fail(ErrSplicingNotNegotiated)
+
+		return
+	}
+
+	if _, ok := m.splices[chanID]; ok {
+		fail(ErrSpliceInProgress)
+
+		return
+	}
+
+	lnChan, ok := m.p.activeChannels.Load(chanID)
+	if !ok || lnChan == nil {
+		fail(fmt.Errorf("channel %v is not active", req.ChanPoint))
+
+		return
+	}
+
+	if err := checkSpliceable(lnChan.State()); err != nil {
+		fail(err)
+
+		return
+	}
+
+	link := m.p.fetchLinkFromKeyAndCid(chanID)
+	if link == nil {
+		fail(fmt.Errorf("link for channel %v not found",
+			req.ChanPoint))
+
+		return
+	}
+
+	m.splices[chanID] = &activeSplice{
+		channel: lnChan,
+		req:     req,
+	}
+
+	quiesced := link.InitStfu()
+
+	m.p.cg.WgAdd(1)
+	go func() {
+		defer m.p.cg.WgDone()
+
+		var initiator fn.Result[lntypes.ChannelParty]
+		select {
+		case initiator = <-quiesced:
+		case <-m.p.cg.Done():
+			return
+		}
+
+		select {
+		case m.quiesced <- &spliceQuiescence{
+			chanID:    chanID,
+			initiator: initiator,
+		}:
+		case <-m.p.cg.Done():
+		}
+	}()
+}
+
+// handleQuiescence starts a splice we initiated once the channel is
+// quiescent.
+func (m *spliceManager) handleQuiescence(quiesced *spliceQuiescence) {
+	chanID := quiesced.chanID
+
+	// The splice may have been replaced by one the remote party
+	// initiated in the meantime.
+	s, ok := m.splices[chanID]
+	if !ok || s.req == nil || s.negotiator != nil {
+		return
+	}
+
+	initiator, err := quiesced.initiator.Unpack()
+	if err == nil && initiator != lntypes.Local {
+		err = errors.New("channel was quiesced by the remote party")
+	}
+	if err != nil {
+		s.resolve(fn.Err[chainhash.Hash](fmt.Errorf("unable to "+
+			"quiesce channel: %w", err)))
+		delete(m.splices, chanID)
+
+		return
+	}
+
+	m.p.handOver(chanID, m.Name())
+
+	req := splice.Request{
+		Amount:         s.req.Amount,
+		FeeRate:        s.req.FeeRate,
+		DeliveryScript: s.req.DeliveryScript,
+	}
+	cfg, err := m.negotiatorConfig(s.channel, fn.Some(req))
+	if err != nil {
+		m.fail(chanID, s, err)
+
+		return
+	}
+
+	s.negotiator = splice.NewNegotiator(cfg)
+	if err := s.negotiator.Start(); err != nil {
+		m.fail(chanID, s, err)
+	}
+}
+
+// handleMsg handles a splice message of the remote party.
+func (m *spliceManager) handleMsg(msg lnwire.Message) {
+	chanID, _ := spliceMsgChanID(msg)
+	s, ok := m.splices[chanID]
+
+	switch {
+	case ok && s.negotiator != nil:
+		if err := s.negotiator.ProcessMessage(msg); err != nil {
+			m.fail(chanID, s, err)
+
+			return
+		}
+
+		m.progress(chanID, s)
+
+	case msg.MsgType() == lnwire.MsgSpliceInit:
+		spliceInit, _ := msg.(*lnwire.SpliceInit)
+		m.acceptSplice(spliceInit)
+
+	case msg.MsgType() == lnwire.MsgTxAbort:
+		m.handleTxAbort(chanID)
+
+	default:
+		m.p.log.Debugf("Ignoring %v for ChannelID(%v) that isn't "+
+			"being spliced", msg.MsgType(), chanID)
+	}
+}
+
+// acceptSplice handles a splice the remote party initiated after it quiesced
+// the channel.
+func (m *spliceManager) acceptSplice(msg *lnwire.SpliceInit) {
+	chanID := msg.ChannelID
+
+	// If we wait for the channel to become quiescent, the remote party
+	// won the race to initiate quiescence, so our request fails.
+	if s, ok := m.splices[chanID]; ok {
+		s.resolve(fn.Err[chainhash.Hash](errors.New("splice was " +
+			"initiated by the remote party")))
+		delete(m.splices, chanID)
+	}
+
+	lnChan, ok := m.p.activeChannels.Load(chanID)
+	if !ok || lnChan == nil {
+		m.p.log.Warnf("Received splice_init for unknown "+
+			"ChannelID(%v)", chanID)
+
+		return
+	}
+
+	link := m.p.fetchLinkFromKeyAndCid(chanID)
+	if link == nil {
+		m.p.log.Warnf("Received splice_init for ChannelID(%v) "+
+			"without link", chanID)
+
+		return
+	}
+
+	initiator, err := link.QuiescenceInitiator().Unpack()
+	if err != nil || initiator != lntypes.Remote {
+		m.p.log.Warnf("Received splice_init for ChannelID(%v) that "+
+			"wasn't quiesced by the remote party", chanID)
+
+		return
+	}
+
+	m.p.handOver(chanID, m.Name())
+
+	s := &activeSplice{
+		channel: lnChan,
+	}
+	m.splices[chanID] = s
+
+	if !m.p.spliceAllowed() {
+		m.fail(chanID, s, ErrSplicingNotNegotiated)
+
+		return
+	}
+
+	if err := checkSpliceable(lnChan.State()); err != nil {
+		m.fail(chanID, s, err)
+
+		return
+	}
+
+	cfg, err := m.negotiatorConfig(lnChan, fn.None[splice.Request]())
+	if err != nil {
+		m.fail(chanID, s, err)
+
+		return
+	}
+
+	s.negotiator = splice.NewNegotiator(cfg)
+	if err := s.negotiator.ProcessMessage(msg); err != nil {
+		m.fail(chanID, s, err)
+
+		return
+	}
+
+	m.progress(chanID, s)
+}
+
+// handleTxAbort handles a tx_abort for a channel that isn't being spliced. If
+// the channel is quiescent, the tx_abort ends its quiescence.
+func (m *spliceManager) handleTxAbort(chanID lnwire.ChannelID) {
+	link := m.p.fetchLinkFromKeyAndCid(chanID)
+	if link == nil || link.QuiescenceInitiator().IsErr() {
+		m.p.log.Debugf("Ignoring tx_abort for ChannelID(%v) that "+
+			"isn't quiescent", chanID)
+
+		return
+	}
+
+	m.p.log.Infof("Remote party aborted quiescence of ChannelID(%v)",
+		chanID)
+
+	m.p.handOver(chanID, m.Name())
+
+	err := m.p.SendMessage(false, &lnwire.TxAbort{
+		ChannelID: chanID,
+		Data:      []byte("quiescence aborted"),
+	})
+	if err != nil {
+		m.p.log.Errorf("Unable to send tx_abort: %v", err)
+	}
+
+	if err := m.p.restoreLink(chanID); err != nil {
+		m.p.log.Errorf("Unable to restore link of ChannelID(%v): %v",
+			chanID, err)
+	}
+}
+
+// progress waits for the splice transaction to confirm once it was signed by
+// both parties, and resolves the request of the splice once the transaction
+// was broadcast.
+func (m *spliceManager) progress(chanID lnwire.ChannelID, s *activeSplice) {
+	if s.negotiator.Signed() && !s.confirming {
+		if err := m.waitForConfirmation(chanID, s); err != nil {
+			m.p.log.Errorf("Unable to wait for splice of "+
+				"ChannelPoint(%v) to confirm: %v",
+				s.channel.ChannelPoint(), err)
+		} else {
+			s.confirming = true
+		}
+	}
+
+	if s.negotiator.Broadcast() {
+		s.negotiator.SpliceTxID().WhenSome(func(txid chainhash.Hash) {
+			s.resolve(fn.Ok(txid))
+		})
+	}
+}
+
+// waitForConfirmation registers for the confirmation of the splice
+// transaction, which is reported to the main loop of the spliceManager.
+func (m *spliceManager) waitForConfirmation(chanID lnwire.ChannelID,
+	s *activeSplice) error {
+
+	pending, err := s.negotiator.PendingSplice().UnwrapOrErr(
+		errors.New("splice not signed"),
+	)
+	if err != nil {
+		return err
+	}
+
+	txid := pending.SpliceTx.TxHash()
+	pkScript := pending.SpliceTx.TxOut[pending.FundingOutpoint.Index].
+		PkScript
+	numConfs := max(uint32(s.channel.State().NumConfsRequired), 1)
+
+	confNtfn, err := m.p.cfg.ChainNotifier.RegisterConfirmationsNtfn(
+		&txid, pkScript, numConfs, pending.BroadcastHeight,
+	)
+	if err != nil {
+		return err
+	}
+
+	m.p.cg.WgAdd(1)
+	go func() {
+		defer m.p.cg.WgDone()
+		defer confNtfn.Cancel()
+
+		select {
+		case _, ok := <-confNtfn.Confirmed:
+			if !ok {
+				return
+			}
+
+		case <-m.p.cg.Done():
+			return
+		}
+
+		select {
+		case m.confs <- chanID:
+		case <-m.p.cg.Done():
+		}
+	}()
+
+	return nil
+}
+
+// handleConfirmation locks in a splice once its transaction confirmed, after
+// which the channel is handed back to a new link.
+func (m *spliceManager) handleConfirmation(chanID lnwire.ChannelID) {
+	s, ok := m.splices[chanID]
+	if !ok {
+		return
+	}
+
+	chanPoint := s.channel.ChannelPoint()
+	txid := s.negotiator.SpliceTxID()
+
+	if err := s.negotiator.Confirmed(); err != nil {
+		m.p.log.Errorf("Unable to send splice_locked for "+
+			"ChannelPoint(%v): %v", chanPoint, err)
+	}
+
+	// The chain watcher may have applied the splice already, in which
+	// case this is a no-op.
+	if err := s.channel.State().ApplySplice(); err != nil {
+		m.p.log.Errorf("Unable to apply splice of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+
+		return
+	}
+
+	m.p.log.Infof("Splice of ChannelPoint(%v) confirmed", chanPoint)
+
+	txid.WhenSome(func(txid chainhash.Hash) {
+		s.resolve(fn.Ok(txid))
+	})
+	delete(m.splices, chanID)
+
+	if err := m.p.restoreLink(chanID); err != nil {
+		m.p.log.Errorf("Unable to restore link of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+	}
+}
+
+// fail fails a splice. A splice that wasn't signed yet is aborted and the
+// channel is handed back to a new link. A signed splice can't be aborted
+// anymore, so only its request is failed.
+func (m *spliceManager) fail(chanID lnwire.ChannelID, s *activeSplice,
+	err error) {
+
+	chanPoint := s.channel.ChannelPoint()
+
+	m.p.log.Errorf("Splice of ChannelPoint(%v) failed: %v", chanPoint,
+		err)
+
+	if s.negotiator != nil && s.negotiator.Signed() {
+		s.resolve(fn.Err[chainhash.Hash](err))
+
+		return
+	}
+
+	var sendErr error
+	switch {
+	case s.negotiator == nil:
+		sendErr = m.p.SendMessage(false, &lnwire.TxAbort{
+			ChannelID: chanID,
+			Data:      []byte(err.Error()),
+		})
+
+	// The remote party aborted the splice, so there's no need to send a
+	// tx_abort.
+	case errors.Is(err, splice.ErrSpliceAborted):
+
+	default:
+		sendErr = s.negotiator.Abort(err)
+	}
+	if sendErr != nil {
+		m.p.log.Errorf("Unable to abort splice of ChannelPoint(%v): "+
+			"%v", chanPoint, sendErr)
+	}
+
+	s.resolve(fn.Err[chainhash.Hash](err))
+	delete(m.splices, chanID)
+
+	if err := m.p.restoreLink(chanID); err != nil {
+		m.p.log.Errorf("Unable to restore link of ChannelPoint(%v): "+
+			"%v", chanPoint, err)
+	}
+}
+
+// negotiatorConfig returns the config of a splice negotiation for the given
+// channel.
+func (m *spliceManager) negotiatorConfig(lnChan *lnwallet.LightningChannel,
+	req fn.Option[splice.Request]) (splice.Config, error) {
+
+	_, bestHeight, err := m.p.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		return splice.Config{}, fmt.Errorf("unable to fetch best "+
+			"block: %w", err)
+	}
+
+	nodeKey, err := btcec.ParsePubKey(m.p.cfg.ServerPubKey[:])
+	if err != nil {
+		return splice.Config{}, err
+	}
+
+	return splice.Config{
+		Channel:      lnChan,
+		Wallet:       m.p.cfg.Wallet,
+		Request:      req,
+		LocalNodeKey: nodeKey,
+		BestHeight:   uint32(bestHeight),
+		SendMessage: func(msg lnwire.Message) error {
+			return m.p.SendMessage(false, msg)
+		},
+	}, nil
+}
+
+// handOver removes the link of a quiescent channel, after which its messages
+// are handled by the given endpoint, like the spliceManager.
+func (p *Brontide) handOver(chanID lnwire.ChannelID,
+	owner msgmux.EndpointName) {
+
+	p.cfg.Switch.RemoveLink(chanID)
+
+	p.handedOver.Store(chanID, owner)
+	p.replacedLinks.Store(chanID, struct{}{})
+}
+
+// restoreLink adds a new link for a channel that was handed over to an
+// endpoint. The channel state machine is reloaded from the database, as the
+// funding output or the parameters of the channel may have changed.
+func (p *Brontide) restoreLink(chanID lnwire.ChannelID) error {
+	lnChan, ok := p.activeChannels.Load(chanID)
+	if !ok || lnChan == nil {
+		return fmt.Errorf("channel %v is not active", chanID)
+	}
+	chanPoint := lnChan.ChannelPoint()
+
+	dbChan, err := p.cfg.ChannelDB.FetchChannel(chanPoint)
+	if err != nil {
+		return fmt.Errorf("unable to fetch channel: %w", err)
+	}
+
+	newChan, err := lnwallet.NewLightningChannel(
+		p.cfg.Signer, dbChan, p.cfg.SigPool,
+		p.channelOpts()...,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to create channel state machine: %w",
+			err)
+	}
+
+	forwardingPolicy, err := p.fetchForwardingPolicy(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	chainEvents, err := p.cfg.ChainArb.SubscribeChannelEvents(chanPoint)
+	if err != nil {
+		return err
+	}
+
+	p.replacedLinks.Store(chanID, struct{}{})
+	p.handedOver.Delete(chanID)
+	p.activeChannels.Store(chanID, newChan)
+
+	err = p.addLink(
+		&chanPoint, newChan, forwardingPolicy, chainEvents, true,
+		fn.None[lnwire.Shutdown](),
+	)
+	if err != nil {
+		return fmt.Errorf("unable to add link: %w", err)
+	}
+
+	if !p.rbfCoopCloseAllowed() {
+		return nil
+	}
+
+	// The RBF chan closer of the channel refers to the replaced link and
+	// funding output, so it's replaced as well.
+	oldCloser, ok := p.activeChanCloses.Load(chanID)
+	if ok {
+		oldCloser.WhenRight(func(c *chancloser.RbfChanCloser) {
+			c.Stop()
+		})
+	}
+
+	if _, err := p.initRbfChanCloser(newChan); err != nil {
+		p.activeChanCloses.Delete(chanID)
+
+		return fmt.Errorf("unable to init RBF chan closer: %w", err)
+	}
+
+	return nil
+}
+
+// checkSpliceable returns an error if the channel can't be spliced.
+func checkSpliceable(state *channeldb.OpenChannel) error {
+	if state.ChanType.IsTaproot() {
+		return errors.New("splicing taproot channels is not supported")
+	}
+
+	// We don't announce the new funding output of a spliced channel yet,
+	// so only private channels can be spliced.
+	if state.ChannelFlags&lnwire.FFAnnounceChannel != 0 {
+		return errors.New("splicing public channels is not supported")
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/msgmux"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSpliceManagerCanHandle asserts that the splice manager only handles the
+// splice messages of its peer, and the messages of channels that were handed
+// over to it.
+func TestSpliceManagerCanHandle(t *testing.T) {
+	t.Parallel()
+
+	peerKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+	otherKey, err := btcec.NewPrivateKey()
+	require.NoError(t, err)
+
+	p := NewBrontide(Config{
+		Addr: &lnwire.NetAddress{
+			IdentityKey: peerKey.PubKey(),
+		},
+	})
+	m := p.spliceMgr
+
+	chanID := lnwire.NewChanIDFromOutPoint(wire.OutPoint{
+		Hash: chainhash.Hash{1},
+	})
+	activeChanID := lnwire.NewChanIDFromOutPoint(wire.OutPoint{
+		Hash: chainhash.Hash{2},
+	})
+	p.activeChannels.Store(activeChanID, &lnwallet.LightningChannel{})
+
+	canHandle := func(key *btcec.PrivateKey, msg lnwire.Message) bool {
+		return m.CanHandle(msgmux.PeerMsg{
+			Message: msg,
+			PeerPub: *key.PubKey(),
+		})
+	}
+
+	// Splice proposals of our peer are always handled, but not the ones
+	// of other peers.
+	spliceInit := &lnwire.SpliceInit{ChannelID: chanID}
+	require.True(t, canHandle(peerKey, spliceInit))
+	require.False(t, canHandle(otherKey, spliceInit))
+	require.True(t, canHandle(peerKey, &lnwire.SpliceLocked{
+		ChannelID: chanID,
+	}))
+
+	// A tx_abort is only handled for active channels.
+	require.False(t, canHandle(peerKey, &lnwire.TxAbort{
+		ChannelID: chanID,
+	}))
+	require.True(t, canHandle(peerKey, &lnwire.TxAbort{
+		ChannelID: activeChanID,
+	}))
+
+	// Messages that are also used outside of a splice are only handled
+	// once the channel was handed over.
+	txAddInput := &lnwire.TxAddInput{ChannelID: chanID}
+	commitSig := &lnwire.CommitSig{ChanID: chanID}
+	require.False(t, canHandle(peerKey, txAddInput))
+	require.False(t, canHandle(peerKey, commitSig))
+
+	p.handedOver.Store(chanID, m.Name())
+	require.True(t, canHandle(peerKey, txAddInput))
+	require.True(t, canHandle(peerKey, commitSig))
+	require.True(t, canHandle(peerKey, &lnwire.TxAbort{
+		ChannelID: chanID,
+	}))
+
+	// Channels that were handed over to another endpoint are left alone.
+	p.handedOver.Store(activeChanID, "other")
+	require.False(t, canHandle(peerKey, &lnwire.TxAbort{
+		ChannelID: activeChanID,
+	}))
+	require.False(t, canHandle(peerKey, &lnwire.CommitSig{
+		ChanID: activeChanID,
+	}))
+
+	// Other messages are never handled.
+	require.False(t, canHandle(peerKey, &lnwire.UpdateAddHTLC{
+		ChanID: chanID,
+	}))
+}
+
+// TestCheckSpliceable asserts that only private non-taproot channels can be
+// spliced.
+func TestCheckSpliceable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		state   *channeldb.OpenChannel
+		spliced bool
+	}{
+		{
+			name: "private",
+			state: &channeldb.OpenChannel{
+				ChanType: channeldb.AnchorOutputsBit,
+			},
+			spliced: true,
+		},
+		{
+			name: "public",
+			state: &channeldb.OpenChannel{
+				ChanType:     channeldb.AnchorOutputsBit,
+				ChannelFlags: lnwire.FFAnnounceChannel,
+			},
+		},
+		{
+			name: "taproot",
+			state: &channeldb.OpenChannel{
+				ChanType: channeldb.SimpleTaprootFeatureBit,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkSpliceable(tc.state)
+			if tc.spliced {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -482,6 +482,12 @@ func (m *mockUpdateHandler) InitStfu() <-chan fn.Result[lntypes.ChannelParty] {
 	return c
 }
 
+func (m *mockUpdateHandler) QuiescenceInitiator() fn.Result[lntypes.ChannelParty] {
+	return fn.Errf[lntypes.ChannelParty](
+		"QuiescenceInitiator not implemented",
+	)
+}
+
 func newMockConn(t *testing.T, expectedMessages int) *mockMessageConn {
 	return &mockMessageConn{
 		t:               t,
@@ -0,0 +1,373 @@
+package record
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+)
+
+// TrampolineOnionType is the type used in the onion to carry the instructions
+// for a trampoline node. The record is only valid in the final hop payload of
+// the outer onion, which is addressed to the trampoline node itself.
+//
+// NOTE: this is an experimental lnd-specific encoding that carries a single
+// trampoline hop in the clear. It is not compatible with the nested trampoline
+// onion (type 66100) that is being specified.
+const TrampolineOnionType tlv.Type = 66200
+
+const (
+	// trampolineAmtType is the inner type of the amount the trampoline
+	// node should deliver to the next node.
+	trampolineAmtType = AmtOnionType
+
+	// trampolineCltvType is the inner type of the absolute expiry of the
+	// HTLC that the next node should receive.
+	trampolineCltvType = LockTimeOnionType
+
+	// trampolinePaymentDataType is the inner type of the payment address
+	// and total amount of the payment.
+	trampolinePaymentDataType = MPPOnionType
+
+	// trampolineNextNodeType is the inner type of the node that the
+	// trampoline node should find a route to.
+	trampolineNextNodeType tlv.Type = 14
+
+	// trampolineMetadataType is the inner type of the payment metadata
+	// that is passed on to the next node.
+	trampolineMetadataType = MetadataOnionType
+
+	// trampolineFeaturesType is the inner type of the invoice features of
+	// the next node, used when it doesn't support trampoline itself.
+	trampolineFeaturesType tlv.Type = 66097
+
+	// trampolineRouteHintsType is the inner type of the invoice route
+	// hints of the next node, used when it doesn't support trampoline
+	// itself.
+	trampolineRouteHintsType tlv.Type = 66099
+
+	// trampolineHopHintSize is the encoded size of a single hop hint.
+	trampolineHopHintSize = 33 + 8 + 4 + 4 + 2
+)
+
+var (
+	// ErrTrampolineMissingField is returned when a trampoline record
+	// lacks one of its mandatory fields.
+	ErrTrampolineMissingField = errors.New("trampoline record missing " +
+		"required field")
+
+	// errInvalidRouteHints is returned when the encoded route hints of a
+	// trampoline record are malformed.
+	errInvalidRouteHints = errors.New("invalid trampoline route hints")
+)
+
+// TrampolineHopHint is a single hop of a route hint that the trampoline node
+// should use to reach the next node, following the layout of the BOLT 11 r
+// field.
+type TrampolineHopHint struct {
+	// NodeID is the public key of the node at the start of the channel.
+	NodeID [33]byte
+
+	// ChannelID is the short channel id of the channel.
+	ChannelID uint64
+
+	// FeeBaseMSat is the base fee of the channel in millisatoshis.
+	FeeBaseMSat uint32
+
+	// FeeProportionalMillionths is the proportional fee rate of the
+	// channel.
+	FeeProportionalMillionths uint32
+
+	// CLTVExpiryDelta is the time lock delta of the channel.
+	CLTVExpiryDelta uint16
+}
+
+// Trampoline is a record that instructs a trampoline node to forward a
+// payment to the next node, finding the route on its own.
+type Trampoline struct {
+	// NextNode is the node the trampoline node should route to.
+	NextNode [33]byte
+
+	// AmtToForward is the amount the next node should receive.
+	AmtToForward lnwire.MilliSatoshi
+
+	// OutgoingCltv is the absolute expiry the HTLC to the next node should
+	// have once it reaches it.
+	OutgoingCltv uint32
+
+	// MPP holds the payment address and total amount that are passed on
+	// to the next node. This is optional.
+	MPP *MPP
+
+	// Metadata is the payment metadata that is passed on to the next
+	// node. This is optional.
+	Metadata []byte
+
+	// Features are the invoice features of the next node. This is
+	// optional.
+	Features *lnwire.RawFeatureVector
+
+	// RouteHints are the invoice route hints of the next node. This is
+	// optional.
+	RouteHints [][]TrampolineHopHint
+}
+
+// encode serializes the fields of the trampoline record as a TLV stream.
+func (t *Trampoline) encode() ([]byte, error) {
+	var (
+		amt      = uint64(t.AmtToForward)
+		cltv     = t.OutgoingCltv
+		nextNode = t.NextNode
+		metadata = t.Metadata
+	)
+
+	records := []tlv.Record{
+		NewAmtToFwdRecord(&amt),
+		NewLockTimeRecord(&cltv),
+	}
+	if t.MPP != nil {
+		records = append(records, t.MPP.Record())
+	}
+	records = append(records, tlv.MakePrimitiveRecord(
+		trampolineNextNodeType, &nextNode,
+	))
+	if len(metadata) > 0 {
+		records = append(records, NewMetadataRecord(&metadata))
+	}
+
+	if t.Features != nil && t.Features.SerializeSize() > 0 {
+		var b bytes.Buffer
+		if err := t.Features.EncodeBase256(&b); err != nil {
+			return nil, err
+		}
+
+		features := b.Bytes()
+		records = append(records, tlv.MakePrimitiveRecord(
+			trampolineFeaturesType, &features,
+		))
+	}
+
+	if len(t.RouteHints) > 0 {
+		hints, err := encodeTrampolineRouteHints(t.RouteHints)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, tlv.MakePrimitiveRecord(
+			trampolineRouteHintsType, &hints,
+		))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := stream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// decode parses the fields of the trampoline record from a TLV stream.
+func (t *Trampoline) decode(r io.Reader) error {
+	var (
+		amt      uint64
+		cltv     uint32
+		mpp      = &MPP{}
+		nextNode [33]byte
+		metadata []byte
+		features []byte
+		hints    []byte
+	)
+
+	stream, err := tlv.NewStream(
+		NewAmtToFwdRecord(&amt),
+		NewLockTimeRecord(&cltv),
+		mpp.Record(),
+		tlv.MakePrimitiveRecord(trampolineNextNodeType, &nextNode),
+		NewMetadataRecord(&metadata),
+		tlv.MakePrimitiveRecord(trampolineFeaturesType, &features),
+		tlv.MakePrimitiveRecord(trampolineRouteHintsType, &hints),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypesP2P(r)
+	if err != nil {
+		return err
+	}
+
+	// Unknown even types must be understood, so we reject them like we do
+	// for the outer onion.
+	for typ, val := range parsedTypes {
+		if val != nil && typ%2 == 0 {
+			return fmt.Errorf("unknown required trampoline type "+
+				"%d", typ)
+		}
+	}
+
+	for _, typ := range []tlv.Type{
+		trampolineAmtType, trampolineCltvType, trampolineNextNodeType,
+	} {
+		if _, ok := parsedTypes[typ]; !ok {
+			return fmt.Errorf("%w: type %d",
+				ErrTrampolineMissingField, typ)
+		}
+	}
+
+	*t = Trampoline{
+		NextNode:     nextNode,
+		AmtToForward: lnwire.MilliSatoshi(amt),
+		OutgoingCltv: cltv,
+		Metadata:     metadata,
+	}
+
+	if _, ok := parsedTypes[trampolinePaymentDataType]; ok {
+		t.MPP = mpp
+	}
+
+	if _, ok := parsedTypes[trampolineFeaturesType]; ok {
+		t.Features = lnwire.NewRawFeatureVector()
+		err := t.Features.DecodeBase256(
+			bytes.NewReader(features), len(features),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, ok := parsedTypes[trampolineRouteHintsType]; ok {
+		t.RouteHints, err = decodeTrampolineRouteHints(hints)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// encodeTrampolineRouteHints serializes route hints as a sequence of routes,
+// each prefixed by its number of hops.
+func encodeTrampolineRouteHints(hints [][]TrampolineHopHint) ([]byte,
+	error) {
+
+	var b bytes.Buffer
+	for _, hint := range hints {
+		if len(hint) == 0 || len(hint) > 255 {
+			return nil, errInvalidRouteHints
+		}
+
+		b.WriteByte(byte(len(hint)))
+		for _, hop := range hint {
+			var buf [trampolineHopHintSize]byte
+			copy(buf[:33], hop.NodeID[:])
+			binary.BigEndian.PutUint64(buf[33:41], hop.ChannelID)
+			binary.BigEndian.PutUint32(buf[41:45], hop.FeeBaseMSat)
+			binary.BigEndian.PutUint32(
+				buf[45:49], hop.FeeProportionalMillionths,
+			)
+			binary.BigEndian.PutUint16(
+				buf[49:51], hop.CLTVExpiryDelta,
+			)
+			b.Write(buf[:])
+		}
+	}
+
+	return b.Bytes(), nil
+}
+
+// decodeTrampolineRouteHints parses route hints that were serialized with
+// encodeTrampolineRouteHints.
+func decodeTrampolineRouteHints(b []byte) ([][]TrampolineHopHint, error) {
+	var hints [][]TrampolineHopHint
+	for len(b) > 0 {
+		numHops := int(b[0])
+		b = b[1:]
+
+		if numHops == 0 || len(b) < numHops*trampolineHopHintSize {
+			return nil, errInvalidRouteHints
+		}
+
+		hint := make([]TrampolineHopHint, numHops)
+		for i := range hint {
+			hop := &hint[i]
+			copy(hop.NodeID[:], b[:33])
+			hop.ChannelID = binary.BigEndian.Uint64(b[33:41])
+			hop.FeeBaseMSat = binary.BigEndian.Uint32(b[41:45])
+			hop.FeeProportionalMillionths = binary.BigEndian.Uint32(
+				b[45:49],
+			)
+			hop.CLTVExpiryDelta = binary.BigEndian.Uint16(b[49:51])
+
+			b = b[trampolineHopHintSize:]
+		}
+
+		hints = append(hints, hint)
+	}
+
+	return hints, nil
+}
+
+// TrampolineEncoder writes the trampoline record to the provided io.Writer.
+func TrampolineEncoder(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*Trampoline); ok {
+		b, err := v.encode()
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(b)
+
+		return err
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "Trampoline")
+}
+
+// TrampolineDecoder reads the trampoline record from the provided io.Reader.
+func TrampolineDecoder(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*Trampoline); ok {
+		return v.decode(io.LimitReader(r, int64(l)))
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "Trampoline", l, l)
+}
+
+// Record returns a tlv.Record that can be used to encode or decode this record.
+func (t *Trampoline) Record() tlv.Record {
+	return tlv.MakeDynamicRecord(
+		TrampolineOnionType, t, t.PayloadSize, TrampolineEncoder,
+		TrampolineDecoder,
+	)
+}
+
+// PayloadSize returns the size this record takes up in encoded form.
+func (t *Trampoline) PayloadSize() uint64 {
+	b, err := t.encode()
+	if err != nil {
+		return 0
+	}
+
+	return uint64(len(b))
+}
+
+// String returns a human-readable representation of the trampoline payload
+// field.
+func (t *Trampoline) String() string {
+	if t == nil {
+		return "<nil>"
+	}
+
+	return fmt.Sprintf("next_node=%x, amt=%v, cltv=%v", t.NextNode,
+		t.AmtToForward, t.OutgoingCltv)
+}
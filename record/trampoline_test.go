@@ -0,0 +1,92 @@
+package record
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTrampolineEncoding tests encoding and decoding of trampoline records.
+func TestTrampolineEncoding(t *testing.T) {
+	t.Parallel()
+
+	hopHint := TrampolineHopHint{
+		NodeID:                    [33]byte{2, 3},
+		ChannelID:                 12345,
+		FeeBaseMSat:               1000,
+		FeeProportionalMillionths: 100,
+		CLTVExpiryDelta:           40,
+	}
+
+	tests := []struct {
+		name       string
+		trampoline *Trampoline
+	}{
+		{
+			name: "required fields only",
+			trampoline: &Trampoline{
+				NextNode:     [33]byte{2, 1},
+				AmtToForward: 1000,
+				OutgoingCltv: 800_000,
+			},
+		},
+		{
+			name: "all fields",
+			trampoline: &Trampoline{
+				NextNode:     [33]byte{3, 1},
+				AmtToForward: 5000,
+				OutgoingCltv: 800_144,
+				MPP:          NewMPP(5000, [32]byte{1}),
+				Metadata:     []byte{1, 2, 3},
+				Features: lnwire.NewRawFeatureVector(
+					lnwire.TLVOnionPayloadRequired,
+					lnwire.PaymentAddrRequired,
+				),
+				RouteHints: [][]TrampolineHopHint{
+					{hopHint},
+					{hopHint, hopHint},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var b bytes.Buffer
+			stream := tlv.MustNewStream(
+				testCase.trampoline.Record(),
+			)
+			require.NoError(t, stream.Encode(&b))
+
+			decoded := &Trampoline{}
+			stream = tlv.MustNewStream(decoded.Record())
+			require.NoError(t, stream.Decode(&b))
+
+			require.Equal(t, testCase.trampoline, decoded)
+		})
+	}
+}
+
+// TestTrampolineMissingField tests that a trampoline record without its next
+// node is rejected.
+func TestTrampolineMissingField(t *testing.T) {
+	t.Parallel()
+
+	var (
+		amt  uint64 = 1000
+		cltv uint32 = 800_000
+		b    bytes.Buffer
+	)
+	stream := tlv.MustNewStream(
+		NewAmtToFwdRecord(&amt), NewLockTimeRecord(&cltv),
+	)
+	require.NoError(t, stream.Encode(&b))
+
+	err := (&Trampoline{}).decode(&b)
+	require.ErrorIs(t, err, ErrTrampolineMissingField)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/btcsuite/btclog/v2"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/routing/chainview"
+	"github.com/lightningnetwork/lnd/tracing"
 )
 
 // log is a logger that is initialized with no output filters.  This means the
@@ -13,6 +14,9 @@ var log btclog.Logger
 
 const Subsystem = "CRTR"
 
+// tracer creates the spans of payments and pathfinding.
+var tracer = tracing.Tracer("routing")
+
 // The default amount of logging is none.
 func init() {
 	UseLogger(build.NewSubLogger(Subsystem, nil))
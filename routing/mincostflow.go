@@ -0,0 +1,706 @@
+package routing
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcutil"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DefaultMinCostFlowMaxHops is the default maximum number of hops of
+	// the paths the min-cost flow is solved over.
+	DefaultMinCostFlowMaxHops = 5
+
+	// DefaultMinCostFlowUnits is the default number of units the amount of
+	// a payment is divided into when solving the min-cost flow.
+	DefaultMinCostFlowUnits = 50
+
+	// maxMinCostFlowUnits is the maximum number of units, which bounds the
+	// number of augmentations of the solver.
+	maxMinCostFlowUnits = 1000
+
+	// flowPieces is the number of linear pieces the uncertainty cost of
+	// the unknown liquidity range of a channel is approximated with.
+	flowPieces = 4
+)
+
+// MinCostFlowConfig configures the splitting of multi-part payments with a
+// min-cost flow.
+type MinCostFlowConfig struct {
+	// MaxHops is the maximum number of hops of the paths the flow is
+	// solved over. Channels that aren't on a path of at most this length
+	// are left out of the flow network.
+	MaxHops uint32
+
+	// Units is the number of units the payment amount is divided into.
+	// More units allow a finer split at the cost of computation time.
+	Units uint32
+}
+
+// Validate checks that the parameters of the min-cost flow are sane.
+func (c *MinCostFlowConfig) Validate() error {
+	if c.MaxHops == 0 || c.MaxHops > sphinx.NumMaxHops {
+		return fmt.Errorf("min-cost flow max hops must be between 1 "+
+			"and %v", sphinx.NumMaxHops)
+	}
+
+	if c.Units == 0 || c.Units > maxMinCostFlowUnits {
+		return fmt.Errorf("min-cost flow units must be between 1 and "+
+			"%v", maxMinCostFlowUnits)
+	}
+
+	return nil
+}
+
+// plannedShard is a shard of a payment planned by the min-cost flow.
+type plannedShard struct {
+	// hops are the nodes of the path of the shard after our own node.
+	hops []route.Vertex
+
+	// amt is the amount the shard delivers to the target.
+	amt lnwire.MilliSatoshi
+}
+
+// flowRequest describes the min-cost flow a payment is split with.
+type flowRequest struct {
+	// self is our own node, which is the source of the flow.
+	self route.Vertex
+
+	// target is the node the flow must arrive at.
+	target route.Vertex
+
+	// amt is the amount that must be delivered to the target.
+	amt lnwire.MilliSatoshi
+
+	// unitAmt is the amount of a unit of flow.
+	unitAmt lnwire.MilliSatoshi
+
+	// maxHops is the maximum number of hops of the paths the flow is
+	// solved over.
+	maxHops uint32
+
+	// attemptCost is the virtual cost of a failed attempt, which weighs
+	// the uncertainty of the liquidity of channels against fees.
+	attemptCost float64
+
+	// liquidityBounds returns the amounts that we know we can and cannot
+	// send over a channel.
+	liquidityBounds func(fromNode, toNode route.Vertex,
+		capacity btcutil.Amount) (lnwire.MilliSatoshi,
+		lnwire.MilliSatoshi)
+}
+
+// flowChannel is a channel of the network the min-cost flow is solved over.
+type flowChannel struct {
+	from route.Vertex
+	to   route.Vertex
+
+	policy   *models.CachedEdgePolicy
+	capacity btcutil.Amount
+
+	// lower and upper are the amounts we know we can and cannot send
+	// over the channel.
+	lower lnwire.MilliSatoshi
+	upper lnwire.MilliSatoshi
+}
+
+// planShards splits the amount of a payment across the paths to the target
+// with a min-cost flow, in which each unit of flow over a channel costs the fee
+// of the channel and the uncertainty that the channel has enough liquidity.
+// The liquidity bounds that mission control learned from previous payments
+// make forwarding up to the lower bound free of uncertainty and forwarding
+// more than the upper bound impossible. Base fees are not linear in the amount
+// and are ignored by the flow, so the shards still need to be checked against
+// the fee limit of the payment.
+func planShards(ctx context.Context, g *graphParams, r *RestrictParams,
+	req *flowRequest) ([]plannedShard, error) {
+
+	_, span := tracer.Start(ctx, "planShards", trace.WithAttributes(
+		attribute.String("target", req.target.String()),
+		attribute.Int64("amt_msat", int64(req.amt)),
+	))
+	defer span.End()
+
+	channels, err := collectFlowChannels(g, r, req)
+	if err != nil {
+		return nil, err
+	}
+
+	span.SetAttributes(attribute.Int("channels", len(channels)))
+
+	network := newFlowNetwork(req, channels)
+
+	units := int64((req.amt + req.unitAmt - 1) / req.unitAmt)
+	if err := network.solve(units); err != nil {
+		return nil, err
+	}
+
+	shards, err := network.decompose(units)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shards are multiples of the unit amount, so the flow may deliver up
+	// to a unit more than the amount. We take the excess from the largest
+	// shard, which is sent first.
+	sort.Slice(shards, func(i, j int) bool {
+		return shards[i].amt > shards[j].amt
+	})
+
+	var total lnwire.MilliSatoshi
+	for _, shard := range shards {
+		total += shard.amt
+	}
+	shards[0].amt -= total - req.amt
+
+	span.SetAttributes(attribute.Int("shards", len(shards)))
+
+	return shards, nil
+}
+
+// collectFlowChannels returns the channels that are on a path of at most the
+// maximum number of hops from our node to the target, along with the bounds
+// of their liquidity.
+func collectFlowChannels(g *graphParams, r *RestrictParams,
+	req *flowRequest) ([]*flowChannel, error) {
+
+	var outgoingChans map[uint64]struct{}
+	if len(r.OutgoingChannelIDs) > 0 {
+		outgoingChans = make(map[uint64]struct{})
+		for _, chanID := range r.OutgoingChannelIDs {
+			outgoingChans[chanID] = struct{}{}
+		}
+	}
+
+	// The private channels of the route hints are indexed by the node at
+	// their start, while we need them by the node at their end.
+	hintChannels := make(map[route.Vertex][]*flowChannel)
+	for from, edges := range g.additionalEdges {
+		for _, edge := range edges {
+			policy := edge.EdgePolicy()
+			to := policy.ToNodePubKey()
+
+			hintChannels[to] = append(
+				hintChannels[to], &flowChannel{
+					from:     from,
+					to:       to,
+					policy:   policy,
+					capacity: fakeHopHintCapacity,
+				},
+			)
+		}
+	}
+
+	// Walk backwards from the target to find the distance of each node to
+	// the target, collecting the channels we walk over.
+	var channels []*flowChannel
+	distToTarget := map[route.Vertex]uint32{req.target: 0}
+	queue := []route.Vertex{req.target}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		dist := distToTarget[node]
+		if dist >= req.maxHops || node == req.self {
+			continue
+		}
+
+		nodeChannels := append([]*flowChannel{}, hintChannels[node]...)
+		numHints := len(nodeChannels)
+
+		cb := func(channel *graphdb.DirectedChannel) error {
+			if channel.InPolicy == nil {
+				return nil
+			}
+
+			nodeChannels = append(nodeChannels, &flowChannel{
+				from:     channel.OtherNode,
+				to:       node,
+				policy:   channel.InPolicy,
+				capacity: channel.Capacity,
+			})
+
+			return nil
+		}
+		err := g.graph.ForEachNodeDirectedChannel(node, cb, func() {
+			nodeChannels = nodeChannels[:numHints]
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, channel := range nodeChannels {
+			if channel.from == req.target {
+				continue
+			}
+
+			isLastHop := node == req.target
+			if isLastHop && r.LastHop != nil &&
+				channel.from != *r.LastHop {
+
+				continue
+			}
+
+			channels = append(channels, channel)
+
+			if _, ok := distToTarget[channel.from]; !ok {
+				distToTarget[channel.from] = dist + 1
+				queue = append(queue, channel.from)
+			}
+		}
+	}
+
+	// Walk forward from our node over the collected channels to find the
+	// distance of each node to us.
+	outgoing := make(map[route.Vertex][]*flowChannel)
+	for _, channel := range channels {
+		outgoing[channel.from] = append(
+			outgoing[channel.from], channel,
+		)
+	}
+
+	distToSelf := map[route.Vertex]uint32{req.self: 0}
+	queue = []route.Vertex{req.self}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, channel := range outgoing[node] {
+			if _, ok := distToSelf[channel.to]; ok {
+				continue
+			}
+
+			distToSelf[channel.to] = distToSelf[node] + 1
+			queue = append(queue, channel.to)
+		}
+	}
+
+	// Only keep the channels that are on a short enough path, and
+	// determine the bounds of their liquidity.
+	var result []*flowChannel
+	for _, channel := range channels {
+		fromDist, ok := distToSelf[channel.from]
+		if !ok {
+			continue
+		}
+		if fromDist+1+distToTarget[channel.to] > req.maxHops {
+			continue
+		}
+
+		capacity := lnwire.NewMSatFromSatoshis(channel.capacity)
+		chanID := channel.policy.ChannelID
+
+		// We know the liquidity of our own channels.
+		if channel.from == req.self {
+			if outgoingChans != nil {
+				if _, ok := outgoingChans[chanID]; !ok {
+					continue
+				}
+			}
+
+			hints := g.bandwidthHints
+			bandwidth, ok := hints.availableChanBandwidth(
+				chanID, req.amt,
+			)
+			if !ok {
+				bandwidth = capacity
+			}
+
+			channel.lower = bandwidth
+			channel.upper = bandwidth
+			result = append(result, channel)
+
+			continue
+		}
+
+		if channel.policy.IsDisabled() {
+			continue
+		}
+
+		lower, upper := req.liquidityBounds(
+			channel.from, channel.to, channel.capacity,
+		)
+		if upper > capacity {
+			upper = capacity
+		}
+
+		// Discard inconsistent knowledge, like the estimator does.
+		if lower >= upper {
+			lower, upper = 0, capacity
+		}
+
+		channel.lower = lower
+		channel.upper = upper
+		result = append(result, channel)
+	}
+
+	return result, nil
+}
+
+// flowArc is an arc of the residual network of the min-cost flow. Every
+// channel is represented by up to flowPieces+1 arcs with increasing costs, each
+// paired with a reverse arc that allows to undo the flow.
+type flowArc struct {
+	to int
+
+	// rev is the index of the paired arc in the arcs of the node at the
+	// end of this arc.
+	rev int
+
+	// capacity is the number of units that can still flow over the arc.
+	capacity int64
+
+	// cost is the cost of a unit of flow over the arc.
+	cost float64
+
+	// channel is the index of the channel of the arc, or -1 for reverse
+	// arcs.
+	channel int
+}
+
+// flowNetwork is the residual network the min-cost flow is solved on.
+type flowNetwork struct {
+	channels []*flowChannel
+
+	// unitAmt is the amount of a unit of flow.
+	unitAmt lnwire.MilliSatoshi
+
+	nodes   []route.Vertex
+	indices map[route.Vertex]int
+	arcs    [][]flowArc
+
+	source int
+	sink   int
+}
+
+// newFlowNetwork creates the residual network of the given channels. The cost
+// of a unit of flow over a channel is its proportional fee plus the increase of
+// the uncertainty cost, which is the attempt cost times the negative logarithm
+// of the success probability of a uniform liquidity distribution between the
+// bounds of the channel. As the uncertainty cost is convex, it is approximated
+// with linear pieces of increasing cost.
+func newFlowNetwork(req *flowRequest, channels []*flowChannel) *flowNetwork {
+	n := &flowNetwork{
+		channels: channels,
+		unitAmt:  req.unitAmt,
+		indices:  make(map[route.Vertex]int),
+	}
+	n.source = n.node(req.self)
+	n.sink = n.node(req.target)
+
+	unitAmt := float64(req.unitAmt)
+	for i, channel := range channels {
+		upper := int64(channel.upper / req.unitAmt)
+		lower := min(int64(channel.lower/req.unitAmt), upper)
+		if upper == 0 {
+			continue
+		}
+
+		// We don't pay fees for our own channels.
+		var feeCost float64
+		if channel.from != req.self {
+			feeRate := channel.policy.FeeProportionalMillionths
+			feeCost = float64(feeRate) * unitAmt / 1_000_000
+		}
+
+		from := n.node(channel.from)
+		to := n.node(channel.to)
+
+		// Up to the lower bound, the channel is certain to have enough
+		// liquidity.
+		if lower > 0 {
+			n.addArc(from, to, lower, feeCost, i)
+		}
+
+		// Beyond the lower bound, the probability of a uniform
+		// liquidity distribution up to the upper bound is
+		// (upper + 1 - x) / (upper + 1 - lower).
+		negLogProb := func(x int64) float64 {
+			return -math.Log(
+				float64(upper+1-x) / float64(upper+1-lower),
+			)
+		}
+
+		uncertain := upper - lower
+		pieces := min(int64(flowPieces), uncertain)
+		start := lower
+		for p := int64(0); p < pieces; p++ {
+			end := lower + uncertain*(p+1)/pieces
+			units := end - start
+
+			uncertaintyCost := req.attemptCost *
+				(negLogProb(end) - negLogProb(start)) /
+				float64(units)
+
+			n.addArc(from, to, units, feeCost+uncertaintyCost, i)
+			start = end
+		}
+	}
+
+	return n
+}
+
+// node returns the index of the given node in the network, adding it if it
+// isn't part of the network yet.
+func (n *flowNetwork) node(vertex route.Vertex) int {
+	if index, ok := n.indices[vertex]; ok {
+		return index
+	}
+
+	index := len(n.nodes)
+	n.indices[vertex] = index
+	n.nodes = append(n.nodes, vertex)
+	n.arcs = append(n.arcs, nil)
+
+	return index
+}
+
+// addArc adds an arc of the given channel and its reverse arc to the network.
+func (n *flowNetwork) addArc(from, to int, capacity int64, cost float64,
+	channel int) {
+
+	n.arcs[from] = append(n.arcs[from], flowArc{
+		to:       to,
+		rev:      len(n.arcs[to]),
+		capacity: capacity,
+		cost:     cost,
+		channel:  channel,
+	})
+	n.arcs[to] = append(n.arcs[to], flowArc{
+		to:       from,
+		rev:      len(n.arcs[from]) - 1,
+		capacity: 0,
+		cost:     -cost,
+		channel:  -1,
+	})
+}
+
+// solve sends the given number of units from the source to the sink at minimum
+// cost, by repeatedly augmenting the flow along the cheapest path of the
+// residual network. Node potentials keep the reduced costs of the residual
+// network non-negative, so that the cheapest path can be found with Dijkstra's
+// algorithm.
+func (n *flowNetwork) solve(units int64) error {
+	potentials := make([]float64, len(n.nodes))
+
+	for units > 0 {
+		dist, prevNode, prevArc := n.cheapestPath(potentials)
+		if math.IsInf(dist[n.sink], 1) {
+			return errNoPathFound
+		}
+
+		for v := range potentials {
+			potentials[v] += math.Min(dist[v], dist[n.sink])
+		}
+
+		// Augment the flow along the path by as many units as all of
+		// its arcs allow.
+		augment := units
+		for v := n.sink; v != n.source; v = prevNode[v] {
+			arc := n.arcs[prevNode[v]][prevArc[v]]
+			augment = min(augment, arc.capacity)
+		}
+
+		for v := n.sink; v != n.source; v = prevNode[v] {
+			arc := &n.arcs[prevNode[v]][prevArc[v]]
+			arc.capacity -= augment
+			n.arcs[v][arc.rev].capacity += augment
+		}
+
+		units -= augment
+	}
+
+	return nil
+}
+
+// cheapestPath runs Dijkstra's algorithm on the residual network with the
+// reduced costs of the given potentials. It returns the distance of each node
+// from the source, and the previous node and arc on the cheapest path to it.
+func (n *flowNetwork) cheapestPath(potentials []float64) ([]float64, []int,
+	[]int) {
+
+	dist := make([]float64, len(n.nodes))
+	for v := range dist {
+		dist[v] = math.Inf(1)
+	}
+	prevNode := make([]int, len(n.nodes))
+	prevArc := make([]int, len(n.nodes))
+
+	dist[n.source] = 0
+	queue := &flowQueue{{node: n.source}}
+	for queue.Len() > 0 {
+		item := heap.Pop(queue).(flowQueueItem)
+		if item.dist > dist[item.node] {
+			continue
+		}
+
+		// The distances of the nodes that are further away than the
+		// sink aren't needed.
+		u := item.node
+		if u == n.sink {
+			break
+		}
+
+		for i, arc := range n.arcs[u] {
+			if arc.capacity == 0 {
+				continue
+			}
+
+			// Rounding errors can make reduced costs slightly
+			// negative.
+			reduced := arc.cost + potentials[u] - potentials[arc.to]
+			reduced = math.Max(reduced, 0)
+
+			if dist[u]+reduced < dist[arc.to] {
+				dist[arc.to] = dist[u] + reduced
+				prevNode[arc.to] = u
+				prevArc[arc.to] = i
+				heap.Push(queue, flowQueueItem{
+					node: arc.to,
+					dist: dist[arc.to],
+				})
+			}
+		}
+	}
+
+	return dist, prevNode, prevArc
+}
+
+// decompose splits the flow into paths from the source to the sink. Cycles in
+// the flow, which don't deliver anything to the sink, are dropped.
+func (n *flowNetwork) decompose(units int64) ([]plannedShard, error) {
+	// The flow of a channel is the capacity of the reverse arcs of its
+	// pieces.
+	flow := make([]int64, len(n.channels))
+	outgoing := make([][]int, len(n.nodes))
+	for u := range n.arcs {
+		for _, arc := range n.arcs[u] {
+			if arc.channel < 0 {
+				continue
+			}
+
+			reverse := n.arcs[arc.to][arc.rev]
+			if reverse.capacity == 0 {
+				continue
+			}
+
+			if flow[arc.channel] == 0 {
+				outgoing[u] = append(outgoing[u], arc.channel)
+			}
+			flow[arc.channel] += reverse.capacity
+		}
+	}
+
+	// nextChannel returns a channel with remaining flow out of the given
+	// node.
+	nextChannel := func(u int) (int, bool) {
+		for _, channel := range outgoing[u] {
+			if flow[channel] > 0 {
+				return channel, true
+			}
+		}
+
+		return 0, false
+	}
+
+	var (
+		shards    []plannedShard
+		delivered int64
+	)
+	for delivered < units {
+		// Follow the flow from the source until we reach the sink. If
+		// we get back to a node of the path, we found a cycle, which
+		// we cancel.
+		var path []int
+		position := map[int]int{n.source: 0}
+		u := n.source
+		for u != n.sink {
+			channel, ok := nextChannel(u)
+			if !ok {
+				return nil, errors.New("flow isn't conserved")
+			}
+
+			path = append(path, channel)
+			u = n.indices[n.channels[channel].to]
+
+			start, ok := position[u]
+			if !ok {
+				position[u] = len(path)
+				continue
+			}
+
+			cycle := path[start:]
+			cycleFlow := flow[cycle[0]]
+			for _, c := range cycle {
+				cycleFlow = min(cycleFlow, flow[c])
+			}
+			for _, c := range cycle {
+				flow[c] -= cycleFlow
+				delete(position, n.indices[n.channels[c].to])
+			}
+			position[u] = start
+			path = path[:start]
+		}
+
+		pathFlow := units - delivered
+		for _, c := range path {
+			pathFlow = min(pathFlow, flow[c])
+		}
+
+		hops := make([]route.Vertex, len(path))
+		for i, c := range path {
+			flow[c] -= pathFlow
+			hops[i] = n.channels[c].to
+		}
+
+		if len(hops) > sphinx.NumMaxHops {
+			return nil, fmt.Errorf("path of %v hops exceeds the "+
+				"maximum of %v", len(hops), sphinx.NumMaxHops)
+		}
+
+		shards = append(shards, plannedShard{
+			hops: hops,
+			amt:  lnwire.MilliSatoshi(pathFlow) * n.unitAmt,
+		})
+		delivered += pathFlow
+	}
+
+	return shards, nil
+}
+
+// flowQueueItem is an item of the priority queue of Dijkstra's algorithm.
+type flowQueueItem struct {
+	node int
+	dist float64
+}
+
+// flowQueue is a priority queue of nodes ordered by their distance.
+type flowQueue []flowQueueItem
+
+func (q flowQueue) Len() int           { return len(q) }
+func (q flowQueue) Less(i, j int) bool { return q[i].dist < q[j].dist }
+func (q flowQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+
+func (q *flowQueue) Push(x any) {
+	*q = append(*q, x.(flowQueueItem))
+}
+
+func (q *flowQueue) Pop() any {
+	old := *q
+	item := old[len(old)-1]
+	*q = old[:len(old)-1]
+
+	return item
+}
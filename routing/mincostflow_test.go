@@ -0,0 +1,289 @@
+package routing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// flowTestMissionControl is a mission control that returns the configured
+// liquidity bounds and a probability of one for every channel.
+type flowTestMissionControl struct {
+	MissionControlQuerier
+
+	bounds map[DirectedNodePair][2]lnwire.MilliSatoshi
+}
+
+func (m *flowTestMissionControl) GetProbability(_, _ route.Vertex,
+	_ lnwire.MilliSatoshi, _ btcutil.Amount) float64 {
+
+	return 1
+}
+
+func (m *flowTestMissionControl) GetLiquidityBounds(fromNode,
+	toNode route.Vertex, capacity btcutil.Amount) (lnwire.MilliSatoshi,
+	lnwire.MilliSatoshi) {
+
+	bounds, ok := m.bounds[NewDirectedNodePair(fromNode, toNode)]
+	if !ok {
+		return 0, lnwire.NewMSatFromSatoshis(capacity)
+	}
+
+	return bounds[0], bounds[1]
+}
+
+// newFlowTestContext creates a test graph with two paths from roasbeef to the
+// target, through a and through b. The path through b charges a higher fee.
+func newFlowTestContext(t *testing.T) *pathFindingTestContext {
+	testChannels := []*testChannel{
+		symmetricTestChannel(
+			"roasbeef", "a", 200000, &testChannelPolicy{}, 1,
+		),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:  40,
+			MinHTLC: 1,
+		}, 2),
+		symmetricTestChannel(
+			"roasbeef", "b", 200000, &testChannelPolicy{}, 3,
+		),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:  40,
+			FeeRate: 1000,
+			MinHTLC: 1,
+		}, 4),
+	}
+
+	return newPathFindingTestContext(t, true, testChannels, "roasbeef")
+}
+
+// TestPlanShards tests that payments are split across paths according to the
+// fees and liquidity bounds of the channels.
+func TestPlanShards(t *testing.T) {
+	t.Parallel()
+
+	type msat = lnwire.MilliSatoshi
+	sat := lnwire.NewMSatFromSatoshis
+
+	testCases := []struct {
+		name string
+		amt  btcutil.Amount
+
+		// bounds are the liquidity bounds of the channels to the
+		// target from the node with the given alias.
+		bounds map[string][2]btcutil.Amount
+
+		// expectedShards are the amounts of the shards through a and b,
+		// or nil if no shards can be planned.
+		expectedShards map[string]btcutil.Amount
+	}{
+		{
+			name: "known liquidity uses cheapest path",
+			amt:  50000,
+			bounds: map[string][2]btcutil.Amount{
+				"a": {90000, 100000},
+				"b": {90000, 100000},
+			},
+			expectedShards: map[string]btcutil.Amount{
+				"a": 50000,
+			},
+		},
+		{
+			name: "amount exceeds capacity of single path",
+			amt:  150000,
+			bounds: map[string][2]btcutil.Amount{
+				"a": {90000, 100000},
+				"b": {90000, 100000},
+			},
+			expectedShards: map[string]btcutil.Amount{
+				"a": 90000,
+				"b": 60000,
+			},
+		},
+		{
+			name: "certain liquidity preferred over fees",
+			amt:  120000,
+			bounds: map[string][2]btcutil.Amount{
+				"a": {0, 30000},
+				"b": {100000, 100000},
+			},
+			expectedShards: map[string]btcutil.Amount{
+				"a": 20000,
+				"b": 100000,
+			},
+		},
+		{
+			name: "insufficient liquidity",
+			amt:  150000,
+			bounds: map[string][2]btcutil.Amount{
+				"a": {0, 50000},
+				"b": {0, 50000},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := newFlowTestContext(t)
+			target := ctx.keyFromAlias("target")
+
+			mc := &flowTestMissionControl{
+				bounds: make(map[DirectedNodePair][2]msat),
+			}
+			for alias, bounds := range tc.bounds {
+				pair := NewDirectedNodePair(
+					ctx.keyFromAlias(alias), target,
+				)
+				mc.bounds[pair] = [2]msat{
+					sat(bounds[0]), sat(bounds[1]),
+				}
+			}
+
+			req := &flowRequest{
+				self:            ctx.source,
+				target:          target,
+				amt:             sat(tc.amt),
+				unitAmt:         sat(10000),
+				maxHops:         3,
+				attemptCost:     float64(sat(100)),
+				liquidityBounds: mc.GetLiquidityBounds,
+			}
+
+			var shards []plannedShard
+			err := ctx.graph.GraphSession(
+				func(graph graphdb.NodeTraverser) error {
+					hints := ctx.bandwidthHints
+					g := &graphParams{
+						bandwidthHints: hints,
+						graph:          graph,
+					}
+
+					var err error
+					shards, err = planShards(
+						context.Background(), g,
+						&ctx.restrictParams, req,
+					)
+
+					return err
+				}, func() {},
+			)
+			if tc.expectedShards == nil {
+				require.ErrorIs(t, err, errNoPathFound)
+				return
+			}
+			require.NoError(t, err)
+
+			amounts := make(map[string]btcutil.Amount)
+			for _, shard := range shards {
+				require.Len(t, shard.hops, 2)
+				require.Equal(t, target, shard.hops[1])
+
+				alias := ctx.aliasFromKey(shard.hops[0])
+				amounts[alias] += shard.amt.ToSatoshis()
+			}
+			require.Equal(t, tc.expectedShards, amounts)
+		})
+	}
+}
+
+// TestRequestRouteMinCostFlow tests that a payment session hands out the
+// shards planned by the min-cost flow.
+func TestRequestRouteMinCostFlow(t *testing.T) {
+	t.Parallel()
+
+	ctx := newFlowTestContext(t)
+	target := ctx.keyFromAlias("target")
+
+	amt := lnwire.NewMSatFromSatoshis(150000)
+	payment := &LightningPayment{
+		Target:         target,
+		Amount:         amt,
+		FeeLimit:       noFeeLimit,
+		CltvLimit:      1000,
+		FinalCLTVDelta: 40,
+		MaxParts:       16,
+		PaymentAddr:    fn.Some([32]byte{1}),
+		DestFeatures: lnwire.NewFeatureVector(
+			mppFeatures, lnwire.Features,
+		),
+	}
+	require.NoError(t, payment.SetPaymentHash(lntypes.Hash{}))
+
+	session, err := newPaymentSession(
+		payment, ctx.source,
+		func(Graph) (bandwidthHints, error) {
+			return &mockBandwidthHints{}, nil
+		},
+		ctx.graph, &flowTestMissionControl{},
+		PathFindingConfig{
+			AttemptCost: lnwire.NewMSatFromSatoshis(100),
+			MinCostFlow: &MinCostFlowConfig{
+				MaxHops: 3,
+				Units:   15,
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	// The pathfinder must not be used while the planned shards are
+	// valid.
+	session.pathFinder = func(context.Context, *graphParams,
+		*RestrictParams, *PathFindingConfig, route.Vertex, route.Vertex,
+		route.Vertex, lnwire.MilliSatoshi, float64, int32) (
+		[]*unifiedEdge, float64, error) {
+
+		t.Fatal("unexpected pathfinding")
+
+		return nil, 0, nil
+	}
+
+	// Neither path can carry the full amount, so it's split in two.
+	first, err := session.RequestRoute(
+		context.Background(), amt, noFeeLimit, 0, 100, nil,
+	)
+	require.NoError(t, err)
+	require.Less(t, first.ReceiverAmt(), amt)
+
+	remaining := amt - first.ReceiverAmt()
+	second, err := session.RequestRoute(
+		context.Background(), remaining, noFeeLimit, 1, 100, nil,
+	)
+	require.NoError(t, err)
+	require.Equal(t, remaining, second.ReceiverAmt())
+
+	require.NotEqual(
+		t, first.Hops[0].PubKeyBytes, second.Hops[0].PubKeyBytes,
+	)
+	require.Empty(t, session.shardPlan)
+}
+
+// TestMinCostFlowConfigValidate tests the validation of the min-cost flow
+// config.
+func TestMinCostFlowConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	cfg := &MinCostFlowConfig{
+		MaxHops: DefaultMinCostFlowMaxHops,
+		Units:   DefaultMinCostFlowUnits,
+	}
+	require.NoError(t, cfg.Validate())
+
+	cfg.MaxHops = 0
+	require.Error(t, cfg.Validate())
+
+	cfg.MaxHops = sphinx.NumMaxHops + 1
+	require.Error(t, cfg.Validate())
+
+	cfg.MaxHops = DefaultMinCostFlowMaxHops
+	cfg.Units = maxMinCostFlowUnits + 1
+	require.Error(t, cfg.Validate())
+}
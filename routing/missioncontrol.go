@@ -121,6 +121,8 @@ type MissionControl struct {
 	// probability estimation.
 	state *missionControlState
 
+	// store persists the payment results of mission control. It is nil
+	// for sandboxes, whose results only live in memory.
 	store *missionControlStore
 
 	// estimator is the probability estimator that is used with the payment
@@ -481,12 +483,16 @@ func (m *MissionControl) GetConfig() *MissionControlConfig {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	return &MissionControlConfig{
+	cfg := &MissionControlConfig{
 		Estimator:               m.estimator,
-		MaxMcHistory:            m.store.maxRecords,
-		McFlushInterval:         m.store.flushInterval,
 		MinFailureRelaxInterval: m.state.minFailureRelaxInterval,
 	}
+	if m.store != nil {
+		cfg.MaxMcHistory = m.store.maxRecords
+		cfg.McFlushInterval = m.store.flushInterval
+	}
+
+	return cfg
 }
 
 // SetConfig validates the config provided and updates mission control's config
@@ -506,7 +512,9 @@ func (m *MissionControl) SetConfig(cfg *MissionControlConfig) error {
 	m.log.Infof("Active mission control cfg: %v, estimator: %v", cfg,
 		cfg.Estimator)
 
-	m.store.maxRecords = cfg.MaxMcHistory
+	if m.store != nil {
+		m.store.maxRecords = cfg.MaxMcHistory
+	}
 	m.state.minFailureRelaxInterval = cfg.MinFailureRelaxInterval
 	m.estimator = cfg.Estimator
 
@@ -524,8 +532,10 @@ func (m *MissionControl) ResetHistory() error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if err := m.store.clear(); err != nil {
-		return err
+	if m.store != nil {
+		if err := m.store.clear(); err != nil {
+			return err
+		}
 	}
 
 	m.state.resetHistory()
@@ -556,6 +566,39 @@ func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
 	)
 }
 
+// liquidityEstimator is implemented by probability estimators that keep track
+// of the range the liquidity of channels lies in.
+type liquidityEstimator interface {
+	// LiquidityBounds returns the amounts that we know we can and cannot
+	// send over the channel to toNode.
+	LiquidityBounds(now time.Time, results NodeResults,
+		toNode route.Vertex, capacity lnwire.MilliSatoshi) (
+		lnwire.MilliSatoshi, lnwire.MilliSatoshi)
+}
+
+// GetLiquidityBounds returns the amounts that we know we can and cannot send
+// from fromNode to toNode over a channel of the given capacity. If the
+// probability estimator doesn't keep track of liquidity, the full range of the
+// capacity is returned.
+func (m *MissionControl) GetLiquidityBounds(fromNode, toNode route.Vertex,
+	capacity btcutil.Amount) (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
+
+	capacityMsat := lnwire.NewMSatFromSatoshis(capacity)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	estimator, ok := m.estimator.(liquidityEstimator)
+	if !ok {
+		return 0, capacityMsat
+	}
+
+	now := m.cfg.clock.Now()
+	results, _ := m.state.getLastPairResult(fromNode)
+
+	return estimator.LiquidityBounds(now, results, toNode, capacityMsat)
+}
+
 // GetHistorySnapshot takes a snapshot from the current mission control state
 // and actual probability estimates.
 func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
@@ -567,6 +610,14 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 	return m.state.getSnapshot()
 }
 
+// NumPairs returns the number of node pairs mission control has a result for.
+func (m *MissionControl) NumPairs() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.state.numPairs()
+}
+
 // ImportHistory imports the set of mission control results provided to our
 // in-memory state. These results are not persisted, so will not survive
 // restarts.
@@ -590,6 +641,35 @@ func (m *MissionControl) ImportHistory(history *MissionControlSnapshot,
 	return nil
 }
 
+// NewSandbox returns a mission control that starts out with a copy of the
+// state of this mission control, onto which the given snapshot is forcibly
+// imported. If fresh is set, the copy is skipped and the sandbox only knows the
+// results of the snapshot. Results reported to the sandbox only change its own
+// in-memory state, which allows path finding to be evaluated against a
+// hypothetical state without affecting live payments.
+func (m *MissionControl) NewSandbox(override *MissionControlSnapshot,
+	fresh bool) *MissionControl {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	state := newMissionControlState(m.state.minFailureRelaxInterval)
+	if !fresh {
+		state = m.state.clone()
+	}
+
+	if override != nil {
+		state.importSnapshot(override, true)
+	}
+
+	return &MissionControl{
+		cfg:       m.cfg,
+		state:     state,
+		estimator: m.estimator,
+		log:       m.log.WithPrefix("[sandbox]:"),
+	}
+}
+
 // GetPairHistorySnapshot returns the stored history for a given node pair.
 func (m *MissionControl) GetPairHistorySnapshot(
 	fromNode, toNode route.Vertex) TimedPairResult {
@@ -652,7 +732,9 @@ func (m *MissionControl) processPaymentResult(result *paymentResult) (
 	*channeldb.FailureReason, error) {
 
 	// Store complete result in database.
-	m.store.AddResult(result)
+	if m.store != nil {
+		m.store.AddResult(result)
+	}
 
 	m.mu.Lock()
 	defer m.mu.Unlock()
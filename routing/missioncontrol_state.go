@@ -53,6 +53,26 @@ func (m *missionControlState) resetHistory() {
 	m.lastSecondChance = make(map[DirectedNodePair]time.Time)
 }
 
+// clone returns a deep copy of the state.
+func (m *missionControlState) clone() *missionControlState {
+	state := newMissionControlState(m.minFailureRelaxInterval)
+
+	for fromNode, fromPairs := range m.lastPairResult {
+		results := make(NodeResults, len(fromPairs))
+		for toNode, result := range fromPairs {
+			results[toNode] = result
+		}
+
+		state.lastPairResult[fromNode] = results
+	}
+
+	for pair, timestamp := range m.lastSecondChance {
+		state.lastSecondChance[pair] = timestamp
+	}
+
+	return state
+}
+
 // setLastPairResult stores a result for a node pair.
 func (m *missionControlState) setLastPairResult(fromNode, toNode route.Vertex,
 	timestamp time.Time, result *pairResult, force bool) {
@@ -185,6 +205,16 @@ func (m *missionControlState) requestSecondChance(timestamp time.Time,
 	return false
 }
 
+// numPairs returns the number of node pairs with a result.
+func (m *missionControlState) numPairs() int {
+	var count int
+	for _, fromPairs := range m.lastPairResult {
+		count += len(fromPairs)
+	}
+
+	return count
+}
+
 // GetHistorySnapshot takes a snapshot from the current mission control state
 // and actual probability estimates.
 func (m *missionControlState) getSnapshot() *MissionControlSnapshot {
@@ -24,6 +24,7 @@ func TestMissionControlStateSetLastPairResult(t *testing.T) {
 	state.setLastPairResult(
 		from, to, timestamp, &pairResult{amt: 1000}, false,
 	)
+	require.Equal(t, 1, state.numPairs())
 	result, _ := state.getLastPairResult(from)
 	if result[to].FailAmt != 1000 {
 		t.Fatalf("unexpected fail amount %v", result[to].FailAmt)
@@ -312,6 +312,50 @@ func TestMissionControlNamespaces(t *testing.T) {
 	ctx.expectP(1000, testAprioriHopProbability+0.05)
 }
 
+// TestMissionControlSandbox tests that a sandbox starts out with the state of
+// mission control and the overridden results, and that results reported to it
+// don't affect the live state.
+func TestMissionControlSandbox(t *testing.T) {
+	ctx := createMcTestContext(t)
+
+	// Fail the edge in the live state.
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	live := ctx.mc
+	liveSnapshot := live.GetHistorySnapshot()
+
+	// A sandbox copies the live state.
+	ctx.mc = live.NewSandbox(nil, false)
+	ctx.expectP(1000, 0)
+
+	// A fresh sandbox only knows the override, which overwrites the
+	// failure.
+	override := &MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{{
+			Pair: NewDirectedNodePair(mcTestNode1, mcTestNode2),
+			TimedPairResult: TimedPairResult{
+				SuccessTime: mcTestTime,
+				SuccessAmt:  2000,
+			},
+		}},
+	}
+	ctx.mc = live.NewSandbox(override, true)
+	ctx.expectP(1000, testAprioriHopProbability+0.05)
+	require.Equal(t, 1, ctx.mc.NumPairs())
+
+	// Results reported to the sandbox only change the sandbox.
+	ctx.reportFailure(500, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	ctx.mc = live
+	ctx.expectP(1000, 0)
+	ctx.expectP(500, testAprioriHopProbability)
+	require.ElementsMatch(
+		t, liveSnapshot.Pairs, live.GetHistorySnapshot().Pairs,
+	)
+}
+
 // testClock is an implementation of clock.Clock that lets the caller overwrite
 // the current time at any point.
 type testClock struct {
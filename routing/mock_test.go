@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -29,7 +30,7 @@ type mockPaymentAttemptDispatcherOld struct {
 
 var _ PaymentAttemptDispatcher = (*mockPaymentAttemptDispatcherOld)(nil)
 
-func (m *mockPaymentAttemptDispatcherOld) SendHTLC(
+func (m *mockPaymentAttemptDispatcherOld) SendHTLC(_ context.Context,
 	firstHop lnwire.ShortChannelID, pid uint64,
 	_ *lnwire.UpdateAddHTLC) error {
 
@@ -157,6 +158,13 @@ func (m *mockMissionControlOld) GetProbability(fromNode, toNode route.Vertex,
 	return 0
 }
 
+func (m *mockMissionControlOld) GetLiquidityBounds(fromNode,
+	toNode route.Vertex, capacity btcutil.Amount) (lnwire.MilliSatoshi,
+	lnwire.MilliSatoshi) {
+
+	return 0, lnwire.NewMSatFromSatoshis(capacity)
+}
+
 type mockPaymentSessionOld struct {
 	routes []*route.Route
 
@@ -168,9 +176,9 @@ type mockPaymentSessionOld struct {
 
 var _ PaymentSession = (*mockPaymentSessionOld)(nil)
 
-func (m *mockPaymentSessionOld) RequestRoute(_, _ lnwire.MilliSatoshi,
-	_, height uint32, _ lnwire.CustomRecords) (*route.Route,
-	error) {
+func (m *mockPaymentSessionOld) RequestRoute(_ context.Context,
+	_, _ lnwire.MilliSatoshi, _, height uint32,
+	_ lnwire.CustomRecords) (*route.Route, error) {
 
 	if m.release != nil {
 		m.release <- struct{}{}
@@ -206,7 +214,7 @@ type mockPayerOld struct {
 
 var _ PaymentAttemptDispatcher = (*mockPayerOld)(nil)
 
-func (m *mockPayerOld) SendHTLC(_ lnwire.ShortChannelID,
+func (m *mockPayerOld) SendHTLC(_ context.Context, _ lnwire.ShortChannelID,
 	paymentID uint64,
 	_ *lnwire.UpdateAddHTLC) error {
 
@@ -592,8 +600,9 @@ type mockPaymentAttemptDispatcher struct {
 
 var _ PaymentAttemptDispatcher = (*mockPaymentAttemptDispatcher)(nil)
 
-func (m *mockPaymentAttemptDispatcher) SendHTLC(firstHop lnwire.ShortChannelID,
-	pid uint64, htlcAdd *lnwire.UpdateAddHTLC) error {
+func (m *mockPaymentAttemptDispatcher) SendHTLC(_ context.Context,
+	firstHop lnwire.ShortChannelID, pid uint64,
+	htlcAdd *lnwire.UpdateAddHTLC) error {
 
 	args := m.Called(firstHop, pid, htlcAdd)
 	return args.Error(0)
@@ -689,14 +698,22 @@ func (m *mockMissionControl) GetProbability(fromNode, toNode route.Vertex,
 	return args.Get(0).(float64)
 }
 
+func (m *mockMissionControl) GetLiquidityBounds(fromNode, toNode route.Vertex,
+	capacity btcutil.Amount) (lnwire.MilliSatoshi, lnwire.MilliSatoshi) {
+
+	args := m.Called(fromNode, toNode, capacity)
+	return args.Get(0).(lnwire.MilliSatoshi),
+		args.Get(1).(lnwire.MilliSatoshi)
+}
+
 type mockPaymentSession struct {
 	mock.Mock
 }
 
 var _ PaymentSession = (*mockPaymentSession)(nil)
 
-func (m *mockPaymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
-	activeShards, height uint32,
+func (m *mockPaymentSession) RequestRoute(_ context.Context,
+	maxAmt, feeLimit lnwire.MilliSatoshi, activeShards, height uint32,
 	firstHopCustomRecords lnwire.CustomRecords) (*route.Route, error) {
 
 	args := m.Called(
@@ -3,6 +3,7 @@ package routing
 import (
 	"bytes"
 	"container/heap"
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -19,6 +20,8 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/record"
 	"github.com/lightningnetwork/lnd/routing/route"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -50,10 +53,10 @@ const (
 )
 
 // pathFinder defines the interface of a path finding algorithm.
-type pathFinder = func(g *graphParams, r *RestrictParams,
-	cfg *PathFindingConfig, self, source, target route.Vertex,
-	amt lnwire.MilliSatoshi, timePref float64, finalHtlcExpiry int32) (
-	[]*unifiedEdge, float64, error)
+type pathFinder = func(ctx context.Context, g *graphParams,
+	r *RestrictParams, cfg *PathFindingConfig, self, source,
+	target route.Vertex, amt lnwire.MilliSatoshi, timePref float64,
+	finalHtlcExpiry int32) ([]*unifiedEdge, float64, error)
 
 var (
 	// DefaultEstimator is the default estimator used for computing
@@ -116,6 +119,10 @@ type finalHopParams struct {
 	// metadata is additional data that is sent along with the payment to
 	// the payee.
 	metadata []byte
+
+	// trampoline optionally holds the instructions for the final hop if
+	// it's a trampoline node.
+	trampoline *TrampolineOptions
 }
 
 // newRoute constructs a route using the provided path and final hop constraints.
@@ -211,6 +218,7 @@ func newRoute(sourceVertex route.Vertex,
 			customRecords       record.CustomSet
 			mpp                 *record.MPP
 			metadata            []byte
+			trampoline          *record.Trampoline
 		)
 
 		// Define a helper function that checks this edge's feature
@@ -266,6 +274,15 @@ func newRoute(sourceVertex route.Vertex,
 
 			metadata = finalHop.metadata
 
+			// If the final hop is a trampoline node, it forwards
+			// the payment with the expiry that remains after its
+			// own delta.
+			if finalHop.trampoline != nil {
+				trampoline = finalHop.trampoline.record(
+					outgoingTimeLock,
+				)
+			}
+
 			if blindedPathSet != nil {
 				totalAmtMsatBlinded = finalHop.totalAmt
 			}
@@ -313,6 +330,7 @@ func newRoute(sourceVertex route.Vertex,
 			MPP:              mpp,
 			Metadata:         metadata,
 			TotalAmtMsat:     totalAmtMsatBlinded,
+			Trampoline:       trampoline,
 		}
 
 		hops = append([]*route.Hop{currentHop}, hops...)
@@ -481,6 +499,10 @@ type RestrictParams struct {
 	// last/exit hop.
 	BlindedPaymentPathSet *BlindedPaymentPathSet
 
+	// Trampoline is necessary to determine the hop size of the last hop if
+	// it's a trampoline node.
+	Trampoline *TrampolineOptions
+
 	// FirstHopCustomRecords includes any records that should be included in
 	// the update_add_htlc message towards our peer.
 	FirstHopCustomRecords lnwire.CustomRecords
@@ -503,6 +525,10 @@ type PathFindingConfig struct {
 	// MinProbability defines the minimum success probability of the
 	// returned route.
 	MinProbability float64
+
+	// MinCostFlow, if set, makes multi-part payments be split with a
+	// min-cost flow instead of halving the amount until routes are found.
+	MinCostFlow *MinCostFlowConfig
 }
 
 // getOutgoingBalance returns the maximum available balance in any of the
@@ -599,10 +625,15 @@ func getOutgoingBalance(node route.Vertex, outgoingChans map[uint64]struct{},
 // source. This is to properly accumulate fees that need to be paid along the
 // path and accurately check the amount to forward at every node against the
 // available bandwidth.
-func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
-	self, source, target route.Vertex, amt lnwire.MilliSatoshi,
-	timePref float64, finalHtlcExpiry int32) ([]*unifiedEdge, float64,
-	error) {
+func findPath(ctx context.Context, g *graphParams, r *RestrictParams,
+	cfg *PathFindingConfig, self, source, target route.Vertex,
+	amt lnwire.MilliSatoshi, timePref float64, finalHtlcExpiry int32) (
+	[]*unifiedEdge, float64, error) {
+
+	_, span := tracer.Start(ctx, "findPath", trace.WithAttributes(
+		attribute.String("target", target.String()),
+		attribute.Int64("amt_msat", int64(amt)),
+	))
 
 	// Pathfinding can be a significant portion of the total payment
 	// latency, especially on low-powered devices. Log several metrics to
@@ -614,6 +645,12 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		timeElapsed := time.Since(start)
 		log.Debugf("Pathfinding perf metrics: nodes=%v, edges=%v, "+
 			"time=%v", nodesVisited, edgesExpanded, timeElapsed)
+
+		span.SetAttributes(
+			attribute.Int("nodes_visited", nodesVisited),
+			attribute.Int("edges_expanded", edgesExpanded),
+		)
+		span.End()
 	}()
 
 	// If no destination features are provided, we will load what features
@@ -752,25 +789,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// if the cltv limit is MaxUint32.
 	absoluteCltvLimit := uint64(r.CltvLimit) + uint64(finalHtlcExpiry)
 
-	// Calculate the default attempt cost as configured globally.
-	defaultAttemptCost := float64(
-		cfg.AttemptCost +
-			amt*lnwire.MilliSatoshi(cfg.AttemptCostPPM)/1000000,
-	)
-
-	// Validate time preference value.
-	if math.Abs(timePref) > 1 {
-		return nil, 0, fmt.Errorf("time preference %v out of range "+
-			"[-1, 1]", timePref)
+	absoluteAttemptCost, err := getAbsoluteAttemptCost(cfg, amt, timePref)
+	if err != nil {
+		return nil, 0, err
 	}
 
-	// Scale to avoid the extremes -1 and 1 which run into infinity issues.
-	timePref *= 0.9
-
-	// Apply time preference. At 0, the default attempt cost will
-	// be used.
-	absoluteAttemptCost := defaultAttemptCost * (1/(0.5-timePref/2) - 1)
-
 	log.Debugf("Pathfinding absolute attempt cost: %v sats",
 		absoluteAttemptCost/1000)
 
@@ -1195,6 +1218,11 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		distance[source].probability, len(pathEdges),
 		distance[source].netAmountReceived-amt)
 
+	span.SetAttributes(
+		attribute.Float64("probability", distance[source].probability),
+		attribute.Int("hops", len(pathEdges)),
+	)
+
 	return pathEdges, distance[source].probability, nil
 }
 
@@ -1516,6 +1544,31 @@ func processNodeForBlindedPath(g Graph, node route.Vertex,
 	return hopSets, chanCount > 1, nil
 }
 
+// getAbsoluteAttemptCost returns the virtual cost of a failed payment attempt
+// for the given amount, adjusted for the time preference.
+func getAbsoluteAttemptCost(cfg *PathFindingConfig, amt lnwire.MilliSatoshi,
+	timePref float64) (float64, error) {
+
+	// Calculate the default attempt cost as configured globally.
+	defaultAttemptCost := float64(
+		cfg.AttemptCost +
+			amt*lnwire.MilliSatoshi(cfg.AttemptCostPPM)/1000000,
+	)
+
+	// Validate time preference value.
+	if math.Abs(timePref) > 1 {
+		return 0, fmt.Errorf("time preference %v out of range "+
+			"[-1, 1]", timePref)
+	}
+
+	// Scale to avoid the extremes -1 and 1 which run into infinity issues.
+	timePref *= 0.9
+
+	// Apply time preference. At 0, the default attempt cost will
+	// be used.
+	return defaultAttemptCost * (1/(0.5-timePref/2) - 1), nil
+}
+
 // getProbabilityBasedDist converts a weight into a distance that takes into
 // account the success probability and the (virtual) cost of a failed payment
 // attempt.
@@ -1614,6 +1667,11 @@ func lastHopPayloadSize(r *RestrictParams, finalHtlcExpiry int32,
 		amp = &record.MaxAmpPayLoadSize
 	}
 
+	var trampoline *record.Trampoline
+	if r.Trampoline != nil {
+		trampoline = r.Trampoline.record(uint32(finalHtlcExpiry))
+	}
+
 	finalHop := route.Hop{
 		AmtToForward:     amount,
 		OutgoingTimeLock: uint32(finalHtlcExpiry),
@@ -1621,6 +1679,7 @@ func lastHopPayloadSize(r *RestrictParams, finalHtlcExpiry int32,
 		MPP:              mpp,
 		AMP:              amp,
 		Metadata:         r.Metadata,
+		Trampoline:       trampoline,
 	}
 
 	// The final hop does not have a short chanID set.
@@ -2381,7 +2381,7 @@ func TestPathFindSpecExample(t *testing.T) {
 	)
 	require.NoError(t, err, "invalid route request")
 
-	route, _, err := ctx.router.FindRoute(req)
+	route, _, err := ctx.router.FindRoute(context.Background(), req)
 	require.NoError(t, err, "unable to find route")
 
 	// Now we'll examine the route returned for correctness.
@@ -2408,7 +2408,7 @@ func TestPathFindSpecExample(t *testing.T) {
 	)
 	require.NoError(t, err, "invalid route request")
 
-	route, _, err = ctx.router.FindRoute(req)
+	route, _, err = ctx.router.FindRoute(context.Background(), req)
 	require.NoError(t, err, "unable to find routes")
 
 	// The route should be two hops.
@@ -3293,7 +3293,7 @@ func dbFindPath(graph *graphdb.ChannelGraph,
 	var route []*unifiedEdge
 	err = graph.GraphSession(func(graph graphdb.NodeTraverser) error {
 		route, _, err = findPath(
-			&graphParams{
+			context.Background(), &graphParams{
 				additionalEdges: additionalEdges,
 				bandwidthHints:  bandwidthHints,
 				graph:           graph,
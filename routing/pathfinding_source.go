@@ -0,0 +1,367 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	// ErrPathFindingSourceExists is returned when a path finding source is
+	// registered under a name that is already taken.
+	ErrPathFindingSourceExists = errors.New("path finding source already " +
+		"registered")
+
+	// ErrUnknownPathFindingSource is returned when a payment selects a
+	// path finding source that isn't registered.
+	ErrUnknownPathFindingSource = errors.New("unknown path finding source")
+)
+
+// PathFindingRequest describes the route a path finding source is asked to
+// propose candidate paths for.
+type PathFindingRequest struct {
+	// Source is the node the paths start at, which is our own node.
+	Source route.Vertex
+
+	// Target is the node the paths must end at.
+	Target route.Vertex
+
+	// Amount is the amount that must be delivered to the target, which
+	// is less than the payment amount if the payment is split.
+	Amount lnwire.MilliSatoshi
+
+	// FeeLimit is the maximum fee the route may cost.
+	FeeLimit lnwire.MilliSatoshi
+
+	// CltvLimit is the maximum sum of the time lock deltas of the
+	// channels of the route, excluding the final cltv delta.
+	CltvLimit uint32
+
+	// OutgoingChannelIDs optionally restricts the first channel of the
+	// route to one of the given channels.
+	OutgoingChannelIDs []uint64
+
+	// LastHop optionally restricts the node before the target.
+	LastHop *route.Vertex
+
+	// RouteHints are the private channels to the target from the invoice.
+	RouteHints [][]zpay32.HopHint
+
+	// ProbabilitySource returns mission control's estimate of the success
+	// probability of forwarding the given amount from one node to another
+	// over a channel of the given capacity. In-process sources can use it
+	// to score their candidates.
+	ProbabilitySource func(route.Vertex, route.Vertex, lnwire.MilliSatoshi,
+		btcutil.Amount) float64
+}
+
+// PathFindingSource proposes candidate paths for a payment as an alternative
+// to lnd's built-in pathfinding. This allows payments to be routed by an
+// external solver, for example over gRPC.
+type PathFindingSource interface {
+	// FindPaths returns candidate paths for the given request, in the
+	// order of preference. Each path lists the nodes after our own node,
+	// ending with the target. Every candidate is validated against the
+	// bandwidth of our channels, the fee and cltv limits and mission
+	// control before it's used, and the first valid one is attempted.
+	FindPaths(ctx context.Context,
+		req *PathFindingRequest) ([][]route.Vertex, error)
+}
+
+// PathFindingSourceRegistry holds the path finding sources payments can
+// select by name.
+type PathFindingSourceRegistry struct {
+	mu      sync.RWMutex
+	sources map[string]PathFindingSource
+}
+
+// NewPathFindingSourceRegistry creates an empty registry of path finding
+// sources.
+func NewPathFindingSourceRegistry() *PathFindingSourceRegistry {
+	return &PathFindingSourceRegistry{
+		sources: make(map[string]PathFindingSource),
+	}
+}
+
+// Register makes the given source selectable by payments under the given
+// name.
+func (r *PathFindingSourceRegistry) Register(name string,
+	source PathFindingSource) error {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sources[name]; ok {
+		return fmt.Errorf("%w: %v", ErrPathFindingSourceExists, name)
+	}
+	r.sources[name] = source
+
+	log.Infof("Registered path finding source %v", name)
+
+	return nil
+}
+
+// Unregister removes the source with the given name. Payments that already
+// selected it keep using it until they complete.
+func (r *PathFindingSourceRegistry) Unregister(name string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.sources, name)
+
+	log.Infof("Unregistered path finding source %v", name)
+}
+
+// Lookup returns the source registered under the given name.
+func (r *PathFindingSourceRegistry) Lookup(name string) (PathFindingSource,
+	error) {
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	source, ok := r.sources[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", ErrUnknownPathFindingSource,
+			name)
+	}
+
+	return source, nil
+}
+
+// Names returns the names of all registered sources.
+func (r *PathFindingSourceRegistry) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	names := make([]string, 0, len(r.sources))
+	for name := range r.sources {
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// newSourcePathFinder returns a pathFinder that asks the given source for
+// candidate paths instead of searching the graph itself, and returns the
+// first candidate that is valid.
+func newSourcePathFinder(source PathFindingSource,
+	routeHints [][]zpay32.HopHint) pathFinder {
+
+	return func(ctx context.Context, g *graphParams, r *RestrictParams,
+		_ *PathFindingConfig, self, _, target route.Vertex,
+		amt lnwire.MilliSatoshi, _ float64, _ int32) ([]*unifiedEdge,
+		float64, error) {
+
+		ctx, span := tracer.Start(ctx, "findSourcePath",
+			trace.WithAttributes(
+				attribute.String("target", target.String()),
+				attribute.Int64("amt_msat", int64(amt)),
+			),
+		)
+		defer span.End()
+
+		paths, err := source.FindPaths(ctx, &PathFindingRequest{
+			Source:             self,
+			Target:             target,
+			Amount:             amt,
+			FeeLimit:           r.FeeLimit,
+			CltvLimit:          r.CltvLimit,
+			OutgoingChannelIDs: r.OutgoingChannelIDs,
+			LastHop:            r.LastHop,
+			RouteHints:         routeHints,
+			ProbabilitySource:  r.ProbabilitySource,
+		})
+		if err != nil {
+			log.Errorf("Path finding source failed: %v", err)
+			return nil, 0, errPathFindingSource
+		}
+
+		span.SetAttributes(attribute.Int("candidates", len(paths)))
+
+		for i, hops := range paths {
+			path, probability, err := validateSourcePath(
+				g, r, self, target, hops, amt,
+			)
+			if err != nil {
+				log.Debugf("Rejecting candidate path %d for "+
+					"amt=%v: %v", i, amt, err)
+
+				continue
+			}
+
+			log.Debugf("Using candidate path %d for amt=%v with "+
+				"probability %v", i, amt, probability)
+
+			return path, probability, nil
+		}
+
+		return nil, 0, errNoPathFound
+	}
+}
+
+// validateSourcePath resolves the channels of a candidate path proposed by a
+// path finding source and checks that the path can carry the given amount
+// within the fee and cltv limits. Like for our own pathfinding, our local
+// channels must have enough bandwidth and mission control must not rule out
+// any of the channels. The returned probability is mission control's estimate
+// of the success probability of the path.
+func validateSourcePath(g *graphParams, r *RestrictParams, self,
+	target route.Vertex, hops []route.Vertex,
+	amt lnwire.MilliSatoshi) ([]*unifiedEdge, float64, error) {
+
+	if len(hops) == 0 || hops[len(hops)-1] != target {
+		return nil, 0, errors.New("path doesn't end at target")
+	}
+
+	// Load the features of the destination from the graph if they
+	// aren't provided, and check them the same way findPath does.
+	features := r.DestFeatures
+	if features == nil {
+		var err error
+		features, err = g.graph.FetchNodeFeatures(target)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if err := feature.ValidateRequired(features); err != nil {
+		return nil, 0, errUnknownRequiredFeature
+	}
+	if err := feature.ValidateDeps(features); err != nil {
+		return nil, 0, errMissingDependentFeature
+	}
+	if r.PaymentAddr.IsSome() &&
+		!features.HasFeature(lnwire.PaymentAddrOptional) {
+
+		return nil, 0, errNoPaymentAddr
+	}
+
+	if r.LastHop != nil {
+		if len(hops) < 2 || hops[len(hops)-2] != *r.LastHop {
+			return nil, 0, errors.New("path doesn't use last hop")
+		}
+	}
+
+	var outgoingChans map[uint64]struct{}
+	if len(r.OutgoingChannelIDs) > 0 {
+		outgoingChans = make(map[uint64]struct{})
+		for _, chanID := range r.OutgoingChannelIDs {
+			outgoingChans[chanID] = struct{}{}
+		}
+	}
+
+	// Resolve the channels of each hop from the graph and the route
+	// hints, which are indexed by the node at the start of the channel.
+	unifiers := make([]*edgeUnifier, len(hops))
+	for i := len(hops) - 1; i >= 0; i-- {
+		toNode := hops[i]
+
+		fromNode := self
+		if i > 0 {
+			fromNode = hops[i-1]
+		}
+
+		isExitHop := i == len(hops)-1
+		u := newNodeEdgeUnifier(self, toNode, !isExitHop, outgoingChans)
+
+		err := u.addGraphPolicies(g.graph)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, edge := range g.additionalEdges[fromNode] {
+			policy := edge.EdgePolicy()
+			if policy.ToNodePubKey() != toNode {
+				continue
+			}
+
+			u.addPolicy(
+				fromNode, policy, models.InboundFee{},
+				fakeHopHintCapacity,
+				edge.IntermediatePayloadSize,
+				edge.BlindedPayment(),
+			)
+		}
+
+		unifier, ok := u.edgeUnifiers[fromNode]
+		if !ok {
+			return nil, 0, ErrNoChannel{position: i}
+		}
+		unifiers[i] = unifier
+	}
+
+	// Select the channels that can forward the amount, which includes
+	// checking the bandwidth of our own channel.
+	path, senderAmt, err := senderAmtBackwardPass(
+		unifiers, fn.Some(amt), g.bandwidthHints,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if fee := senderAmt - amt; fee > r.FeeLimit {
+		return nil, 0, fmt.Errorf("fee %v exceeds limit %v", fee,
+			r.FeeLimit)
+	}
+
+	// Our own channel doesn't add a time lock delta.
+	var cltvDelta uint32
+	for _, edge := range path[1:] {
+		cltvDelta += uint32(edge.policy.TimeLockDelta)
+	}
+	if r.CltvLimit > 0 && cltvDelta > r.CltvLimit {
+		return nil, 0, fmt.Errorf("cltv delta %v exceeds limit %v",
+			cltvDelta, r.CltvLimit)
+	}
+
+	// Walk the path backwards to ask mission control about the amount
+	// each channel forwards, which includes the fees of the nodes after
+	// it.
+	probability := 1.0
+	incomingAmt := amt
+	for i := len(path) - 1; i >= 0; i-- {
+		fromNode := self
+		if i > 0 {
+			fromNode = hops[i-1]
+		}
+
+		edgeProbability := r.ProbabilitySource(
+			fromNode, hops[i], incomingAmt, path[i].capacity,
+		)
+		if edgeProbability == 0 {
+			return nil, 0, fmt.Errorf("mission control rules out "+
+				"channel %v", path[i].policy.ChannelID)
+		}
+		probability *= edgeProbability
+
+		if i == 0 {
+			break
+		}
+
+		outboundFee := path[i].policy.ComputeFee(incomingAmt)
+		netAmount := incomingAmt + outboundFee
+		inboundFee := calcCappedInboundFee(
+			path[i-1], netAmount, outboundFee,
+		)
+		incomingAmt = lnwire.MilliSatoshi(
+			int64(netAmount) + inboundFee,
+		)
+	}
+
+	// The route is built with the features of the destination, which
+	// may differ from the ones announced in the graph.
+	path[len(path)-1].policy.ToNodeFeatures = features
+
+	return path, probability, nil
+}
@@ -0,0 +1,328 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	graphdb "github.com/lightningnetwork/lnd/graph/db"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPathFindingSource is a path finding source that returns a fixed set of
+// candidate paths.
+type mockPathFindingSource struct {
+	paths [][]route.Vertex
+	err   error
+
+	requests []*PathFindingRequest
+}
+
+// FindPaths returns the candidate paths of the mock.
+func (m *mockPathFindingSource) FindPaths(_ context.Context,
+	req *PathFindingRequest) ([][]route.Vertex, error) {
+
+	m.requests = append(m.requests, req)
+
+	return m.paths, m.err
+}
+
+// newSourcePathFindingTestContext creates a test graph with three paths from
+// roasbeef to the target: through a (cheap, high time lock), through b
+// (expensive, low time lock) and through c and d.
+func newSourcePathFindingTestContext(t *testing.T) *pathFindingTestContext {
+	testChannels := []*testChannel{
+		symmetricTestChannel(
+			"roasbeef", "a", 100000, &testChannelPolicy{}, 1,
+		),
+		symmetricTestChannel("a", "target", 100000, &testChannelPolicy{
+			Expiry:      144,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}, 4),
+		symmetricTestChannel(
+			"roasbeef", "b", 100000, &testChannelPolicy{}, 2,
+		),
+		symmetricTestChannel("b", "target", 100000, &testChannelPolicy{
+			Expiry:      100,
+			FeeBaseMsat: 20000,
+			MinHTLC:     1,
+		}, 5),
+		symmetricTestChannel(
+			"roasbeef", "c", 100000, &testChannelPolicy{}, 3,
+		),
+		symmetricTestChannel("c", "d", 100000, &testChannelPolicy{
+			Expiry:      30,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}, 6),
+		symmetricTestChannel("d", "target", 100000, &testChannelPolicy{
+			Expiry:      30,
+			FeeBaseMsat: 10000,
+			MinHTLC:     1,
+		}, 7),
+	}
+
+	return newPathFindingTestContext(t, true, testChannels, "roasbeef")
+}
+
+// runSourcePathFinder runs the given path finder against the graph of the test
+// context.
+func (c *pathFindingTestContext) runSourcePathFinder(finder pathFinder,
+	target route.Vertex, amt lnwire.MilliSatoshi) ([]*unifiedEdge, float64,
+	error) {
+
+	var (
+		path        []*unifiedEdge
+		probability float64
+	)
+	err := c.graph.GraphSession(func(graph graphdb.NodeTraverser) error {
+		var err error
+		path, probability, err = finder(
+			context.Background(), &graphParams{
+				bandwidthHints: c.bandwidthHints,
+				graph:          graph,
+			},
+			&c.restrictParams, &c.pathFindingConfig, c.source,
+			c.source, target, amt, c.timePref, 0,
+		)
+
+		return err
+	}, func() {
+		path = nil
+	})
+
+	return path, probability, err
+}
+
+// TestValidateSourcePath tests that candidate paths of a path finding source
+// are checked against the restrictions of the payment.
+func TestValidateSourcePath(t *testing.T) {
+	t.Parallel()
+
+	const paymentAmt = lnwire.MilliSatoshi(100000)
+
+	testCases := []struct {
+		name         string
+		hops         []string
+		modify       func(*pathFindingTestContext)
+		expectedPath []uint64
+		expectedProb float64
+	}{
+		{
+			name:         "valid path",
+			hops:         []string{"a", "target"},
+			expectedPath: []uint64{1, 4},
+			expectedProb: 1,
+		},
+		{
+			name:         "valid path with two hops",
+			hops:         []string{"c", "d", "target"},
+			expectedPath: []uint64{3, 6, 7},
+			expectedProb: 1,
+		},
+		{
+			name: "path doesn't end at target",
+			hops: []string{"a"},
+		},
+		{
+			name: "no channel between hops",
+			hops: []string{"a", "d", "target"},
+		},
+		{
+			name: "fee limit exceeded",
+			hops: []string{"b", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.restrictParams.FeeLimit = 15000
+			},
+		},
+		{
+			name: "cltv limit exceeded",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.restrictParams.CltvLimit = 100
+			},
+		},
+		{
+			name: "last hop restriction",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				lastHop := c.keyFromAlias("b")
+				c.restrictParams.LastHop = &lastHop
+			},
+		},
+		{
+			name: "outgoing channel restriction",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.restrictParams.OutgoingChannelIDs = []uint64{
+					2,
+				}
+			},
+		},
+		{
+			name: "insufficient local bandwidth",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.bandwidthHints = &mockBandwidthHints{
+					hints: map[uint64]lnwire.MilliSatoshi{
+						1: 1000,
+					},
+				}
+			},
+		},
+		{
+			name: "payment addr not supported",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.restrictParams.PaymentAddr = fn.Some(
+					[32]byte{1},
+				)
+				c.restrictParams.DestFeatures = tlvFeatures
+			},
+		},
+		{
+			name: "mission control probability",
+			hops: []string{"c", "d", "target"},
+			modify: func(c *pathFindingTestContext) {
+				c.restrictParams.ProbabilitySource = func(_,
+					_ route.Vertex, _ lnwire.MilliSatoshi,
+					_ btcutil.Amount) float64 {
+
+					return 0.5
+				}
+			},
+			expectedPath: []uint64{3, 6, 7},
+			expectedProb: 0.125,
+		},
+		{
+			name: "ruled out by mission control",
+			hops: []string{"a", "target"},
+			modify: func(c *pathFindingTestContext) {
+				a := c.keyFromAlias("a")
+				c.restrictParams.ProbabilitySource = func(from,
+					_ route.Vertex, _ lnwire.MilliSatoshi,
+					_ btcutil.Amount) float64 {
+
+					if from == a {
+						return 0
+					}
+
+					return 1
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := newSourcePathFindingTestContext(t)
+			if tc.modify != nil {
+				tc.modify(ctx)
+			}
+
+			hops := make([]route.Vertex, len(tc.hops))
+			for i, alias := range tc.hops {
+				hops[i] = ctx.keyFromAlias(alias)
+			}
+
+			finder := func(_ context.Context, g *graphParams,
+				r *RestrictParams, _ *PathFindingConfig, self,
+				_, target route.Vertex, amt lnwire.MilliSatoshi,
+				_ float64, _ int32) ([]*unifiedEdge, float64,
+				error) {
+
+				return validateSourcePath(
+					g, r, self, target, hops, amt,
+				)
+			}
+
+			path, probability, err := ctx.runSourcePathFinder(
+				finder, ctx.keyFromAlias("target"), paymentAmt,
+			)
+			if tc.expectedPath == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			ctx.assertPath(path, tc.expectedPath)
+			require.InDelta(t, tc.expectedProb, probability, 1e-9)
+		})
+	}
+}
+
+// TestSourcePathFinder tests that the path finder of a path finding source
+// uses the first valid candidate path.
+func TestSourcePathFinder(t *testing.T) {
+	t.Parallel()
+
+	const paymentAmt = lnwire.MilliSatoshi(100000)
+
+	ctx := newSourcePathFindingTestContext(t)
+	ctx.restrictParams.FeeLimit = 15000
+
+	a := ctx.keyFromAlias("a")
+	b := ctx.keyFromAlias("b")
+	target := ctx.keyFromAlias("target")
+
+	// The first candidate exceeds the fee limit, so the second one must
+	// be used.
+	source := &mockPathFindingSource{
+		paths: [][]route.Vertex{{b, target}, {a, target}},
+	}
+	finder := newSourcePathFinder(source, nil)
+
+	path, _, err := ctx.runSourcePathFinder(finder, target, paymentAmt)
+	require.NoError(t, err)
+	ctx.assertPath(path, []uint64{1, 4})
+
+	require.Len(t, source.requests, 1)
+	req := source.requests[0]
+	require.Equal(t, ctx.source, req.Source)
+	require.Equal(t, target, req.Target)
+	require.Equal(t, paymentAmt, req.Amount)
+	require.Equal(t, lnwire.MilliSatoshi(15000), req.FeeLimit)
+
+	// Without a valid candidate, no path is found.
+	source.paths = source.paths[:1]
+	_, _, err = ctx.runSourcePathFinder(finder, target, paymentAmt)
+	require.ErrorIs(t, err, errNoPathFound)
+
+	// A failing source fails the payment.
+	source.err = errors.New("solver unavailable")
+	_, _, err = ctx.runSourcePathFinder(finder, target, paymentAmt)
+	require.ErrorIs(t, err, errPathFindingSource)
+}
+
+// TestPathFindingSourceRegistry tests registering and looking up path finding
+// sources.
+func TestPathFindingSourceRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry := NewPathFindingSourceRegistry()
+	source := &mockPathFindingSource{}
+
+	_, err := registry.Lookup("mcf")
+	require.ErrorIs(t, err, ErrUnknownPathFindingSource)
+
+	require.NoError(t, registry.Register("mcf", source))
+	err = registry.Register("mcf", &mockPathFindingSource{})
+	require.ErrorIs(t, err, ErrPathFindingSourceExists)
+
+	found, err := registry.Lookup("mcf")
+	require.NoError(t, err)
+	require.Equal(t, source, found)
+	require.Equal(t, []string{"mcf"}, registry.Names())
+
+	registry.Unregister("mcf")
+	_, err = registry.Lookup("mcf")
+	require.ErrorIs(t, err, ErrUnknownPathFindingSource)
+	require.Empty(t, registry.Names())
+}
@@ -19,6 +19,9 @@ import (
 	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/routing/shards"
 	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/lightningnetwork/lnd/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // ErrPaymentLifecycleExiting is used when waiting for htlc attempt result, but
@@ -191,6 +194,13 @@ func (p *paymentLifecycle) decideNextStep(
 func (p *paymentLifecycle) resumePayment(ctx context.Context) ([32]byte,
 	*route.Route, error) {
 
+	ctx, span := tracer.Start(
+		ctx, "paymentLifecycle.resumePayment", trace.WithAttributes(
+			attribute.String("payment_id", p.identifier.String()),
+		),
+	)
+	defer span.End()
+
 	// When the payment lifecycle loop exits, we make sure to signal any
 	// sub goroutine of the HTLC attempt to exit, then wait for them to
 	// return.
@@ -201,6 +211,7 @@ func (p *paymentLifecycle) resumePayment(ctx context.Context) ([32]byte,
 	// lifecycle loop below.
 	payment, err := p.reloadInflightAttempts()
 	if err != nil {
+		tracing.RecordError(span, err)
 		return [32]byte{}, nil, err
 	}
 
@@ -218,6 +229,7 @@ func (p *paymentLifecycle) resumePayment(ctx context.Context) ([32]byte,
 		// the payment fetching to return an error.
 		log.Errorf("Payment %v with status=%v failed: %v", p.identifier,
 			status, err)
+		tracing.RecordError(span, err)
 
 		return [32]byte{}, nil, err
 	}
@@ -280,7 +292,7 @@ lifecycle:
 		}
 
 		// Now request a route to be used to create our HTLC attempt.
-		rt, err := p.requestRoute(ps)
+		rt, err := p.requestRoute(ctx, ps)
 		if err != nil {
 			return exitWithErr(err)
 		}
@@ -305,7 +317,7 @@ lifecycle:
 		}
 
 		// Once the attempt is created, send it to the htlcswitch.
-		result, err := p.sendAttempt(attempt)
+		result, err := p.sendAttempt(ctx, attempt)
 		if err != nil {
 			return exitWithErr(err)
 		}
@@ -334,6 +346,8 @@ lifecycle:
 	}
 
 	// Otherwise return the payment failure reason.
+	tracing.RecordError(span, *failure)
+
 	return [32]byte{}, nil, *failure
 }
 
@@ -380,14 +394,24 @@ func (p *paymentLifecycle) checkContext(ctx context.Context) error {
 
 // requestRoute is responsible for finding a route to be used to create an HTLC
 // attempt.
-func (p *paymentLifecycle) requestRoute(
+func (p *paymentLifecycle) requestRoute(ctx context.Context,
 	ps *channeldb.MPPaymentState) (*route.Route, error) {
 
 	remainingFees := p.calcFeeBudget(ps.FeesPaid)
 
+	ctx, span := tracer.Start(
+		ctx, "paymentLifecycle.requestRoute", trace.WithAttributes(
+			attribute.Int64("remaining_amt_msat",
+				int64(ps.RemainingAmt)),
+			attribute.Int("attempts_in_flight",
+				ps.NumAttemptsInFlight),
+		),
+	)
+	defer span.End()
+
 	// Query our payment session to construct a route.
 	rt, err := p.paySession.RequestRoute(
-		ps.RemainingAmt, remainingFees,
+		ctx, ps.RemainingAmt, remainingFees,
 		uint32(ps.NumAttemptsInFlight), uint32(p.currentHeight),
 		p.firstHopCustomRecords,
 	)
@@ -406,6 +430,7 @@ func (p *paymentLifecycle) requestRoute(
 
 	// Otherwise we need to handle the error.
 	log.Warnf("Failed to find route for payment %v: %v", p.identifier, err)
+	tracing.RecordError(span, err)
 
 	// If the error belongs to `noRouteError` set, it means a non-critical
 	// error has happened during path finding, and we will mark the payment
@@ -651,9 +676,19 @@ func (p *paymentLifecycle) createNewPaymentAttempt(rt *route.Route,
 // sendAttempt attempts to send the current attempt to the switch to complete
 // the payment. If this attempt fails, then we'll continue on to the next
 // available route.
-func (p *paymentLifecycle) sendAttempt(
+func (p *paymentLifecycle) sendAttempt(ctx context.Context,
 	attempt *channeldb.HTLCAttempt) (*attemptResult, error) {
 
+	ctx, span := tracer.Start(
+		ctx, "paymentLifecycle.sendAttempt", trace.WithAttributes(
+			attribute.Int64("attempt_id", int64(attempt.AttemptID)),
+			attribute.Int64("amt_msat",
+				int64(attempt.Route.TotalAmount)),
+			attribute.Int("hops", len(attempt.Route.Hops)),
+		),
+	)
+	defer span.End()
+
 	log.Debugf("Sending HTLC attempt(id=%v, total_amt=%v, first_hop_amt=%d"+
 		") for payment %v", attempt.AttemptID,
 		attempt.Route.TotalAmount, attempt.Route.FirstHopAmount.Val,
@@ -692,10 +727,13 @@ func (p *paymentLifecycle) sendAttempt(
 	// the Switch successfully has persisted the payment attempt,
 	// such that we can resume waiting for the result after a
 	// restart.
-	err = p.router.cfg.Payer.SendHTLC(firstHop, attempt.AttemptID, htlcAdd)
+	err = p.router.cfg.Payer.SendHTLC(
+		ctx, firstHop, attempt.AttemptID, htlcAdd,
+	)
 	if err != nil {
 		log.Errorf("Failed sending attempt %d for payment %v to "+
 			"switch: %v", attempt.AttemptID, p.identifier, err)
+		tracing.RecordError(span, err)
 
 		return p.handleSwitchErr(attempt, err)
 	}
@@ -1,7 +1,9 @@
 package routing
 
 import (
+	"context"
 	"fmt"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btclog/v2"
@@ -65,6 +67,10 @@ const (
 	// errMissingDependentFeature is returned when the destination node
 	// misses a feature that a feature that we require depends on.
 	errMissingDependentFeature
+
+	// errPathFindingSource is returned when the path finding source
+	// selected by the payment fails to propose paths.
+	errPathFindingSource
 )
 
 var (
@@ -98,6 +104,9 @@ func (e noRouteError) Error() string {
 	case errMissingDependentFeature:
 		return "missing dependent feature"
 
+	case errPathFindingSource:
+		return "path finding source failed"
+
 	default:
 		return "unknown no-route error"
 	}
@@ -138,7 +147,7 @@ type PaymentSession interface {
 	//
 	// A noRouteError is returned if a non-critical error is encountered
 	// during path finding.
-	RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
+	RequestRoute(ctx context.Context, maxAmt, feeLimit lnwire.MilliSatoshi,
 		activeShards, height uint32,
 		firstHopCustomRecords lnwire.CustomRecords) (*route.Route,
 		error)
@@ -186,6 +195,13 @@ type paymentSession struct {
 
 	missionControl MissionControlQuerier
 
+	// shardPlan holds the shards planned by the min-cost flow that haven't
+	// been sent yet.
+	shardPlan []plannedShard
+
+	// planMtx protects shardPlan.
+	planMtx sync.Mutex
+
 	// minShardAmt is the amount beyond which we won't try to further split
 	// the payment if no route is found. If the maximum number of htlcs
 	// specified in the payment is one, under no circumstances splitting
@@ -214,6 +230,11 @@ func newPaymentSession(p *LightningPayment, selfNode route.Vertex,
 				"and blinded path")
 		}
 
+		if p.PathFindingSource != nil {
+			return nil, fmt.Errorf("path finding sources don't " +
+				"support blinded paths")
+		}
+
 		edges, err = p.BlindedPathSet.ToRouteHints()
 		if err != nil {
 			return nil, err
@@ -222,12 +243,19 @@ func newPaymentSession(p *LightningPayment, selfNode route.Vertex,
 
 	logPrefix := fmt.Sprintf("PaymentSession(%x):", p.Identifier())
 
+	// Unless the payment selected a path finding source, we search the
+	// graph ourselves.
+	finder := findPath
+	if p.PathFindingSource != nil {
+		finder = newSourcePathFinder(p.PathFindingSource, p.RouteHints)
+	}
+
 	return &paymentSession{
 		selfNode:          selfNode,
 		additionalEdges:   edges,
 		getBandwidthHints: getBandwidthHints,
 		payment:           p,
-		pathFinder:        findPath,
+		pathFinder:        finder,
 		graphSessFactory:  graphSessFactory,
 		pathFindingConfig: pathFindingConfig,
 		missionControl:    missionControl,
@@ -256,8 +284,8 @@ func (e *pathFindingError) Unwrap() error {
 //
 // NOTE: This function is safe for concurrent access.
 // NOTE: Part of the PaymentSession interface.
-func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
-	activeShards, height uint32,
+func (p *paymentSession) RequestRoute(ctx context.Context,
+	maxAmt, feeLimit lnwire.MilliSatoshi, activeShards, height uint32,
 	firstHopCustomRecords lnwire.CustomRecords) (*route.Route, error) {
 
 	if p.empty {
@@ -291,6 +319,7 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		Amp:                   p.payment.amp,
 		Metadata:              p.payment.Metadata,
 		FirstHopCustomRecords: firstHopCustomRecords,
+		Trampoline:            p.payment.Trampoline,
 	}
 
 	finalHtlcExpiry := int32(height) + int32(finalCltvDelta)
@@ -307,7 +336,16 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		maxAmt = *p.payment.MaxShardAmt
 	}
 
-	var path []*unifiedEdge
+	// Unless the payment can't be split, we first try to split it with a
+	// min-cost flow, which is only done once. If that doesn't yield a
+	// valid shard, we fall back to our regular pathfinding, which halves
+	// the amount until a route is found.
+	tryMinCostFlow := p.canSplitWithMinCostFlow(activeShards)
+
+	var (
+		path []*unifiedEdge
+		amt  lnwire.MilliSatoshi
+	)
 	findPath := func(graph graphdb.NodeTraverser) error {
 		// We'll also obtain a set of bandwidthHints from the lower
 		// layer for each of our outbound channels. This will allow the
@@ -320,16 +358,32 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 			return err
 		}
 
+		g := &graphParams{
+			additionalEdges: p.additionalEdges,
+			bandwidthHints:  bandwidthHints,
+			graph:           graph,
+		}
+
+		if tryMinCostFlow {
+			tryMinCostFlow = false
+
+			path, amt, err = p.nextPlannedShard(
+				ctx, g, restrictions, maxAmt, activeShards,
+			)
+			if err == nil {
+				return nil
+			}
+
+			p.log.Debugf("Unable to split amt=%v with min-cost "+
+				"flow: %v", maxAmt, err)
+		}
+
 		p.log.Debugf("pathfinding for amt=%v", maxAmt)
 
 		// Find a route for the current amount.
+		amt = maxAmt
 		path, _, err = p.pathFinder(
-			&graphParams{
-				additionalEdges: p.additionalEdges,
-				bandwidthHints:  bandwidthHints,
-				graph:           graph,
-			},
-			restrictions, &p.pathFindingConfig,
+			ctx, g, restrictions, &p.pathFindingConfig,
 			p.selfNode, p.selfNode, p.payment.Target,
 			maxAmt, p.payment.TimePref, finalHtlcExpiry,
 		)
@@ -440,12 +494,13 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 		route, err := newRoute(
 			p.selfNode, path, height,
 			finalHopParams{
-				amt:         maxAmt,
+				amt:         amt,
 				totalAmt:    p.payment.Amount,
 				cltvDelta:   finalCltvDelta,
 				records:     p.payment.DestCustomRecords,
 				paymentAddr: p.payment.PaymentAddr,
 				metadata:    p.payment.Metadata,
+				trampoline:  p.payment.Trampoline,
 			}, p.payment.BlindedPathSet,
 		)
 		if err != nil {
@@ -456,6 +511,121 @@ func (p *paymentSession) RequestRoute(maxAmt, feeLimit lnwire.MilliSatoshi,
 	}
 }
 
+// canSplitWithMinCostFlow returns true if the payment can be split with a
+// min-cost flow.
+func (p *paymentSession) canSplitWithMinCostFlow(activeShards uint32) bool {
+	payment := p.payment
+
+	switch {
+	case p.pathFindingConfig.MinCostFlow == nil:
+		return false
+
+	// Payments with a path finding source, blinded paths or a maximum
+	// shard amount are routed the regular way.
+	case payment.PathFindingSource != nil, payment.BlindedPathSet != nil,
+		payment.MaxShardAmt != nil:
+
+		return false
+
+	case payment.Target == p.selfNode:
+		return false
+
+	case payment.PaymentAddr.IsNone() || payment.DestFeatures == nil:
+		return false
+
+	case !payment.DestFeatures.HasFeature(lnwire.MPPOptional) &&
+		!payment.DestFeatures.HasFeature(lnwire.AMPOptional):
+
+		return false
+
+	// Splitting requires at least two more shards.
+	case activeShards+1 >= payment.MaxParts:
+		return false
+	}
+
+	return true
+}
+
+// nextPlannedShard returns the path and amount of the next shard planned by
+// the min-cost flow. If the amount to send doesn't match the remaining shards
+// of the plan, for example because a shard failed, the min-cost flow is solved
+// again for the amount. The shard is validated like the paths of a path
+// finding source.
+func (p *paymentSession) nextPlannedShard(ctx context.Context, g *graphParams,
+	r *RestrictParams, amt lnwire.MilliSatoshi,
+	activeShards uint32) ([]*unifiedEdge, lnwire.MilliSatoshi, error) {
+
+	p.planMtx.Lock()
+	defer p.planMtx.Unlock()
+
+	var planned lnwire.MilliSatoshi
+	for _, shard := range p.shardPlan {
+		planned += shard.amt
+	}
+
+	if planned != amt {
+		p.shardPlan = nil
+
+		shards, err := p.planShards(ctx, g, r, amt)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		maxShards := p.payment.MaxParts - activeShards
+		if uint32(len(shards)) > maxShards {
+			return nil, 0, fmt.Errorf("%v shards exceed the "+
+				"remaining %v parts", len(shards), maxShards)
+		}
+
+		p.log.Debugf("Split amt=%v into %v shards with min-cost flow",
+			amt, len(shards))
+
+		p.shardPlan = shards
+	}
+
+	shard := p.shardPlan[0]
+	p.shardPlan = p.shardPlan[1:]
+
+	path, _, err := validateSourcePath(
+		g, r, p.selfNode, p.payment.Target, shard.hops, shard.amt,
+	)
+	if err != nil {
+		p.shardPlan = nil
+		return nil, 0, err
+	}
+
+	return path, shard.amt, nil
+}
+
+// planShards solves the min-cost flow for the given amount.
+func (p *paymentSession) planShards(ctx context.Context, g *graphParams,
+	r *RestrictParams, amt lnwire.MilliSatoshi) ([]plannedShard, error) {
+
+	cfg := p.pathFindingConfig.MinCostFlow
+
+	attemptCost, err := getAbsoluteAttemptCost(
+		&p.pathFindingConfig, amt, p.payment.TimePref,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shards must not be smaller than the minimum shard amount.
+	unitAmt := (amt + lnwire.MilliSatoshi(cfg.Units) - 1) /
+		lnwire.MilliSatoshi(cfg.Units)
+	unitAmt = min(max(unitAmt, p.minShardAmt), amt)
+
+	return planShards(ctx, g, r, &flowRequest{
+		self:            p.selfNode,
+		target:          p.payment.Target,
+		amt:             amt,
+		unitAmt:         unitAmt,
+		maxHops:         cfg.MaxHops,
+		attemptCost:     attemptCost,
+		liquidityBounds: p.missionControl.GetLiquidityBounds,
+	})
+}
+
 // UpdateAdditionalEdge updates the channel edge policy for a private edge. It
 // validates the message signature and checks it's up to date, then applies the
 // updates to the supplied policy. It returns a boolean to indicate whether
@@ -1,6 +1,7 @@
 package routing
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -206,8 +207,8 @@ func TestRequestRoute(t *testing.T) {
 	}
 
 	// Override pathfinder with a mock.
-	session.pathFinder = func(_ *graphParams, r *RestrictParams,
-		_ *PathFindingConfig, _, _, _ route.Vertex,
+	session.pathFinder = func(_ context.Context, _ *graphParams,
+		r *RestrictParams, _ *PathFindingConfig, _, _, _ route.Vertex,
 		_ lnwire.MilliSatoshi, _ float64, _ int32) ([]*unifiedEdge,
 		float64, error) {
 
@@ -234,7 +235,8 @@ func TestRequestRoute(t *testing.T) {
 	}
 
 	route, err := session.RequestRoute(
-		payment.Amount, payment.FeeLimit, 0, height,
+		context.Background(), payment.Amount, payment.FeeLimit, 0,
+		height,
 		lnwire.CustomRecords{
 			lnwire.MinCustomRecordsTlvType + 123: []byte{1, 2, 3},
 		},
@@ -198,7 +198,34 @@ func (p *BimodalEstimator) directProbability(now time.Time,
 	capacity lnwire.MilliSatoshi) float64 {
 
 	// We first determine the time-adjusted success and failure amounts to
-	// then compute a probability. We know that we can send a zero amount.
+	// then compute a probability.
+	successAmount, failAmount := p.LiquidityBounds(
+		now, results, toNode, capacity,
+	)
+
+	// Compute the direct channel probability.
+	probability, err := p.probabilityFormula(
+		capacity, successAmount, failAmount, amt,
+	)
+	if err != nil {
+		log.Errorf("error computing probability to node: %v "+
+			"(node: %v, results: %v, amt: %v, capacity: %v)",
+			err, toNode, results, amt, capacity)
+
+		return 0.0
+	}
+
+	return probability
+}
+
+// LiquidityBounds returns the time-adjusted amounts that we know we can and
+// cannot send over the channel to toNode, based on previous successes and
+// failures. The liquidity of the channel is expected to lie in between.
+func (p *BimodalEstimator) LiquidityBounds(now time.Time, results NodeResults,
+	toNode route.Vertex, capacity lnwire.MilliSatoshi) (lnwire.MilliSatoshi,
+	lnwire.MilliSatoshi) {
+
+	// We know that we can send a zero amount.
 	successAmount := lnwire.MilliSatoshi(0)
 
 	// We know that we cannot send the full capacity.
@@ -225,19 +252,7 @@ func (p *BimodalEstimator) directProbability(now time.Time,
 		}
 	}
 
-	// Compute the direct channel probability.
-	probability, err := p.probabilityFormula(
-		capacity, successAmount, failAmount, amt,
-	)
-	if err != nil {
-		log.Errorf("error computing probability to node: %v "+
-			"(node: %v, results: %v, amt: %v, capacity: %v)",
-			err, toNode, results, amt, capacity)
-
-		return 0.0
-	}
-
-	return probability
+	return successAmount, failAmount
 }
 
 // calculateProbability computes the total hop probability combining the channel
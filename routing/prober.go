@@ -0,0 +1,605 @@
+package routing
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// ProbeMissionControlNamespace is the mission control namespace the
+	// results of scheduled probes are recorded in.
+	ProbeMissionControlNamespace = "probing"
+
+	// DefaultProbeInterval is the default time between two scheduled
+	// probes.
+	DefaultProbeInterval = time.Minute
+
+	// DefaultProbeAmount is the default amount that is probed.
+	DefaultProbeAmount = lnwire.MilliSatoshi(100_000_000)
+
+	// DefaultProbeMaxInFlight is the default maximum total amount of
+	// probes that are in flight at the same time.
+	DefaultProbeMaxInFlight = lnwire.MilliSatoshi(1_000_000_000)
+
+	// DefaultProbeAutoDestinations is the default number of destinations
+	// that are chosen automatically.
+	DefaultProbeAutoDestinations = 10
+
+	// DefaultProbeHistorySize is the default number of probes that are
+	// kept in the probe history.
+	DefaultProbeHistorySize = 1000
+)
+
+var (
+	// ErrNoProbeDestinations is returned when a probe is to be sent, but
+	// neither configured nor automatically chosen destinations exist.
+	ErrNoProbeDestinations = errors.New("no probe destinations")
+
+	// ErrProbeBudgetExceeded is returned when sending a probe would
+	// exceed the in-flight budget of the prober.
+	ErrProbeBudgetExceeded = errors.New("probe in-flight budget exceeded")
+)
+
+// ProbeResult describes a probe sent by the prober.
+type ProbeResult struct {
+	// ID is the sequence number of the probe.
+	ID uint64
+
+	// Destination is the node that was probed.
+	Destination route.Vertex
+
+	// Amount is the amount that was probed.
+	Amount lnwire.MilliSatoshi
+
+	// Route is the route the probe was sent along. It is nil if no route
+	// to the destination was found.
+	Route *route.Route
+
+	// Reached is true if the probe reached the destination.
+	Reached bool
+
+	// FailureSourceIdx is the index of the node that failed the probe.
+	// It is only set if the probe was sent and the failure source is
+	// known.
+	FailureSourceIdx *int
+
+	// FailureCode is the code of the failure the probe was failed with.
+	// It is zero if the failure is unknown.
+	FailureCode lnwire.FailCode
+
+	// Err is set if the probe couldn't be sent or resolved.
+	Err error
+
+	// SendTime is the time the probe was started.
+	SendTime time.Time
+
+	// ResolveTime is the time the probe was resolved. It is zero while
+	// the probe is in flight.
+	ResolveTime time.Time
+}
+
+// InFlight returns true if the probe is still in flight.
+func (p *ProbeResult) InFlight() bool {
+	return p.ResolveTime.IsZero()
+}
+
+// ProberConfig holds the configuration of the prober.
+type ProberConfig struct {
+	// SelfNode is our own node.
+	SelfNode route.Vertex
+
+	// Destinations are the configured probe destinations.
+	Destinations []route.Vertex
+
+	// AutoDestinations is the number of destinations that are chosen
+	// automatically in addition to the configured ones.
+	AutoDestinations int
+
+	// SelectDestinations returns up to the given number of destinations
+	// worth probing. It is required if AutoDestinations is non-zero.
+	SelectDestinations func(n int) ([]route.Vertex, error)
+
+	// Amount is the amount that is probed.
+	Amount lnwire.MilliSatoshi
+
+	// MaxInFlight is the maximum total amount, including fees, of the
+	// probes that are in flight at the same time.
+	MaxInFlight lnwire.MilliSatoshi
+
+	// Ticker triggers the probes. Its interval bounds the probe rate.
+	Ticker ticker.Ticker
+
+	// HistorySize is the number of probes that are kept in the history.
+	HistorySize int
+
+	// MissionControl is the mission control the routes of probes are
+	// found with and their results are recorded in.
+	MissionControl MissionControlQuerier
+
+	// FindRoute finds a route for a probe.
+	FindRoute func(context.Context, *RouteRequest) (*route.Route,
+		float64, error)
+
+	// SendProbe sends a probe along the given route, waits for it to
+	// resolve and records the result in the given mission control.
+	SendProbe func(context.Context, *route.Route,
+		MissionControlQuerier) (*ProbeResult, error)
+
+	// Clock is used to timestamp probes.
+	Clock clock.Clock
+}
+
+// Validate checks that the prober config is usable.
+func (c *ProberConfig) Validate() error {
+	if c.Amount == 0 {
+		return errors.New("probe amount must be positive")
+	}
+
+	if c.MaxInFlight < c.Amount {
+		return fmt.Errorf("probe in-flight budget %v is smaller than "+
+			"the probe amount %v", c.MaxInFlight, c.Amount)
+	}
+
+	if c.AutoDestinations < 0 {
+		return errors.New("number of automatic destinations must not " +
+			"be negative")
+	}
+
+	if len(c.Destinations) == 0 && c.AutoDestinations == 0 {
+		return ErrNoProbeDestinations
+	}
+
+	if c.HistorySize <= 0 {
+		return errors.New("probe history size must be positive")
+	}
+
+	return nil
+}
+
+// Prober periodically sends probes with random payment hashes to a set of
+// destinations, so that mission control learns about the liquidity in the
+// network before payments are made.
+type Prober struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *ProberConfig
+
+	// destinations holds the destinations of the current round of probes
+	// and next is the index of the next one to probe.
+	destinations []route.Vertex
+	next         int
+
+	// mu protects the fields below.
+	mu       sync.Mutex
+	lastID   uint64
+	inFlight lnwire.MilliSatoshi
+	history  []*ProbeResult
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewProber creates a new prober.
+func NewProber(cfg *ProberConfig) (*Prober, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Prober{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}, nil
+}
+
+// Start starts sending probes.
+func (p *Prober) Start() error {
+	if !p.started.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Prober starting")
+
+	p.cfg.Ticker.Resume()
+
+	p.wg.Add(1)
+	go p.probeLoop()
+
+	return nil
+}
+
+// Stop stops sending probes. Probes that are still in flight are abandoned.
+func (p *Prober) Stop() error {
+	if !p.stopped.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Prober shutting down...")
+	defer log.Debug("Prober shutdown complete")
+
+	close(p.quit)
+	p.wg.Wait()
+
+	p.cfg.Ticker.Stop()
+
+	return nil
+}
+
+// probeLoop sends a probe on every tick of the ticker.
+func (p *Prober) probeLoop() {
+	defer p.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case <-p.cfg.Ticker.Ticks():
+			err := p.probeNext(ctx)
+			if err != nil {
+				log.Debugf("Unable to send probe: %v", err)
+			}
+
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// probeNext probes the next destination. The probe is sent in the background.
+func (p *Prober) probeNext(ctx context.Context) error {
+	if p.next >= len(p.destinations) {
+		destinations, err := p.selectDestinations()
+		if err != nil {
+			return err
+		}
+
+		p.destinations = destinations
+		p.next = 0
+	}
+
+	// The destination is only skipped once a probe was started, so that
+	// it isn't passed over while the budget is exhausted.
+	probe, err := p.startProbe(ctx, p.destinations[p.next])
+	if err != nil {
+		return err
+	}
+	p.next++
+
+	// If no route was found, the probe is already resolved.
+	if !probe.InFlight() {
+		return probe.Err
+	}
+
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		p.sendProbe(ctx, probe)
+	}()
+
+	return nil
+}
+
+// selectDestinations returns the destinations of the next round of probes,
+// which are the configured ones followed by the automatically chosen ones.
+func (p *Prober) selectDestinations() ([]route.Vertex, error) {
+	destinations := slices.Clone(p.cfg.Destinations)
+
+	if p.cfg.AutoDestinations > 0 {
+		auto, err := p.cfg.SelectDestinations(p.cfg.AutoDestinations)
+		if err != nil {
+			return nil, fmt.Errorf("unable to select probe "+
+				"destinations: %w", err)
+		}
+
+		for _, dest := range auto {
+			if dest == p.cfg.SelfNode ||
+				slices.Contains(destinations, dest) {
+
+				continue
+			}
+
+			destinations = append(destinations, dest)
+		}
+	}
+
+	if len(destinations) == 0 {
+		return nil, ErrNoProbeDestinations
+	}
+
+	return destinations, nil
+}
+
+// startProbe finds a route to the destination and adds the probe to the
+// history. The amount of the route is reserved from the in-flight budget.
+func (p *Prober) startProbe(ctx context.Context,
+	dest route.Vertex) (*ProbeResult, error) {
+
+	p.mu.Lock()
+	budget := p.cfg.MaxInFlight - p.inFlight
+	p.mu.Unlock()
+
+	if budget < p.cfg.Amount {
+		return nil, ErrProbeBudgetExceeded
+	}
+
+	restrictions := &RestrictParams{
+		ProbabilitySource: p.cfg.MissionControl.GetProbability,
+		FeeLimit:          budget - p.cfg.Amount,
+		CltvLimit:         math.MaxUint32,
+	}
+	req, err := NewRouteRequest(
+		p.cfg.SelfNode, &dest, p.cfg.Amount, 0, restrictions, nil,
+		nil, nil, MinCLTVDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	probe := &ProbeResult{
+		Destination: dest,
+		Amount:      p.cfg.Amount,
+		SendTime:    p.cfg.Clock.Now(),
+	}
+
+	probe.Route, _, err = p.cfg.FindRoute(ctx, req)
+	if err != nil {
+		probe.Err = err
+		probe.ResolveTime = probe.SendTime
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.lastID++
+	probe.ID = p.lastID
+
+	// Probes are only started from the probe loop, so the in-flight
+	// amount can't have grown since the budget was checked.
+	if probe.Route != nil {
+		p.inFlight += probe.Route.TotalAmount
+	}
+
+	p.addToHistory(probe)
+
+	return probe, nil
+}
+
+// sendProbe sends the probe along its route and waits for it to resolve.
+func (p *Prober) sendProbe(ctx context.Context, probe *ProbeResult) {
+	rt := probe.Route
+
+	log.Debugf("Sending probe %v of %v to %v", probe.ID, probe.Amount,
+		probe.Destination)
+
+	result, err := p.cfg.SendProbe(ctx, rt, p.cfg.MissionControl)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.inFlight -= rt.TotalAmount
+
+	probe.ResolveTime = p.cfg.Clock.Now()
+	if err != nil {
+		probe.Err = err
+
+		log.Debugf("Probe %v to %v failed: %v", probe.ID,
+			probe.Destination, err)
+
+		return
+	}
+
+	probe.Reached = result.Reached
+	probe.FailureSourceIdx = result.FailureSourceIdx
+	probe.FailureCode = result.FailureCode
+
+	log.Debugf("Probe %v to %v resolved: reached=%v", probe.ID,
+		probe.Destination, probe.Reached)
+}
+
+// addToHistory adds the probe to the history, evicting the oldest probe if
+// the history is full.
+//
+// NOTE: The caller must hold the mutex.
+func (p *Prober) addToHistory(probe *ProbeResult) {
+	if len(p.history) >= p.cfg.HistorySize {
+		p.history = slices.Delete(
+			p.history, 0, len(p.history)-p.cfg.HistorySize+1,
+		)
+	}
+
+	p.history = append(p.history, probe)
+}
+
+// History returns the probes in the history, oldest first.
+func (p *Prober) History() []*ProbeResult {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	history := make([]*ProbeResult, len(p.history))
+	for i, probe := range p.history {
+		probeCopy := *probe
+		history[i] = &probeCopy
+	}
+
+	return history
+}
+
+// InFlight returns the total amount of the probes that are in flight.
+func (p *Prober) InFlight() lnwire.MilliSatoshi {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.inFlight
+}
+
+// SendProbe sends an HTLC with a random payment hash along the given route and
+// waits for it to be failed. The result is recorded in the given mission
+// control. Unlike payments, probes aren't stored in the payment database.
+func (r *ChannelRouter) SendProbe(ctx context.Context, rt *route.Route,
+	mc MissionControlQuerier) (*ProbeResult, error) {
+
+	ctx, span := tracer.Start(ctx, "SendProbe", trace.WithAttributes(
+		attribute.Int64("amt_msat", int64(rt.TotalAmount)),
+		attribute.Int("hops", len(rt.Hops)),
+	))
+	defer span.End()
+
+	var hash lntypes.Hash
+	if _, err := rand.Read(hash[:]); err != nil {
+		return nil, err
+	}
+
+	sessionKey, err := generateNewSessionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptID, err := r.cfg.NextPaymentID()
+	if err != nil {
+		return nil, err
+	}
+
+	attempt, err := channeldb.NewHtlcAttempt(
+		attemptID, sessionKey, *rt, r.cfg.Clock.Now(), &hash,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	onionBlob, err := attempt.OnionBlob()
+	if err != nil {
+		return nil, err
+	}
+
+	htlcAdd := &lnwire.UpdateAddHTLC{
+		Amount:      rt.TotalAmount,
+		Expiry:      rt.TotalTimeLock,
+		PaymentHash: hash,
+		OnionBlob:   onionBlob,
+	}
+	firstHop := lnwire.NewShortChanIDFromInt(rt.Hops[0].ChannelID)
+
+	sendErr := r.cfg.Payer.SendHTLC(ctx, firstHop, attemptID, htlcAdd)
+	if sendErr == nil {
+		circuit, err := attempt.Circuit()
+		if err != nil {
+			return nil, err
+		}
+
+		errorDecryptor := &htlcswitch.SphinxErrorDecrypter{
+			OnionErrorDecrypter: sphinx.NewOnionErrorDecrypter(
+				circuit,
+			),
+		}
+
+		resultChan, err := r.cfg.Payer.GetAttemptResult(
+			attemptID, hash, errorDecryptor,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case result, ok := <-resultChan:
+			if !ok {
+				return nil, htlcswitch.ErrSwitchExiting
+			}
+
+			// A probe can't be settled without the preimage, but
+			// if it is, the route evidently worked.
+			if result.Error == nil {
+				err := mc.ReportPaymentSuccess(attemptID, rt)
+
+				return &ProbeResult{Reached: true}, err
+			}
+
+			sendErr = result.Error
+
+		case <-ctx.Done():
+			return nil, ctx.Err()
+
+		case <-r.quit:
+			return nil, ErrRouterShuttingDown
+		}
+	}
+
+	result := &ProbeResult{}
+
+	var srcIdx *int
+	var failure lnwire.FailureMessage
+
+	var rtErr htlcswitch.ClearTextError
+	switch {
+	case errors.Is(sendErr, htlcswitch.ErrUnreadableFailureMessage):
+
+	case errors.As(sendErr, &rtErr):
+		idx := 0
+		var fwdErr *htlcswitch.ForwardingError
+		if errors.As(rtErr, &fwdErr) {
+			idx = fwdErr.FailureSourceIdx
+		}
+		srcIdx = &idx
+		failure = rtErr.WireMessage()
+
+		if failure != nil {
+			result.FailureCode = failure.Code()
+			r.applyProbeChannelUpdate(rt, idx, failure)
+		}
+
+		_, incorrectDetails := failure.(*lnwire.FailIncorrectDetails)
+		result.Reached = idx == len(rt.Hops) && incorrectDetails
+
+	default:
+		return nil, sendErr
+	}
+
+	result.FailureSourceIdx = srcIdx
+
+	_, err = mc.ReportPaymentFail(attemptID, rt, srcIdx, failure)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// applyProbeChannelUpdate applies the channel update a probe failure carries,
+// if any.
+func (r *ChannelRouter) applyProbeChannelUpdate(rt *route.Route, srcIdx int,
+	failure lnwire.FailureMessage) {
+
+	// Our own channel updates are never applied.
+	if srcIdx == 0 {
+		return
+	}
+
+	update := r.extractChannelUpdate(failure)
+	if update == nil {
+		return
+	}
+
+	if !r.cfg.ApplyChannelUpdate(update) {
+		log.Debugf("Invalid channel update received: node=%v",
+			rt.Hops[srcIdx-1].PubKeyBytes)
+	}
+}
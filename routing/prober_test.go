@@ -0,0 +1,253 @@
+package routing
+
+import (
+	"context"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// proberTestContext holds a prober with mocked route finding and probe
+// sending.
+type proberTestContext struct {
+	prober *Prober
+
+	// unreachable is the destination no route is found to.
+	unreachable route.Vertex
+
+	// release resolves the probes in flight.
+	release chan struct{}
+
+	mu    sync.Mutex
+	sends []route.Vertex
+}
+
+func newProberTestContext(t *testing.T, dests []route.Vertex,
+	auto []route.Vertex) *proberTestContext {
+
+	ctx := &proberTestContext{
+		unreachable: route.Vertex{9},
+		release:     make(chan struct{}),
+	}
+
+	cfg := &ProberConfig{
+		SelfNode:         route.Vertex{1},
+		Destinations:     dests,
+		AutoDestinations: len(auto),
+		SelectDestinations: func(n int) ([]route.Vertex, error) {
+			return auto[:n], nil
+		},
+		Amount:         100000,
+		MaxInFlight:    250000,
+		Ticker:         ticker.NewForce(time.Hour),
+		HistorySize:    3,
+		MissionControl: &mockMissionControl{},
+		FindRoute: func(_ context.Context, req *RouteRequest) (
+			*route.Route, float64, error) {
+
+			if req.Target == ctx.unreachable {
+				return nil, 0, errNoPathFound
+			}
+
+			// Every route charges a fee of 1000 msat.
+			return &route.Route{
+				TotalAmount: req.Amount + 1000,
+				Hops: []*route.Hop{{
+					PubKeyBytes:  req.Target,
+					AmtToForward: req.Amount,
+				}},
+			}, 1, nil
+		},
+		SendProbe: func(_ context.Context, rt *route.Route,
+			_ MissionControlQuerier) (*ProbeResult, error) {
+
+			ctx.mu.Lock()
+			ctx.sends = append(ctx.sends, rt.Hops[0].PubKeyBytes)
+			ctx.mu.Unlock()
+
+			<-ctx.release
+
+			return &ProbeResult{Reached: true}, nil
+		},
+		Clock: clock.NewTestClock(time.Unix(1, 0)),
+	}
+
+	prober, err := NewProber(cfg)
+	require.NoError(t, err)
+	ctx.prober = prober
+
+	return ctx
+}
+
+// TestProberBudget tests that the prober cycles through its destinations and
+// stays within its in-flight budget.
+func TestProberBudget(t *testing.T) {
+	t.Parallel()
+
+	a, b := route.Vertex{2}, route.Vertex{3}
+	ctx := newProberTestContext(
+		t, []route.Vertex{a},
+		[]route.Vertex{route.Vertex{1}, route.Vertex{9}, b, a},
+	)
+	prober := ctx.prober
+
+	// The automatically chosen destinations are added after the
+	// configured ones, skipping ourselves and duplicates.
+	require.NoError(t, prober.probeNext(context.Background()))
+	require.Equal(
+		t, []route.Vertex{a, ctx.unreachable, b}, prober.destinations,
+	)
+
+	// A destination that can't be reached is resolved right away.
+	err := prober.probeNext(context.Background())
+	require.ErrorIs(t, err, errNoPathFound)
+
+	require.NoError(t, prober.probeNext(context.Background()))
+	require.Equal(t, lnwire.MilliSatoshi(202000), prober.InFlight())
+
+	// The next round starts, but the budget doesn't allow for a third
+	// probe.
+	err = prober.probeNext(context.Background())
+	require.ErrorIs(t, err, ErrProbeBudgetExceeded)
+
+	history := prober.History()
+	require.Len(t, history, 3)
+	require.True(t, history[0].InFlight())
+	require.Equal(t, ctx.unreachable, history[1].Destination)
+	require.False(t, history[1].InFlight())
+	require.ErrorIs(t, history[1].Err, errNoPathFound)
+	require.Equal(t, b, history[2].Destination)
+	require.True(t, history[2].InFlight())
+
+	// Once the probes resolve, the budget is released.
+	close(ctx.release)
+	prober.wg.Wait()
+
+	require.Zero(t, prober.InFlight())
+	history = prober.History()
+	require.False(t, history[0].InFlight())
+	require.True(t, history[0].Reached)
+
+	// The history is limited in size, so the next probe evicts the first
+	// one.
+	require.NoError(t, prober.probeNext(context.Background()))
+	prober.wg.Wait()
+
+	history = prober.History()
+	require.Len(t, history, 3)
+	require.Equal(t, uint64(2), history[0].ID)
+	require.Equal(t, uint64(4), history[2].ID)
+	require.Equal(t, a, history[2].Destination)
+}
+
+// TestProberSchedule tests that the prober sends a probe on every tick.
+func TestProberSchedule(t *testing.T) {
+	t.Parallel()
+
+	a := route.Vertex{2}
+	ctx := newProberTestContext(t, []route.Vertex{a}, nil)
+	close(ctx.release)
+
+	require.NoError(t, ctx.prober.Start())
+	t.Cleanup(func() {
+		require.NoError(t, ctx.prober.Stop())
+	})
+
+	force := ctx.prober.cfg.Ticker.(*ticker.Force)
+	for i := 0; i < 2; i++ {
+		force.Force <- time.Now()
+	}
+
+	require.Eventually(t, func() bool {
+		ctx.mu.Lock()
+		defer ctx.mu.Unlock()
+
+		return len(ctx.sends) == 2
+	}, time.Second, 10*time.Millisecond)
+	require.Equal(t, []route.Vertex{a, a}, ctx.sends)
+}
+
+// TestSendProbe tests that a probe is sent to the switch and that its result
+// is recorded in the given mission control.
+func TestSendProbe(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx := createTestCtxFromFile(t, startingBlockHeight, basicGraphFilePath)
+
+	target := ctx.aliases["sophon"]
+	restrictions := &RestrictParams{
+		FeeLimit:          noFeeLimit,
+		ProbabilitySource: noProbabilitySource,
+		CltvLimit:         math.MaxUint32,
+	}
+	req, err := NewRouteRequest(
+		ctx.router.cfg.SelfNode, &target,
+		lnwire.NewMSatFromSatoshis(1000), 0, restrictions, nil, nil,
+		nil, MinCLTVDelta,
+	)
+	require.NoError(t, err)
+
+	rt, _, err := ctx.router.FindRoute(context.Background(), req)
+	require.NoError(t, err)
+
+	payer, ok := ctx.router.cfg.Payer.(*mockPaymentAttemptDispatcherOld)
+	require.True(t, ok)
+
+	testCases := []struct {
+		name        string
+		failure     lnwire.FailureMessage
+		srcIdx      int
+		reached     bool
+		failureCode lnwire.FailCode
+	}{
+		{
+			name:        "destination reached",
+			failure:     &lnwire.FailIncorrectDetails{},
+			srcIdx:      len(rt.Hops),
+			reached:     true,
+			failureCode: lnwire.CodeIncorrectOrUnknownPaymentDetails,
+		},
+		{
+			name:        "failed at first hop",
+			failure:     &lnwire.FailTemporaryChannelFailure{},
+			srcIdx:      1,
+			failureCode: lnwire.CodeTemporaryChannelFailure,
+		},
+	}
+
+	for _, tc := range testCases {
+		payer.setPaymentResult(
+			func(lnwire.ShortChannelID) ([32]byte, error) {
+				return [32]byte{}, htlcswitch.NewForwardingError(
+					tc.failure, tc.srcIdx,
+				)
+			},
+		)
+
+		mc := &mockMissionControl{}
+		mc.On(
+			"ReportPaymentFail", mock.Anything, rt, &tc.srcIdx,
+			tc.failure,
+		).Return(nil, nil).Once()
+
+		result, err := ctx.router.SendProbe(
+			context.Background(), rt, mc,
+		)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.reached, result.Reached, tc.name)
+		require.Equal(t, tc.failureCode, result.FailureCode, tc.name)
+		require.Equal(t, tc.srcIdx, *result.FailureSourceIdx, tc.name)
+
+		mc.AssertExpectations(t)
+	}
+}
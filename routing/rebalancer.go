@@ -0,0 +1,684 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/subscribe"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultRebalanceInterval is the default time between two automatic
+	// rebalances.
+	DefaultRebalanceInterval = 10 * time.Minute
+
+	// DefaultRebalanceMaxAmount is the default maximum amount that is
+	// moved by a rebalance whose amount isn't given.
+	DefaultRebalanceMaxAmount = lnwire.MilliSatoshi(1_000_000_000)
+
+	// DefaultRebalanceMaxFeePPM is the default maximum fee of a rebalance
+	// in parts per million of the amount.
+	DefaultRebalanceMaxFeePPM = 500
+
+	// DefaultRebalanceMinLocalRatio is the default local balance ratio
+	// below which a channel is a rebalance destination.
+	DefaultRebalanceMinLocalRatio = 0.3
+
+	// DefaultRebalanceMaxLocalRatio is the default local balance ratio
+	// above which a channel is a rebalance source.
+	DefaultRebalanceMaxLocalRatio = 0.7
+
+	// RebalanceFinalCltvDelta is the final CLTV delta of the invoices that
+	// circular payments pay.
+	RebalanceFinalCltvDelta = 40
+)
+
+var (
+	// ErrNoRebalanceSource is returned when no channel has enough local
+	// balance to be the source of a rebalance.
+	ErrNoRebalanceSource = errors.New("no channel to rebalance from")
+
+	// ErrNoRebalanceDestination is returned when no channel lacks enough
+	// local balance to be the destination of a rebalance.
+	ErrNoRebalanceDestination = errors.New("no channel to rebalance to")
+
+	// ErrRebalanceInFlight is returned when a rebalance is requested for
+	// a channel that is already part of a rebalance in flight.
+	ErrRebalanceInFlight = errors.New("channel is already being " +
+		"rebalanced")
+)
+
+// RebalanceChannel describes one of our channels that can take part in a
+// rebalance.
+type RebalanceChannel struct {
+	// ChanID is the short channel ID of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Peer is the node at the other end of the channel.
+	Peer route.Vertex
+
+	// Capacity is the capacity of the channel.
+	Capacity btcutil.Amount
+
+	// LocalBalance is our balance in the channel.
+	LocalBalance lnwire.MilliSatoshi
+}
+
+// localRatio returns the share of the channel's capacity that is on our side.
+func (c *RebalanceChannel) localRatio() float64 {
+	capacity := lnwire.NewMSatFromSatoshis(c.Capacity)
+	if capacity == 0 {
+		return 0
+	}
+
+	return float64(c.LocalBalance) / float64(capacity)
+}
+
+// RebalanceRequest describes a rebalance to perform.
+type RebalanceRequest struct {
+	// OutgoingChanID is the channel to move liquidity out of. If not set,
+	// the channel with the highest local balance ratio above the maximum
+	// ratio is chosen.
+	OutgoingChanID fn.Option[lnwire.ShortChannelID]
+
+	// IncomingChanID is the channel to move liquidity into. If not set,
+	// the channel with the lowest local balance ratio below the minimum
+	// ratio is chosen.
+	IncomingChanID fn.Option[lnwire.ShortChannelID]
+
+	// Amount is the amount to move. If zero, the amount that brings both
+	// channels closest to an even balance is moved, up to the configured
+	// maximum amount.
+	Amount lnwire.MilliSatoshi
+
+	// MaxFeePPM is the maximum fee in parts per million of the amount. If
+	// zero, the configured maximum fee is used.
+	MaxFeePPM uint64
+
+	// DryRun indicates that a route is only searched for, but no payment
+	// is made.
+	DryRun bool
+}
+
+// RebalanceStatus describes the state of a rebalance.
+type RebalanceStatus uint8
+
+const (
+	// RebalanceStatusPlanned means that a route was found for a dry run.
+	RebalanceStatusPlanned RebalanceStatus = iota
+
+	// RebalanceStatusInFlight means that the circular payment was sent
+	// and is not resolved yet.
+	RebalanceStatusInFlight
+
+	// RebalanceStatusSucceeded means that the circular payment settled.
+	RebalanceStatusSucceeded
+
+	// RebalanceStatusFailed means that no route was found or that the
+	// circular payment failed.
+	RebalanceStatusFailed
+)
+
+// String returns a human-readable representation of the rebalance status.
+func (s RebalanceStatus) String() string {
+	switch s {
+	case RebalanceStatusPlanned:
+		return "planned"
+
+	case RebalanceStatusInFlight:
+		return "in_flight"
+
+	case RebalanceStatusSucceeded:
+		return "succeeded"
+
+	case RebalanceStatusFailed:
+		return "failed"
+
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(s))
+	}
+}
+
+// RebalanceResult describes a rebalance performed by the rebalancer. Updates
+// of it are sent to the subscribers whenever its status changes.
+type RebalanceResult struct {
+	// ID is the sequence number of the rebalance.
+	ID uint64
+
+	// OutgoingChanID is the channel liquidity is moved out of.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// IncomingChanID is the channel liquidity is moved into. This is the
+	// channel the route returns through, which may differ from the
+	// requested one if we have several channels with its peer.
+	IncomingChanID lnwire.ShortChannelID
+
+	// Amount is the amount that is moved.
+	Amount lnwire.MilliSatoshi
+
+	// DryRun is true if no payment is made.
+	DryRun bool
+
+	// Route is the circular route. It is nil if no route was found.
+	Route *route.Route
+
+	// PaymentHash is the hash of the circular payment. It is zero for dry
+	// runs and if no route was found.
+	PaymentHash lntypes.Hash
+
+	// Status is the status of the rebalance.
+	Status RebalanceStatus
+
+	// Err is set if the rebalance failed.
+	Err error
+
+	// StartTime is the time the rebalance was started.
+	StartTime time.Time
+
+	// ResolveTime is the time the rebalance was resolved. It is zero while
+	// the circular payment is in flight.
+	ResolveTime time.Time
+}
+
+// Fee returns the fee of the circular route.
+func (r *RebalanceResult) Fee() lnwire.MilliSatoshi {
+	if r.Route == nil {
+		return 0
+	}
+
+	return r.Route.TotalFees()
+}
+
+// RebalancerConfig holds the configuration of the rebalancer.
+type RebalancerConfig struct {
+	// SelfNode is our own node.
+	SelfNode route.Vertex
+
+	// Active enables the automatic rebalances. Rebalances can always be
+	// requested manually.
+	Active bool
+
+	// Ticker triggers the automatic rebalances.
+	Ticker ticker.Ticker
+
+	// MaxAmount is the maximum amount that is moved by rebalances whose
+	// amount isn't given.
+	MaxAmount lnwire.MilliSatoshi
+
+	// MaxFeePPM is the maximum fee of a rebalance in parts per million of
+	// the amount, unless the request sets its own.
+	MaxFeePPM uint64
+
+	// MinLocalRatio is the local balance ratio below which a channel is a
+	// rebalance destination.
+	MinLocalRatio float64
+
+	// MaxLocalRatio is the local balance ratio above which a channel is a
+	// rebalance source.
+	MaxLocalRatio float64
+
+	// FetchChannels returns our active channels.
+	FetchChannels func() ([]RebalanceChannel, error)
+
+	// MissionControl is the mission control the circular routes are found
+	// with.
+	MissionControl MissionControlQuerier
+
+	// FindRoute finds a circular route.
+	FindRoute func(context.Context, *RouteRequest) (*route.Route,
+		float64, error)
+
+	// AddInvoice adds an invoice over the given amount to our own node and
+	// returns its payment hash and payment address.
+	AddInvoice func(ctx context.Context, amt lnwire.MilliSatoshi,
+		finalCltvDelta uint16) (lntypes.Hash, [32]byte, error)
+
+	// CancelInvoice cancels the invoice of a failed circular payment.
+	CancelInvoice func(ctx context.Context, hash lntypes.Hash) error
+
+	// SendRebalance sends the circular payment along the given route and
+	// waits for it to resolve.
+	SendRebalance func(lntypes.Hash, *route.Route,
+		*channeldb.RebalanceInfo) (*channeldb.HTLCAttempt, error)
+
+	// Clock is used to timestamp rebalances.
+	Clock clock.Clock
+}
+
+// Validate checks that the rebalancer config is usable.
+func (c *RebalancerConfig) Validate() error {
+	if c.MaxAmount == 0 {
+		return errors.New("maximum rebalance amount must be positive")
+	}
+
+	if c.MinLocalRatio < 0 || c.MinLocalRatio >= c.MaxLocalRatio ||
+		c.MaxLocalRatio > 1 {
+
+		return fmt.Errorf("invalid local ratio range [%v, %v]",
+			c.MinLocalRatio, c.MaxLocalRatio)
+	}
+
+	return nil
+}
+
+// Rebalancer moves liquidity between our channels with circular payments. It
+// picks the channels to rebalance from their local balances, either on
+// request or periodically if active.
+type Rebalancer struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *RebalancerConfig
+
+	ntfnServer *subscribe.Server
+
+	// mu protects the fields below.
+	mu       sync.Mutex
+	lastID   uint64
+	inFlight map[lnwire.ShortChannelID]struct{}
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewRebalancer creates a new rebalancer.
+func NewRebalancer(cfg *RebalancerConfig) (*Rebalancer, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &Rebalancer{
+		cfg:        cfg,
+		ntfnServer: subscribe.NewServer(),
+		inFlight:   make(map[lnwire.ShortChannelID]struct{}),
+		quit:       make(chan struct{}),
+	}, nil
+}
+
+// Start starts the rebalancer and, if active, the automatic rebalances.
+func (r *Rebalancer) Start() error {
+	if !r.started.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Rebalancer starting")
+
+	if err := r.ntfnServer.Start(); err != nil {
+		return err
+	}
+
+	if r.cfg.Active {
+		r.cfg.Ticker.Resume()
+
+		r.wg.Add(1)
+		go r.rebalanceLoop()
+	}
+
+	return nil
+}
+
+// Stop stops the rebalancer. Circular payments that are in flight are
+// resolved by the router.
+func (r *Rebalancer) Stop() error {
+	if !r.stopped.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("Rebalancer shutting down...")
+	defer log.Debug("Rebalancer shutdown complete")
+
+	close(r.quit)
+	r.wg.Wait()
+
+	if r.cfg.Active {
+		r.cfg.Ticker.Stop()
+	}
+
+	return r.ntfnServer.Stop()
+}
+
+// SubscribeRebalances returns a client that receives an update of type
+// *RebalanceResult whenever a rebalance is started or resolved.
+func (r *Rebalancer) SubscribeRebalances() (*subscribe.Client, error) {
+	return r.ntfnServer.Subscribe()
+}
+
+// rebalanceLoop starts an automatic rebalance on every tick of the ticker.
+func (r *Rebalancer) rebalanceLoop() {
+	defer r.wg.Done()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for {
+		select {
+		case <-r.cfg.Ticker.Ticks():
+			result, err := r.Rebalance(ctx, &RebalanceRequest{})
+			switch {
+			case errors.Is(err, ErrNoRebalanceSource),
+				errors.Is(err, ErrNoRebalanceDestination):
+
+				log.Tracef("Nothing to rebalance: %v", err)
+
+			case err != nil:
+				log.Debugf("Unable to rebalance: %v", err)
+
+			case result.Err != nil:
+				log.Debugf("Rebalance %v failed: %v",
+					result.ID, result.Err)
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// Rebalance performs the requested rebalance and blocks until the circular
+// payment is resolved. An error is returned if the request can't be served,
+// while failures to find a route or to pay are reported in the result.
+func (r *Rebalancer) Rebalance(ctx context.Context,
+	req *RebalanceRequest) (*RebalanceResult, error) {
+
+	channels, err := r.cfg.FetchChannels()
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch channels: %w", err)
+	}
+
+	outgoing, incoming, err := r.selectChannels(channels, req)
+	if err != nil {
+		return nil, err
+	}
+
+	amt := req.Amount
+	if amt == 0 {
+		amt = r.balancingAmount(outgoing, incoming)
+	}
+	if amt == 0 {
+		return nil, fmt.Errorf("no liquidity to move from %v to %v",
+			outgoing.ChanID, incoming.ChanID)
+	}
+
+	feePPM := req.MaxFeePPM
+	if feePPM == 0 {
+		feePPM = r.cfg.MaxFeePPM
+	}
+
+	if err := r.reserve(outgoing.ChanID, incoming.ChanID); err != nil {
+		return nil, err
+	}
+	defer r.release(outgoing.ChanID, incoming.ChanID)
+
+	r.mu.Lock()
+	r.lastID++
+	result := &RebalanceResult{
+		ID:             r.lastID,
+		OutgoingChanID: outgoing.ChanID,
+		IncomingChanID: incoming.ChanID,
+		Amount:         amt,
+		DryRun:         req.DryRun,
+		StartTime:      r.cfg.Clock.Now(),
+	}
+	r.mu.Unlock()
+
+	log.Debugf("Rebalance %v: moving %v from %v to %v (dry_run=%v)",
+		result.ID, amt, outgoing.ChanID, incoming.ChanID, req.DryRun)
+
+	rt, err := r.findRoute(ctx, outgoing, incoming, amt, feePPM)
+	if err != nil {
+		return r.resolve(result, RebalanceStatusFailed, err), nil
+	}
+	result.Route = rt
+	result.IncomingChanID = lnwire.NewShortChanIDFromInt(
+		rt.Hops[len(rt.Hops)-1].ChannelID,
+	)
+
+	if req.DryRun {
+		return r.resolve(result, RebalanceStatusPlanned, nil), nil
+	}
+
+	hash, payAddr, err := r.cfg.AddInvoice(
+		ctx, amt, RebalanceFinalCltvDelta,
+	)
+	if err != nil {
+		err = fmt.Errorf("unable to add invoice: %w", err)
+		return r.resolve(result, RebalanceStatusFailed, err), nil
+	}
+	result.PaymentHash = hash
+
+	// The route was found for a placeholder payment address of the same
+	// size, so the real one can be filled in.
+	rt.Hops[len(rt.Hops)-1].MPP = record.NewMPP(amt, payAddr)
+
+	result.Status = RebalanceStatusInFlight
+	r.notify(result)
+
+	attempt, err := r.cfg.SendRebalance(hash, rt, &channeldb.RebalanceInfo{
+		OutgoingChanID: result.OutgoingChanID,
+		IncomingChanID: result.IncomingChanID,
+	})
+	if err == nil && attempt.Settle == nil {
+		err = errors.New("circular payment not settled")
+	}
+	if err != nil {
+		cancelErr := r.cfg.CancelInvoice(ctx, hash)
+		if cancelErr != nil {
+			log.Warnf("Unable to cancel invoice %v of rebalance "+
+				"%v: %v", hash, result.ID, cancelErr)
+		}
+
+		return r.resolve(result, RebalanceStatusFailed, err), nil
+	}
+
+	return r.resolve(result, RebalanceStatusSucceeded, nil), nil
+}
+
+// selectChannels returns the outgoing and incoming channel of the rebalance.
+// Channels that aren't given by the request are chosen by their local
+// balance ratio.
+func (r *Rebalancer) selectChannels(channels []RebalanceChannel,
+	req *RebalanceRequest) (*RebalanceChannel, *RebalanceChannel, error) {
+
+	var (
+		outgoing, incoming *RebalanceChannel
+		err                error
+	)
+	findChannel := func(chanID lnwire.ShortChannelID) *RebalanceChannel {
+		for i := range channels {
+			if channels[i].ChanID == chanID {
+				return &channels[i]
+			}
+		}
+
+		err = fmt.Errorf("channel %v not found", chanID)
+
+		return nil
+	}
+
+	req.OutgoingChanID.WhenSome(func(chanID lnwire.ShortChannelID) {
+		outgoing = findChannel(chanID)
+	})
+	req.IncomingChanID.WhenSome(func(chanID lnwire.ShortChannelID) {
+		incoming = findChannel(chanID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The two channels must lead to different peers, as a route can't
+	// leave through one peer and return from the same peer.
+	if outgoing == nil {
+		for i := range channels {
+			c := &channels[i]
+			if c.localRatio() <= r.cfg.MaxLocalRatio ||
+				(incoming != nil && c.Peer == incoming.Peer) {
+
+				continue
+			}
+
+			if outgoing == nil || c.localRatio() >
+				outgoing.localRatio() {
+
+				outgoing = c
+			}
+		}
+	}
+	if outgoing == nil {
+		return nil, nil, ErrNoRebalanceSource
+	}
+
+	if incoming == nil {
+		for i := range channels {
+			c := &channels[i]
+			if c.localRatio() >= r.cfg.MinLocalRatio ||
+				c.Peer == outgoing.Peer {
+
+				continue
+			}
+
+			if incoming == nil || c.localRatio() <
+				incoming.localRatio() {
+
+				incoming = c
+			}
+		}
+	}
+	if incoming == nil {
+		return nil, nil, ErrNoRebalanceDestination
+	}
+
+	if outgoing.Peer == incoming.Peer {
+		return nil, nil, errors.New("outgoing and incoming channel " +
+			"must have different peers")
+	}
+
+	return outgoing, incoming, nil
+}
+
+// balancingAmount returns the amount that brings the outgoing and incoming
+// channel closest to an even balance, capped by the maximum amount.
+func (r *Rebalancer) balancingAmount(outgoing,
+	incoming *RebalanceChannel) lnwire.MilliSatoshi {
+
+	halfOut := lnwire.NewMSatFromSatoshis(outgoing.Capacity) / 2
+	halfIn := lnwire.NewMSatFromSatoshis(incoming.Capacity) / 2
+
+	if outgoing.LocalBalance <= halfOut || incoming.LocalBalance >= halfIn {
+		return 0
+	}
+
+	return min(
+		outgoing.LocalBalance-halfOut, halfIn-incoming.LocalBalance,
+		r.cfg.MaxAmount,
+	)
+}
+
+// findRoute finds a route that leaves through the outgoing channel and returns
+// from the peer of the incoming channel.
+func (r *Rebalancer) findRoute(ctx context.Context, outgoing,
+	incoming *RebalanceChannel, amt lnwire.MilliSatoshi,
+	feePPM uint64) (*route.Route, error) {
+
+	var (
+		lastHop  = incoming.Peer
+		feeLimit = amt * lnwire.MilliSatoshi(feePPM) / 1_000_000
+	)
+	restrictions := &RestrictParams{
+		ProbabilitySource:  r.cfg.MissionControl.GetProbability,
+		FeeLimit:           feeLimit,
+		OutgoingChannelIDs: []uint64{outgoing.ChanID.ToUint64()},
+		LastHop:            &lastHop,
+		CltvLimit:          math.MaxUint32,
+
+		// A placeholder payment address makes path finding account
+		// for the size of the MPP record of the final hop.
+		PaymentAddr: fn.Some([32]byte{}),
+	}
+	req, err := NewRouteRequest(
+		r.cfg.SelfNode, &r.cfg.SelfNode, amt, 0, restrictions, nil,
+		nil, nil, RebalanceFinalCltvDelta,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, _, err := r.cfg.FindRoute(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return rt, nil
+}
+
+// reserve marks the channels as being rebalanced, or fails if one of them
+// already is.
+func (r *Rebalancer) reserve(chanIDs ...lnwire.ShortChannelID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, chanID := range chanIDs {
+		if _, ok := r.inFlight[chanID]; ok {
+			return fmt.Errorf("%w: %v", ErrRebalanceInFlight,
+				chanID)
+		}
+	}
+
+	for _, chanID := range chanIDs {
+		r.inFlight[chanID] = struct{}{}
+	}
+
+	return nil
+}
+
+// release unmarks the channels as being rebalanced.
+func (r *Rebalancer) release(chanIDs ...lnwire.ShortChannelID) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, chanID := range chanIDs {
+		delete(r.inFlight, chanID)
+	}
+}
+
+// resolve sets the final status of the rebalance and notifies the
+// subscribers.
+func (r *Rebalancer) resolve(result *RebalanceResult, status RebalanceStatus,
+	err error) *RebalanceResult {
+
+	result.Status = status
+	result.Err = err
+	result.ResolveTime = r.cfg.Clock.Now()
+
+	if err != nil {
+		log.Debugf("Rebalance %v failed: %v", result.ID, err)
+	} else {
+		log.Debugf("Rebalance %v resolved: status=%v, fee=%v",
+			result.ID, status, result.Fee())
+	}
+
+	r.notify(result)
+
+	return result
+}
+
+// notify sends a copy of the rebalance to the subscribers.
+func (r *Rebalancer) notify(result *RebalanceResult) {
+	update := *result
+	if err := r.ntfnServer.SendUpdate(&update); err != nil {
+		log.Warnf("Unable to send rebalance update: %v", err)
+	}
+}
@@ -0,0 +1,337 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/clock"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/ticker"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	rebalanceSelf = route.Vertex{1}
+
+	// rebalanceChannels are channels with a capacity of 1 BTC and local
+	// balance ratios of 0.9, 0.8, 0.5, 0.2 and 0.1, where the first and
+	// the last one lead to the same peer.
+	rebalanceChannels = []RebalanceChannel{
+		{
+			ChanID:       lnwire.NewShortChanIDFromInt(1),
+			Peer:         route.Vertex{2},
+			Capacity:     100_000_000,
+			LocalBalance: 90_000_000_000,
+		},
+		{
+			ChanID:       lnwire.NewShortChanIDFromInt(2),
+			Peer:         route.Vertex{3},
+			Capacity:     100_000_000,
+			LocalBalance: 80_000_000_000,
+		},
+		{
+			ChanID:       lnwire.NewShortChanIDFromInt(3),
+			Peer:         route.Vertex{4},
+			Capacity:     100_000_000,
+			LocalBalance: 50_000_000_000,
+		},
+		{
+			ChanID:       lnwire.NewShortChanIDFromInt(4),
+			Peer:         route.Vertex{5},
+			Capacity:     100_000_000,
+			LocalBalance: 20_000_000_000,
+		},
+		{
+			ChanID:       lnwire.NewShortChanIDFromInt(5),
+			Peer:         route.Vertex{2},
+			Capacity:     100_000_000,
+			LocalBalance: 10_000_000_000,
+		},
+	}
+)
+
+func newTestRebalancer(t *testing.T,
+	modify func(*RebalancerConfig)) *Rebalancer {
+
+	cfg := &RebalancerConfig{
+		SelfNode:      rebalanceSelf,
+		Ticker:        ticker.NewForce(time.Hour),
+		MaxAmount:     DefaultRebalanceMaxAmount,
+		MaxFeePPM:     DefaultRebalanceMaxFeePPM,
+		MinLocalRatio: DefaultRebalanceMinLocalRatio,
+		MaxLocalRatio: DefaultRebalanceMaxLocalRatio,
+		FetchChannels: func() ([]RebalanceChannel, error) {
+			return rebalanceChannels, nil
+		},
+		MissionControl: &mockMissionControl{},
+		Clock:          clock.NewTestClock(time.Unix(1, 0)),
+	}
+	if modify != nil {
+		modify(cfg)
+	}
+
+	rebalancer, err := NewRebalancer(cfg)
+	require.NoError(t, err)
+
+	require.NoError(t, rebalancer.Start())
+	t.Cleanup(func() {
+		require.NoError(t, rebalancer.Stop())
+	})
+
+	return rebalancer
+}
+
+// TestRebalancerSelectChannels tests that the channels to rebalance are chosen
+// by their local balance ratios.
+func TestRebalancerSelectChannels(t *testing.T) {
+	t.Parallel()
+
+	chanID := lnwire.NewShortChanIDFromInt
+
+	tests := []struct {
+		name             string
+		req              *RebalanceRequest
+		expectedOutgoing lnwire.ShortChannelID
+		expectedIncoming lnwire.ShortChannelID
+		expectedErr      error
+	}{
+		{
+			// The channel with the highest ratio and the one with
+			// the lowest ratio share a peer, so the second lowest
+			// ratio is used.
+			name:             "automatic",
+			req:              &RebalanceRequest{},
+			expectedOutgoing: chanID(1),
+			expectedIncoming: chanID(4),
+		},
+		{
+			name: "given incoming",
+			req: &RebalanceRequest{
+				IncomingChanID: fn.Some(chanID(5)),
+			},
+			expectedOutgoing: chanID(2),
+			expectedIncoming: chanID(5),
+		},
+		{
+			// A given channel doesn't need to exceed the ratio
+			// bounds.
+			name: "given outgoing",
+			req: &RebalanceRequest{
+				OutgoingChanID: fn.Some(chanID(3)),
+			},
+			expectedOutgoing: chanID(3),
+			expectedIncoming: chanID(5),
+		},
+		{
+			name: "same peer",
+			req: &RebalanceRequest{
+				OutgoingChanID: fn.Some(chanID(1)),
+				IncomingChanID: fn.Some(chanID(5)),
+			},
+			expectedErr: errors.New("outgoing and incoming " +
+				"channel must have different peers"),
+		},
+		{
+			name: "no destination",
+			req: &RebalanceRequest{
+				IncomingChanID: fn.Some(chanID(6)),
+			},
+			expectedErr: errors.New("channel 0:0:6 not found"),
+		},
+	}
+
+	rebalancer := newTestRebalancer(t, nil)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			outgoing, incoming, err := rebalancer.selectChannels(
+				rebalanceChannels, test.req,
+			)
+			if test.expectedErr != nil {
+				require.EqualError(
+					t, err, test.expectedErr.Error(),
+				)
+				return
+			}
+			require.NoError(t, err)
+
+			require.Equal(t, test.expectedOutgoing, outgoing.ChanID)
+			require.Equal(t, test.expectedIncoming, incoming.ChanID)
+		})
+	}
+
+	// Without imbalanced channels, there's nothing to rebalance.
+	_, _, err := rebalancer.selectChannels(
+		rebalanceChannels[2:3], &RebalanceRequest{},
+	)
+	require.ErrorIs(t, err, ErrNoRebalanceSource)
+
+	_, _, err = rebalancer.selectChannels(
+		rebalanceChannels[:3], &RebalanceRequest{},
+	)
+	require.ErrorIs(t, err, ErrNoRebalanceDestination)
+}
+
+// TestRebalancerRebalance tests that the rebalancer sends a circular payment
+// along the route it finds and reports its progress to the subscribers.
+func TestRebalancerRebalance(t *testing.T) {
+	t.Parallel()
+
+	var (
+		hash      = lntypes.Hash{1}
+		payAddr   = [32]byte{2}
+		sendErr   error
+		routeReq  *RouteRequest
+		sent      *route.Route
+		rebalance *channeldb.RebalanceInfo
+		invoices  []lnwire.MilliSatoshi
+		canceled  []lntypes.Hash
+	)
+	rebalancer := newTestRebalancer(t, func(cfg *RebalancerConfig) {
+		cfg.FindRoute = func(_ context.Context, req *RouteRequest) (
+			*route.Route, float64, error) {
+
+			routeReq = req
+
+			// The route charges a fee of 1000 msat.
+			return &route.Route{
+				TotalAmount: req.Amount + 1000,
+				Hops: []*route.Hop{
+					{
+						PubKeyBytes:  route.Vertex{3},
+						ChannelID:    2,
+						AmtToForward: req.Amount,
+					},
+					{
+						PubKeyBytes:  rebalanceSelf,
+						ChannelID:    4,
+						AmtToForward: req.Amount,
+					},
+				},
+			}, 1, nil
+		}
+		cfg.AddInvoice = func(_ context.Context,
+			amt lnwire.MilliSatoshi, _ uint16) (lntypes.Hash,
+			[32]byte, error) {
+
+			invoices = append(invoices, amt)
+
+			return hash, payAddr, nil
+		}
+		cfg.CancelInvoice = func(_ context.Context,
+			hash lntypes.Hash) error {
+
+			canceled = append(canceled, hash)
+
+			return nil
+		}
+		cfg.SendRebalance = func(_ lntypes.Hash, rt *route.Route,
+			info *channeldb.RebalanceInfo) (*channeldb.HTLCAttempt,
+			error) {
+
+			sent, rebalance = rt, info
+			if sendErr != nil {
+				return nil, sendErr
+			}
+
+			return &channeldb.HTLCAttempt{
+				Settle: &channeldb.HTLCSettleInfo{},
+			}, nil
+		}
+	})
+
+	client, err := rebalancer.SubscribeRebalances()
+	require.NoError(t, err)
+	defer client.Cancel()
+
+	nextUpdate := func() *RebalanceResult {
+		select {
+		case update := <-client.Updates():
+			return update.(*RebalanceResult)
+
+		case <-time.After(time.Second):
+			t.Fatal("no rebalance update")
+			return nil
+		}
+	}
+
+	// A dry run only finds a route. The amount balances both channels and
+	// the fee is limited relative to it.
+	result, err := rebalancer.Rebalance(
+		context.Background(), &RebalanceRequest{
+			DryRun:    true,
+			MaxFeePPM: 100,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, result.Err)
+	require.Equal(t, RebalanceStatusPlanned, result.Status)
+	require.Equal(t, lnwire.MilliSatoshi(1000), result.Fee())
+	require.Equal(t, DefaultRebalanceMaxAmount, result.Amount)
+	require.Empty(t, invoices)
+
+	require.Equal(t, rebalanceSelf, routeReq.Target)
+	require.Equal(t, []uint64{1}, routeReq.Restrictions.OutgoingChannelIDs)
+	require.Equal(t, route.Vertex{5}, *routeReq.Restrictions.LastHop)
+	require.Equal(
+		t, lnwire.MilliSatoshi(100_000), routeReq.Restrictions.FeeLimit,
+	)
+
+	require.Equal(t, RebalanceStatusPlanned, nextUpdate().Status)
+
+	// A real rebalance pays an invoice to ourselves and records the
+	// payment as a rebalance.
+	result, err = rebalancer.Rebalance(
+		context.Background(), &RebalanceRequest{
+			Amount: 5000,
+		},
+	)
+	require.NoError(t, err)
+	require.NoError(t, result.Err)
+	require.Equal(t, RebalanceStatusSucceeded, result.Status)
+	require.Equal(t, hash, result.PaymentHash)
+	require.Equal(t, []lnwire.MilliSatoshi{5000}, invoices)
+
+	finalHop := sent.Hops[len(sent.Hops)-1]
+	require.Equal(t, payAddr, finalHop.MPP.PaymentAddr())
+	require.Equal(t, lnwire.MilliSatoshi(5000), finalHop.MPP.TotalMsat())
+	require.Equal(t, &channeldb.RebalanceInfo{
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(4),
+	}, rebalance)
+
+	require.Equal(t, RebalanceStatusInFlight, nextUpdate().Status)
+	require.Equal(t, RebalanceStatusSucceeded, nextUpdate().Status)
+
+	// If the payment fails, the invoice is canceled.
+	sendErr = errors.New("payment failed")
+	result, err = rebalancer.Rebalance(
+		context.Background(), &RebalanceRequest{
+			Amount: 5000,
+		},
+	)
+	require.NoError(t, err)
+	require.ErrorIs(t, result.Err, sendErr)
+	require.Equal(t, RebalanceStatusFailed, result.Status)
+	require.Equal(t, []lntypes.Hash{hash}, canceled)
+
+	require.Equal(t, RebalanceStatusInFlight, nextUpdate().Status)
+	require.Equal(t, RebalanceStatusFailed, nextUpdate().Status)
+
+	// A channel can only be part of one rebalance at a time.
+	outgoing := lnwire.NewShortChanIDFromInt(1)
+	require.NoError(t, rebalancer.reserve(outgoing))
+	_, err = rebalancer.Rebalance(
+		context.Background(), &RebalanceRequest{},
+	)
+	require.ErrorIs(t, err, ErrRebalanceInFlight)
+	rebalancer.release(outgoing)
+}
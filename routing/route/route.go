@@ -39,6 +39,12 @@ var (
 	// record but no MPP record is presented for the final hop.
 	ErrAMPMissingMPP = errors.New("cannot send AMP without MPP record")
 
+	// ErrIntermediateTrampolineHop is returned when a hop tries to deliver
+	// trampoline instructions to an intermediate hop, only the final hop
+	// of the route is the trampoline node.
+	ErrIntermediateTrampolineHop = errors.New("cannot send trampoline " +
+		"to intermediate")
+
 	// ErrMissingField is returned if a required TLV is missing.
 	ErrMissingField = errors.New("required tlv missing")
 
@@ -183,6 +189,10 @@ type Hop struct {
 	// spread over more than one HTLC. This field should only be set for
 	// the final hop in a blinded path.
 	TotalAmtMsat lnwire.MilliSatoshi
+
+	// Trampoline holds the instructions for a trampoline node to forward
+	// the payment. This field should only be set for the final hop.
+	Trampoline *record.Trampoline
 }
 
 // Copy returns a deep copy of the Hop.
@@ -204,6 +214,11 @@ func (h *Hop) Copy() *Hop {
 		c.BlindingPoint = &b
 	}
 
+	if h.Trampoline != nil {
+		t := *h.Trampoline
+		c.Trampoline = &t
+	}
+
 	return &c
 }
 
@@ -329,6 +344,15 @@ func (h *Hop) PackHopPayload(w io.Writer, nextChanID uint64,
 		)
 	}
 
+	// Trampoline instructions are only ever addressed to the final hop.
+	if h.Trampoline != nil {
+		if !finalHop {
+			return ErrIntermediateTrampolineHop
+		}
+
+		records = append(records, h.Trampoline.Record())
+	}
+
 	// Append any custom types destined for this hop.
 	tlvRecords := tlv.MapToRecords(h.CustomRecords)
 	records = append(records, tlvRecords...)
@@ -471,6 +495,11 @@ func (h *Hop) PayloadSize(nextChanID uint64) uint64 {
 		)
 	}
 
+	// Add trampoline instructions if present.
+	if h.Trampoline != nil {
+		addRecord(record.TrampolineOnionType, h.Trampoline.PayloadSize())
+	}
+
 	// Add custom records.
 	for k, v := range h.CustomRecords {
 		addRecord(tlv.Type(k), uint64(len(v)))
@@ -342,6 +342,29 @@ func TestPayloadSize(t *testing.T) {
 		},
 	}
 
+	trampolineHops := []*Hop{
+		{
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1500,
+			OutgoingTimeLock: 700000,
+			ChannelID:        63584534844,
+		},
+		{
+			// Final hop is the trampoline node, which is told
+			// where to forward the payment to.
+			PubKeyBytes:      testPubKeyBytes,
+			AmtToForward:     1000,
+			OutgoingTimeLock: 700000,
+			ChannelID:        51784534844,
+			Trampoline: &record.Trampoline{
+				NextNode:     testPubKeyBytes,
+				AmtToForward: 900,
+				OutgoingCltv: 699000,
+				MPP:          record.NewMPP(900, [32]byte{}),
+			},
+		},
+	}
+
 	testCases := []struct {
 		name string
 		hops []*Hop
@@ -354,6 +377,10 @@ func TestPayloadSize(t *testing.T) {
 			name: "blinded route",
 			hops: blindedHops,
 		},
+		{
+			name: "trampoline route",
+			hops: trampolineHops,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -108,7 +108,7 @@ type PaymentAttemptDispatcher interface {
 	// forward a fully encoded payment to the first hop in the route
 	// denoted by its public key. A non-nil error is to be returned if the
 	// payment was unsuccessful.
-	SendHTLC(firstHop lnwire.ShortChannelID,
+	SendHTLC(ctx context.Context, firstHop lnwire.ShortChannelID,
 		attemptID uint64,
 		htlcAdd *lnwire.UpdateAddHTLC) error
 
@@ -184,6 +184,13 @@ type MissionControlQuerier interface {
 	// payment from fromNode along edge.
 	GetProbability(fromNode, toNode route.Vertex,
 		amt lnwire.MilliSatoshi, capacity btcutil.Amount) float64
+
+	// GetLiquidityBounds returns the amounts that we know we can and
+	// cannot send from fromNode to toNode over a channel of the given
+	// capacity.
+	GetLiquidityBounds(fromNode, toNode route.Vertex,
+		capacity btcutil.Amount) (lnwire.MilliSatoshi,
+		lnwire.MilliSatoshi)
 }
 
 // FeeSchema is the set fee configuration for a Lightning Node on the network.
@@ -513,8 +520,8 @@ func getTargetNode(target *route.Vertex,
 // FindRoute attempts to query the ChannelRouter for the optimum path to a
 // particular target destination to which it is able to send `amt` after
 // factoring in channel capacities and cumulative fees along the route.
-func (r *ChannelRouter) FindRoute(req *RouteRequest) (*route.Route, float64,
-	error) {
+func (r *ChannelRouter) FindRoute(ctx context.Context,
+	req *RouteRequest) (*route.Route, float64, error) {
 
 	log.Debugf("Searching for path to %v, sending %v", req.Target,
 		req.Amount)
@@ -536,28 +543,46 @@ func (r *ChannelRouter) FindRoute(req *RouteRequest) (*route.Route, float64,
 		return nil, 0, err
 	}
 
-	// Now that we know the destination is reachable within the graph, we'll
-	// execute our path finding algorithm.
-	finalHtlcExpiry := currentHeight + int32(req.FinalExpiry)
-
 	// Validate time preference.
 	timePref := req.TimePreference
 	if timePref < -1 || timePref > 1 {
 		return nil, 0, errors.New("time preference out of range")
 	}
 
+	route, _, probability, err := r.findRoute(
+		ctx, req, req.Restrictions, bandwidthHints, currentHeight,
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	go log.Tracef("Obtained path to send %v to %x: %v",
+		req.Amount, req.Target, lnutils.SpewLogClosure(route))
+
+	return route, probability, nil
+}
+
+// findRoute finds a path for the route request under the given restrictions
+// and turns it into a route with absolute time lock values. The edges of the
+// path are returned along with the route.
+func (r *ChannelRouter) findRoute(ctx context.Context, req *RouteRequest,
+	restrictions *RestrictParams, bandwidthHints bandwidthHints,
+	currentHeight int32) (*route.Route, []*unifiedEdge, float64, error) {
+
+	finalHtlcExpiry := currentHeight + int32(req.FinalExpiry)
+
 	path, probability, err := findPath(
-		&graphParams{
+		ctx, &graphParams{
 			additionalEdges: req.RouteHints,
 			bandwidthHints:  bandwidthHints,
 			graph:           r.cfg.RoutingGraph,
 		},
-		req.Restrictions, &r.cfg.PathFindingConfig,
+		restrictions, &r.cfg.PathFindingConfig,
 		r.cfg.SelfNode, req.Source, req.Target, req.Amount,
 		req.TimePreference, finalHtlcExpiry,
 	)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 
 	// Create the route with absolute time lock values.
@@ -571,13 +596,10 @@ func (r *ChannelRouter) FindRoute(req *RouteRequest) (*route.Route, float64,
 		}, req.BlindedPathSet,
 	)
 	if err != nil {
-		return nil, 0, err
+		return nil, nil, 0, err
 	}
 
-	go log.Tracef("Obtained path to send %v to %x: %v",
-		req.Amount, req.Target, lnutils.SpewLogClosure(route))
-
-	return route, probability, nil
+	return route, path, probability, nil
 }
 
 // probabilitySource defines the signature of a function that can be used to
@@ -848,6 +870,15 @@ type LightningPayment struct {
 	// Metadata is additional data that is sent along with the payment to
 	// the payee.
 	Metadata []byte
+
+	// PathFindingSource optionally proposes the paths of the payment
+	// instead of our own pathfinding.
+	PathFindingSource PathFindingSource
+
+	// Trampoline optionally routes the payment through a trampoline node,
+	// which is then the Target of the payment. The trampoline node
+	// forwards the payment to the destination in its instructions.
+	Trampoline *TrampolineOptions
 }
 
 // AMPOptions houses information that must be known in order to send an AMP
@@ -1040,7 +1071,7 @@ func (r *ChannelRouter) SendToRoute(htlcHash lntypes.Hash, rt *route.Route,
 	firstHopCustomRecords lnwire.CustomRecords) (*channeldb.HTLCAttempt,
 	error) {
 
-	return r.sendToRoute(htlcHash, rt, false, firstHopCustomRecords)
+	return r.sendToRoute(htlcHash, rt, false, firstHopCustomRecords, nil)
 }
 
 // SendToRouteSkipTempErr sends a payment using the provided route and fails
@@ -1050,7 +1081,23 @@ func (r *ChannelRouter) SendToRouteSkipTempErr(htlcHash lntypes.Hash,
 	firstHopCustomRecords lnwire.CustomRecords) (*channeldb.HTLCAttempt,
 	error) {
 
-	return r.sendToRoute(htlcHash, rt, true, firstHopCustomRecords)
+	return r.sendToRoute(htlcHash, rt, true, firstHopCustomRecords, nil)
+}
+
+// SendRebalance sends a circular payment using the provided route, which must
+// end at our own node, and records the payment as a rebalance between the
+// given channels. Just like SendToRoute, the payment is failed if the attempt
+// fails.
+func (r *ChannelRouter) SendRebalance(htlcHash lntypes.Hash, rt *route.Route,
+	rebalance *channeldb.RebalanceInfo) (*channeldb.HTLCAttempt, error) {
+
+	numHops := len(rt.Hops)
+	if numHops == 0 || rt.Hops[numHops-1].PubKeyBytes != r.cfg.SelfNode {
+		return nil, errors.New("rebalance route must end at our own " +
+			"node")
+	}
+
+	return r.sendToRoute(htlcHash, rt, false, nil, rebalance)
 }
 
 // sendToRoute attempts to send a payment with the given hash through the
@@ -1058,11 +1105,11 @@ func (r *ChannelRouter) SendToRouteSkipTempErr(htlcHash lntypes.Hash,
 // information as it is stored in the database. For a successful htlc, this
 // information will contain the preimage. If an error occurs after the attempt
 // was initiated, both return values will be non-nil. If skipTempErr is true,
-// the payment won't be failed unless a terminal error has occurred.
+// the payment won't be failed unless a terminal error has occurred. If the
+// rebalance info is set, the payment is recorded as a rebalance.
 func (r *ChannelRouter) sendToRoute(htlcHash lntypes.Hash, rt *route.Route,
-	skipTempErr bool,
-	firstHopCustomRecords lnwire.CustomRecords) (*channeldb.HTLCAttempt,
-	error) {
+	skipTempErr bool, firstHopCustomRecords lnwire.CustomRecords,
+	rebalance *channeldb.RebalanceInfo) (*channeldb.HTLCAttempt, error) {
 
 	// Helper function to fail a payment. It makes sure the payment is only
 	// failed once so that the failure reason is not overwritten.
@@ -1127,6 +1174,7 @@ func (r *ChannelRouter) sendToRoute(htlcHash lntypes.Hash, rt *route.Route,
 		CreationTime:          r.cfg.Clock.Now(),
 		PaymentRequest:        nil,
 		FirstHopCustomRecords: firstHopCustomRecords,
+		Rebalance:             rebalance,
 	}
 
 	err := r.cfg.Control.InitPayment(paymentIdentifier, info)
@@ -1182,7 +1230,7 @@ func (r *ChannelRouter) sendToRoute(htlcHash lntypes.Hash, rt *route.Route,
 	// the `err` returned here has already been processed by
 	// `handleSwitchErr`, which means if there's a terminal failure, the
 	// payment has been failed.
-	result, err := p.sendAttempt(attempt)
+	result, err := p.sendAttempt(context.TODO(), attempt)
 	if err != nil {
 		return nil, err
 	}
@@ -262,7 +262,7 @@ func TestFindRoutesWithFeeLimit(t *testing.T) {
 	)
 	require.NoError(t, err, "invalid route request")
 
-	route, _, err := ctx.router.FindRoute(req)
+	route, _, err := ctx.router.FindRoute(context.Background(), req)
 	require.NoError(t, err, "unable to find any routes")
 
 	require.Falsef(t,
@@ -2866,7 +2866,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 		paymentAmt, 0, noRestrictions, nil, nil, nil, MinCLTVDelta,
 	)
 	require.NoError(t, err, "invalid route request")
-	_, _, err = ctx.router.FindRoute(req)
+	_, _, err = ctx.router.FindRoute(context.Background(), req)
 	require.NoError(t, err, "unable to find any routes")
 
 	// Now check that we can update the node info for the partial node
@@ -2905,7 +2905,7 @@ func TestAddEdgeUnknownVertexes(t *testing.T) {
 	)
 	require.NoError(t, err, "invalid route request")
 
-	_, _, err = ctx.router.FindRoute(req)
+	_, _, err = ctx.router.FindRoute(context.Background(), req)
 	require.NoError(t, err, "unable to find any routes")
 
 	copy1, err := ctx.graph.FetchLightningNode(ctxb, pub1)
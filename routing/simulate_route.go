@@ -0,0 +1,232 @@
+package routing
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// MaxSimulatedRoutes is the maximum number of candidate routes a
+	// route simulation returns.
+	MaxSimulatedRoutes = 20
+)
+
+// SimulatedHop describes how a hop of a simulated route contributes to the
+// cost of the route.
+type SimulatedHop struct {
+	// Amount is the amount sent over the channel to the hop, which
+	// includes the fees of the hop and the hops after it.
+	Amount lnwire.MilliSatoshi
+
+	// Fee is the fee the hop charges for forwarding.
+	Fee lnwire.MilliSatoshi
+
+	// Probability is the estimated probability that the amount can be
+	// sent over the channel to the hop.
+	Probability float64
+}
+
+// SimulatedRoute is a candidate route of a route simulation.
+type SimulatedRoute struct {
+	// Route is the candidate route.
+	Route *route.Route
+
+	// Probability is the estimated success probability of the route.
+	Probability float64
+
+	// Hops holds the probability and fee of each hop of the route.
+	Hops []SimulatedHop
+
+	// Cost is the cost path finding ranks the route by, which weighs the
+	// fees of the route against its success probability.
+	Cost int64
+}
+
+// SimulateRoutes finds up to numRoutes candidate routes for the request,
+// ranked by the cost path finding assigns to them. The mission control state
+// the routes are evaluated against is the one behind the probability source
+// of the request's restrictions, which allows a sandboxed mission control to
+// be used.
+//
+// The best route is the one FindRoute returns. Further candidates are found by
+// excluding the node pairs of the candidates found so far one at a time, so
+// that each candidate deviates from a better one in at least one hop.
+func (r *ChannelRouter) SimulateRoutes(ctx context.Context, req *RouteRequest,
+	numRoutes uint32) ([]*SimulatedRoute, error) {
+
+	if numRoutes == 0 || numRoutes > MaxSimulatedRoutes {
+		return nil, fmt.Errorf("number of routes must be between 1 "+
+			"and %v", MaxSimulatedRoutes)
+	}
+
+	if req.TimePreference < -1 || req.TimePreference > 1 {
+		return nil, errors.New("time preference out of range")
+	}
+
+	ctx, span := tracer.Start(ctx, "SimulateRoutes", trace.WithAttributes(
+		attribute.String("target", req.Target.String()),
+		attribute.Int64("amt_msat", int64(req.Amount)),
+	))
+	defer span.End()
+
+	bandwidthHints, err := newBandwidthManager(
+		r.cfg.RoutingGraph, r.cfg.SelfNode, r.cfg.GetLink,
+		fn.None[tlv.Blob](), r.cfg.TrafficShaper,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	attemptCost, err := getAbsoluteAttemptCost(
+		&r.cfg.PathFindingConfig, req.Amount, req.TimePreference,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// simulate finds the best route that avoids the given node pairs.
+	type candidate struct {
+		excluded []DirectedNodePair
+		route    *SimulatedRoute
+	}
+	simulate := func(excluded []DirectedNodePair) (*candidate, error) {
+		restrictions := *req.Restrictions
+		probabilitySource := req.Restrictions.ProbabilitySource
+		restrictions.ProbabilitySource = func(fromNode,
+			toNode route.Vertex, amt lnwire.MilliSatoshi,
+			capacity btcutil.Amount) float64 {
+
+			pair := NewDirectedNodePair(fromNode, toNode)
+			if slices.Contains(excluded, pair) {
+				return 0
+			}
+
+			return probabilitySource(
+				fromNode, toNode, amt, capacity,
+			)
+		}
+
+		rt, path, probability, err := r.findRoute(
+			ctx, req, &restrictions, bandwidthHints,
+			currentHeight,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		simulated := &SimulatedRoute{
+			Route:       rt,
+			Probability: probability,
+			Hops:        make([]SimulatedHop, len(rt.Hops)),
+		}
+
+		fromNode := rt.SourcePubKey
+		amt := rt.TotalAmount
+		for i, hop := range rt.Hops {
+			simulated.Hops[i] = SimulatedHop{
+				Amount: amt,
+				Fee:    rt.HopFee(i),
+				Probability: probabilitySource(
+					fromNode, hop.PubKeyBytes, amt,
+					path[i].capacity,
+				),
+			}
+
+			fromNode = hop.PubKeyBytes
+			amt = hop.AmtToForward
+		}
+
+		simulated.Cost = getProbabilityBasedDist(
+			int64(rt.TotalFees()), probability, attemptCost,
+		)
+
+		return &candidate{excluded: excluded, route: simulated}, nil
+	}
+
+	best, err := simulate(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// routeKey identifies a route by the channels it uses.
+	routeKey := func(rt *route.Route) string {
+		var key strings.Builder
+		for _, hop := range rt.Hops {
+			fmt.Fprintf(&key, "%v:", hop.ChannelID)
+		}
+
+		return key.String()
+	}
+
+	seen := map[string]struct{}{routeKey(best.route.Route): {}}
+	queue := []*candidate{best}
+
+	var routes []*SimulatedRoute
+	for len(queue) > 0 && uint32(len(routes)) < numRoutes {
+		// Take the cheapest candidate from the queue.
+		next := 0
+		for i, c := range queue {
+			if c.route.Cost < queue[next].route.Cost {
+				next = i
+			}
+		}
+		c := queue[next]
+		queue = slices.Delete(queue, next, next+1)
+
+		routes = append(routes, c.route)
+
+		// Deviate from the candidate in each of its hops.
+		fromNode := c.route.Route.SourcePubKey
+		for _, hop := range c.route.Route.Hops {
+			pair := NewDirectedNodePair(fromNode, hop.PubKeyBytes)
+			fromNode = hop.PubKeyBytes
+
+			excluded := append(slices.Clone(c.excluded), pair)
+			deviation, err := simulate(excluded)
+
+			var noRouteErr noRouteError
+			switch {
+			case errors.As(err, &noRouteErr):
+				continue
+
+			case err != nil:
+				return nil, err
+			}
+
+			key := routeKey(deviation.route.Route)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			queue = append(queue, deviation)
+		}
+	}
+
+	// Path finding doesn't minimize the cost exactly, so a deviation may
+	// rank better than a candidate taken from the queue before it.
+	slices.SortStableFunc(routes, func(a, b *SimulatedRoute) int {
+		return cmp.Compare(a.Cost, b.Cost)
+	})
+
+	span.SetAttributes(attribute.Int("routes", len(routes)))
+
+	return routes, nil
+}
@@ -0,0 +1,97 @@
+package routing
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/stretchr/testify/require"
+)
+
+// TestSimulateRoutes tests that the candidate routes of a route simulation
+// are ranked by their cost and reflect the given probability source.
+func TestSimulateRoutes(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx := createTestCtxFromFile(t, startingBlockHeight, basicGraphFilePath)
+
+	// There are two routes from roasbeef to sophon, through songoku and
+	// through phamnuwen, of which the one through songoku is cheaper.
+	target := ctx.aliases["sophon"]
+	songoku := ctx.aliases["songoku"]
+	phamnuwen := ctx.aliases["phamnuwen"]
+
+	simulate := func(source probabilitySource,
+		numRoutes uint32) ([]*SimulatedRoute, error) {
+
+		restrictions := &RestrictParams{
+			FeeLimit:          noFeeLimit,
+			ProbabilitySource: source,
+			CltvLimit:         math.MaxUint32,
+		}
+
+		req, err := NewRouteRequest(
+			ctx.router.cfg.SelfNode, &target,
+			lnwire.NewMSatFromSatoshis(100), 0, restrictions, nil,
+			nil, nil, MinCLTVDelta,
+		)
+		require.NoError(t, err)
+
+		return ctx.router.SimulateRoutes(
+			context.Background(), req, numRoutes,
+		)
+	}
+
+	routes, err := simulate(noProbabilitySource, 5)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(routes), 2)
+	require.Equal(t, songoku, routes[0].Route.Hops[0].PubKeyBytes)
+	require.Equal(t, phamnuwen, routes[1].Route.Hops[0].PubKeyBytes)
+
+	for i, rt := range routes {
+		require.Len(t, rt.Hops, len(rt.Route.Hops))
+		require.Equal(t, rt.Route.TotalAmount, rt.Hops[0].Amount)
+		for j, hop := range rt.Hops {
+			require.Equal(t, rt.Route.HopFee(j), hop.Fee)
+			require.Equal(t, 1.0, hop.Probability)
+		}
+
+		if i > 0 {
+			require.LessOrEqual(t, routes[i-1].Cost, rt.Cost)
+		}
+	}
+
+	// The number of routes is limited to the requested number.
+	routes, err = simulate(noProbabilitySource, 1)
+	require.NoError(t, err)
+	require.Len(t, routes, 1)
+
+	_, err = simulate(noProbabilitySource, MaxSimulatedRoutes+1)
+	require.Error(t, err)
+
+	// With a high attempt cost, a low probability of the channel from
+	// songoku makes the route through phamnuwen the best one.
+	ctx.router.cfg.PathFindingConfig.AttemptCost =
+		lnwire.NewMSatFromSatoshis(1000)
+
+	lowProbability := func(fromNode, toNode route.Vertex,
+		_ lnwire.MilliSatoshi, _ btcutil.Amount) float64 {
+
+		if fromNode == songoku && toNode == target {
+			return 0.1
+		}
+
+		return 1
+	}
+	routes, err = simulate(lowProbability, 2)
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+	require.Equal(t, phamnuwen, routes[0].Route.Hops[0].PubKeyBytes)
+	require.Equal(t, songoku, routes[1].Route.Hops[0].PubKeyBytes)
+	require.Equal(t, 0.1, routes[1].Hops[1].Probability)
+	require.InDelta(t, 0.1, routes[1].Probability, 1e-9)
+}
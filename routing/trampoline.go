@@ -0,0 +1,557 @@
+package routing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/routing/shards"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// DefaultTrampolineFeeBase is the default base fee we charge for
+	// forwarding a payment as trampoline node.
+	DefaultTrampolineFeeBase = lnwire.MilliSatoshi(1000)
+
+	// DefaultTrampolineFeeRate is the default proportional fee in parts
+	// per million we charge for forwarding a payment as trampoline node.
+	DefaultTrampolineFeeRate = lnwire.MilliSatoshi(1000)
+
+	// DefaultTrampolineCltvDelta is the default time lock delta we keep
+	// for ourselves when forwarding a payment as trampoline node.
+	DefaultTrampolineCltvDelta = 144
+
+	// DefaultTrampolineMaxParts is the default number of parts we may
+	// split a payment into when forwarding it as trampoline node.
+	DefaultTrampolineMaxParts = 16
+
+	// DefaultTrampolinePaymentTimeout is the default time we try to
+	// forward a payment as trampoline node before giving up.
+	DefaultTrampolinePaymentTimeout = time.Minute
+)
+
+// TrampolineOptions describes how a payment is routed through a trampoline
+// node.
+type TrampolineOptions struct {
+	// Instructions are the instructions for the trampoline node. The
+	// outgoing cltv is derived from the time lock of each route.
+	Instructions record.Trampoline
+
+	// CltvDelta is the part of the final hop's time lock delta that is
+	// left to the trampoline node for its own delta and the route to the
+	// destination.
+	CltvDelta uint16
+}
+
+// record returns the trampoline instructions for a route whose final hop has
+// the given time lock.
+func (o *TrampolineOptions) record(finalTimeLock uint32) *record.Trampoline {
+	r := o.Instructions
+	r.OutgoingCltv = finalTimeLock - uint32(o.CltvDelta)
+
+	return &r
+}
+
+// RouteViaTrampoline retargets the payment to the given trampoline node. The
+// node is paid the fee on top of the payment amount and is left cltvDelta
+// blocks to forward the payment to the original target.
+func (l *LightningPayment) RouteViaTrampoline(node route.Vertex,
+	fee lnwire.MilliSatoshi, cltvDelta uint16) error {
+
+	switch {
+	case l.amp != nil:
+		return errors.New("AMP payments can't be sent via a " +
+			"trampoline node")
+
+	case l.BlindedPathSet != nil:
+		return errors.New("payments to blinded paths can't be sent " +
+			"via a trampoline node")
+
+	case len(l.DestCustomRecords) > 0:
+		return errors.New("custom records can't be sent via a " +
+			"trampoline node")
+
+	case node == l.Target:
+		return errors.New("trampoline node is the destination")
+	}
+
+	finalCltvDelta := uint32(l.FinalCLTVDelta) + uint32(cltvDelta)
+	if finalCltvDelta > uint32(^uint16(0)) {
+		return fmt.Errorf("final cltv delta %v exceeds maximum",
+			finalCltvDelta)
+	}
+
+	instructions := record.Trampoline{
+		NextNode:     l.Target,
+		AmtToForward: l.Amount,
+		Metadata:     l.Metadata,
+	}
+	l.PaymentAddr.WhenSome(func(addr [32]byte) {
+		instructions.MPP = record.NewMPP(l.Amount, addr)
+	})
+	if l.DestFeatures != nil {
+		instructions.Features = l.DestFeatures.RawFeatureVector.Clone()
+	}
+
+	for _, routeHint := range l.RouteHints {
+		hint := make([]record.TrampolineHopHint, 0, len(routeHint))
+		for _, hop := range routeHint {
+			var nodeID [33]byte
+			copy(nodeID[:], hop.NodeID.SerializeCompressed())
+
+			feeRate := hop.FeeProportionalMillionths
+			hint = append(hint, record.TrampolineHopHint{
+				NodeID:                    nodeID,
+				ChannelID:                 hop.ChannelID,
+				FeeBaseMSat:               hop.FeeBaseMSat,
+				FeeProportionalMillionths: feeRate,
+				CLTVExpiryDelta:           hop.CLTVExpiryDelta,
+			})
+		}
+
+		instructions.RouteHints = append(instructions.RouteHints, hint)
+	}
+
+	l.Trampoline = &TrampolineOptions{
+		Instructions: instructions,
+		CltvDelta:    cltvDelta,
+	}
+
+	// The details of the destination are now only known to the trampoline
+	// node, which is paid as a whole.
+	l.Target = node
+	l.Amount += fee
+	l.FinalCLTVDelta = uint16(finalCltvDelta)
+	l.PaymentAddr = fn.None[[32]byte]()
+	l.Metadata = nil
+	l.RouteHints = nil
+	l.DestFeatures = nil
+	l.MaxParts = 1
+	l.MaxShardAmt = nil
+
+	return nil
+}
+
+// TrampolineConfig holds the configuration of the trampoline forwarder.
+type TrampolineConfig struct {
+	// FeeBase is the base fee we charge for every trampoline payment.
+	FeeBase lnwire.MilliSatoshi
+
+	// FeeRate is the proportional fee in parts per million that we charge
+	// on the amount delivered to the next node.
+	FeeRate lnwire.MilliSatoshi
+
+	// CltvDelta is the time lock delta we keep for ourselves. The rest of
+	// the delta between the incoming htlc and the trampoline expiry is
+	// available to the route to the next node.
+	CltvDelta uint16
+
+	// MaxParts is the maximum number of parts the payment to the next
+	// node may be split into, if the next node supports it.
+	MaxParts uint32
+
+	// PayAttemptTimeout is the time we try to pay the next node before
+	// giving up.
+	PayAttemptTimeout time.Duration
+
+	// PreparePayment creates the payment session for a payment and
+	// registers it with the control tower.
+	PreparePayment func(*LightningPayment) (PaymentSession,
+		shards.ShardTracker, error)
+
+	// SendPaymentAsync sends a payment that was prepared with
+	// PreparePayment.
+	SendPaymentAsync func(context.Context, *LightningPayment,
+		PaymentSession, shards.ShardTracker)
+
+	// SubscribePayment subscribes to the updates of a payment.
+	SubscribePayment func(lntypes.Hash) (ControlTowerSubscriber, error)
+}
+
+// Validate checks that the trampoline config is sane.
+func (c *TrampolineConfig) Validate() error {
+	if c.CltvDelta == 0 {
+		return errors.New("trampoline cltv delta must be positive")
+	}
+
+	if c.MaxParts == 0 {
+		return errors.New("trampoline max parts must be positive")
+	}
+
+	if c.PayAttemptTimeout <= 0 {
+		return errors.New("trampoline payment timeout must be " +
+			"positive")
+	}
+
+	return nil
+}
+
+// TrampolineForwarder forwards the payments for which we were chosen as
+// trampoline node. The incoming htlc is held until the payment to the next
+// node completes, after which it's settled with the same preimage or failed.
+type TrampolineForwarder struct {
+	started atomic.Bool
+	stopped atomic.Bool
+
+	cfg *TrampolineConfig
+
+	// hodlSubscriptions tracks the links that are waiting on the
+	// resolution of their trampoline htlcs.
+	hodlSubscriptions map[chan<- interface{}]map[models.CircuitKey]struct{}
+
+	sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// A compile-time check to ensure TrampolineForwarder implements the
+// htlcswitch.TrampolineForwarder interface.
+var _ htlcswitch.TrampolineForwarder = (*TrampolineForwarder)(nil)
+
+// NewTrampolineForwarder creates a new trampoline forwarder.
+func NewTrampolineForwarder(cfg *TrampolineConfig) *TrampolineForwarder {
+	return &TrampolineForwarder{
+		cfg: cfg,
+		hodlSubscriptions: make(
+			map[chan<- interface{}]map[models.CircuitKey]struct{},
+		),
+		quit: make(chan struct{}),
+	}
+}
+
+// Start starts the trampoline forwarder.
+func (t *TrampolineForwarder) Start() error {
+	if !t.started.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("TrampolineForwarder starting")
+
+	return nil
+}
+
+// Stop stops the trampoline forwarder. Payments that are still in flight
+// continue in the router and are picked up again after a restart.
+func (t *TrampolineForwarder) Stop() error {
+	if !t.stopped.CompareAndSwap(false, true) {
+		return nil
+	}
+
+	log.Info("TrampolineForwarder shutting down...")
+	defer log.Debug("TrampolineForwarder shutdown complete")
+
+	close(t.quit)
+	t.wg.Wait()
+
+	return nil
+}
+
+// Fee returns the trampoline fee we charge for delivering the given amount to
+// the next node.
+func (t *TrampolineForwarder) Fee(
+	amt lnwire.MilliSatoshi) lnwire.MilliSatoshi {
+
+	return t.cfg.FeeBase + amt*t.cfg.FeeRate/1_000_000
+}
+
+// NotifyTrampolineHtlc forwards the htlc to the next node of the trampoline
+// instructions.
+//
+// NOTE: Part of the htlcswitch.TrampolineForwarder interface.
+func (t *TrampolineForwarder) NotifyTrampolineHtlc(payHash lntypes.Hash,
+	amt lnwire.MilliSatoshi, expiry uint32, currentHeight int32,
+	circuitKey models.CircuitKey, hodlChan chan<- interface{},
+	trampoline *record.Trampoline) (invoices.HtlcResolution, error) {
+
+	height := uint32(currentHeight)
+
+	payment, failure := t.newPayment(payHash, amt, expiry, height,
+		trampoline)
+	if failure != nil {
+		log.Debugf("Rejecting trampoline htlc %v: %v", circuitKey,
+			failure)
+
+		return htlcswitch.NewTrampolineFailResolution(
+			circuitKey, failure,
+		), nil
+	}
+
+	paySession, shardTracker, err := t.cfg.PreparePayment(payment)
+	switch {
+	// If we already forwarded this payment before a restart, the router
+	// has resumed it and we only need to wait for its result.
+	case errors.Is(err, channeldb.ErrPaymentInFlight),
+		errors.Is(err, channeldb.ErrPaymentExists),
+		errors.Is(err, channeldb.ErrAlreadyPaid):
+
+		log.Debugf("Resuming trampoline payment %v for htlc %v",
+			payHash, circuitKey)
+
+	case err != nil:
+		log.Errorf("Unable to prepare trampoline payment %v: %v",
+			payHash, err)
+
+		return htlcswitch.NewTrampolineFailResolution(
+			circuitKey, &lnwire.FailTemporaryNodeFailure{},
+		), nil
+
+	default:
+		log.Infof("Forwarding trampoline payment %v of %v to %x",
+			payHash, trampoline.AmtToForward, trampoline.NextNode)
+
+		t.cfg.SendPaymentAsync(
+			context.Background(), payment, paySession, shardTracker,
+		)
+	}
+
+	sub, err := t.cfg.SubscribePayment(payHash)
+	if err != nil {
+		return nil, fmt.Errorf("unable to subscribe to trampoline "+
+			"payment %v: %w", payHash, err)
+	}
+
+	t.Lock()
+	subscriptions, ok := t.hodlSubscriptions[hodlChan]
+	if !ok {
+		subscriptions = make(map[models.CircuitKey]struct{})
+		t.hodlSubscriptions[hodlChan] = subscriptions
+	}
+	subscriptions[circuitKey] = struct{}{}
+	t.Unlock()
+
+	t.wg.Add(1)
+	go t.waitForPayment(sub, amt, currentHeight, circuitKey, hodlChan)
+
+	return nil, nil
+}
+
+// newPayment checks that the incoming htlc leaves enough fee and time lock
+// delta for the trampoline instructions and returns the payment to the next
+// node. If the htlc can't be forwarded, the failure to send back is returned
+// instead.
+func (t *TrampolineForwarder) newPayment(payHash lntypes.Hash,
+	amt lnwire.MilliSatoshi, expiry, height uint32,
+	trampoline *record.Trampoline) (*LightningPayment,
+	lnwire.FailureMessage) {
+
+	invalidPayload := lnwire.NewInvalidOnionPayload(
+		uint64(record.TrampolineOnionType), 0,
+	)
+
+	// We forward the payment as a whole, so the next node must expect
+	// exactly the amount we deliver.
+	mpp := trampoline.MPP
+	if mpp != nil && mpp.TotalMsat() != trampoline.AmtToForward {
+		return nil, invalidPayload
+	}
+
+	// The htlc needs to cover our own fee, the rest of it is available as
+	// fee budget for the route to the next node.
+	minAmt := trampoline.AmtToForward + t.Fee(trampoline.AmtToForward)
+	if amt < minAmt {
+		return nil, &lnwire.FailTrampolineFeeInsufficient{}
+	}
+
+	// Likewise, we keep our own delta and make the rest available to the
+	// route. The final hop of the route gets the requested expiry, which
+	// includes the block padding that the router adds.
+	minExpiry := trampoline.OutgoingCltv + uint32(t.cfg.CltvDelta)
+	if expiry < minExpiry ||
+		trampoline.OutgoingCltv <= height+uint32(BlockPadding) {
+
+		return nil, &lnwire.FailTrampolineExpiryTooSoon{}
+	}
+
+	finalCltvDelta := trampoline.OutgoingCltv - height -
+		uint32(BlockPadding)
+	if finalCltvDelta > uint32(^uint16(0)) {
+		return nil, &lnwire.FailExpiryTooFar{}
+	}
+
+	routeHints, err := unmarshallTrampolineRouteHints(
+		trampoline.RouteHints,
+	)
+	if err != nil {
+		return nil, invalidPayload
+	}
+
+	payment := &LightningPayment{
+		Target:            route.Vertex(trampoline.NextNode),
+		Amount:            trampoline.AmtToForward,
+		FeeLimit:          amt - minAmt,
+		CltvLimit:         expiry - uint32(t.cfg.CltvDelta) - height,
+		FinalCLTVDelta:    uint16(finalCltvDelta),
+		PayAttemptTimeout: t.cfg.PayAttemptTimeout,
+		RouteHints:        routeHints,
+		Metadata:          trampoline.Metadata,
+		MaxParts:          1,
+	}
+
+	if trampoline.Features != nil {
+		payment.DestFeatures = lnwire.NewFeatureVector(
+			trampoline.Features, lnwire.Features,
+		)
+	}
+
+	if mpp != nil {
+		payment.PaymentAddr = fn.Some(mpp.PaymentAddr())
+		payment.MaxParts = t.cfg.MaxParts
+	}
+
+	if err := payment.SetPaymentHash(payHash); err != nil {
+		return nil, &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return payment, nil
+}
+
+// unmarshallTrampolineRouteHints converts the route hints of the trampoline
+// instructions into the route hints used by the router.
+func unmarshallTrampolineRouteHints(
+	hints [][]record.TrampolineHopHint) ([][]zpay32.HopHint, error) {
+
+	routeHints := make([][]zpay32.HopHint, 0, len(hints))
+	for _, hint := range hints {
+		routeHint := make([]zpay32.HopHint, 0, len(hint))
+		for _, hop := range hint {
+			nodeID, err := btcec.ParsePubKey(hop.NodeID[:])
+			if err != nil {
+				return nil, err
+			}
+
+			feeRate := hop.FeeProportionalMillionths
+			routeHint = append(routeHint, zpay32.HopHint{
+				NodeID:                    nodeID,
+				ChannelID:                 hop.ChannelID,
+				FeeBaseMSat:               hop.FeeBaseMSat,
+				FeeProportionalMillionths: feeRate,
+				CLTVExpiryDelta:           hop.CLTVExpiryDelta,
+			})
+		}
+
+		routeHints = append(routeHints, routeHint)
+	}
+
+	return routeHints, nil
+}
+
+// waitForPayment waits for the payment to the next node to complete and
+// resolves the incoming htlc accordingly.
+func (t *TrampolineForwarder) waitForPayment(sub ControlTowerSubscriber,
+	amt lnwire.MilliSatoshi, currentHeight int32,
+	circuitKey models.CircuitKey, hodlChan chan<- interface{}) {
+
+	defer t.wg.Done()
+	defer sub.Close()
+
+	for {
+		select {
+		case item, ok := <-sub.Updates():
+			if !ok {
+				return
+			}
+
+			payment, ok := item.(*channeldb.MPPayment)
+			if !ok || !payment.Terminated() {
+				continue
+			}
+
+			resolution := trampolineResolution(
+				payment, amt, currentHeight, circuitKey,
+			)
+			t.notifyHodlSubscriber(hodlChan, resolution)
+
+			return
+
+		case <-t.quit:
+			return
+		}
+	}
+}
+
+// trampolineResolution returns the resolution of the incoming htlc for the
+// terminated payment to the next node.
+func trampolineResolution(payment *channeldb.MPPayment,
+	amt lnwire.MilliSatoshi, currentHeight int32,
+	circuitKey models.CircuitKey) invoices.HtlcResolution {
+
+	settle, reason := payment.TerminalInfo()
+	if settle != nil {
+		return invoices.NewSettleResolution(
+			settle.Settle.Preimage, circuitKey, currentHeight,
+			invoices.ResultSettled,
+		)
+	}
+
+	var failure lnwire.FailureMessage
+	switch {
+	// If the next node rejected the payment, we pass that on to the
+	// sender.
+	case reason != nil && *reason == channeldb.FailureReasonPaymentDetails:
+		failure = lnwire.NewFailIncorrectDetails(
+			amt, uint32(currentHeight),
+		)
+
+	// If we couldn't find a route within the fee budget, the sender may
+	// retry with a higher fee.
+	case reason != nil && *reason == channeldb.FailureReasonNoRoute:
+		failure = &lnwire.FailTrampolineFeeInsufficient{}
+
+	default:
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	}
+
+	return htlcswitch.NewTrampolineFailResolution(circuitKey, failure)
+}
+
+// notifyHodlSubscriber sends the resolution to the link that holds the htlc,
+// unless it unsubscribed in the meantime.
+func (t *TrampolineForwarder) notifyHodlSubscriber(
+	hodlChan chan<- interface{}, resolution invoices.HtlcResolution) {
+
+	t.Lock()
+	defer t.Unlock()
+
+	subscriptions, ok := t.hodlSubscriptions[hodlChan]
+	if !ok {
+		return
+	}
+
+	circuitKey := resolution.CircuitKey()
+	if _, ok := subscriptions[circuitKey]; !ok {
+		return
+	}
+	delete(subscriptions, circuitKey)
+
+	select {
+	case hodlChan <- resolution:
+	case <-t.quit:
+	}
+}
+
+// HodlUnsubscribeAll cancels the subscription of the link that is stopping.
+//
+// NOTE: Part of the htlcswitch.TrampolineForwarder interface.
+func (t *TrampolineForwarder) HodlUnsubscribeAll(
+	subscriber chan<- interface{}) {
+
+	t.Lock()
+	defer t.Unlock()
+
+	delete(t.hodlSubscriptions, subscriber)
+}
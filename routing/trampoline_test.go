@@ -0,0 +1,220 @@
+package routing
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/fn/v2"
+	"github.com/lightningnetwork/lnd/graph/db/models"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/record"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/routing/shards"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPaymentSubscriber is a ControlTowerSubscriber that delivers the updates
+// it's given.
+type mockPaymentSubscriber struct {
+	updates chan interface{}
+}
+
+func (s *mockPaymentSubscriber) Updates() <-chan interface{} {
+	return s.updates
+}
+
+func (s *mockPaymentSubscriber) Close() {}
+
+// TestTrampolineForwarder tests that the trampoline forwarder validates the
+// incoming htlc, forwards the payment and resolves the htlc with its result.
+func TestTrampolineForwarder(t *testing.T) {
+	t.Parallel()
+
+	const height = 100
+
+	var (
+		preimage = lntypes.Preimage{1}
+		hash     = preimage.Hash()
+		next     = route.Vertex{2}
+		sub      = &mockPaymentSubscriber{
+			updates: make(chan interface{}, 1),
+		}
+		prepared []*LightningPayment
+		sent     int
+		prepErr  error
+	)
+
+	forwarder := NewTrampolineForwarder(&TrampolineConfig{
+		FeeBase:   1000,
+		FeeRate:   1000,
+		CltvDelta: 40,
+		MaxParts:  DefaultTrampolineMaxParts,
+		PreparePayment: func(p *LightningPayment) (PaymentSession,
+			shards.ShardTracker, error) {
+
+			prepared = append(prepared, p)
+
+			return nil, nil, prepErr
+		},
+		SendPaymentAsync: func(context.Context, *LightningPayment,
+			PaymentSession, shards.ShardTracker) {
+
+			sent++
+		},
+		SubscribePayment: func(lntypes.Hash) (ControlTowerSubscriber,
+			error) {
+
+			return sub, nil
+		},
+	})
+	require.NoError(t, forwarder.Start())
+	t.Cleanup(func() {
+		require.NoError(t, forwarder.Stop())
+	})
+
+	trampoline := &record.Trampoline{
+		NextNode:     next,
+		AmtToForward: 100_000,
+		OutgoingCltv: height + 50,
+		MPP:          record.NewMPP(100_000, [32]byte{1}),
+	}
+	hodlChan := make(chan interface{}, 1)
+
+	// The trampoline fee is 1100 msat, so an htlc that only leaves 1000
+	// msat is rejected.
+	circuitKey := models.CircuitKey{HtlcID: 1}
+	res, err := forwarder.NotifyTrampolineHtlc(
+		hash, 101_000, height+200, height, circuitKey, hodlChan,
+		trampoline,
+	)
+	require.NoError(t, err)
+	require.IsType(t, &htlcswitch.TrampolineFailResolution{}, res)
+	require.IsType(
+		t, &lnwire.FailTrampolineFeeInsufficient{},
+		res.(*htlcswitch.TrampolineFailResolution).Failure,
+	)
+
+	// An expiry that doesn't leave us our delta is rejected as well.
+	res, err = forwarder.NotifyTrampolineHtlc(
+		hash, 110_000, height+80, height, circuitKey, hodlChan,
+		trampoline,
+	)
+	require.NoError(t, err)
+	require.IsType(
+		t, &lnwire.FailTrampolineExpiryTooSoon{},
+		res.(*htlcswitch.TrampolineFailResolution).Failure,
+	)
+	require.Empty(t, prepared)
+
+	// A valid htlc is forwarded with the remaining fee as budget.
+	res, err = forwarder.NotifyTrampolineHtlc(
+		hash, 110_000, height+200, height, circuitKey, hodlChan,
+		trampoline,
+	)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, sent)
+
+	require.Len(t, prepared, 1)
+	payment := prepared[0]
+	require.Equal(t, next, payment.Target)
+	require.Equal(t, trampoline.AmtToForward, payment.Amount)
+	require.Equal(t, lnwire.MilliSatoshi(8_900), payment.FeeLimit)
+	require.Equal(t, uint32(160), payment.CltvLimit)
+	require.Equal(t, 50-BlockPadding, payment.FinalCLTVDelta)
+	require.Equal(t, [32]byte(hash), payment.Identifier())
+	require.Equal(t, uint32(DefaultTrampolineMaxParts), payment.MaxParts)
+
+	// Once the payment succeeds, the htlc is settled with its preimage.
+	sub.updates <- &channeldb.MPPayment{
+		Status: channeldb.StatusSucceeded,
+		HTLCs: []channeldb.HTLCAttempt{{
+			Settle: &channeldb.HTLCSettleInfo{Preimage: preimage},
+		}},
+	}
+
+	select {
+	case item := <-hodlChan:
+		settle, ok := item.(*invoices.HtlcSettleResolution)
+		require.True(t, ok)
+		require.Equal(t, preimage, settle.Preimage)
+		require.Equal(t, circuitKey, settle.CircuitKey())
+
+	case <-time.After(time.Second):
+		t.Fatal("htlc not resolved")
+	}
+
+	// After a restart, the payment already exists, so we only wait for
+	// its result.
+	prepErr = channeldb.ErrPaymentInFlight
+	res, err = forwarder.NotifyTrampolineHtlc(
+		hash, 110_000, height+200, height, circuitKey, hodlChan,
+		trampoline,
+	)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, 1, sent)
+
+	reason := channeldb.FailureReasonNoRoute
+	sub.updates <- &channeldb.MPPayment{
+		Status:        channeldb.StatusFailed,
+		FailureReason: &reason,
+	}
+
+	select {
+	case item := <-hodlChan:
+		fail, ok := item.(*htlcswitch.TrampolineFailResolution)
+		require.True(t, ok)
+		require.IsType(
+			t, &lnwire.FailTrampolineFeeInsufficient{},
+			fail.Failure,
+		)
+
+	case <-time.After(time.Second):
+		t.Fatal("htlc not resolved")
+	}
+}
+
+// TestRouteViaTrampoline tests that a payment sent through a trampoline node
+// is retargeted to it and that its final hop carries the instructions.
+func TestRouteViaTrampoline(t *testing.T) {
+	t.Parallel()
+
+	var (
+		dest           = route.Vertex{2}
+		trampolineNode = route.Vertex{3}
+	)
+
+	payment := &LightningPayment{
+		Target:         dest,
+		Amount:         100_000,
+		FinalCLTVDelta: 40,
+		PaymentAddr:    fn.Some([32]byte{1}),
+		MaxParts:       16,
+	}
+	require.NoError(t, payment.RouteViaTrampoline(
+		trampolineNode, 2_000, 500,
+	))
+
+	require.Equal(t, trampolineNode, payment.Target)
+	require.Equal(t, lnwire.MilliSatoshi(102_000), payment.Amount)
+	require.Equal(t, uint16(540), payment.FinalCLTVDelta)
+	require.True(t, payment.PaymentAddr.IsNone())
+	require.Equal(t, uint32(1), payment.MaxParts)
+
+	// The trampoline node is left its delta to forward the payment.
+	instructions := payment.Trampoline.record(1000)
+	require.Equal(t, uint32(500), instructions.OutgoingCltv)
+	require.Equal(t, dest, route.Vertex(instructions.NextNode))
+	require.EqualValues(t, 100_000, instructions.AmtToForward)
+	require.Equal(t, [32]byte{1}, instructions.MPP.PaymentAddr())
+
+	// A payment can't be sent through its own destination.
+	payment = &LightningPayment{Target: dest}
+	require.Error(t, payment.RouteViaTrampoline(dest, 0, 500))
+}
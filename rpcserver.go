@@ -35,6 +35,7 @@ import (
 	"github.com/btcsuite/btcwallet/wallet/txauthor"
 	"github.com/davecgh/go-spew/spew"
 	proxy "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	sphinx "github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/build"
 	"github.com/lightningnetwork/lnd/chainreg"
@@ -69,8 +70,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chancloser"
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/dyncomm"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/macaroons"
+	"github.com/lightningnetwork/lnd/onionmessage"
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/record"
@@ -342,6 +345,45 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/SpliceIn": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SpliceOut": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ModifyChannelParams": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BumpChannelOpenFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListLeaseOffers": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/BuyLiquidity": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/GetInfo": {{
 			Entity: "info",
 			Action: "read",
@@ -569,6 +611,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/SendOnionMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeOnionMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/LookupHtlcResolution": {{
 			Entity: "offchain",
 			Action: "read",
@@ -731,6 +781,7 @@ func (r *rpcServer) addDeps(ctx context.Context, s *server,
 			return info.NodeKey1Bytes, info.NodeKey2Bytes, nil
 		},
 		FindRoute:              s.chanRouter.FindRoute,
+		SimulateRoutes:         s.chanRouter.SimulateRoutes,
 		MissionControl:         s.defaultMC,
 		ActiveNetParams:        r.cfg.ActiveNetParams.Params,
 		Tower:                  s.controlTower,
@@ -769,7 +820,21 @@ func (r *rpcServer) addDeps(ctx context.Context, s *server,
 				EndorsementExperimentEnd,
 			)
 		},
+		PathFindingSources: routing.NewPathFindingSourceRegistry(),
+		NewMissionControlSandbox: func(
+			override *routing.MissionControlSnapshot,
+			fresh bool) routerrpc.MissionControl {
+
+			return s.defaultMC.NewSandbox(override, fresh)
+		},
+	}
+
+	// Only expose the prober if it's active, so that the interface isn't
+	// set to a nil pointer.
+	if s.prober != nil {
+		routerBackend.Prober = s.prober
 	}
+	routerBackend.Rebalancer = s.rebalancer
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
 		return s.featureMgr.Get(feature.SetInvoice)
@@ -793,11 +858,12 @@ func (r *rpcServer) addDeps(ctx context.Context, s *server,
 	//
 	// TODO(roasbeef): extend sub-sever config to have both (local vs remote) DB
 	err = subServerCgs.PopulateDependencies(
-		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, invoiceRegistry,
-		s.htlcSwitch, r.cfg.ActiveNetParams.Params, s.chanRouter,
-		routerBackend, s.nodeSigner, s.graphDB, s.chanStateDB,
-		s.sweeper, tower, s.towerClientMgr, r.cfg.net.ResolveTCPAddr,
-		genInvoiceFeatures, genAmpInvoiceFeatures,
+		r.cfg, s.cc, r.cfg.networkDir, macService, atpl, s.feeAutopilot,
+		invoiceRegistry, s.htlcSwitch, r.cfg.ActiveNetParams.Params,
+		s.chanRouter, routerBackend, s.nodeSigner, s.identityECDH,
+		s.identityKeyLoc, s.graphDB, s.chanStateDB, s.sweeper, tower,
+		s.towerClientMgr,
+		r.cfg.net.ResolveTCPAddr, genInvoiceFeatures, genAmpInvoiceFeatures,
 		s.getNodeAnnouncement, s.updateAndBroadcastSelfNode, parseAddr,
 		rpcsLog, s.aliasMgr, r.implCfg.AuxDataParser,
 		invoiceHtlcModifier,
@@ -2418,6 +2484,7 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 		FundUpToMaxAmt:    fundUpToMaxAmt,
 		MinFundAmt:        minFundAmt,
 		Memo:              []byte(in.Memo),
+		DualFunded:        in.DualFunded,
 		Outpoints:         outpoints,
 	}, nil
 }
@@ -2811,8 +2878,9 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 	} else {
 		// If this is a frozen channel, then we only allow the co-op
 		// close to proceed if we were the responder to this channel if
-		// the absolute thaw height has not been met.
-		if channel.IsInitiator {
+		// the absolute thaw height has not been met. If the responder
+		// sold the lease of the channel, it's the other way around.
+		if channel.IsInitiator != channel.ChanType.HasAcceptorLease() {
 			absoluteThawHeight, err := channel.AbsoluteThawHeight()
 			if err != nil {
 				return err
@@ -3205,6 +3273,378 @@ func (r *rpcServer) abandonChan(chanPoint *wire.OutPoint,
 	return nil
 }
 
+// SpliceIn adds funds of the wallet to an existing channel by splicing them
+// into its funding output.
+func (r *rpcServer) SpliceIn(ctx context.Context,
+	in *lnrpc.SpliceInRequest) (*lnrpc.SpliceResponse, error) {
+
+	if in.Amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	return r.splice(
+		ctx, in.ChannelPoint, btcutil.Amount(in.Amount), nil,
+		in.SatPerVbyte, in.TargetConf,
+	)
+}
+
+// SpliceOut removes funds from an existing channel by splicing them out of its
+// funding output to an on-chain address.
+func (r *rpcServer) SpliceOut(ctx context.Context,
+	in *lnrpc.SpliceOutRequest) (*lnrpc.SpliceResponse, error) {
+
+	if in.Amount <= 0 {
+		return nil, errors.New("amount must be positive")
+	}
+
+	var deliveryScript lnwire.DeliveryAddress
+	if in.Addr != "" {
+		addr, err := btcutil.DecodeAddress(
+			in.Addr, r.cfg.ActiveNetParams.Params,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("invalid address: %w", err)
+		}
+
+		if !addr.IsForNet(r.cfg.ActiveNetParams.Params) {
+			return nil, fmt.Errorf("address is not for %s",
+				r.cfg.ActiveNetParams.Params.Name)
+		}
+
+		deliveryScript, err = txscript.PayToAddrScript(addr)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		addr, err := newSweepPkScriptGen(
+			r.server.cc.Wallet, r.cfg.ActiveNetParams.Params,
+		)().Unpack()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate address: "+
+				"%w", err)
+		}
+
+		deliveryScript = addr.DeliveryAddress
+	}
+
+	return r.splice(
+		ctx, in.ChannelPoint, -btcutil.Amount(in.Amount),
+		deliveryScript, in.SatPerVbyte, in.TargetConf,
+	)
+}
+
+// splice splices the given amount into or out of a channel.
+func (r *rpcServer) splice(ctx context.Context,
+	rpcChanPoint *lnrpc.ChannelPoint, amt btcutil.Amount,
+	deliveryScript lnwire.DeliveryAddress, satPerVByte uint64,
+	targetConf int32) (*lnrpc.SpliceResponse, error) {
+
+	txid, err := lnrpc.GetChanPointFundingTxid(rpcChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, rpcChanPoint.OutputIndex)
+
+	// If the fee rate isn't set, we'll use the default conf target.
+	feeRate, err := lnrpc.CalculateFeeRate(
+		0, satPerVByte,
+		maybeUseDefaultConf(0, satPerVByte, uint32(targetConf)),
+		r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Debugf("[splice] chan_point=%v, amount=%v, fee_rate=%v",
+		chanPoint, amt, feeRate)
+
+	spliceTxid, err := r.server.SpliceChannel(ctx, peer.SpliceRequest{
+		ChanPoint:      *chanPoint,
+		Amount:         amt,
+		FeeRate:        feeRate,
+		DeliveryScript: deliveryScript,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to splice channel: %w", err)
+	}
+
+	return &lnrpc.SpliceResponse{
+		Txid: spliceTxid.String(),
+	}, nil
+}
+
+// ModifyChannelParams changes the parameters of an existing channel through a
+// dynamic commitment with its peer.
+func (r *rpcServer) ModifyChannelParams(ctx context.Context,
+	in *lnrpc.ModifyChannelParamsRequest) (
+	*lnrpc.ModifyChannelParamsResponse, error) {
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	var req dyncomm.Request
+	if in.DustLimitSat != nil {
+		req.DustLimit = fn.Some(btcutil.Amount(*in.DustLimitSat))
+	}
+	if in.MaxValueInFlightMsat != nil {
+		req.MaxValueInFlight = fn.Some(
+			lnwire.MilliSatoshi(*in.MaxValueInFlightMsat),
+		)
+	}
+	if in.HtlcMinimumMsat != nil {
+		req.HtlcMinimum = fn.Some(
+			lnwire.MilliSatoshi(*in.HtlcMinimumMsat),
+		)
+	}
+	if in.ChannelReserveSat != nil {
+		req.ChannelReserve = fn.Some(
+			btcutil.Amount(*in.ChannelReserveSat),
+		)
+	}
+	if in.CsvDelay != nil {
+		if *in.CsvDelay > math.MaxUint16 {
+			return nil, fmt.Errorf("csv_delay must not exceed %d",
+				math.MaxUint16)
+		}
+		req.CsvDelay = fn.Some(uint16(*in.CsvDelay))
+	}
+	if in.MaxAcceptedHtlcs != nil {
+		if *in.MaxAcceptedHtlcs > math.MaxUint16 {
+			return nil, fmt.Errorf("max_accepted_htlcs must not "+
+				"exceed %d", math.MaxUint16)
+		}
+		req.MaxAcceptedHTLCs = fn.Some(uint16(*in.MaxAcceptedHtlcs))
+	}
+	req.UpgradeToTaproot = in.UpgradeToTaproot
+
+	rpcsLog.Debugf("[modifychannelparams] chan_point=%v", chanPoint)
+
+	kickoffTxid, err := r.server.ModifyChannelParams(ctx, *chanPoint, req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to modify channel params: %w",
+			err)
+	}
+
+	resp := &lnrpc.ModifyChannelParamsResponse{}
+	kickoffTxid.WhenSome(func(txid chainhash.Hash) {
+		resp.KickoffTxid = txid.String()
+	})
+
+	return resp, nil
+}
+
+// BumpChannelOpenFee replaces the funding transaction of a pending
+// dual-funded channel with one that pays a higher fee rate.
+func (r *rpcServer) BumpChannelOpenFee(_ context.Context,
+	in *lnrpc.BumpChannelOpenFeeRequest) (
+	*lnrpc.BumpChannelOpenFeeResponse, error) {
+
+	txid, err := lnrpc.GetChanPointFundingTxid(in.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(txid, in.ChannelPoint.OutputIndex)
+
+	if in.SatPerVbyte == 0 {
+		return nil, errors.New("sat_per_vbyte must be set")
+	}
+	feeRate := chainfee.SatPerKVByte(in.SatPerVbyte * 1000).FeePerKWeight()
+
+	rpcsLog.Debugf("[bumpchannelopenfee] chan_point=%v, fee_rate=%v",
+		chanPoint, feeRate)
+
+	newChanPoint, err := r.server.fundingMgr.BumpFundingFee(
+		*chanPoint, feeRate,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to bump funding fee: %w", err)
+	}
+
+	return &lnrpc.BumpChannelOpenFeeResponse{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: newChanPoint.Hash[:],
+			},
+			OutputIndex: newChanPoint.Index,
+		},
+	}, nil
+}
+
+// ListLeaseOffers returns the nodes of the graph that advertise lease rates
+// for inbound liquidity, along with the lease fee they charge for the given
+// amount.
+func (r *rpcServer) ListLeaseOffers(ctx context.Context,
+	in *lnrpc.ListLeaseOffersRequest) (*lnrpc.ListLeaseOffersResponse,
+	error) {
+
+	if in.LeaseAmt < 0 {
+		return nil, errors.New("lease_amt must not be negative")
+	}
+	leaseAmt := btcutil.Amount(in.LeaseAmt)
+
+	// The funding weight of the seller is paid at the fee rate of the
+	// funding transaction.
+	targetConf := maybeUseDefaultConf(
+		0, in.SatPerVbyte, uint32(in.TargetConf),
+	)
+	feeRate, err := lnrpc.CalculateFeeRate(
+		0, in.SatPerVbyte, targetConf, r.server.cc.FeeEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	selfKey := r.server.identityECDH.PubKey().SerializeCompressed()
+
+	resp := &lnrpc.ListLeaseOffersResponse{}
+	graph := r.server.graphDB
+	err = graph.ForEachNode(ctx, func(nodeTx graphdb.NodeRTx) error {
+		node := nodeTx.Node()
+		if bytes.Equal(node.PubKeyBytes[:], selfKey) {
+			return nil
+		}
+
+		// The lease rates of a node are only known once we received
+		// its announcement, so nodes with malformed rates are skipped
+		// rather than failing the whole call.
+		rates, err := lnwire.ParseLeaseRates(node.ExtraOpaqueData)
+		if err != nil {
+			rpcsLog.Debugf("Skipping lease rates of node %x: %v",
+				node.PubKeyBytes, err)
+
+			return nil
+		}
+
+		rates.WhenSome(func(rates lnwire.LeaseRates) {
+			leaseFee := rates.LeaseFee(leaseAmt, uint32(feeRate))
+			nodeKey := hex.EncodeToString(node.PubKeyBytes[:])
+			resp.Offers = append(resp.Offers, &lnrpc.LeaseOffer{
+				NodePubkey:  nodeKey,
+				Alias:       node.Alias,
+				Rates:       marshalLeaseRates(&rates),
+				LeaseFeeSat: int64(leaseFee),
+			})
+		})
+
+		return nil
+	}, func() {
+		resp.Offers = nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// marshalLeaseRates converts the lease rates of a node into their RPC
+// representation.
+func marshalLeaseRates(rates *lnwire.LeaseRates) *lnrpc.LeaseRates {
+	return &lnrpc.LeaseRates{
+		LeaseFeeBaseSat:       rates.LeaseFeeBase,
+		LeaseFeeBasis:         uint32(rates.LeaseFeeBasis),
+		FundingWeight:         uint32(rates.FundingWeight),
+		ChannelFeeMaxBaseMsat: rates.ChannelFeeMaxBase,
+		ChannelFeeMaxPpm:      rates.MaxFeeRatePPM(),
+	}
+}
+
+// BuyLiquidity opens a dual-funded channel with a node that advertises lease
+// rates and leases inbound liquidity from it. It returns once the funding
+// transaction is published.
+func (r *rpcServer) BuyLiquidity(ctx context.Context,
+	in *lnrpc.BuyLiquidityRequest) (*lnrpc.BuyLiquidityResponse, error) {
+
+	if err := r.canOpenChannel(); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case in.LeaseAmt <= 0:
+		return nil, errors.New("lease_amt must be positive")
+
+	case in.MaxLeaseFeeSat <= 0:
+		return nil, errors.New("max_lease_fee_sat must be positive")
+	}
+
+	// The funds of the seller are locked in the channel until the lease
+	// expires by the script enforced lease commitment type.
+	req, err := r.parseOpenChannelReq(&lnrpc.OpenChannelRequest{
+		NodePubkey:         in.NodePubkey,
+		LocalFundingAmount: in.LocalFundingAmount,
+		TargetConf:         in.TargetConf,
+		SatPerVbyte:        in.SatPerVbyte,
+		Private:            in.Private,
+		CommitmentType:     lnrpc.CommitmentType_SCRIPT_ENFORCED_LEASE,
+		DualFunded:         true,
+	}, true)
+	if err != nil {
+		return nil, err
+	}
+	req.LeaseAmt = btcutil.Amount(in.LeaseAmt)
+	req.MaxLeaseFee = btcutil.Amount(in.MaxLeaseFeeSat)
+
+	rpcsLog.Debugf("[buyliquidity] request to lease %v from NodeKey(%x) "+
+		"for at most %v", req.LeaseAmt, in.NodePubkey, req.MaxLeaseFee)
+
+	var chanPoint wire.OutPoint
+	updateChan, errChan := r.server.OpenChannel(req)
+	select {
+	case err := <-errChan:
+		rpcsLog.Errorf("unable to lease liquidity from NodeKey(%x): %v",
+			in.NodePubkey, err)
+		return nil, err
+
+	case fundingUpdate := <-updateChan:
+		upd := fundingUpdate.Update
+		update, ok := upd.(*lnrpc.OpenStatusUpdate_ChanPending)
+		if !ok {
+			return nil, fmt.Errorf("unexpected funding update: %v",
+				fundingUpdate)
+		}
+
+		txid, err := chainhash.NewHash(update.ChanPending.Txid)
+		if err != nil {
+			return nil, err
+		}
+		chanPoint = wire.OutPoint{
+			Hash:  *txid,
+			Index: update.ChanPending.OutputIndex,
+		}
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case <-r.quit:
+		return nil, ErrServerShuttingDown
+	}
+
+	dbChan, err := r.server.chanStateDB.FetchChannel(chanPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// The lease fee is paid from our contribution to the channel, so the
+	// initial balance of the seller exceeds its contribution by the fee.
+	leaseAmt := dbChan.Capacity - req.LocalFundingAmt
+	leaseFee := dbChan.InitialRemoteBalance.ToSatoshis() - leaseAmt
+
+	return &lnrpc.BuyLiquidityResponse{
+		ChannelPoint: &lnrpc.ChannelPoint{
+			FundingTxid: &lnrpc.ChannelPoint_FundingTxidBytes{
+				FundingTxidBytes: chanPoint.Hash[:],
+			},
+			OutputIndex: chanPoint.Index,
+		},
+		LeaseAmt:    int64(leaseAmt),
+		LeaseFeeSat: int64(leaseFee),
+		LeaseExpiry: dbChan.ThawHeight,
+	}, nil
+}
+
 // AbandonChannel removes all channel state from the database except for a
 // close summary. This method can be used to get rid of permanently unusable
 // channels due to bugs fixed in newer versions of lnd.
@@ -7523,7 +7963,7 @@ func (r *rpcServer) ListPayments(ctx context.Context,
 		query.MaxPayments = math.MaxUint64
 	}
 
-	paymentsQuerySlice, err := r.server.miscDB.QueryPayments(query)
+	paymentsQuerySlice, err := r.server.paymentDB.QueryPayments(query)
 	if err != nil {
 		return nil, err
 	}
@@ -7565,7 +8005,7 @@ func (r *rpcServer) DeletePayment(ctx context.Context,
 	rpcsLog.Infof("[DeletePayment] payment_identifier=%v, "+
 		"failed_htlcs_only=%v", hash, req.FailedHtlcsOnly)
 
-	err = r.server.miscDB.DeletePayment(hash, req.FailedHtlcsOnly)
+	err = r.server.paymentDB.DeletePayment(hash, req.FailedHtlcsOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -7605,7 +8045,7 @@ func (r *rpcServer) DeleteAllPayments(ctx context.Context,
 		"failed_htlcs_only=%v", req.FailedPaymentsOnly,
 		req.FailedHtlcsOnly)
 
-	numDeletedPayments, err := r.server.miscDB.DeletePayments(
+	numDeletedPayments, err := r.server.paymentDB.DeletePayments(
 		req.FailedPaymentsOnly, req.FailedHtlcsOnly,
 	)
 	if err != nil {
@@ -7807,7 +8247,7 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 		return nil, err
 	}
 
-	fwdEventLog := r.server.miscDB.ForwardingLog()
+	fwdEventLog := r.server.fwdingLogDB
 
 	// computeFeeSum is a helper function that computes the total fees for
 	// a particular time slice described by a forwarding event query.
@@ -8097,6 +8537,30 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 	incomingChanIDs := fn.NewSet(req.IncomingChanIds...)
 	outgoingChanIDs := fn.NewSet(req.OutgoingChanIds...)
 
+	// If peers were given to filter by, we add all their channels to the
+	// channel filters.
+	incomingPeerChanIDs, err := r.fetchPeerChanIDs(req.IncomingPeers)
+	if err != nil {
+		return nil, err
+	}
+	outgoingPeerChanIDs, err := r.fetchPeerChanIDs(req.OutgoingPeers)
+	if err != nil {
+		return nil, err
+	}
+	incomingChanIDs = incomingChanIDs.Union(incomingPeerChanIDs)
+	outgoingChanIDs = outgoingChanIDs.Union(outgoingPeerChanIDs)
+
+	// An empty channel filter matches all events. So if a peer filter was
+	// given but none of the peers has any channels, no event can match and
+	// we return early.
+	if (len(req.IncomingPeers) > 0 && incomingChanIDs.IsEmpty()) ||
+		(len(req.OutgoingPeers) > 0 && outgoingChanIDs.IsEmpty()) {
+
+		return &lnrpc.ForwardingHistoryResponse{
+			LastOffsetIndex: req.IndexOffset,
+		}, nil
+	}
+
 	// Next, we'll map the proto request into a format that is understood by
 	// the forwarding log.
 	eventQuery := channeldb.ForwardingEventQuery{
@@ -8107,7 +8571,7 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		IncomingChanIDs: incomingChanIDs,
 		OutgoingChanIDs: outgoingChanIDs,
 	}
-	timeSlice, err := r.server.miscDB.ForwardingLog().Query(eventQuery)
+	timeSlice, err := r.server.fwdingLogDB.Query(eventQuery)
 	if err != nil {
 		return nil, fmt.Errorf("unable to query forwarding log: %w",
 			err)
@@ -8211,9 +8675,97 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		}
 	}
 
+	// If requested, we'll let the forwarding log aggregate the volume and
+	// fees of all matching events per outgoing channel and day.
+	if req.IncludeFeeAggregates {
+		aggregates, err := r.server.fwdingLogDB.QueryFeeAggregates(
+			eventQuery,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query forwarding "+
+				"fee aggregates: %w", err)
+		}
+
+		resp.FeeAggregates = make(
+			[]*lnrpc.ChannelFeeAggregate, 0, len(aggregates),
+		)
+		for _, agg := range aggregates {
+			resp.FeeAggregates = append(
+				resp.FeeAggregates, &lnrpc.ChannelFeeAggregate{
+					ChanId:      agg.ChanID.ToUint64(),
+					DayStart:    uint64(agg.Day.Unix()),
+					NumForwards: agg.NumForwards,
+					AmtInMsat:   uint64(agg.TotalAmtIn),
+					AmtOutMsat:  uint64(agg.TotalAmtOut),
+					FeeMsat:     uint64(agg.TotalFees),
+				},
+			)
+		}
+	}
+
 	return resp, nil
 }
 
+// fetchPeerChanIDs returns the short channel IDs of all open and closed
+// channels with the given peers. For open channels, all of their aliases are
+// included as well.
+func (r *rpcServer) fetchPeerChanIDs(peers [][]byte) (fn.Set[uint64],
+	error) {
+
+	chanIDs := fn.NewSet[uint64]()
+	if len(peers) == 0 {
+		return chanIDs, nil
+	}
+
+	peerSet := fn.NewSet[route.Vertex]()
+	for _, peer := range peers {
+		pubKey, err := btcec.ParsePubKey(peer)
+		if err != nil {
+			return nil, fmt.Errorf("invalid peer public key: %w",
+				err)
+		}
+
+		vertex := route.NewVertex(pubKey)
+		if peerSet.Contains(vertex) {
+			continue
+		}
+		peerSet.Add(vertex)
+
+		openChans, err := r.server.chanStateDB.FetchOpenChannels(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch open channels "+
+				"of peer %v: %w", vertex, err)
+		}
+
+		for _, channel := range openChans {
+			chanIDs.Add(channel.ShortChannelID.ToUint64())
+
+			aliases := r.server.aliasMgr.GetAliases(
+				channel.ShortChannelID,
+			)
+			for _, alias := range aliases {
+				chanIDs.Add(alias.ToUint64())
+			}
+		}
+	}
+
+	closedChans, err := r.server.chanStateDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch closed channels: %w",
+			err)
+	}
+	for _, summary := range closedChans {
+		remotePub := route.NewVertex(summary.RemotePub)
+		if !peerSet.Contains(remotePub) {
+			continue
+		}
+
+		chanIDs.Add(summary.ShortChanID.ToUint64())
+	}
+
+	return chanIDs, nil
+}
+
 // ExportChannelBackup attempts to return an encrypted static channel backup
 // for the target channel identified by it channel point. The backup is
 // encrypted with a key generated from the aezeed seed of the user. The
@@ -9200,6 +9752,174 @@ func (r *rpcServer) SubscribeCustomMessages(
 	}
 }
 
+// errOnionMessagesDisabled is returned by the onion message RPCs if onion
+// messaging is disabled.
+var errOnionMessagesDisabled = errors.New("onion messages are disabled, " +
+	"remove protocol.no-onion-messages to enable them")
+
+// SendOnionMessage sends an onion message along a blinded path.
+func (r *rpcServer) SendOnionMessage(_ context.Context,
+	req *lnrpc.SendOnionMessageRequest) (*lnrpc.SendOnionMessageResponse,
+	error) {
+
+	messenger := r.server.onionMessenger
+	if messenger == nil {
+		return nil, errOnionMessagesDisabled
+	}
+
+	path, err := unmarshalOnionMessagePath(req.BlindedPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid blinded path: %w", err)
+	}
+
+	for typ := range req.FinalHopPayloads {
+		if typ < onionmessage.FinalHopPayloadStart {
+			return nil, fmt.Errorf("final hop payload type %d "+
+				"must be at least %d", typ,
+				onionmessage.FinalHopPayloadStart)
+		}
+	}
+
+	// If a reply path ID is given, we'll include a blinded path to our
+	// own node in the message.
+	var replyPath *sphinx.BlindedPath
+	if len(req.ReplyPathId) > 0 {
+		replyPath, err = onionmessage.BuildBlindedPath(
+			[]*btcec.PublicKey{r.server.identityECDH.PubKey()},
+			req.ReplyPathId,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create reply path: "+
+				"%w", err)
+		}
+	}
+
+	err = messenger.Send(path, replyPath, req.FinalHopPayloads)
+	switch {
+	case errors.Is(err, ErrPeerNotConnected):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	return &lnrpc.SendOnionMessageResponse{}, nil
+}
+
+// SubscribeOnionMessages subscribes to a stream of incoming onion messages for
+// which we are the final hop.
+func (r *rpcServer) SubscribeOnionMessages(
+	_ *lnrpc.SubscribeOnionMessagesRequest,
+	server lnrpc.Lightning_SubscribeOnionMessagesServer) error {
+
+	messenger := r.server.onionMessenger
+	if messenger == nil {
+		return errOnionMessagesDisabled
+	}
+
+	client, err := messenger.SubscribeMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case <-client.Quit():
+			return errors.New("shutdown")
+
+		case <-server.Context().Done():
+			return server.Context().Err()
+
+		case update := <-client.Updates():
+			msg := update.(*onionmessage.IncomingMessage)
+
+			rpcMsg := &lnrpc.OnionMessageUpdate{
+				Peer:             msg.Peer[:],
+				PathId:           msg.PathID,
+				FinalHopPayloads: msg.FinalHopPayloads,
+			}
+			if msg.ReplyPath != nil {
+				rpcMsg.ReplyPath = marshalOnionMessagePath(
+					msg.ReplyPath,
+				)
+			}
+
+			if err := server.Send(rpcMsg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// unmarshalOnionMessagePath converts the RPC representation of a blinded path
+// to the one used by the onion messenger.
+func unmarshalOnionMessagePath(
+	rpcPath *lnrpc.BlindedPath) (*sphinx.BlindedPath, error) {
+
+	if rpcPath == nil {
+		return nil, errors.New("blinded path required")
+	}
+
+	introduction, err := btcec.ParsePubKey(rpcPath.IntroductionNode)
+	if err != nil {
+		return nil, err
+	}
+
+	blinding, err := btcec.ParsePubKey(rpcPath.BlindingPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rpcPath.BlindedHops) == 0 {
+		return nil, errors.New("at least 1 blinded hop required")
+	}
+
+	path := &sphinx.BlindedPath{
+		IntroductionPoint: introduction,
+		BlindingPoint:     blinding,
+		BlindedHops: make(
+			[]*sphinx.BlindedHopInfo, 0, len(rpcPath.BlindedHops),
+		),
+	}
+	for _, hop := range rpcPath.BlindedHops {
+		pubKey, err := btcec.ParsePubKey(hop.BlindedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(hop.EncryptedData) == 0 {
+			return nil, errors.New("empty encrypted data not " +
+				"allowed")
+		}
+
+		path.BlindedHops = append(
+			path.BlindedHops, &sphinx.BlindedHopInfo{
+				BlindedNodePub: pubKey,
+				CipherText:     hop.EncryptedData,
+			},
+		)
+	}
+
+	return path, nil
+}
+
+// marshalOnionMessagePath converts a blinded path to its RPC representation.
+func marshalOnionMessagePath(path *sphinx.BlindedPath) *lnrpc.BlindedPath {
+	hops := make([]*lnrpc.BlindedHop, 0, len(path.BlindedHops))
+	for _, hop := range path.BlindedHops {
+		hops = append(hops, &lnrpc.BlindedHop{
+			BlindedNode:   hop.BlindedNodePub.SerializeCompressed(),
+			EncryptedData: hop.CipherText,
+		})
+	}
+
+	return &lnrpc.BlindedPath{
+		IntroductionNode: path.IntroductionPoint.SerializeCompressed(),
+		BlindingPoint:    path.BlindingPoint.SerializeCompressed(),
+		BlindedHops:      hops,
+	}
+}
+
 // ListAliases returns the set of all aliases we have ever allocated along with
 // their base SCIDs and possibly a separate confirmed SCID in the case of
 // zero-conf.
@@ -2,6 +2,7 @@ package lnd
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"crypto/rand"
 	"encoding/hex"
@@ -10,6 +11,7 @@ import (
 	"math/big"
 	prand "math/rand"
 	"net"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -41,6 +43,7 @@ import (
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/feature"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	"github.com/lightningnetwork/lnd/funding"
 	"github.com/lightningnetwork/lnd/graph"
@@ -57,14 +60,19 @@ import (
 	"github.com/lightningnetwork/lnd/lnpeer"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/lightningnetwork/lnd/lnutils"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/chainfee"
 	"github.com/lightningnetwork/lnd/lnwallet/chanfunding"
+	"github.com/lightningnetwork/lnd/lnwallet/dyncomm"
 	"github.com/lightningnetwork/lnd/lnwallet/rpcwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/monitoring"
+	"github.com/lightningnetwork/lnd/msgmux"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/netann"
+	"github.com/lightningnetwork/lnd/onionmessage"
 	"github.com/lightningnetwork/lnd/peer"
 	"github.com/lightningnetwork/lnd/peernotifier"
 	"github.com/lightningnetwork/lnd/pool"
@@ -335,6 +343,14 @@ type server struct {
 
 	invoicesDB invoices.InvoiceDB
 
+	// paymentDB is the DB that stores all outgoing payments and their HTLC
+	// attempts.
+	paymentDB channeldb.PaymentDB
+
+	// fwdingLogDB is the DB that stores the forwarding history of the
+	// node.
+	fwdingLogDB channeldb.ForwardingLogDB
+
 	aliasMgr *aliasmgr.Manager
 
 	htlcSwitch *htlcswitch.Switch
@@ -362,12 +378,28 @@ type server struct {
 
 	chanRouter *routing.ChannelRouter
 
+	// prober sends scheduled probes in the background. It is nil if
+	// probing is disabled.
+	prober *routing.Prober
+
+	// rebalancer moves liquidity between our channels with circular
+	// payments.
+	rebalancer *routing.Rebalancer
+
+	// trampolineForwarder forwards the payments for which we were chosen
+	// as trampoline node. It is nil if trampoline forwarding is disabled.
+	trampolineForwarder *routing.TrampolineForwarder
+
 	controlTower routing.ControlTower
 
 	authGossiper *discovery.AuthenticatedGossiper
 
 	localChanMgr *localchans.Manager
 
+	// feeAutopilot sets the fee rates of our channels based on their
+	// liquidity and flow.
+	feeAutopilot *feeautopilot.Manager
+
 	utxoNursery *contractcourt.UtxoNursery
 
 	sweeper *sweep.UtxoSweeper
@@ -417,6 +449,10 @@ type server struct {
 
 	customMessageServer *subscribe.Server
 
+	// onionMessenger sends, receives and forwards onion messages. It is
+	// nil if onion messaging is disabled.
+	onionMessenger *onionmessage.Messenger
+
 	// txPublisher is a publisher with fee-bumping capability.
 	txPublisher *sweep.TxPublisher
 
@@ -650,6 +686,10 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		NoExperimentalEndorsement: cfg.ProtocolOptions.NoExperimentalEndorsement(),
 		NoQuiescence:              cfg.ProtocolOptions.NoQuiescence(),
 		NoRbfCoopClose:            !cfg.ProtocolOptions.RbfCoopClose,
+		NoOnionMessages:           cfg.ProtocolOptions.NoOnionMessages(),
+		NoSplicing:                !cfg.ProtocolOptions.Splicing,
+		NoDynamicCommitments:      !cfg.ProtocolOptions.DynamicCommitments,
+		NoDualFunding:             !cfg.ProtocolOptions.DualFunding,
 	})
 	if err != nil {
 		return nil, err
@@ -678,6 +718,8 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		addrSource:     addrSource,
 		miscDB:         dbs.ChanStateDB,
 		invoicesDB:     dbs.InvoiceDB,
+		paymentDB:      dbs.PaymentDB,
+		fwdingLogDB:    dbs.ForwardingLogDB,
 		cc:             cc,
 		sigPool:        lnwallet.NewSigPool(cfg.Workers.Sig, cc.Signer),
 		writePool:      writePool,
@@ -793,7 +835,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 
 			peer.HandleLocalCloseChanReqs(request)
 		},
-		FwdingLog:              dbs.ChanStateDB.ForwardingLog(),
+		FwdingLog:              dbs.ForwardingLogDB,
 		SwitchPackager:         channeldb.NewSwitchPackager(),
 		ExtractErrorEncrypter:  s.sphinx.ExtractErrorEncrypter,
 		FetchLastChannelUpdate: s.fetchLastChanUpdate(),
@@ -978,6 +1020,26 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, fmt.Errorf("unable to fetch source node: %w", err)
 	}
 
+	// If we sell inbound liquidity, then we advertise our lease rates in
+	// our node announcement, and the funding manager leases it at them.
+	var (
+		leasePolicy = fn.None[funding.LeasePolicy]()
+		leaseRates  = fn.None[lnwire.LeaseRates]()
+	)
+	if liquidityAds := cfg.LiquidityAds; liquidityAds.Active {
+		rates := liquidityAds.LeaseRates()
+		leaseRates = fn.Some(rates)
+		leasePolicy = fn.Some(funding.LeasePolicy{
+			Rates:       rates,
+			MinLeaseAmt: btcutil.Amount(liquidityAds.MinLeaseSize),
+			MaxLeaseAmt: btcutil.Amount(liquidityAds.MaxLeaseSize),
+		})
+	}
+	selfExtraData, err := lnwire.SetLeaseRates(nil, leaseRates)
+	if err != nil {
+		return nil, err
+	}
+
 	selfNode := &models.LightningNode{
 		HaveNodeAnnouncement: true,
 		LastUpdate:           nodeLastUpdate,
@@ -985,6 +1047,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		Alias:                nodeAlias.String(),
 		Features:             s.featureMgr.Get(feature.SetNodeAnn),
 		Color:                color,
+		ExtraOpaqueData:      selfExtraData,
 	}
 	copy(selfNode.PubKeyBytes[:], nodeKeyDesc.PubKey.SerializeCompressed())
 
@@ -1115,6 +1178,19 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		MinProbability: routingConfig.MinRouteProbability,
 	}
 
+	// Split multi-part payments with a min-cost flow if it's enabled.
+	if mcfConfig := routingConfig.MinCostFlowConfig; mcfConfig.Active {
+		pathFindingConfig.MinCostFlow = &routing.MinCostFlowConfig{
+			MaxHops: mcfConfig.MaxHops,
+			Units:   mcfConfig.Units,
+		}
+
+		err := pathFindingConfig.MinCostFlow.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sourceNode, err := dbs.GraphDB.SourceNode(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting source node: %w", err)
@@ -1127,9 +1203,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		PathFindingConfig:   pathFindingConfig,
 	}
 
-	paymentControl := channeldb.NewPaymentControl(dbs.ChanStateDB)
-
-	s.controlTower = routing.NewControlTower(paymentControl)
+	s.controlTower = routing.NewControlTower(dbs.PaymentDB)
 
 	strictPruning := cfg.Bitcoin.Node == "neutrino" ||
 		cfg.Routing.StrictZombiePruning
@@ -1171,6 +1245,41 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		return nil, fmt.Errorf("can't create router: %w", err)
 	}
 
+	if proberCfg := routingConfig.ProberConfig; proberCfg.Active {
+		s.prober, err = s.newProber(proberCfg, selfNode.PubKeyBytes)
+		if err != nil {
+			return nil, fmt.Errorf("can't create prober: %w", err)
+		}
+	}
+
+	s.rebalancer, err = s.newRebalancer(
+		routingConfig.RebalancerConfig, selfNode.PubKeyBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create rebalancer: %w", err)
+	}
+
+	if tCfg := routingConfig.TrampolineConfig; tCfg.Active {
+		trampolineCfg := &routing.TrampolineConfig{
+			FeeBase:           lnwire.MilliSatoshi(tCfg.FeeBase),
+			FeeRate:           lnwire.MilliSatoshi(tCfg.FeeRate),
+			CltvDelta:         tCfg.CltvDelta,
+			MaxParts:          tCfg.MaxParts,
+			PayAttemptTimeout: tCfg.PaymentTimeout,
+			PreparePayment:    s.chanRouter.PreparePayment,
+			SendPaymentAsync:  s.chanRouter.SendPaymentAsync,
+			SubscribePayment:  s.controlTower.SubscribePayment,
+		}
+		if err := trampolineCfg.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid trampoline config: %w",
+				err)
+		}
+
+		s.trampolineForwarder = routing.NewTrampolineForwarder(
+			trampolineCfg,
+		)
+	}
+
 	chanSeries := discovery.NewChanSeries(s.graphDB)
 	gossipMessageStore, err := discovery.NewMessageStore(dbs.ChanStateDB)
 	if err != nil {
@@ -1278,6 +1387,11 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		},
 	}
 
+	s.feeAutopilot, err = s.newFeeAutopilot(cfg.FeeAutopilot, selfVertex)
+	if err != nil {
+		return nil, fmt.Errorf("can't create fee autopilot: %w", err)
+	}
+
 	utxnStore, err := contractcourt.NewNurseryStore(
 		s.cfg.ActiveNetParams.GenesisHash, dbs.ChanStateDB,
 	)
@@ -1749,6 +1863,7 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		AuxFundingController: implCfg.AuxFundingController,
 		AuxSigner:            implCfg.AuxSigner,
 		AuxResolver:          implCfg.AuxContractResolver,
+		LeasePolicy:          leasePolicy,
 	})
 	if err != nil {
 		return nil, err
@@ -1925,6 +2040,30 @@ func newServer(ctx context.Context, cfg *Config, listenAddrs []net.Addr,
 		})
 	}
 
+	// Create the onion messenger, unless onion messaging is disabled.
+	if !cfg.ProtocolOptions.NoOnionMessages() {
+		s.onionMessenger = onionmessage.NewMessenger(
+			&onionmessage.Config{
+				NodeKey:          nodeKeyECDH,
+				SendToPeer:       s.sendOnionMessage,
+				FetchChannelPeer: s.fetchChannelPeer,
+				RateLimit:        onionmessage.DefaultRateLimit,
+				RateBurst:        onionmessage.DefaultRateBurst,
+			},
+		)
+
+		// If a global message router is used, the messenger only needs
+		// to be registered once.
+		err := fn.MapOptionZ(
+			implCfg.MsgRouter, func(r msgmux.Router) error {
+				return r.RegisterEndpoint(s.onionMessenger)
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Create liveness monitor.
 	s.createLivenessMonitor(cfg, cc, leaderElector)
 
@@ -2294,6 +2433,14 @@ func (s *server) Start(ctx context.Context) error {
 			return
 		}
 
+		if s.onionMessenger != nil {
+			cleanup = cleanup.add(s.onionMessenger.Stop)
+			if err := s.onionMessenger.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		if s.hostAnn != nil {
 			cleanup = cleanup.add(s.hostAnn.Stop)
 			if err := s.hostAnn.Start(); err != nil {
@@ -2479,6 +2626,34 @@ func (s *server) Start(ctx context.Context) error {
 		})
 		s.missionController.RunStoreTickers()
 
+		if s.prober != nil {
+			cleanup = cleanup.add(s.prober.Stop)
+			if err := s.prober.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		cleanup = cleanup.add(s.rebalancer.Stop)
+		if err := s.rebalancer.Start(); err != nil {
+			startErr = err
+			return
+		}
+
+		if s.trampolineForwarder != nil {
+			cleanup = cleanup.add(s.trampolineForwarder.Stop)
+			if err := s.trampolineForwarder.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
+		cleanup = cleanup.add(s.feeAutopilot.Stop)
+		if err := s.feeAutopilot.Start(); err != nil {
+			startErr = err
+			return
+		}
+
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
 		// that have all the information we need to handle channel
@@ -2750,6 +2925,29 @@ func (s *server) Stop() error {
 			srvrLog.Warnf("failed to stop htlc invoices "+
 				"modifier: %v", err)
 		}
+		if s.onionMessenger != nil {
+			if err := s.onionMessenger.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop onion "+
+					"messenger: %v", err)
+			}
+		}
+		if s.prober != nil {
+			if err := s.prober.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop prober: %v", err)
+			}
+		}
+		if err := s.rebalancer.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop rebalancer: %v", err)
+		}
+		if err := s.feeAutopilot.Stop(); err != nil {
+			srvrLog.Warnf("failed to stop fee autopilot: %v", err)
+		}
+		if s.trampolineForwarder != nil {
+			if err := s.trampolineForwarder.Stop(); err != nil {
+				srvrLog.Warnf("failed to stop trampoline "+
+					"forwarder: %v", err)
+			}
+		}
 		if err := s.chanRouter.Stop(); err != nil {
 			srvrLog.Warnf("failed to stop chanRouter: %v", err)
 		}
@@ -2879,6 +3077,30 @@ func (s *server) Stopped() bool {
 	return atomic.LoadInt32(&s.stopping) != 0
 }
 
+// monitoringConfig returns the subsystems of the server whose internals are
+// exported as Prometheus metrics.
+func (s *server) monitoringConfig() *monitoring.Config {
+	return &monitoring.Config{
+		FetchChannels:       s.chanStateDB.FetchAllOpenChannels,
+		SubscribeHtlcEvents: s.htlcNotifier.SubscribeHtlcEvents,
+		FeeBumpStats:        s.txPublisher.FeeBumpStats,
+		SyncerStates:        s.authGossiper.SyncManager().SyncerStates,
+		MissionControlPairs: func() (map[string]int, error) {
+			mc := s.missionController
+			pairs := make(map[string]int)
+			for _, ns := range mc.ListNamespaces() {
+				store, err := mc.GetNamespacedStore(ns)
+				if err != nil {
+					return nil, err
+				}
+				pairs[ns] = store.NumPairs()
+			}
+
+			return pairs, nil
+		},
+	}
+}
+
 // configurePortForwarding attempts to set up port forwarding for the different
 // ports that the server will be listening on.
 //
@@ -4545,10 +4767,12 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		DisallowQuiescence:     s.cfg.ProtocolOptions.NoQuiescence(),
 		QuiescenceTimeout:      s.cfg.Htlcswitch.QuiescenceTimeout,
 		MaxFeeExposure:         thresholdMSats,
+		MaxLocalCSVDelay:       s.cfg.Bitcoin.MaxLocalDelay,
 		Quit:                   s.quit,
 		AuxLeafStore:           s.implCfg.AuxLeafStore,
 		AuxSigner:              s.implCfg.AuxSigner,
 		MsgRouter:              s.implCfg.MsgRouter,
+		OnionMessenger:         s.onionMessengerEndpoint(),
 		AuxChanCloser:          s.implCfg.AuxChanCloser,
 		AuxResolver:            s.implCfg.AuxContractResolver,
 		AuxTrafficShaper:       s.implCfg.TrafficShaper,
@@ -4567,6 +4791,12 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 	copy(pCfg.PubKeyBytes[:], peerAddr.IdentityKey.SerializeCompressed())
 	copy(pCfg.ServerPubKey[:], s.identityECDH.PubKey().SerializeCompressed())
 
+	// Only set the trampoline forwarder if it's enabled, so that the links
+	// don't see a typed nil interface.
+	if s.trampolineForwarder != nil {
+		pCfg.TrampolineForwarder = s.trampolineForwarder
+	}
+
 	p := peer.NewBrontide(pCfg)
 
 	// Update the access manager with the access permission for this peer.
@@ -5421,6 +5651,67 @@ func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
 	return peer.SendMessageLazy(true, msg)
 }
 
+// onionMessengerEndpoint returns the onion messenger as an endpoint for the
+// message router of a peer, if onion messaging is enabled.
+func (s *server) onionMessengerEndpoint() fn.Option[msgmux.Endpoint] {
+	if s.onionMessenger == nil {
+		return fn.None[msgmux.Endpoint]()
+	}
+
+	return fn.Some[msgmux.Endpoint](s.onionMessenger)
+}
+
+// sendOnionMessage sends an onion message to the given peer. The peer must be
+// connected, active and support onion messages.
+func (s *server) sendOnionMessage(peerPub route.Vertex,
+	msg *lnwire.OnionMessage) error {
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	select {
+	case <-peer.ActiveSignal():
+	default:
+		return fmt.Errorf("peer %v not active", peerPub)
+	}
+
+	features := peer.RemoteFeatures()
+	if !features.HasFeature(lnwire.OnionMessagesOptional) {
+		return fmt.Errorf("peer %v doesn't support onion messages",
+			peerPub)
+	}
+
+	// Onion messages are best effort, so we don't wait for the message to
+	// be sent.
+	return peer.SendMessageLazy(false, msg)
+}
+
+// fetchChannelPeer returns the node on the other side of our public channel
+// with the given short channel ID.
+func (s *server) fetchChannelPeer(
+	scid lnwire.ShortChannelID) (route.Vertex, error) {
+
+	info, _, _, err := s.graphDB.FetchChannelEdgesByID(scid.ToUint64())
+	if err != nil {
+		return route.Vertex{}, err
+	}
+
+	ourKey := route.NewVertex(s.identityECDH.PubKey())
+	switch ourKey {
+	case info.NodeKey1Bytes:
+		return info.NodeKey2Bytes, nil
+
+	case info.NodeKey2Bytes:
+		return info.NodeKey1Bytes, nil
+
+	default:
+		return route.Vertex{}, fmt.Errorf("channel %v is not ours",
+			scid)
+	}
+}
+
 // newSweepPkScriptGen creates closure that generates a new public key script
 // which should be used to sweep any funds into the on-chain wallet.
 // Specifically, the script generated is a version 0, pay-to-witness-pubkey-hash
@@ -5457,6 +5748,275 @@ func newSweepPkScriptGen(
 	}
 }
 
+// newProber creates the background prober, which records the results of its
+// probes in a dedicated mission control namespace.
+func (s *server) newProber(cfg *routerrpc.ProberConfig,
+	self route.Vertex) (*routing.Prober, error) {
+
+	mc, err := s.missionController.GetNamespacedStore(
+		routing.ProbeMissionControlNamespace,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	destinations := make([]route.Vertex, len(cfg.Destinations))
+	for i, dest := range cfg.Destinations {
+		pubKey, err := hex.DecodeString(dest)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe destination "+
+				"%v: %w", dest, err)
+		}
+
+		destinations[i], err = route.NewVertexFromBytes(pubKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid probe destination "+
+				"%v: %w", dest, err)
+		}
+	}
+
+	return routing.NewProber(&routing.ProberConfig{
+		SelfNode:           self,
+		Destinations:       destinations,
+		AutoDestinations:   cfg.AutoDestinations,
+		SelectDestinations: s.selectProbeDestinations,
+		Amount:             lnwire.NewMSatFromSatoshis(cfg.Amount),
+		MaxInFlight:        lnwire.NewMSatFromSatoshis(cfg.MaxInFlight),
+		Ticker:             ticker.New(cfg.Interval),
+		HistorySize:        cfg.HistorySize,
+		MissionControl:     mc,
+		FindRoute:          s.chanRouter.FindRoute,
+		SendProbe:          s.chanRouter.SendProbe,
+		Clock:              clock.NewDefaultClock(),
+	})
+}
+
+// newRebalancer creates the rebalancer, which pays invoices of our own node
+// along circular routes to move liquidity between our channels.
+func (s *server) newRebalancer(cfg *routerrpc.RebalancerConfig,
+	self route.Vertex) (*routing.Rebalancer, error) {
+
+	return routing.NewRebalancer(&routing.RebalancerConfig{
+		SelfNode:       self,
+		Active:         cfg.Active,
+		Ticker:         ticker.New(cfg.Interval),
+		MaxAmount:      lnwire.NewMSatFromSatoshis(cfg.MaxAmount),
+		MaxFeePPM:      cfg.MaxFeePPM,
+		MinLocalRatio:  cfg.MinLocalRatio,
+		MaxLocalRatio:  cfg.MaxLocalRatio,
+		FetchChannels:  s.fetchRebalanceChannels,
+		MissionControl: s.defaultMC,
+		FindRoute:      s.chanRouter.FindRoute,
+		AddInvoice:     s.addRebalanceInvoice,
+		CancelInvoice:  s.invoices.CancelInvoice,
+		SendRebalance:  s.chanRouter.SendRebalance,
+		Clock:          clock.NewDefaultClock(),
+	})
+}
+
+// fetchRebalanceChannels returns our confirmed channels along with their
+// local balances.
+func (s *server) fetchRebalanceChannels() ([]routing.RebalanceChannel, error) {
+	openChannels, err := s.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]routing.RebalanceChannel, 0, len(openChannels))
+	for _, channel := range openChannels {
+		if channel.IsPending {
+			continue
+		}
+
+		channels = append(channels, routing.RebalanceChannel{
+			ChanID:       channel.ShortChannelID,
+			Peer:         route.NewVertex(channel.IdentityPub),
+			Capacity:     channel.Capacity,
+			LocalBalance: channel.LocalCommitment.LocalBalance,
+		})
+	}
+
+	return channels, nil
+}
+
+// addRebalanceInvoice adds an invoice of our own node that a circular payment
+// of the rebalancer pays, and returns its payment hash and payment address.
+func (s *server) addRebalanceInvoice(ctx context.Context,
+	amt lnwire.MilliSatoshi, finalCltvDelta uint16) (lntypes.Hash,
+	[32]byte, error) {
+
+	var (
+		preimage lntypes.Preimage
+		payAddr  [32]byte
+	)
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return lntypes.Hash{}, payAddr, err
+	}
+	if _, err := rand.Read(payAddr[:]); err != nil {
+		return lntypes.Hash{}, payAddr, err
+	}
+
+	hash := preimage.Hash()
+	invoice := &invoices.Invoice{
+		CreationDate: time.Now(),
+		Memo:         []byte("rebalance"),
+		Terms: invoices.ContractTerm{
+			FinalCltvDelta:  int32(finalCltvDelta),
+			Value:           amt,
+			PaymentPreimage: &preimage,
+			PaymentAddr:     payAddr,
+			Features:        s.featureMgr.Get(feature.SetInvoice),
+		},
+	}
+	if _, err := s.invoices.AddInvoice(ctx, invoice, hash); err != nil {
+		return lntypes.Hash{}, payAddr, err
+	}
+
+	return hash, payAddr, nil
+}
+
+// newFeeAutopilot creates the fee autopilot, which updates the policies of
+// our channels through the local channel manager.
+func (s *server) newFeeAutopilot(cfg *lncfg.FeeAutopilot,
+	self route.Vertex) (*feeautopilot.Manager, error) {
+
+	strategy, err := feeautopilot.NewStrategy(
+		cfg.Strategy, feeautopilot.FeeBounds{
+			MinFeeRate:         cfg.MinFeeRate,
+			MaxFeeRate:         cfg.MaxFeeRate,
+			MaxInboundDiscount: cfg.MaxInboundDiscount,
+		}, cfg.TargetRatio, cfg.DemandStep,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeautopilot.NewManager(&feeautopilot.Config{
+		Active:            cfg.Active,
+		Strategy:          strategy,
+		FlowWindow:        cfg.FlowWindow,
+		MinUpdateInterval: cfg.MinUpdateInterval,
+		MinRelativeChange: cfg.MinRelativeChange,
+		MaxUpdatesPerRun:  cfg.MaxUpdatesPerRun,
+		FetchChannels: func(ctx context.Context) (
+			[]feeautopilot.LocalChannel, error) {
+
+			return s.fetchFeeAutopilotChannels(ctx, self)
+		},
+		ForwardingLog:       s.fwdingLogDB,
+		SubscribeHtlcEvents: s.htlcNotifier.SubscribeHtlcEvents,
+		UpdatePolicy: func(ctx context.Context, chanPoint wire.OutPoint,
+			policy routing.ChannelPolicy) error {
+
+			failed, err := s.localChanMgr.UpdatePolicy(
+				ctx, policy, false, chanPoint,
+			)
+			if err != nil {
+				return err
+			}
+			if len(failed) > 0 {
+				return errors.New(failed[0].UpdateError)
+			}
+
+			return nil
+		},
+		Ticker: ticker.New(cfg.Interval),
+		Clock:  clock.NewDefaultClock(),
+	})
+}
+
+// fetchFeeAutopilotChannels returns our open channels along with our current
+// policies. Channels whose policy isn't known yet are skipped.
+func (s *server) fetchFeeAutopilotChannels(ctx context.Context,
+	self route.Vertex) ([]feeautopilot.LocalChannel, error) {
+
+	policies := make(map[wire.OutPoint]*models.ChannelEdgePolicy)
+	err := s.graphDB.ForEachNodeChannel(ctx, self,
+		func(info *models.ChannelEdgeInfo,
+			policy *models.ChannelEdgePolicy,
+			_ *models.ChannelEdgePolicy) error {
+
+			if policy != nil {
+				policies[info.ChannelPoint] = policy
+			}
+
+			return nil
+		}, func() {
+			clear(policies)
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	openChannels, err := s.chanStateDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	channels := make([]feeautopilot.LocalChannel, 0, len(openChannels))
+	for _, channel := range openChannels {
+		policy, ok := policies[channel.FundingOutpoint]
+		if !ok {
+			continue
+		}
+
+		channels = append(channels, feeautopilot.LocalChannel{
+			ChanPoint:    channel.FundingOutpoint,
+			ChanID:       channel.ShortChannelID,
+			Capacity:     channel.Capacity,
+			LocalBalance: channel.LocalCommitment.LocalBalance,
+			Policy:       policy,
+		})
+	}
+
+	return channels, nil
+}
+
+// selectProbeDestinations returns the n nodes of the graph with the largest
+// total channel capacity.
+func (s *server) selectProbeDestinations(n int) ([]route.Vertex, error) {
+	type candidate struct {
+		node     route.Vertex
+		capacity btcutil.Amount
+	}
+
+	var candidates []candidate
+	err := s.graphDB.ForEachNodeCached(context.TODO(),
+		func(node route.Vertex,
+			chans map[uint64]*graphdb.DirectedChannel) error {
+
+			var capacity btcutil.Amount
+			for _, channel := range chans {
+				capacity += channel.Capacity
+			}
+
+			candidates = append(candidates, candidate{
+				node:     node,
+				capacity: capacity,
+			})
+
+			return nil
+		}, func() {
+			candidates = nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(b.capacity, a.capacity)
+	})
+
+	destinations := make([]route.Vertex, 0, n)
+	for _, c := range candidates[:min(n, len(candidates))] {
+		destinations = append(destinations, c.node)
+	}
+
+	return destinations, nil
+}
+
 // fetchClosedChannelSCIDs returns a set of SCIDs that have their force closing
 // finished.
 func (s *server) fetchClosedChannelSCIDs() map[lnwire.ShortChannelID]struct{} {
@@ -5603,6 +6163,57 @@ func (s *server) attemptCoopRbfFeeBump(ctx context.Context,
 	return closeUpdates, nil
 }
 
+// SpliceChannel splices funds into or out of a channel with an online peer.
+// The txid of the splice transaction is returned once it was broadcast.
+func (s *server) SpliceChannel(ctx context.Context,
+	req peer.SpliceRequest) (chainhash.Hash, error) {
+
+	channel, err := s.chanStateDB.FetchChannel(req.ChanPoint)
+	if err != nil {
+		return chainhash.Hash{}, fmt.Errorf("unable to fetch channel: "+
+			"%w", err)
+	}
+
+	peerPub := channel.IdentityPub.SerializeCompressed()
+
+	s.mu.RLock()
+	targetPeer, ok := s.peersByPub[string(peerPub)]
+	s.mu.RUnlock()
+	if !ok {
+		return chainhash.Hash{}, fmt.Errorf("peer for "+
+			"ChannelPoint(%v) is not online", req.ChanPoint)
+	}
+
+	return targetPeer.Splice(ctx, req)
+}
+
+// ModifyChannelParams changes the parameters of the channel with the given
+// channel point through a dynamic commitment with its peer. If the channel was
+// reanchored, the txid of the kickoff transaction is returned.
+func (s *server) ModifyChannelParams(ctx context.Context,
+	chanPoint wire.OutPoint,
+	req dyncomm.Request) (fn.Option[chainhash.Hash], error) {
+
+	none := fn.None[chainhash.Hash]()
+
+	channel, err := s.chanStateDB.FetchChannel(chanPoint)
+	if err != nil {
+		return none, fmt.Errorf("unable to fetch channel: %w", err)
+	}
+
+	peerPub := channel.IdentityPub.SerializeCompressed()
+
+	s.mu.RLock()
+	targetPeer, ok := s.peersByPub[string(peerPub)]
+	s.mu.RUnlock()
+	if !ok {
+		return none, fmt.Errorf("peer for ChannelPoint(%v) is not "+
+			"online", chanPoint)
+	}
+
+	return targetPeer.ModifyChannelParams(ctx, chanPoint, req)
+}
+
 // AttemptRBFCloseUpdate attempts to trigger a new RBF iteration for a co-op
 // close update. This route it to be used only if the target channel in question
 // is no longer active in the link. This can happen when we restart while we
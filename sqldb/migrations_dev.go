@@ -17,4 +17,32 @@ var migrationAdditions = []MigrationConfig{
 		// schema. This is optional and can be disabled by the
 		// user if necessary.
 	},
+	{
+		Name:          "000008_payments",
+		Version:       10,
+		SchemaVersion: 8,
+	},
+	{
+		Name:          "kv_payments_migration",
+		Version:       11,
+		SchemaVersion: 8,
+		// A migration function may be attached to this
+		// migration to migrate KV payments to the native SQL
+		// schema. This is optional and can be disabled by the
+		// user if necessary.
+	},
+	{
+		Name:          "000009_forwarding_events",
+		Version:       12,
+		SchemaVersion: 9,
+	},
+	{
+		Name:          "kv_forwarding_log_migration",
+		Version:       13,
+		SchemaVersion: 9,
+		// A migration function may be attached to this
+		// migration to migrate the KV forwarding log to the
+		// native SQL schema. This is optional and can be
+		// disabled by the user if necessary.
+	},
 }
@@ -0,0 +1,15 @@
+-- Drop indexes.
+DROP INDEX IF EXISTS payments_payment_identifier_unique;
+DROP INDEX IF EXISTS payments_payment_identifier_idx;
+DROP INDEX IF EXISTS payments_status_idx;
+DROP INDEX IF EXISTS payments_created_at_idx;
+DROP INDEX IF EXISTS payment_first_hop_custom_records_unique;
+DROP INDEX IF EXISTS payment_htlc_attempts_unique;
+DROP INDEX IF EXISTS payment_htlc_attempts_payment_id_idx;
+
+-- Drop tables in order of reverse dependencies.
+DROP TABLE IF EXISTS payment_htlc_attempts;
+DROP TABLE IF EXISTS payment_rebalances;
+DROP TABLE IF EXISTS payment_first_hop_custom_records;
+DROP TABLE IF EXISTS payments;
+DROP TABLE IF EXISTS payment_sequences;
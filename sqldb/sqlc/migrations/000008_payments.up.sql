@@ -0,0 +1,139 @@
+-- payment_sequences contains all sequences used for payments.
+CREATE TABLE IF NOT EXISTS payment_sequences (
+    name TEXT PRIMARY KEY,
+    current_value BIGINT NOT NULL
+);
+
+-- Initialize a sequence for the payment index which is used to order payments
+-- by creation and remains compatible with the sequence numbers of the legacy
+-- channeldb implementation.
+INSERT INTO payment_sequences (name, current_value)
+VALUES ('payment_index', 0)
+    ON CONFLICT (name) DO NOTHING;
+
+-- payments contains all the information about an outgoing payment that is not
+-- specific to a single HTLC attempt.
+CREATE TABLE IF NOT EXISTS payments (
+    -- The id of the payment. This is the sequence number of the payment and
+    -- is always set explicitly from the payment_index sequence so that it is
+    -- compatible with the sequence numbers of the legacy KV store.
+    id INTEGER PRIMARY KEY,
+
+    -- The payment identifier. This is the payment hash for non-AMP payments
+    -- and the set ID for AMP payments.
+    payment_identifier BLOB NOT NULL,
+
+    -- The amount of the payment in millisatoshis.
+    amount_msat BIGINT NOT NULL,
+
+    -- The unix timestamp in nanoseconds of when the payment was created.
+    created_at BIGINT NOT NULL,
+
+    -- The full payment request, if any.
+    payment_request BLOB,
+
+    -- The current status of the payment. This is derived from the state of
+    -- the payment's HTLC attempts and its failure reason, and is stored so
+    -- that payments can be filtered by status without loading their attempts.
+    status SMALLINT NOT NULL,
+
+    -- The reason the payment failed. This is NULL as long as the payment has
+    -- not been marked as failed.
+    fail_reason SMALLINT,
+
+    -- Whether this payment is a duplicate payment to a payment identifier that
+    -- was made by an old version of lnd. These are only created by the KV to
+    -- SQL migration and are never written to otherwise.
+    legacy_duplicate BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+-- Only a single non-duplicate payment may exist for a given identifier.
+CREATE UNIQUE INDEX IF NOT EXISTS payments_payment_identifier_unique ON payments (
+    payment_identifier
+) WHERE legacy_duplicate = FALSE;
+CREATE INDEX IF NOT EXISTS payments_payment_identifier_idx ON payments(payment_identifier);
+CREATE INDEX IF NOT EXISTS payments_status_idx ON payments(status);
+CREATE INDEX IF NOT EXISTS payments_created_at_idx ON payments(created_at);
+
+-- payment_first_hop_custom_records contains the custom records that are sent
+-- to the first hop of a payment via the wire message.
+CREATE TABLE IF NOT EXISTS payment_first_hop_custom_records (
+    -- The custom type identifier for this record.
+    key BIGINT NOT NULL,
+
+    -- The custom value for this record.
+    value BLOB NOT NULL,
+
+    -- The payment this record belongs to.
+    payment_id BIGINT NOT NULL REFERENCES payments(id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS payment_first_hop_custom_records_unique ON payment_first_hop_custom_records (
+    payment_id, key
+);
+
+-- payment_rebalances marks the payments that are circular payments moving
+-- liquidity between two of our own channels.
+CREATE TABLE IF NOT EXISTS payment_rebalances (
+    -- The payment that is a rebalance.
+    payment_id BIGINT PRIMARY KEY REFERENCES payments(id) ON DELETE CASCADE,
+
+    -- The short channel ID of the channel the payment leaves through.
+    outgoing_chan_id BIGINT NOT NULL,
+
+    -- The short channel ID of the channel the payment returns through.
+    incoming_chan_id BIGINT NOT NULL
+);
+
+-- payment_htlc_attempts contains all the HTLC attempts that were made for a
+-- payment together with their outcome, if known.
+CREATE TABLE IF NOT EXISTS payment_htlc_attempts (
+    -- The db ID of the attempt. This is only used for DB level relations.
+    id INTEGER PRIMARY KEY,
+
+    -- The attempt ID that was assigned to this attempt by the router.
+    attempt_index BIGINT NOT NULL,
+
+    -- The payment this attempt belongs to.
+    payment_id BIGINT NOT NULL REFERENCES payments(id) ON DELETE CASCADE,
+
+    -- The ephemeral session key used for the onion of this attempt.
+    session_key BLOB NOT NULL,
+
+    -- The unix timestamp in nanoseconds of when this attempt was made.
+    attempt_time BIGINT NOT NULL,
+
+    -- The hash used for this attempt. For AMP payments this differs across
+    -- attempts. This can be NULL for attempts that were made by old versions
+    -- of lnd, in which case the payment identifier is the hash.
+    payment_hash BLOB,
+
+    -- The route that was attempted, serialized using the same encoding as
+    -- the legacy KV store.
+    route BLOB NOT NULL,
+
+    -- The preimage that settled this attempt. This is NULL if the attempt
+    -- was not settled.
+    settle_preimage BLOB,
+
+    -- The unix timestamp in nanoseconds of when this attempt was settled.
+    settle_time BIGINT,
+
+    -- The reason this attempt failed. This is NULL if the attempt did not
+    -- fail.
+    fail_reason SMALLINT,
+
+    -- The unix timestamp in nanoseconds of when this attempt failed.
+    fail_time BIGINT,
+
+    -- The encoded wire failure message that failed this attempt, if any.
+    fail_message BLOB,
+
+    -- The position in the path of the node that generated the failure.
+    fail_source_index INTEGER
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS payment_htlc_attempts_unique ON payment_htlc_attempts (
+    payment_id, attempt_index
+);
+CREATE INDEX IF NOT EXISTS payment_htlc_attempts_payment_id_idx ON payment_htlc_attempts(payment_id);
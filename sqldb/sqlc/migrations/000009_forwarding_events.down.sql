@@ -0,0 +1,10 @@
+-- Drop indexes.
+DROP INDEX IF EXISTS forwarding_events_timestamp_ns_unique;
+DROP INDEX IF EXISTS forwarding_events_incoming_chan_id_idx;
+DROP INDEX IF EXISTS forwarding_events_outgoing_chan_id_idx;
+DROP INDEX IF EXISTS forwarding_events_amt_in_msat_idx;
+DROP INDEX IF EXISTS forwarding_events_amt_out_msat_idx;
+DROP INDEX IF EXISTS forwarding_events_fee_msat_idx;
+
+-- Drop tables.
+DROP TABLE IF EXISTS forwarding_events;
@@ -0,0 +1,48 @@
+-- forwarding_events contains the time series of all HTLCs that were
+-- successfully forwarded by this node. Each event describes the settlement of
+-- a payment circuit, i.e. the incoming and outgoing HTLC that make up a
+-- forward.
+CREATE TABLE IF NOT EXISTS forwarding_events (
+    -- The id of the forwarding event.
+    id INTEGER PRIMARY KEY,
+
+    -- The unix timestamp in nanoseconds of when the payment circuit was
+    -- settled. Just like the key of the legacy KV forwarding log, this is
+    -- unique across all events.
+    timestamp_ns BIGINT NOT NULL,
+
+    -- The short channel ID of the channel the HTLC was received on.
+    incoming_chan_id BIGINT NOT NULL,
+
+    -- The short channel ID of the channel the HTLC was forwarded on.
+    outgoing_chan_id BIGINT NOT NULL,
+
+    -- The amount of the incoming HTLC in millisatoshis.
+    amt_in_msat BIGINT NOT NULL,
+
+    -- The amount of the outgoing HTLC in millisatoshis.
+    amt_out_msat BIGINT NOT NULL,
+
+    -- The fee earned for this forward in millisatoshis. This is always the
+    -- difference between the incoming and the outgoing amount and is stored
+    -- explicitly so that events can be filtered and aggregated by fee without
+    -- having to compute it for every row.
+    fee_msat BIGINT NOT NULL,
+
+    -- The ID of the incoming HTLC. This is NULL for events that were logged
+    -- before the HTLC IDs were recorded.
+    incoming_htlc_id BIGINT,
+
+    -- The ID of the outgoing HTLC. This is NULL for events that were logged
+    -- before the HTLC IDs were recorded.
+    outgoing_htlc_id BIGINT
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS forwarding_events_timestamp_ns_unique ON forwarding_events (
+    timestamp_ns
+);
+CREATE INDEX IF NOT EXISTS forwarding_events_incoming_chan_id_idx ON forwarding_events(incoming_chan_id, timestamp_ns);
+CREATE INDEX IF NOT EXISTS forwarding_events_outgoing_chan_id_idx ON forwarding_events(outgoing_chan_id, timestamp_ns);
+CREATE INDEX IF NOT EXISTS forwarding_events_amt_in_msat_idx ON forwarding_events(amt_in_msat);
+CREATE INDEX IF NOT EXISTS forwarding_events_amt_out_msat_idx ON forwarding_events(amt_out_msat);
+CREATE INDEX IF NOT EXISTS forwarding_events_fee_msat_idx ON forwarding_events(fee_msat);
@@ -28,6 +28,18 @@ type AmpSubInvoiceHtlc struct {
 	Preimage   []byte
 }
 
+type ForwardingEvent struct {
+	ID             int64
+	TimestampNs    int64
+	IncomingChanID int64
+	OutgoingChanID int64
+	AmtInMsat      int64
+	AmtOutMsat     int64
+	FeeMsat        int64
+	IncomingHtlcID sql.NullInt64
+	OutgoingHtlcID sql.NullInt64
+}
+
 type GraphChannel struct {
 	ID                int64
 	Version           int16
@@ -202,3 +214,47 @@ type MigrationTracker struct {
 	Version       int32
 	MigrationTime time.Time
 }
+
+type Payment struct {
+	ID                int64
+	PaymentIdentifier []byte
+	AmountMsat        int64
+	CreatedAt         int64
+	PaymentRequest    []byte
+	Status            int16
+	FailReason        sql.NullInt16
+	LegacyDuplicate   bool
+}
+
+type PaymentFirstHopCustomRecord struct {
+	Key       int64
+	Value     []byte
+	PaymentID int64
+}
+
+type PaymentHtlcAttempt struct {
+	ID              int64
+	AttemptIndex    int64
+	PaymentID       int64
+	SessionKey      []byte
+	AttemptTime     int64
+	PaymentHash     []byte
+	Route           []byte
+	SettlePreimage  []byte
+	SettleTime      sql.NullInt64
+	FailReason      sql.NullInt16
+	FailTime        sql.NullInt64
+	FailMessage     []byte
+	FailSourceIndex sql.NullInt32
+}
+
+type PaymentRebalance struct {
+	PaymentID      int64
+	OutgoingChanID int64
+	IncomingChanID int64
+}
+
+type PaymentSequence struct {
+	Name         string
+	CurrentValue int64
+}
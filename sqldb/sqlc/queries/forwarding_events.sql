@@ -0,0 +1,51 @@
+/* ─────────────────────────────────────────────
+   forwarding event queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: InsertForwardingEvent :execresult
+INSERT INTO forwarding_events (
+    timestamp_ns, incoming_chan_id, outgoing_chan_id, amt_in_msat,
+    amt_out_msat, fee_msat, incoming_htlc_id, outgoing_htlc_id
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+)
+ON CONFLICT (timestamp_ns) DO NOTHING;
+
+-- name: FilterForwardingEvents :many
+SELECT *
+FROM forwarding_events
+WHERE timestamp_ns >= @start_time
+  AND timestamp_ns <= @end_time
+  AND (
+    @filter_incoming_chans = FALSE OR
+    incoming_chan_id IN (sqlc.slice('incoming_chan_ids')/*SLICE:incoming_chan_ids*/)
+  )
+  AND (
+    @filter_outgoing_chans = FALSE OR
+    outgoing_chan_id IN (sqlc.slice('outgoing_chan_ids')/*SLICE:outgoing_chan_ids*/)
+  )
+ORDER BY timestamp_ns ASC
+LIMIT @num_limit OFFSET @num_offset;
+
+-- name: GetForwardingFeesPerChannelDay :many
+SELECT
+    outgoing_chan_id,
+    CAST(timestamp_ns / 86400000000000 AS BIGINT) AS day_index,
+    COUNT(*) AS num_forwards,
+    CAST(SUM(amt_in_msat) AS BIGINT) AS total_amt_in_msat,
+    CAST(SUM(amt_out_msat) AS BIGINT) AS total_amt_out_msat,
+    CAST(SUM(fee_msat) AS BIGINT) AS total_fee_msat
+FROM forwarding_events
+WHERE timestamp_ns >= @start_time
+  AND timestamp_ns <= @end_time
+  AND (
+    @filter_incoming_chans = FALSE OR
+    incoming_chan_id IN (sqlc.slice('incoming_chan_ids')/*SLICE:incoming_chan_ids*/)
+  )
+  AND (
+    @filter_outgoing_chans = FALSE OR
+    outgoing_chan_id IN (sqlc.slice('outgoing_chan_ids')/*SLICE:outgoing_chan_ids*/)
+  )
+GROUP BY outgoing_chan_id, day_index
+ORDER BY day_index ASC, outgoing_chan_id ASC;
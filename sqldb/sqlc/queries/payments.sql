@@ -0,0 +1,173 @@
+/* ─────────────────────────────────────────────
+   payment sequence queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: NextPaymentIndex :one
+UPDATE payment_sequences SET current_value = current_value + 1
+WHERE name = 'payment_index'
+RETURNING current_value;
+
+-- name: SetPaymentIndex :exec
+UPDATE payment_sequences SET current_value = $1
+WHERE name = 'payment_index' AND current_value < $1;
+
+/* ─────────────────────────────────────────────
+   payment queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: InsertPayment :exec
+INSERT INTO payments (
+    id, payment_identifier, amount_msat, created_at, payment_request,
+    status, fail_reason, legacy_duplicate
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8
+);
+
+-- name: GetPaymentByIdentifier :one
+SELECT *
+FROM payments
+WHERE payment_identifier = $1 AND legacy_duplicate = FALSE;
+
+-- name: GetPaymentsByIDs :many
+SELECT *
+FROM payments
+WHERE id IN (sqlc.slice('ids')/*SLICE:ids*/)
+ORDER BY id ASC;
+
+-- name: GetLegacyDuplicatePayments :many
+SELECT *
+FROM payments
+WHERE payment_identifier = $1 AND legacy_duplicate = TRUE
+ORDER BY id ASC;
+
+-- name: UpdatePaymentStatus :exec
+UPDATE payments
+SET status = $2
+WHERE id = $1;
+
+-- name: UpdatePaymentFailReason :exec
+UPDATE payments
+SET fail_reason = $2, status = $3
+WHERE id = $1;
+
+-- name: FetchPaymentsByStatus :many
+SELECT *
+FROM payments
+WHERE (status = @initiated_status OR status = @in_flight_status)
+    AND legacy_duplicate = FALSE
+ORDER BY id ASC;
+
+-- name: FilterPayments :many
+SELECT *
+FROM payments
+WHERE (
+    id > sqlc.narg('index_offset_get') OR
+    sqlc.narg('index_offset_get') IS NULL
+) AND (
+    id < sqlc.narg('index_offset_let') OR
+    sqlc.narg('index_offset_let') IS NULL
+) AND (
+    status = sqlc.narg('status') OR
+    sqlc.narg('status') IS NULL
+) AND (
+    created_at >= sqlc.narg('created_after') OR
+    sqlc.narg('created_after') IS NULL
+) AND (
+    created_at < sqlc.narg('created_before') OR
+    sqlc.narg('created_before') IS NULL
+)
+ORDER BY
+CASE
+    WHEN sqlc.narg('reverse') = FALSE OR sqlc.narg('reverse') IS NULL THEN id
+    ELSE NULL
+    END ASC,
+CASE
+    WHEN sqlc.narg('reverse') = TRUE THEN id
+    ELSE NULL
+END DESC
+LIMIT @num_limit;
+
+-- name: CountPayments :one
+SELECT COUNT(*)
+FROM payments;
+
+-- name: DeletePayment :exec
+DELETE FROM payments
+WHERE id = $1;
+
+-- name: DeletePaymentsByIdentifier :execresult
+DELETE FROM payments
+WHERE payment_identifier = $1;
+
+/* ─────────────────────────────────────────────
+   payment first hop custom record queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: InsertPaymentFirstHopCustomRecord :exec
+INSERT INTO payment_first_hop_custom_records (
+    payment_id, key, value
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetPaymentFirstHopCustomRecordsByPaymentIDs :many
+SELECT *
+FROM payment_first_hop_custom_records
+WHERE payment_id IN (sqlc.slice('payment_ids')/*SLICE:payment_ids*/);
+
+/* ─────────────────────────────────────────────
+   payment htlc attempt queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: InsertPaymentHTLCAttempt :exec
+INSERT INTO payment_htlc_attempts (
+    attempt_index, payment_id, session_key, attempt_time, payment_hash,
+    route, settle_preimage, settle_time, fail_reason, fail_time,
+    fail_message, fail_source_index
+) VALUES (
+    $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+);
+
+-- name: GetPaymentHTLCAttemptsByPaymentIDs :many
+SELECT *
+FROM payment_htlc_attempts
+WHERE payment_id IN (sqlc.slice('payment_ids')/*SLICE:payment_ids*/)
+ORDER BY payment_id ASC, attempt_index ASC;
+
+-- name: SettlePaymentHTLCAttempt :execresult
+UPDATE payment_htlc_attempts
+SET settle_preimage = $3, settle_time = $4
+WHERE payment_id = $1 AND attempt_index = $2
+    AND settle_preimage IS NULL AND fail_reason IS NULL;
+
+-- name: FailPaymentHTLCAttempt :execresult
+UPDATE payment_htlc_attempts
+SET fail_reason = $3, fail_time = $4, fail_message = $5,
+    fail_source_index = $6
+WHERE payment_id = $1 AND attempt_index = $2
+    AND settle_preimage IS NULL AND fail_reason IS NULL;
+
+-- name: DeleteFailedPaymentHTLCAttempts :exec
+DELETE FROM payment_htlc_attempts
+WHERE payment_id = $1 AND fail_reason IS NOT NULL;
+
+/* ─────────────────────────────────────────────
+   payment rebalance queries
+   ─────────────────────────────────────────────
+*/
+
+-- name: InsertPaymentRebalance :exec
+INSERT INTO payment_rebalances (
+    payment_id, outgoing_chan_id, incoming_chan_id
+) VALUES (
+    $1, $2, $3
+);
+
+-- name: GetPaymentRebalancesByPaymentIDs :many
+SELECT *
+FROM payment_rebalances
+WHERE payment_id IN (sqlc.slice('payment_ids')/*SLICE:payment_ids*/);
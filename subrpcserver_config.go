@@ -12,14 +12,17 @@ import (
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/chainreg"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/feeautopilot"
 	"github.com/lightningnetwork/lnd/fn/v2"
 	graphdb "github.com/lightningnetwork/lnd/graph/db"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/invoices"
+	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnrpc/autopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/chainrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/devrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/feeautopilotrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/invoicesrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/neutrinorpc"
 	"github.com/lightningnetwork/lnd/lnrpc/peersrpc"
@@ -32,6 +35,8 @@ import (
 	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/netann"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/routing/blindedpath"
+	"github.com/lightningnetwork/lnd/routing/route"
 	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/watchtower"
 	"github.com/lightningnetwork/lnd/watchtower/wtclient"
@@ -59,6 +64,10 @@ type subRPCServerConfigs struct {
 	// autopilot as a gRPC service.
 	AutopilotRPC *autopilotrpc.Config `group:"autopilotrpc" namespace:"autopilotrpc"`
 
+	// FeeAutopilotRPC is a sub-RPC server that exposes methods on the
+	// running fee autopilot as a gRPC service.
+	FeeAutopilotRPC *feeautopilotrpc.Config `group:"feeautopilotrpc" namespace:"feeautopilotrpc"`
+
 	// ChainRPC is a sub-RPC server that exposes functionality allowing a
 	// client to be notified of certain on-chain events (new blocks,
 	// confirmations, spends).
@@ -108,12 +117,15 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 	cc *chainreg.ChainControl,
 	networkDir string, macService *macaroons.Service,
 	atpl *autopilot.Manager,
+	feeAutopilot *feeautopilot.Manager,
 	invoiceRegistry *invoices.InvoiceRegistry,
 	htlcSwitch *htlcswitch.Switch,
 	activeNetParams *chaincfg.Params,
 	chanRouter *routing.ChannelRouter,
 	routerBackend *routerrpc.RouterBackend,
 	nodeSigner *netann.NodeSigner,
+	nodeKeyECDH keychain.SingleKeyECDH,
+	nodeKeyLoc keychain.KeyLocator,
 	graphDB *graphdb.ChannelGraph,
 	chanStateDB *channeldb.ChannelStateDB,
 	sweeper *sweep.UtxoSweeper,
@@ -220,6 +232,13 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 				reflect.ValueOf(atpl),
 			)
 
+		case *feeautopilotrpc.Config:
+			subCfgValue := extractReflectValue(subCfg)
+
+			subCfgValue.FieldByName("Manager").Set(
+				reflect.ValueOf(feeAutopilot),
+			)
+
 		case *chainrpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
 
@@ -294,6 +313,63 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config,
 				reflect.ValueOf(parseAuxData),
 			)
 
+			// The blinded payment paths of BOLT 12 invoices are
+			// built with the globally configured restrictions.
+			bpConfig := cfg.Routing.BlindedPaths
+			restrictions := &routing.BlindedPathRestrictions{
+				MinDistanceFromIntroNode: bpConfig.
+					MinNumRealHops,
+				NumHops:         bpConfig.NumHops,
+				MaxNumPaths:     bpConfig.MaxNumPaths,
+				NodeOmissionSet: fn.NewSet[route.Vertex](),
+			}
+			queryBlindedRoutes := func(amt lnwire.MilliSatoshi) (
+				[]*route.Route, error) {
+
+				return chanRouter.FindBlindedPaths(
+					routerBackend.SelfNode, amt,
+					routerBackend.MissionControl.
+						GetProbability,
+					restrictions,
+				)
+			}
+			subCfgValue.FieldByName("BestHeight").Set(
+				reflect.ValueOf(cc.BestBlockTracker.BestHeight),
+			)
+			subCfgValue.FieldByName("QueryBlindedRoutes").Set(
+				reflect.ValueOf(queryBlindedRoutes),
+			)
+
+			blindedPathCfg := &invoicesrpc.BlindedPathConfig{
+				RoutePolicyIncrMultiplier: bpConfig.
+					PolicyIncreaseMultiplier,
+				RoutePolicyDecrMultiplier: bpConfig.
+					PolicyDecreaseMultiplier,
+				DefaultDummyHopPolicy: &blindedpath.
+					BlindedHopPolicy{
+
+					CLTVExpiryDelta: uint16(defaultDelta),
+					FeeRate: uint32(
+						cfg.Bitcoin.FeeRate,
+					),
+					BaseFee:     cfg.Bitcoin.BaseFee,
+					MinHTLCMsat: cfg.Bitcoin.MinHTLCIn,
+				},
+				MinNumPathHops: bpConfig.NumHops,
+			}
+			subCfgValue.FieldByName("BlindedPathCfg").Set(
+				reflect.ValueOf(blindedPathCfg),
+			)
+			subCfgValue.FieldByName("NodeKeyECDH").Set(
+				reflect.ValueOf(nodeKeyECDH),
+			)
+			subCfgValue.FieldByName("NodeKeyLoc").Set(
+				reflect.ValueOf(nodeKeyLoc),
+			)
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.KeyRing),
+			)
+
 		case *neutrinorpc.Config:
 			subCfgValue := extractReflectValue(subCfg)
 
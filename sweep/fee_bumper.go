@@ -387,6 +387,14 @@ type TxPublisher struct {
 	// the chan that the publisher sends the fee bump result to.
 	subscriberChans lnutils.SyncMap[uint64, chan *BumpResult]
 
+	// feeBumpAttempts is the number of replacement txes the publisher
+	// tried to create after increasing their fee rate.
+	feeBumpAttempts atomic.Uint64
+
+	// feeBumpReplacements is the number of replacement txes the publisher
+	// successfully published.
+	feeBumpReplacements atomic.Uint64
+
 	// quit is used to signal the publisher to stop.
 	quit chan struct{}
 }
@@ -397,6 +405,18 @@ var _ Bumper = (*TxPublisher)(nil)
 // Compile-time check for the chainio.Consumer interface.
 var _ chainio.Consumer = (*TxPublisher)(nil)
 
+// FeeBumpStats holds the number of fee bumps performed by the TxPublisher
+// since it was created.
+type FeeBumpStats struct {
+	// Attempts is the number of replacement txes the publisher tried to
+	// create after increasing their fee rate.
+	Attempts uint64
+
+	// Replacements is the number of replacement txes the publisher
+	// successfully published.
+	Replacements uint64
+}
+
 // NewTxPublisher creates a new TxPublisher.
 func NewTxPublisher(cfg TxPublisherConfig) *TxPublisher {
 	tp := &TxPublisher{
@@ -474,6 +494,15 @@ func (t *TxPublisher) updateRecord(r *monitorRecord,
 	return r
 }
 
+// FeeBumpStats returns the number of fee bumps the publisher performed since
+// it was created.
+func (t *TxPublisher) FeeBumpStats() FeeBumpStats {
+	return FeeBumpStats{
+		Attempts:     t.feeBumpAttempts.Load(),
+		Replacements: t.feeBumpReplacements.Load(),
+	}
+}
+
 // NOTE: part of the `chainio.Consumer` interface.
 func (t *TxPublisher) Name() string {
 	return "TxPublisher"
@@ -1299,6 +1328,8 @@ func (t *TxPublisher) createUnknownSpentBumpResult(
 func (t *TxPublisher) createAndPublishTx(
 	r *monitorRecord) fn.Option[BumpResult] {
 
+	t.feeBumpAttempts.Add(1)
+
 	// Fetch the old tx.
 	oldTx := r.tx
 
@@ -1356,6 +1387,7 @@ func (t *TxPublisher) createAndPublishTx(
 
 	// Otherwise, it's a successful RBF, set the event and return.
 	result.Event = TxReplaced
+	t.feeBumpReplacements.Add(1)
 
 	return fn.Some(*result)
 }
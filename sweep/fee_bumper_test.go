@@ -1188,6 +1188,9 @@ func TestCreateAnPublishFail(t *testing.T) {
 	// Call the createAndPublish method and expect a none option.
 	resultOpt = tp.createAndPublishTx(record)
 	require.True(t, resultOpt.IsNone())
+
+	// Each call is counted as an attempt, but none replaced the tx.
+	require.Equal(t, FeeBumpStats{Attempts: 3}, tp.FeeBumpStats())
 }
 
 // TestCreateAnPublishSuccess checks the expected result is returned from the
@@ -1258,6 +1261,8 @@ func TestCreateAnPublishSuccess(t *testing.T) {
 
 	// We expect the result to be TxReplaced and the error is nil.
 	require.Equal(t, TxReplaced, result.Event)
+	require.Equal(t, FeeBumpStats{Attempts: 2, Replacements: 1},
+		tp.FeeBumpStats())
 	require.Nil(t, result.Err)
 
 	// Check the Tx and ReplacedTx are set.
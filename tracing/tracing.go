@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	// instrumentationPrefix is the prefix of the names of the tracers of
+	// lnd's subsystems.
+	instrumentationPrefix = "github.com/lightningnetwork/lnd/"
+)
+
+// Config holds the parameters of the traces exported to an OTLP collector.
+type Config struct {
+	// Endpoint is the host:port of the OTLP gRPC collector.
+	Endpoint string
+
+	// Insecure disables the encryption of the connection to the
+	// collector.
+	Insecure bool
+
+	// SampleRatio is the ratio of traces started by lnd that are sampled.
+	SampleRatio float64
+
+	// ServiceName is the service name spans are reported under.
+	ServiceName string
+
+	// ServiceVersion is the version of lnd reported with the spans.
+	ServiceVersion string
+}
+
+// Setup installs a global tracer provider that exports spans to the OTLP
+// collector of the given config, and a propagator for W3C trace context. The
+// returned function flushes all pending spans and must be called on
+// shutdown.
+func Setup(ctx context.Context,
+	cfg *Config) (func(context.Context) error, error) {
+
+	opts := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		opts = append(opts, otlptracegrpc.WithInsecure())
+	}
+
+	// The exporter connects to the collector lazily, so an unreachable
+	// collector doesn't prevent lnd from starting.
+	exporter, err := otlptracegrpc.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create OTLP exporter: %w",
+			err)
+	}
+
+	provider := newProvider(
+		sdktrace.WithBatcher(exporter), cfg.SampleRatio,
+		resource.NewSchemaless(
+			attribute.String("service.name", cfg.ServiceName),
+			attribute.String("service.version", cfg.ServiceVersion),
+		),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Warnf("Unable to export spans: %v", err)
+	}))
+
+	log.Infof("Exporting traces to %v with sample ratio %v",
+		cfg.Endpoint, cfg.SampleRatio)
+
+	return provider.Shutdown, nil
+}
+
+// newProvider creates a tracer provider that hands its spans to the given
+// span processor. Traces started by lnd are sampled with the given ratio,
+// while the sampling decision of a remote parent is always honored.
+func newProvider(processor sdktrace.TracerProviderOption, sampleRatio float64,
+	res *resource.Resource) *sdktrace.TracerProvider {
+
+	sampler := sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))
+
+	return sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(res),
+	)
+}
+
+// Tracer returns the tracer of the given subsystem of lnd. Until Setup is
+// called, the returned tracer creates no-op spans.
+func Tracer(subsystem string) trace.Tracer {
+	return otel.Tracer(instrumentationPrefix + subsystem)
+}
+
+// RecordError records the given error on the span and marks the span as
+// failed, if the error isn't nil.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// End records the given error on the span, if it isn't nil, and ends the
+// span.
+func End(span trace.Span, err error) {
+	RecordError(span, err)
+	span.End()
+}
+
+// ServerOption returns a gRPC server option that creates a span for each RPC
+// call, as the child of the trace context in the metadata of the call if the
+// caller sent one.
+func ServerOption() grpc.ServerOption {
+	return grpc.StatsHandler(otelgrpc.NewServerHandler())
+}
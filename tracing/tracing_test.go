@@ -0,0 +1,85 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TestEnd tests that errors are recorded on the span before it's ended.
+func TestEnd(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := newProvider(
+		sdktrace.WithSpanProcessor(recorder), 1, resource.Empty(),
+	)
+	tracer := provider.Tracer("test")
+
+	_, span := tracer.Start(context.Background(), "ok")
+	End(span, nil)
+
+	_, span = tracer.Start(context.Background(), "failed")
+	End(span, errors.New("no route"))
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	require.Equal(t, "ok", spans[0].Name())
+	require.Equal(t, codes.Unset, spans[0].Status().Code)
+	require.Empty(t, spans[0].Events())
+
+	require.Equal(t, "failed", spans[1].Name())
+	require.Equal(t, codes.Error, spans[1].Status().Code)
+	require.Equal(t, "no route", spans[1].Status().Description)
+	require.Len(t, spans[1].Events(), 1)
+}
+
+// TestSampling tests that traces started by lnd are sampled with the
+// configured ratio, while the sampling decision of a remote caller is always
+// honored.
+func TestSampling(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := newProvider(
+		sdktrace.WithSpanProcessor(recorder), 0, resource.Empty(),
+	)
+	tracer := provider.Tracer("test")
+
+	// With a sample ratio of zero, none of our own traces are sampled.
+	_, span := tracer.Start(context.Background(), "local")
+	span.End()
+	require.Empty(t, recorder.Ended())
+
+	// A trace context sent by a caller that sampled the trace is
+	// extracted from the metadata, and the trace is continued.
+	const traceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-" +
+		"00f067aa0ba902b7-01"
+	carrier := propagation.MapCarrier{"traceparent": traceParent}
+	ctx := propagation.TraceContext{}.Extract(
+		context.Background(), carrier,
+	)
+
+	_, span = tracer.Start(ctx, "remote")
+	span.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(
+		t, "4bf92f3577b34da6a3ce929d0e0e4736",
+		spans[0].SpanContext().TraceID().String(),
+	)
+	require.Equal(
+		t, "00f067aa0ba902b7", spans[0].Parent().SpanID().String(),
+	)
+	require.Equal(t, trace.SpanKindInternal, spans[0].SpanKind())
+}